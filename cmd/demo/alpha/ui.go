@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"github.com/zodimo/go-compose/compose/foundation/layout/arrangement"
 
 	"github.com/zodimo/go-compose/compose/foundation/layout/box"
 	"github.com/zodimo/go-compose/compose/foundation/layout/column"
@@ -41,7 +42,7 @@ func UI() api.Composable {
 					),
 				),
 			),
-			column.WithSpacing(column.SpaceSides),
+			column.WithVerticalArrangement(arrangement.Center),
 			column.WithAlignment(column.Middle),
 			column.WithModifier(size.FillMax()),
 		)(c)
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/zodimo/go-compose/compose"
+	"github.com/zodimo/go-compose/compose/foundation/layout/box"
+	"github.com/zodimo/go-compose/compose/foundation/layout/row"
+	"github.com/zodimo/go-compose/compose/foundation/lazy"
+	"github.com/zodimo/go-compose/compose/material3/appbar"
+	"github.com/zodimo/go-compose/compose/material3/bottomappbar"
+	"github.com/zodimo/go-compose/compose/material3/button"
+	"github.com/zodimo/go-compose/compose/material3/iconbutton"
+	"github.com/zodimo/go-compose/compose/material3/scaffold"
+	"github.com/zodimo/go-compose/compose/material3/text"
+	"github.com/zodimo/go-compose/compose/ui/input/nestedscroll"
+	"github.com/zodimo/go-compose/modifiers/padding"
+	"github.com/zodimo/go-compose/modifiers/size"
+	"github.com/zodimo/go-compose/pkg/api"
+
+	"gioui.org/layout"
+	mdicons "golang.org/x/exp/shiny/materialdesign/icons"
+)
+
+var behaviorNames = []string{"Pinned", "Enter always", "Exit until collapsed"}
+
+func UI() api.Composable {
+	return func(c api.Composer) api.Composer {
+		selected := c.State("behavior", func() any { return 2 })
+
+		// Each behavior keeps its own state, so all are remembered on every
+		// composition.
+		behaviors := []appbar.TopAppBarScrollBehavior{
+			appbar.TopAppBarDefaults.PinnedScrollBehavior(c),
+			appbar.TopAppBarDefaults.EnterAlwaysScrollBehavior(c),
+			appbar.TopAppBarDefaults.ExitUntilCollapsedScrollBehavior(c),
+		}
+		topBehavior := behaviors[selected.Get().(int)]
+		bottomBehavior := bottomappbar.BottomAppBarDefaults.ExitAlwaysScrollBehavior(c)
+
+		title := text.TextWithStyle(behaviorNames[selected.Get().(int)], text.TypestyleHeadlineMedium)
+		var topBar api.Composable
+		if topBehavior.IsPinned() {
+			topBar = appbar.TopAppBar(title, appbar.WithScrollBehavior(topBehavior))
+		} else {
+			topBar = appbar.LargeTopAppBar(title, appbar.WithScrollBehavior(topBehavior))
+		}
+
+		pickers := make([]api.Composable, len(behaviorNames))
+		for i, name := range behaviorNames {
+			pick := func() { selected.Set(i) }
+			if i == selected.Get().(int) {
+				pickers[i] = button.Filled(pick, name)
+			} else {
+				pickers[i] = button.Text(pick, name)
+			}
+		}
+
+		content := lazy.LazyColumn(func(scope lazy.LazyListScope) {
+			scope.Item("pickers", row.Row(
+				compose.Sequence(pickers...),
+				row.WithModifier(padding.All(8)),
+			))
+			scope.Items(50, func(i int) any { return i }, func(i int) api.Composable {
+				return box.Box(
+					text.TextWithStyle(fmt.Sprintf("Item %d", i+1), text.TypestyleBodyLarge),
+					box.WithAlignment(layout.W),
+					box.WithModifier(size.FillMaxWidth().Then(padding.Padding(24, 12, 24, 12))),
+				)
+			})
+		})
+
+		return scaffold.Scaffold(
+			content,
+			// The scrolling of the list collapses the top app bar and hides
+			// the bottom app bar.
+			scaffold.WithModifier(
+				nestedscroll.NestedScroll(topBehavior.NestedScrollConnection(), nil).
+					Then(nestedscroll.NestedScroll(bottomBehavior.NestedScrollConnection(), nil)),
+			),
+			scaffold.WithTopBar(topBar),
+			scaffold.WithBottomBar(
+				bottomappbar.BottomAppBar(
+					row.Row(
+						compose.Sequence(
+							iconbutton.Standard(func() {}, mdicons.ActionSearch, "Search"),
+							iconbutton.Standard(func() {}, mdicons.SocialShare, "Share"),
+						),
+					),
+					bottomappbar.WithScrollBehavior(bottomBehavior),
+				),
+			),
+		)(c)
+	}
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/zodimo/go-compose/compose"
+	"github.com/zodimo/go-compose/compose/foundation/layout/box"
+	"github.com/zodimo/go-compose/compose/foundation/layout/column"
+	"github.com/zodimo/go-compose/compose/foundation/layout/spacer"
+	"github.com/zodimo/go-compose/compose/foundation/lazy"
+	"github.com/zodimo/go-compose/compose/material3/appbar"
+	"github.com/zodimo/go-compose/compose/material3/bottomsheet"
+	"github.com/zodimo/go-compose/compose/material3/button"
+	"github.com/zodimo/go-compose/compose/material3/text"
+	"github.com/zodimo/go-compose/modifiers/padding"
+	"github.com/zodimo/go-compose/modifiers/size"
+	"github.com/zodimo/go-compose/pkg/api"
+
+	"gioui.org/layout"
+)
+
+func UI() api.Composable {
+	return func(c api.Composer) api.Composer {
+		locked := c.State("locked", func() any { return false })
+		// The sheet can be hidden, and dragging it does not collapse it while
+		// locked.
+		sheetState := bottomsheet.RememberStandardBottomSheetState(c,
+			bottomsheet.WithSkipHiddenState(false),
+			bottomsheet.WithConfirmValueChange(func(value bottomsheet.SheetValue) bool {
+				return !locked.Get().(bool) || value == bottomsheet.Expanded
+			}),
+		)
+
+		// The list in the sheet drags the sheet up until it is expanded,
+		// then scrolls; scrolled back to its top, it drags the sheet down.
+		sheetContent := column.Column(
+			compose.Sequence(
+				text.TextWithStyle("Swipe up to expand the sheet", text.TypestyleTitleMedium),
+				spacer.Height(16),
+				lazy.LazyColumn(func(scope lazy.LazyListScope) {
+					scope.Items(30, func(i int) any { return i }, func(i int) api.Composable {
+						return box.Box(
+							text.TextWithStyle(fmt.Sprintf("Sheet item %d", i+1), text.TypestyleBodyLarge),
+							box.WithAlignment(layout.W),
+							box.WithModifier(size.FillMaxWidth().Then(padding.Padding(24, 12, 24, 12))),
+						)
+					})
+				}),
+			),
+			column.WithAlignment(column.Middle),
+			column.WithModifier(size.FillMaxWidth()),
+		)
+
+		lockLabel := "Lock expanded"
+		if locked.Get().(bool) {
+			lockLabel = "Unlock"
+		}
+		content := column.Column(
+			compose.Sequence(
+				text.TextWithStyle(fmt.Sprintf("Sheet: %v", sheetState.CurrentValue()), text.TypestyleBodyLarge),
+				spacer.Height(16),
+				button.Filled(sheetState.Expand, "Expand"),
+				button.FilledTonal(sheetState.PartialExpand, "Partially expand"),
+				button.Outlined(sheetState.Hide, "Hide"),
+				button.Text(func() {
+					locked.Set(!locked.Get().(bool))
+					if locked.Get().(bool) {
+						sheetState.Expand()
+					}
+				}, lockLabel),
+			),
+			column.WithModifier(padding.All(24)),
+		)
+
+		return bottomsheet.BottomSheetScaffold(
+			sheetContent,
+			content,
+			bottomsheet.WithScaffoldSheetState(sheetState),
+			bottomsheet.WithSheetPeekHeight(128),
+			bottomsheet.WithScaffoldTopBar(
+				appbar.TopAppBar(
+					text.TextWithStyle("Bottom Sheet Scaffold Demo", text.TypestyleTitleMedium),
+				),
+			),
+		)(c)
+	}
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/zodimo/go-compose/compose/foundation/layout/box"
+	"github.com/zodimo/go-compose/compose/foundation/layout/column"
+	"github.com/zodimo/go-compose/compose/foundation/layout/row"
+	"github.com/zodimo/go-compose/compose/foundation/layout/spacer"
+	"github.com/zodimo/go-compose/compose/material3"
+	"github.com/zodimo/go-compose/compose/material3/button"
+	"github.com/zodimo/go-compose/compose/material3/carousel"
+	"github.com/zodimo/go-compose/compose/material3/text"
+	"github.com/zodimo/go-compose/compose/ui/graphics"
+	"github.com/zodimo/go-compose/modifiers/background"
+	"github.com/zodimo/go-compose/modifiers/padding"
+	"github.com/zodimo/go-compose/modifiers/size"
+	"github.com/zodimo/go-compose/pkg/api"
+)
+
+var swatches = []graphics.Color{
+	graphics.NewColorSrgb(239, 83, 80, 255),
+	graphics.NewColorSrgb(171, 71, 188, 255),
+	graphics.NewColorSrgb(92, 107, 192, 255),
+	graphics.NewColorSrgb(41, 182, 246, 255),
+	graphics.NewColorSrgb(38, 166, 154, 255),
+	graphics.NewColorSrgb(156, 204, 101, 255),
+	graphics.NewColorSrgb(255, 202, 40, 255),
+	graphics.NewColorSrgb(255, 112, 67, 255),
+	graphics.NewColorSrgb(141, 110, 99, 255),
+	graphics.NewColorSrgb(120, 144, 156, 255),
+}
+
+func UI() api.Composable {
+	return func(c api.Composer) api.Composer {
+		multiBrowse := carousel.RememberCarouselState(c, 0, func() int { return len(swatches) })
+		uncontained := carousel.RememberCarouselState(c, 0, func() int { return len(swatches) })
+		hero := carousel.RememberCarouselState(c, 0, func() int { return len(swatches) })
+
+		return column.Column(
+			c.Sequence(
+				text.TextWithStyle("Multi-browse", text.TypestyleTitleMedium),
+				spacer.Height(8),
+				carousel.HorizontalMultiBrowseCarousel(multiBrowse, 186, swatch,
+					carousel.WithItemSpacing(8),
+					carousel.WithModifier(size.FillMaxWidth().Then(size.Height(221))),
+				),
+				spacer.Height(24),
+				text.TextWithStyle("Uncontained", text.TypestyleTitleMedium),
+				spacer.Height(8),
+				carousel.HorizontalUncontainedCarousel(uncontained, 240, swatch,
+					carousel.WithItemSpacing(8),
+					carousel.WithModifier(size.FillMaxWidth().Then(size.Height(160))),
+				),
+				spacer.Height(24),
+				text.TextWithStyle("Centered hero", text.TypestyleTitleMedium),
+				spacer.Height(8),
+				carousel.HorizontalCenteredHeroCarousel(hero, swatch,
+					carousel.WithItemSpacing(8),
+					carousel.WithMaxItemWidth(480),
+					carousel.WithModifier(size.FillMaxWidth().Then(size.Height(221))),
+				),
+				spacer.Height(8),
+				row.Row(
+					c.Sequence(
+						button.Text(func() { hero.AnimateScrollToItem(hero.CurrentItem() - 1) }, "Previous"),
+						text.TextWithStyle(fmt.Sprintf("Item %d of %d", hero.CurrentItem()+1, hero.ItemCount()), text.TypestyleBodyMedium),
+						button.Text(func() { hero.AnimateScrollToItem(hero.CurrentItem() + 1) }, "Next"),
+					),
+					row.WithAlignment(row.Middle),
+				),
+			),
+			column.WithModifier(padding.All(16)),
+		)(c)
+	}
+}
+
+// swatch is an item of the carousels, a color with its number.
+func swatch(scope carousel.CarouselItemScope, item int) api.Composable {
+	return box.Box(
+		text.TextWithStyle(fmt.Sprintf("%d", item+1), text.TypestyleHeadlineMedium),
+		box.WithAlignment(box.Center),
+		box.WithModifier(size.FillMax().
+			Then(scope.MaskClip(material3.ShapeExtraLarge)).
+			Then(background.Background(swatches[item%len(swatches)]))),
+	)
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/zodimo/go-compose/compose/foundation/layout/column"
+	"github.com/zodimo/go-compose/compose/foundation/layout/row"
+	"github.com/zodimo/go-compose/compose/foundation/layout/spacer"
+	"github.com/zodimo/go-compose/compose/material3/button"
+	"github.com/zodimo/go-compose/compose/material3/datepicker"
+	"github.com/zodimo/go-compose/compose/material3/text"
+	"github.com/zodimo/go-compose/modifiers/padding"
+	"github.com/zodimo/go-compose/pkg/api"
+	"github.com/zodimo/go-compose/state"
+)
+
+func UI() api.Composable {
+	return func(c api.Composer) api.Composer {
+		// Weekends cannot be picked.
+		weekdays := datepicker.SelectableDatesFunc(func(date time.Time) bool {
+			return date.Weekday() != time.Saturday && date.Weekday() != time.Sunday
+		})
+		dateState := datepicker.RememberDatePickerState(c, datepicker.WithSelectableDates(weekdays))
+		rangeState := datepicker.RememberDateRangePickerState(c, datepicker.WithYearRange(2020, 2030))
+		dialogState := datepicker.RememberDatePickerState(c)
+		showDialog := state.MustState(c, "show_date_picker_dialog", func() bool { return false })
+		confirmed := state.MustState(c, "confirmed_date", func() time.Time { return time.Time{} })
+
+		confirmedText := "No date confirmed"
+		if !confirmed.Get().IsZero() {
+			confirmedText = fmt.Sprintf("Confirmed: %s", confirmed.Get().Format("2006-01-02"))
+		}
+
+		return column.Column(
+			c.Sequence(
+				text.TextWithStyle("Date Picker Demo", text.TypestyleTitleLarge),
+				spacer.Height(16),
+				row.Row(
+					c.Sequence(
+						datepicker.DatePicker(dateState),
+						spacer.Width(24),
+						datepicker.DateRangePicker(rangeState),
+					),
+				),
+				spacer.Height(16),
+				row.Row(
+					c.Sequence(
+						button.Filled(func() { showDialog.Set(true) }, "Open date picker dialog"),
+						spacer.Width(16),
+						text.TextWithStyle(confirmedText, text.TypestyleBodyMedium),
+					),
+					row.WithAlignment(row.Middle),
+				),
+				c.When(showDialog.Get(),
+					datepicker.DatePickerDialog(
+						func() { showDialog.Set(false) },
+						button.Text(func() {
+							confirmed.Set(dialogState.SelectedDate())
+							showDialog.Set(false)
+						}, "OK"),
+						datepicker.DatePicker(dialogState),
+						datepicker.WithDismissButton(button.Text(func() { showDialog.Set(false) }, "Cancel")),
+					),
+				),
+			),
+			column.WithModifier(padding.All(24)),
+		)(c)
+	}
+}
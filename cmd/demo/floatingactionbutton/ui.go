@@ -3,11 +3,17 @@ package main
 import (
 	"fmt"
 
-	"github.com/zodimo/go-compose/compose/foundation/icon"
+	"github.com/zodimo/go-compose/compose"
 	"github.com/zodimo/go-compose/compose/foundation/layout/box"
+	"github.com/zodimo/go-compose/compose/foundation/layout/column"
+	"github.com/zodimo/go-compose/compose/foundation/layout/row"
+	"github.com/zodimo/go-compose/compose/foundation/lazy"
+	"github.com/zodimo/go-compose/compose/material3/button"
 	"github.com/zodimo/go-compose/compose/material3/floatingactionbutton"
+	"github.com/zodimo/go-compose/compose/material3/icon"
 	"github.com/zodimo/go-compose/compose/material3/scaffold"
 	"github.com/zodimo/go-compose/compose/material3/text"
+	"github.com/zodimo/go-compose/modifiers/padding"
 	"github.com/zodimo/go-compose/modifiers/size"
 	"github.com/zodimo/go-compose/pkg/api"
 
@@ -15,34 +21,113 @@ import (
 	"golang.org/x/exp/shiny/materialdesign/icons"
 )
 
+var fabPositions = []struct {
+	name     string
+	position scaffold.FabPosition
+}{
+	{"End", scaffold.FabPositionEnd},
+	{"Center", scaffold.FabPositionCenter},
+	{"Start", scaffold.FabPositionStart},
+}
+
 func UI() api.Composable {
 	return func(c api.Composer) api.Composer {
-		// State for click count
 		count := c.State("click_count", func() any { return 0 })
+		menuMode := c.State("menu_mode", func() any { return false })
+		menuExpanded := c.State("menu_expanded", func() any { return false })
+		position := c.State("fab_position", func() any { return 0 })
+		listState := lazy.RememberLazyListState(c)
 
-		return scaffold.Scaffold(
-			// Content
-			func(c api.Composer) api.Composer {
-				return box.Box(
-					text.TextWithStyle(
-						fmt.Sprintf("FAB Clicked: %d", count.Get().(int)),
-						text.TypestyleBodyLarge, // Added Typestyle
-					),
-					box.WithAlignment(layout.Center),
-					box.WithModifier(size.FillMax()),
-				)(c)
-			},
-			// FAB
-			scaffold.WithFloatingActionButton(
-				floatingactionbutton.FloatingActionButton(
+		onClick := func() {
+			count.Set(count.Get().(int) + 1)
+		}
+
+		// The extended FAB collapses once the list is scrolled, and the FAB
+		// menu replaces it in menu mode.
+		fab := floatingactionbutton.ExtendedFloatingActionButton(
+			onClick,
+			text.TextWithStyle("Compose", text.TypestyleLabelLarge),
+			icon.Icon(icon.IconBytes(icons.ContentCreate)),
+			listState.FirstVisibleItemIndex() == 0,
+		)
+		if menuMode.Get().(bool) {
+			expanded := menuExpanded.Get().(bool)
+			menuItem := func(label string, data []byte) api.Composable {
+				return floatingactionbutton.FloatingActionButtonMenuItem(
 					func() {
-						current := count.Get().(int)
-						count.Set(current + 1)
-						fmt.Println("FAB Clicked!")
+						onClick()
+						menuExpanded.Set(false)
+					},
+					text.TextWithStyle(label, text.TypestyleTitleMedium),
+					icon.Icon(icon.IconBytes(data)),
+				)
+			}
+			fab = floatingactionbutton.FloatingActionButtonMenu(
+				expanded,
+				floatingactionbutton.ToggleFloatingActionButton(
+					expanded,
+					func(checked bool) { menuExpanded.Set(checked) },
+					func(checkedProgress float32) api.Composable {
+						if checkedProgress > 0.5 {
+							return icon.Icon(icon.IconBytes(icons.NavigationClose))
+						}
+						return icon.Icon(icon.IconBytes(icons.ContentAdd))
 					},
-					icon.Icon(icons.ContentAdd),
+				),
+				[]api.Composable{
+					menuItem("Reply", icons.ContentReply),
+					menuItem("Archive", icons.ContentArchive),
+					menuItem("Label", icons.ActionLabel),
+				},
+			)
+		}
+
+		current := fabPositions[position.Get().(int)]
+		modeLabel := "Show FAB menu"
+		if menuMode.Get().(bool) {
+			modeLabel = "Show extended FAB"
+		}
+		controls := column.Column(
+			compose.Sequence(
+				text.TextWithStyle(fmt.Sprintf("FAB Clicked: %d", count.Get().(int)), text.TypestyleBodyLarge),
+				row.Row(
+					compose.Sequence(
+						floatingactionbutton.SmallFloatingActionButton(onClick, icon.Icon(icon.IconBytes(icons.ContentAdd))),
+						floatingactionbutton.FloatingActionButton(onClick, icon.Icon(icon.IconBytes(icons.ContentAdd))),
+						floatingactionbutton.LargeFloatingActionButton(onClick, icon.Icon(icon.IconBytes(icons.ContentAdd))),
+					),
+					row.WithAlignment(row.Middle),
+					row.WithSpacing(row.SpaceEvenly),
+					row.WithModifier(size.FillMaxWidth().Then(padding.Padding(0, 16, 0, 16))),
+				),
+				row.Row(
+					compose.Sequence(
+						button.FilledTonal(func() {
+							menuMode.Set(!menuMode.Get().(bool))
+							menuExpanded.Set(false)
+						}, modeLabel),
+						button.Text(func() {
+							position.Set((position.Get().(int) + 1) % len(fabPositions))
+						}, "Position: "+current.name),
+					),
 				),
 			),
+			column.WithModifier(padding.All(16)),
+		)
+
+		return scaffold.Scaffold(
+			lazy.LazyColumn(func(scope lazy.LazyListScope) {
+				scope.Item("controls", controls)
+				scope.Items(40, func(i int) any { return i }, func(i int) api.Composable {
+					return box.Box(
+						text.TextWithStyle(fmt.Sprintf("Message %d", i+1), text.TypestyleBodyLarge),
+						box.WithAlignment(layout.W),
+						box.WithModifier(size.FillMaxWidth().Then(padding.Padding(16, 16, 16, 16))),
+					)
+				})
+			}, lazy.WithState(listState)),
+			scaffold.WithFloatingActionButton(fab),
+			scaffold.WithFloatingActionButtonPosition(current.position),
 		)(c)
 	}
 }
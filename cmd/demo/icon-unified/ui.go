@@ -1,6 +1,7 @@
 package main
 
 import (
+	"github.com/zodimo/go-compose/compose/foundation/layout/arrangement"
 	"github.com/zodimo/go-compose/compose/foundation/layout/column"
 	"github.com/zodimo/go-compose/compose/foundation/layout/row"
 	"github.com/zodimo/go-compose/compose/foundation/text"
@@ -41,7 +42,7 @@ func UI(c api.Composer) api.LayoutNode {
 				text.Text("SymbolName (Font)", text.WithModifier(padding.All(8))),
 				text.Text("Size/Color", text.WithModifier(padding.All(8))),
 			),
-			row.WithSpacing(row.SpaceEvenly),
+			row.WithHorizontalArrangement(arrangement.SpaceEvenly),
 		),
 	)
 
@@ -71,7 +72,7 @@ func UI(c api.Composer) api.LayoutNode {
 					wrapWithPadding(iconSymbol),
 					label,
 				),
-				row.WithSpacing(row.SpaceEvenly),
+				row.WithHorizontalArrangement(arrangement.SpaceEvenly),
 				row.WithAlignment(row.Middle),
 			),
 		)
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"github.com/zodimo/go-compose/compose/foundation/layout/arrangement"
 	"github.com/zodimo/go-compose/compose/foundation/layout/column"
 	"github.com/zodimo/go-compose/compose/material3/icon"
 	"github.com/zodimo/go-compose/compose/ui/unit"
@@ -20,7 +21,7 @@ func UI(c api.Composer) api.Composer {
 			// Settings icon
 			icon.Symbol(icon.SymbolSettings, icon.WithSymbolSize(unit.Sp(100))),
 		),
-		column.WithSpacing(column.SpaceEvenly),
+		column.WithVerticalArrangement(arrangement.SpaceEvenly),
 		column.WithAlignment(column.Middle),
 	)(c)
 
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"github.com/zodimo/go-compose/compose/foundation/layout/arrangement"
 	"github.com/zodimo/go-compose/compose/foundation/layout/column"
 	"github.com/zodimo/go-compose/compose/foundation/layout/spacer"
 	"github.com/zodimo/go-compose/compose/material3/appbar"
@@ -25,7 +26,7 @@ func UI(c api.Composer) api.LayoutNode {
 					),
 				),
 				column.WithModifier(size.FillMax()),
-				column.WithSpacing(column.SpaceEvenly),
+				column.WithVerticalArrangement(arrangement.SpaceEvenly),
 				column.WithAlignment(column.Middle),
 			)(c)
 		},
@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 
+	"github.com/zodimo/go-compose/compose"
 	"github.com/zodimo/go-compose/compose/foundation/layout/box"
 	"github.com/zodimo/go-compose/compose/foundation/layout/column"
 	"github.com/zodimo/go-compose/compose/foundation/layout/spacer"
@@ -11,10 +12,12 @@ import (
 	"github.com/zodimo/go-compose/compose/material3/icon"
 	"github.com/zodimo/go-compose/compose/material3/menu"
 	"github.com/zodimo/go-compose/compose/material3/scaffold"
+	"github.com/zodimo/go-compose/compose/material3/textfield"
 	"github.com/zodimo/go-compose/compose/ui"
 	"github.com/zodimo/go-compose/compose/ui/unit"
 	"github.com/zodimo/go-compose/modifiers/padding"
 	"github.com/zodimo/go-compose/pkg/api"
+	"github.com/zodimo/go-compose/state"
 
 	mdicons "golang.org/x/exp/shiny/materialdesign/icons"
 )
@@ -131,6 +134,11 @@ func UI() api.Composable {
 								return c
 							},
 						),
+						spacer.Height(32),
+						// Demo 3: Exposed Dropdown Menus
+						SelectField(),
+						spacer.Height(16),
+						AutocompleteField(),
 					),
 					column.WithModifier(padding.All(32)),
 				)(c)
@@ -139,3 +147,79 @@ func UI() api.Composable {
 		)(c)
 	}
 }
+
+var fruits = []string{
+	"Apple", "Apricot", "Banana", "Blueberry", "Cherry", "Grape",
+	"Kiwi", "Lemon", "Mango", "Orange", "Peach", "Pear", "Pineapple",
+}
+
+// SelectField picks a fruit from a read-only text field.
+func SelectField() api.Composable {
+	return func(c api.Composer) api.Composer {
+		expanded := state.MustState(c, "selectExpanded", func() bool { return false })
+		selected := state.MustState(c, "selected", func() string { return fruits[0] })
+
+		return menu.ExposedDropdownMenuBox(
+			expanded.Get(),
+			expanded.Set,
+			func(scope menu.ExposedDropdownMenuBoxScope) api.Composable {
+				items := make([]api.Composable, len(fruits))
+				for i, fruit := range fruits {
+					items[i] = scope.ExposedDropdownMenuItem(fruit, func() {
+						selected.Set(fruit)
+						expanded.Set(false)
+					})
+				}
+				return compose.Sequence(
+					textfield.Outlined(
+						selected.Get(),
+						func(string) {},
+						textfield.WithLabel("Fruit"),
+						textfield.WithReadOnly(true),
+						textfield.WithSingleLine(true),
+						textfield.WithTrailingIcon(menu.ExposedDropdownMenuDefaults.TrailingIcon(expanded.Get())),
+						textfield.WithModifier(scope.MenuAnchor(menu.MenuAnchorTypePrimaryNotEditable, true)),
+					),
+					scope.ExposedDropdownMenu(expanded.Get(), func() { expanded.Set(false) }, items),
+				)
+			},
+		)(c)
+	}
+}
+
+// AutocompleteField filters the fruits as the user types.
+func AutocompleteField() api.Composable {
+	return func(c api.Composer) api.Composer {
+		expanded := state.MustState(c, "autocompleteExpanded", func() bool { return false })
+		query := state.MustState(c, "query", func() string { return "" })
+
+		return menu.ExposedDropdownMenuBox(
+			expanded.Get(),
+			expanded.Set,
+			func(scope menu.ExposedDropdownMenuBoxScope) api.Composable {
+				options := menu.FilterOptions(fruits, query.Get())
+				items := make([]api.Composable, len(options))
+				for i, fruit := range options {
+					items[i] = scope.ExposedDropdownMenuItem(fruit, func() {
+						query.Set(fruit)
+						expanded.Set(false)
+					})
+				}
+				return compose.Sequence(
+					textfield.Filled(
+						query.Get(),
+						func(text string) {
+							query.Set(text)
+							expanded.Set(true)
+						},
+						textfield.WithLabel("Search fruit"),
+						textfield.WithSingleLine(true),
+						textfield.WithTrailingIcon(menu.ExposedDropdownMenuDefaults.TrailingIcon(expanded.Get())),
+						textfield.WithModifier(scope.MenuAnchor(menu.MenuAnchorTypePrimaryEditable, true)),
+					),
+					scope.ExposedDropdownMenu(expanded.Get(), func() { expanded.Set(false) }, items),
+				)
+			},
+		)(c)
+	}
+}
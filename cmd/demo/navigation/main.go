@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"github.com/zodimo/go-compose/compose/foundation/layout/arrangement"
 	"log"
 	"os"
 
@@ -122,7 +123,7 @@ func HomeScreen(navController *navigation.NavController) api.Composable {
 					"View Item 303",
 				),
 			),
-			column.WithSpacing(layout.SpaceAround),
+			column.WithVerticalArrangement(arrangement.SpaceAround),
 			column.WithAlignment(layout.Middle),
 		)(c)
 	}
@@ -151,7 +152,7 @@ func DetailsScreen(navController *navigation.NavController, entry *navigation.Ba
 					"Go Back",
 				),
 			),
-			column.WithSpacing(layout.SpaceAround),
+			column.WithVerticalArrangement(arrangement.SpaceAround),
 			column.WithAlignment(layout.Middle),
 		)(c)
 	}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/zodimo/go-compose/compose/foundation/layout/box"
+	"github.com/zodimo/go-compose/compose/foundation/lazy"
+	"github.com/zodimo/go-compose/compose/material3/appbar"
+	"github.com/zodimo/go-compose/compose/material3/pulltorefresh"
+	"github.com/zodimo/go-compose/compose/material3/scaffold"
+	"github.com/zodimo/go-compose/compose/material3/text"
+	"github.com/zodimo/go-compose/modifiers/padding"
+	"github.com/zodimo/go-compose/modifiers/size"
+	"github.com/zodimo/go-compose/pkg/api"
+
+	"gioui.org/layout"
+)
+
+// refreshDuration is how long the simulated refresh takes.
+const refreshDuration = 2 * time.Second
+
+func UI() api.Composable {
+	return func(c api.Composer) api.Composer {
+		itemCount := c.State("itemCount", func() any { return 20 })
+		refreshStarted := c.State("refreshStarted", func() any { return time.Time{} })
+
+		// The refresh runs for refreshDuration, then adds an item at the
+		// top of the feed.
+		isRefreshing := !refreshStarted.Get().(time.Time).IsZero()
+		if isRefreshing && time.Since(refreshStarted.Get().(time.Time)) >= refreshDuration {
+			refreshStarted.Set(time.Time{})
+			itemCount.Set(itemCount.Get().(int) + 1)
+			isRefreshing = false
+		}
+		onRefresh := func() {
+			refreshStarted.Set(time.Now())
+		}
+
+		count := itemCount.Get().(int)
+		feed := lazy.LazyColumn(func(scope lazy.LazyListScope) {
+			scope.Items(count, func(i int) any { return count - i }, func(i int) api.Composable {
+				return box.Box(
+					text.TextWithStyle(fmt.Sprintf("Post %d", count-i), text.TypestyleBodyLarge),
+					box.WithAlignment(layout.W),
+					box.WithModifier(size.FillMaxWidth().Then(padding.Padding(24, 16, 24, 16))),
+				)
+			})
+		})
+
+		return scaffold.Scaffold(
+			// Pull the list down from its top, or scroll the mouse wheel up
+			// there, to refresh.
+			pulltorefresh.PullToRefreshBox(
+				isRefreshing,
+				onRefresh,
+				feed,
+				pulltorefresh.WithModifier(size.FillMax()),
+			),
+			scaffold.WithTopBar(
+				appbar.TopAppBar(
+					text.TextWithStyle("Pull to Refresh Demo", text.TypestyleTitleMedium),
+				),
+			),
+		)(c)
+	}
+}
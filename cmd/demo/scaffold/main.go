@@ -5,6 +5,7 @@ import (
 	"os"
 
 	"github.com/zodimo/go-compose/compose"
+	"github.com/zodimo/go-compose/compose/foundation/layout/windowinsets"
 	"github.com/zodimo/go-compose/runtime"
 	"github.com/zodimo/go-compose/state"
 	"github.com/zodimo/go-compose/store"
@@ -44,10 +45,15 @@ func Run(window *app.Window) error {
 		case app.DestroyEvent:
 			return frameEvent.Err
 		case app.FrameEvent:
+			// The content is laid out edge to edge and padded by windowinsets,
+			// so NewContext must not inset it as well.
+			insets := windowinsets.FrameInsets(frameEvent.Insets)
+			frameEvent.Insets = app.Insets{}
 			gtx := app.NewContext(&ops, frameEvent)
 			gtx.Locale = enLocale
 
 			gtx = themeManager.Material3ThemeInit(gtx)
+			gtx = windowinsets.ProvideFrame(gtx, insets)
 
 			composer := compose.NewComposer(store)
 			// UI function returns api.LayoutNode
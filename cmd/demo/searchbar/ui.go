@@ -0,0 +1,122 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/zodimo/go-compose/compose/foundation/layout/box"
+	"github.com/zodimo/go-compose/compose/foundation/layout/column"
+	"github.com/zodimo/go-compose/compose/foundation/layout/spacer"
+	"github.com/zodimo/go-compose/compose/foundation/lazy"
+	"github.com/zodimo/go-compose/compose/material3/appbar"
+	"github.com/zodimo/go-compose/compose/material3/icon"
+	"github.com/zodimo/go-compose/compose/material3/iconbutton"
+	"github.com/zodimo/go-compose/compose/material3/menu"
+	"github.com/zodimo/go-compose/compose/material3/scaffold"
+	"github.com/zodimo/go-compose/compose/material3/searchbar"
+	"github.com/zodimo/go-compose/compose/material3/text"
+	"github.com/zodimo/go-compose/modifiers/clickable"
+	"github.com/zodimo/go-compose/modifiers/padding"
+	"github.com/zodimo/go-compose/modifiers/size"
+	"github.com/zodimo/go-compose/pkg/api"
+	"github.com/zodimo/go-compose/state"
+
+	mdicons "golang.org/x/exp/shiny/materialdesign/icons"
+)
+
+var fruits = []string{
+	"Apple", "Apricot", "Avocado", "Banana", "Blackberry", "Blueberry",
+	"Cherry", "Coconut", "Fig", "Grape", "Kiwi", "Lemon", "Lime", "Mango",
+	"Melon", "Orange", "Papaya", "Peach", "Pear", "Pineapple", "Plum",
+	"Raspberry", "Strawberry", "Watermelon",
+}
+
+func UI() api.Composable {
+	return func(c api.Composer) api.Composer {
+		query := state.MustState(c, "search_query", func() string { return "" })
+		expanded := state.MustState(c, "search_expanded", func() bool { return false })
+		dockedQuery := state.MustState(c, "docked_query", func() string { return "" })
+		dockedExpanded := state.MustState(c, "docked_expanded", func() bool { return false })
+		selected := state.MustState(c, "selected_fruit", func() string { return "Nothing selected" })
+
+		return scaffold.Scaffold(
+			column.Column(
+				c.Sequence(
+					text.TextWithStyle("Docked Search Bar", text.TypestyleTitleMedium),
+					spacer.Height(8),
+					searchbar.DockedSearchBar(
+						searchbar.InputField(
+							dockedQuery.Get(),
+							dockedQuery.Set,
+							func(string) { dockedExpanded.Set(false) },
+							dockedExpanded.Get(),
+							dockedExpanded.Set,
+							searchbar.WithPlaceholder("Search fruits"),
+							searchbar.WithLeadingIcon(icon.Icon(icon.IconBytes(mdicons.ActionSearch))),
+						),
+						dockedExpanded.Get(),
+						dockedExpanded.Set,
+						suggestions(dockedQuery.Get(), func(fruit string) {
+							dockedQuery.Set(fruit)
+							selected.Set(fruit)
+							dockedExpanded.Set(false)
+						}),
+					),
+					spacer.Height(16),
+					text.TextWithStyle(selected.Get(), text.TypestyleBodyMedium),
+				),
+				column.WithModifier(padding.All(16)),
+			),
+			scaffold.WithTopBar(
+				searchbar.AppBarWithSearch(
+					searchbar.SearchBar(
+						searchbar.InputField(
+							query.Get(),
+							query.Set,
+							func(string) { expanded.Set(false) },
+							expanded.Get(),
+							expanded.Set,
+							searchbar.WithPlaceholder("Search"),
+							searchbar.WithLeadingIcon(icon.Icon(icon.IconBytes(mdicons.ActionSearch))),
+							searchbar.WithTrailingIcon(
+								iconbutton.Standard(func() { query.Set("") }, mdicons.NavigationClose, "Clear"),
+							),
+						),
+						expanded.Get(),
+						expanded.Set,
+						suggestions(query.Get(), func(fruit string) {
+							query.Set(fruit)
+							selected.Set(fruit)
+							expanded.Set(false)
+						}),
+					),
+					expanded.Get(),
+					appbar.WithNavigationIcon(iconbutton.Standard(func() {}, mdicons.NavigationMenu, "Menu")),
+					appbar.WithActions(iconbutton.Standard(func() {}, mdicons.ActionAccountCircle, "Account")),
+				),
+			),
+		)(c)
+	}
+}
+
+// suggestions lists the fruits matching query.
+func suggestions(query string, onSelect func(string)) api.Composable {
+	matches := menu.FilterOptions(fruits, query)
+	return lazy.LazyColumn(func(scope lazy.LazyListScope) {
+		if len(matches) == 0 {
+			scope.Item("empty", box.Box(
+				text.TextWithStyle(fmt.Sprintf("No fruit matches %q", query), text.TypestyleBodyLarge),
+				box.WithModifier(padding.All(16)),
+			))
+			return
+		}
+		scope.Items(len(matches), func(i int) any { return matches[i] }, func(i int) api.Composable {
+			fruit := matches[i]
+			return box.Box(
+				text.TextWithStyle(fruit, text.TypestyleBodyLarge),
+				box.WithModifier(size.FillMaxWidth().
+					Then(clickable.OnClick(func() { onSelect(fruit) })).
+					Then(padding.Padding(16, 12, 16, 12))),
+			)
+		})
+	})
+}
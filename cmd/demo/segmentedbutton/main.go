@@ -48,7 +48,8 @@ func run(w *app.Window) error {
 			rootComposer := UI()(composer)
 			layoutNode := rootComposer.Build()
 
-			_ = rt.Run(gtx, layoutNode)
+			callOp := rt.Run(gtx, layoutNode)
+			callOp.Add(gtx.Ops)
 			e.Frame(gtx.Ops)
 		}
 	}
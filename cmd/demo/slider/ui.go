@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"image/color"
 
+	"github.com/zodimo/go-compose/compose/foundation/gestures"
 	"github.com/zodimo/go-compose/compose/foundation/layout/column"
 	"github.com/zodimo/go-compose/compose/foundation/layout/spacer"
 	"github.com/zodimo/go-compose/compose/material3/scaffold"
@@ -11,6 +12,7 @@ import (
 	m3text "github.com/zodimo/go-compose/compose/material3/text"
 	"github.com/zodimo/go-compose/compose/ui/graphics"
 	"github.com/zodimo/go-compose/modifiers/padding"
+	"github.com/zodimo/go-compose/modifiers/size"
 	"github.com/zodimo/go-compose/pkg/api"
 )
 
@@ -24,6 +26,8 @@ func UI() Composable {
 		steppedValue := c.State("stepped", func() any { return float32(20.0) })
 		rangeMappedValue := c.State("range", func() any { return float32(50.0) })
 		customColorValue := c.State("custom", func() any { return float32(0.3) })
+		priceRange := c.State("price", func() any { return slider.ClosedRange{Start: 20, End: 80} })
+		volumeValue := c.State("volume", func() any { return float32(40) })
 
 		return scaffold.Scaffold(
 			func(c Composer) Composer {
@@ -86,7 +90,34 @@ func UI() Composable {
 
 						spacer.Height(24)(c)
 
-						// 5. Disabled Slider
+						// 5. Range Slider with value labels
+						price := priceRange.Get().(slider.ClosedRange)
+						Label("Price Filter ($0-$200, steps of $10)")(c)
+						slider.RangeSlider(
+							price,
+							func(r slider.ClosedRange) { priceRange.Set(r) },
+							slider.WithValueRange(0, 200),
+							slider.WithSteps(19),
+							slider.WithValueLabel(func(v float32) string { return fmt.Sprintf("$%.0f", v) }),
+						)(c)
+						m3text.TextWithStyle(fmt.Sprintf("$%.0f - $%.0f", price.Start, price.End), m3text.TypestyleBodyMedium)(c)
+
+						spacer.Height(24)(c)
+
+						// 6. Vertical Slider
+						Label("Vertical Slider")(c)
+						slider.Slider(
+							volumeValue.Get().(float32),
+							func(v float32) { volumeValue.Set(v) },
+							slider.WithValueRange(0, 100),
+							slider.WithOrientation(gestures.OrientationVertical),
+							slider.WithValueLabel(slider.SliderDefaults.ValueLabel),
+							slider.WithModifier(size.Height(160)),
+						)(c)
+
+						spacer.Height(24)(c)
+
+						// 7. Disabled Slider
 						Label("Disabled Slider")(c)
 						slider.Slider(
 							0.5,
@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"github.com/zodimo/go-compose/compose/foundation/layout/arrangement"
 	"log"
 	"os"
 
@@ -91,7 +92,7 @@ func UI(c compose.Composer) api.LayoutNode {
 							},
 						),
 					),
-					row.WithSpacing(row.SpaceStart),
+					row.WithHorizontalArrangement(arrangement.End),
 					row.WithAlignment(row.Middle),
 				),
 				text.TextWithStyle("Switch should be to the right to text above", text.TypestyleBodyMedium),
@@ -108,13 +109,13 @@ func UI(c compose.Composer) api.LayoutNode {
 						spacer.Width(20),
 						text.TextWithStyle("Enable Feature", text.TypestyleBodyMedium),
 					),
-					row.WithSpacing(row.SpaceStart),
+					row.WithHorizontalArrangement(arrangement.End),
 					row.WithAlignment(row.Middle),
 				),
 				// Case 2: Just confirmation text
 				text.TextWithStyle("Switch should be to the right to text above", text.TypestyleBodyMedium),
 			),
-			column.WithSpacing(column.SpaceAround),
+			column.WithVerticalArrangement(arrangement.SpaceAround),
 			column.WithAlignment(column.Middle),
 		),
 		scaffold.WithTopBar(
@@ -3,8 +3,10 @@ package main
 import (
 	"fmt"
 
+	"github.com/zodimo/go-compose/compose/foundation/layout/box"
 	"github.com/zodimo/go-compose/compose/foundation/layout/column"
 	"github.com/zodimo/go-compose/compose/foundation/layout/spacer"
+	"github.com/zodimo/go-compose/compose/foundation/pager"
 	"github.com/zodimo/go-compose/compose/material3/scaffold"
 	"github.com/zodimo/go-compose/compose/material3/tab"
 	"github.com/zodimo/go-compose/compose/material3/text"
@@ -22,6 +24,8 @@ func UI() Composable {
 		// State for selected tab
 		selectedTabIndex := c.State("selectedTabIndex", func() any { return 0 })
 		selectedTabIndex2 := c.State("selectedTabIndex2", func() any { return 0 })
+		pagerTitles := []string{"Photos", "Albums", "Shared"}
+		pagerState := pager.RememberPagerState(c, 0, func() int { return len(pagerTitles) })
 
 		return scaffold.Scaffold(
 			column.Column(
@@ -77,6 +81,38 @@ func UI() Composable {
 					// Spacing
 					spacer.Height(24),
 
+					// Tab Row 3: Linked to a pager
+					text.TextWithStyle("Tabs with a Pager (swipe the pages)", text.TypestyleLabelLarge),
+					tab.TabRow(
+						pagerState.CurrentPage(),
+						func(c Composer) Composer {
+							for i, title := range pagerTitles {
+								index := i // Capture loop variable
+								tab.Tab(
+									pagerState.CurrentPage() == index,
+									func() { pagerState.AnimateScrollToPage(index) },
+									text.TextWithStyle(title, text.TypestyleLabelMedium),
+								)(c)
+							}
+							return c
+						},
+						tab.WithPagerState(pagerState),
+					),
+					pager.HorizontalPager(
+						pagerState,
+						func(page int) Composable {
+							return box.Box(
+								text.TextWithStyle(fmt.Sprintf("Page: %s", pagerTitles[page]), text.TypestyleTitleMedium),
+								box.WithAlignment(box.Center),
+								box.WithModifier(size.FillMax()),
+							)
+						},
+						pager.WithModifier(size.FillMaxWidth().Then(size.Height(160))),
+					),
+
+					// Spacing
+					spacer.Height(24),
+
 					// Explanatory text
 					text.TextWithStyle(
 						"Tab colors now use theme-aware styling. "+
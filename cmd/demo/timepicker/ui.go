@@ -0,0 +1,60 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/zodimo/go-compose/compose/foundation/layout/column"
+	"github.com/zodimo/go-compose/compose/foundation/layout/row"
+	"github.com/zodimo/go-compose/compose/foundation/layout/spacer"
+	"github.com/zodimo/go-compose/compose/material3/button"
+	"github.com/zodimo/go-compose/compose/material3/text"
+	"github.com/zodimo/go-compose/compose/material3/timepicker"
+	"github.com/zodimo/go-compose/modifiers/padding"
+	"github.com/zodimo/go-compose/pkg/api"
+	"github.com/zodimo/go-compose/state"
+)
+
+func UI() api.Composable {
+	return func(c api.Composer) api.Composer {
+		pickerState := timepicker.RememberTimePickerState(c, 9, 30)
+		inputState := timepicker.RememberTimePickerState(c, 18, 45, timepicker.WithIs24Hour(true))
+		dialogState := timepicker.RememberTimePickerState(c, 7, 0)
+		showDialog := state.MustState(c, "show_time_picker_dialog", func() bool { return false })
+		confirmed := state.MustState(c, "confirmed_time", func() string { return "No time confirmed" })
+
+		return column.Column(
+			c.Sequence(
+				text.TextWithStyle("Time Picker Demo", text.TypestyleTitleLarge),
+				spacer.Height(16),
+				row.Row(
+					c.Sequence(
+						timepicker.TimePicker(pickerState),
+						spacer.Width(24),
+						timepicker.TimeInput(inputState),
+					),
+				),
+				spacer.Height(16),
+				row.Row(
+					c.Sequence(
+						button.Filled(func() { showDialog.Set(true) }, "Open time picker dialog"),
+						spacer.Width(16),
+						text.TextWithStyle(confirmed.Get(), text.TypestyleBodyMedium),
+					),
+					row.WithAlignment(row.Middle),
+				),
+				c.When(showDialog.Get(),
+					timepicker.TimePickerDialog(
+						func() { showDialog.Set(false) },
+						button.Text(func() {
+							confirmed.Set(fmt.Sprintf("Confirmed: %02d:%02d", dialogState.Hour(), dialogState.Minute()))
+							showDialog.Set(false)
+						}, "OK"),
+						dialogState,
+						timepicker.WithDismissButton(button.Text(func() { showDialog.Set(false) }, "Cancel")),
+					),
+				),
+			),
+			column.WithModifier(padding.All(24)),
+		)(c)
+	}
+}
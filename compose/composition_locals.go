@@ -28,7 +28,17 @@ func (local *CompositionLocal[T]) Provides(value T) api.ProvidedValue {
 
 // Current returns the current value of the CompositionLocal from the Composer.
 func (local *CompositionLocal[T]) Current(c api.Composer) T {
-	val := c.Consume(local)
+	return local.valueOf(c.Consume(local))
+}
+
+// CurrentAt returns the value of the CompositionLocal where the layout node
+// of an element was composed, for modifiers that read it while laying the
+// element out.
+func (local *CompositionLocal[T]) CurrentAt(node interface{ CompositionLocal(key any) any }) T {
+	return local.valueOf(node.CompositionLocal(local))
+}
+
+func (local *CompositionLocal[T]) valueOf(val any) T {
 	if val == nil {
 		return local.defaultValueFactory()
 	}
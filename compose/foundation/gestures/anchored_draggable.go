@@ -0,0 +1,549 @@
+package gestures
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/zodimo/go-compose/compose"
+	"github.com/zodimo/go-compose/compose/ui"
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+	"github.com/zodimo/go-compose/internal/gesturestate"
+	"github.com/zodimo/go-compose/modifiers/offset"
+	"github.com/zodimo/go-compose/modifiers/pointer"
+)
+
+// Anchor associates a value of an AnchoredDraggableState with a position.
+type Anchor[T comparable] struct {
+	Value    T
+	Position float32
+}
+
+// AnchorAt creates an Anchor for value at position, in pixels.
+func AnchorAt[T comparable](value T, position float32) Anchor[T] {
+	return Anchor[T]{Value: value, Position: position}
+}
+
+// DraggableAnchors are the positions an AnchoredDraggableState settles at.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/foundation/foundation/src/commonMain/kotlin/androidx/compose/foundation/gestures/AnchoredDraggable.kt
+type DraggableAnchors[T comparable] struct {
+	// anchors are sorted by position.
+	anchors []Anchor[T]
+}
+
+// NewDraggableAnchors creates anchors from a list of value positions. When a
+// value appears more than once, the last position wins.
+//
+//	anchors := gestures.NewDraggableAnchors(
+//		gestures.AnchorAt(Collapsed, 0),
+//		gestures.AnchorAt(Expanded, 400),
+//	)
+func NewDraggableAnchors[T comparable](anchors ...Anchor[T]) *DraggableAnchors[T] {
+	byValue := make(map[T]int, len(anchors))
+	unique := make([]Anchor[T], 0, len(anchors))
+	for _, a := range anchors {
+		if i, ok := byValue[a.Value]; ok {
+			unique[i] = a
+			continue
+		}
+		byValue[a.Value] = len(unique)
+		unique = append(unique, a)
+	}
+	sort.SliceStable(unique, func(i, j int) bool { return unique[i].Position < unique[j].Position })
+	return &DraggableAnchors[T]{anchors: unique}
+}
+
+// Size returns the number of anchors.
+func (a *DraggableAnchors[T]) Size() int {
+	if a == nil {
+		return 0
+	}
+	return len(a.anchors)
+}
+
+// PositionOf returns the position of value, and false when value has no anchor.
+func (a *DraggableAnchors[T]) PositionOf(value T) (float32, bool) {
+	if a == nil {
+		return float32(math.NaN()), false
+	}
+	for _, anchor := range a.anchors {
+		if anchor.Value == value {
+			return anchor.Position, true
+		}
+	}
+	return float32(math.NaN()), false
+}
+
+// HasAnchorFor reports whether value has an anchor.
+func (a *DraggableAnchors[T]) HasAnchorFor(value T) bool {
+	_, ok := a.PositionOf(value)
+	return ok
+}
+
+// ClosestAnchor returns the anchor closest to position.
+func (a *DraggableAnchors[T]) ClosestAnchor(position float32) (T, bool) {
+	var closest T
+	if a.Size() == 0 {
+		return closest, false
+	}
+	best := float32(math.Inf(1))
+	for _, anchor := range a.anchors {
+		if d := gesturestate.Abs(anchor.Position - position); d < best {
+			best = d
+			closest = anchor.Value
+		}
+	}
+	return closest, true
+}
+
+// ClosestAnchorInDirection returns the anchor closest to position that is
+// above it when searchUpwards is true, or below it otherwise. It falls back
+// to the closest anchor when there is none in that direction.
+func (a *DraggableAnchors[T]) ClosestAnchorInDirection(position float32, searchUpwards bool) (T, bool) {
+	var closest T
+	found := false
+	best := float32(math.Inf(1))
+	for _, anchor := range a.anchorsOrNil() {
+		d := anchor.Position - position
+		if searchUpwards && d < 0 || !searchUpwards && d > 0 {
+			continue
+		}
+		if gesturestate.Abs(d) < best {
+			best = gesturestate.Abs(d)
+			closest = anchor.Value
+			found = true
+		}
+	}
+	if !found {
+		return a.ClosestAnchor(position)
+	}
+	return closest, true
+}
+
+// MinPosition returns the smallest anchor position, or NaN without anchors.
+func (a *DraggableAnchors[T]) MinPosition() float32 {
+	if a.Size() == 0 {
+		return float32(math.NaN())
+	}
+	return a.anchors[0].Position
+}
+
+// MaxPosition returns the largest anchor position, or NaN without anchors.
+func (a *DraggableAnchors[T]) MaxPosition() float32 {
+	if a.Size() == 0 {
+		return float32(math.NaN())
+	}
+	return a.anchors[len(a.anchors)-1].Position
+}
+
+// Equal reports whether both anchors hold the same values at the same positions.
+func (a *DraggableAnchors[T]) Equal(other *DraggableAnchors[T]) bool {
+	if a.Size() != other.Size() {
+		return false
+	}
+	for i, anchor := range a.anchorsOrNil() {
+		if other.anchors[i] != anchor {
+			return false
+		}
+	}
+	return true
+}
+
+func (a *DraggableAnchors[T]) anchorsOrNil() []Anchor[T] {
+	if a == nil {
+		return nil
+	}
+	return a.anchors
+}
+
+func (a *DraggableAnchors[T]) String() string {
+	return fmt.Sprintf("DraggableAnchors%v", a.anchorsOrNil())
+}
+
+// PositionalThreshold returns how far, in pixels, the offset must move from
+// an anchor towards the next one for the state to settle at the next one.
+// totalDistance is the distance between both anchors.
+type PositionalThreshold func(totalDistance float32) float32
+
+type AnchoredDraggableStateOptions struct {
+	PositionalThreshold PositionalThreshold
+	// VelocityThreshold is the release velocity, per second, above which the
+	// state settles at the next anchor in the fling direction.
+	VelocityThreshold unit.Dp
+	// Stiffness and DampingRatio configure the spring used to settle.
+	Stiffness    float32
+	DampingRatio float32
+	// ConfirmValueChange can veto a settle to a new value.
+	ConfirmValueChange func(newValue any) bool
+}
+
+type AnchoredDraggableStateOption func(*AnchoredDraggableStateOptions)
+
+func WithPositionalThreshold(threshold PositionalThreshold) AnchoredDraggableStateOption {
+	return func(o *AnchoredDraggableStateOptions) {
+		o.PositionalThreshold = threshold
+	}
+}
+
+func WithVelocityThreshold(threshold unit.Dp) AnchoredDraggableStateOption {
+	return func(o *AnchoredDraggableStateOptions) {
+		o.VelocityThreshold = threshold
+	}
+}
+
+func WithSpring(stiffness, dampingRatio float32) AnchoredDraggableStateOption {
+	return func(o *AnchoredDraggableStateOptions) {
+		o.Stiffness = stiffness
+		o.DampingRatio = dampingRatio
+	}
+}
+
+// WithConfirmValueChange sets a callback that can veto settling at newValue.
+func WithConfirmValueChange[T comparable](confirm func(newValue T) bool) AnchoredDraggableStateOption {
+	return func(o *AnchoredDraggableStateOptions) {
+		o.ConfirmValueChange = func(newValue any) bool { return confirm(newValue.(T)) }
+	}
+}
+
+func DefaultAnchoredDraggableStateOptions() AnchoredDraggableStateOptions {
+	return AnchoredDraggableStateOptions{
+		PositionalThreshold: func(totalDistance float32) float32 { return totalDistance * 0.5 },
+		VelocityThreshold:   unit.Dp(125),
+		Stiffness:           StiffnessMedium,
+		DampingRatio:        DampingRatioNoBouncy,
+		ConfirmValueChange:  func(any) bool { return true },
+	}
+}
+
+// AnchoredDraggableState holds the offset of an AnchoredDraggable element and
+// the anchor it rests at. After a drag, it settles at an anchor picked from
+// the release velocity and positional threshold, with a spring animation.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/foundation/foundation/src/commonMain/kotlin/androidx/compose/foundation/gestures/AnchoredDraggable.kt
+type AnchoredDraggableState[T comparable] struct {
+	options AnchoredDraggableStateOptions
+
+	currentValue T
+	offset       float32
+	anchors      *DraggableAnchors[T]
+	dragging     bool
+
+	animation       *springAnimation
+	animationTarget T
+
+	density unit.Density
+	// dragOptions are those of the last AnchoredDraggable modifier using the state.
+	dragOptions DraggableOptions
+	revision    gesturestate.Revision
+}
+
+// NewAnchoredDraggableState creates a state resting at initialValue. Its
+// offset is unknown until anchors are set with UpdateAnchors.
+func NewAnchoredDraggableState[T comparable](initialValue T, options ...AnchoredDraggableStateOption) *AnchoredDraggableState[T] {
+	opt := DefaultAnchoredDraggableStateOptions()
+	for _, option := range options {
+		if option == nil {
+			continue
+		}
+		option(&opt)
+	}
+	return &AnchoredDraggableState[T]{
+		options:      opt,
+		currentValue: initialValue,
+		offset:       float32(math.NaN()),
+		density:      unit.NewDensity(1, 1),
+		revision:     gesturestate.NewRevision(),
+	}
+}
+
+// RememberAnchoredDraggableState returns an AnchoredDraggableState that
+// survives recompositions. Changes to it schedule a new frame.
+func RememberAnchoredDraggableState[T comparable](c compose.Composer, initialValue T, options ...AnchoredDraggableStateOption) *AnchoredDraggableState[T] {
+	key := fmt.Sprintf("anchoredDraggableState-%v", c.GenerateID())
+	revision := gesturestate.RememberRevision(c, key+"/revision")
+	return c.State(key, func() any {
+		s := NewAnchoredDraggableState(initialValue, options...)
+		s.revision = revision
+		return s
+	}).Get().(*AnchoredDraggableState[T])
+}
+
+// CurrentValue returns the value the state rests at, or last rested at while
+// dragging or animating.
+func (s *AnchoredDraggableState[T]) CurrentValue() T {
+	s.revision.Observe()
+	return s.currentValue
+}
+
+// TargetValue returns the value the state is moving to: the animation target
+// while settling, or the anchor it would settle at when released now.
+func (s *AnchoredDraggableState[T]) TargetValue() T {
+	s.revision.Observe()
+	if s.animation != nil {
+		return s.animationTarget
+	}
+	return s.computeTarget(0)
+}
+
+// SettledValue returns the value of the anchor the offset is at, or the
+// current value while between anchors.
+func (s *AnchoredDraggableState[T]) SettledValue() T {
+	s.revision.Observe()
+	for _, anchor := range s.anchors.anchorsOrNil() {
+		if anchor.Position == s.offset {
+			return anchor.Value
+		}
+	}
+	return s.currentValue
+}
+
+// Offset returns the offset in pixels, or NaN until anchors are set.
+func (s *AnchoredDraggableState[T]) Offset() float32 {
+	s.revision.Observe()
+	return s.offset
+}
+
+// RequireOffset returns the offset, panicking when it is not known yet.
+func (s *AnchoredDraggableState[T]) RequireOffset() float32 {
+	if math.IsNaN(float64(s.Offset())) {
+		panic("AnchoredDraggableState: the offset was read before the anchors were set")
+	}
+	return s.offset
+}
+
+// Anchors returns the current anchors, nil until set.
+func (s *AnchoredDraggableState[T]) Anchors() *DraggableAnchors[T] {
+	return s.anchors
+}
+
+// IsAnimationRunning reports whether the state is settling.
+func (s *AnchoredDraggableState[T]) IsAnimationRunning() bool {
+	s.revision.Observe()
+	return s.animation != nil
+}
+
+// IsDragging reports whether a drag is in progress.
+func (s *AnchoredDraggableState[T]) IsDragging() bool {
+	return s.dragging
+}
+
+// Density returns the density of the last frame the state was laid out in.
+func (s *AnchoredDraggableState[T]) Density() unit.Density {
+	return s.density
+}
+
+// Progress returns how far the offset is from the anchor of from to the
+// anchor of to, in [0, 1].
+func (s *AnchoredDraggableState[T]) Progress(from, to T) float32 {
+	s.revision.Observe()
+	fromPosition, okFrom := s.anchors.PositionOf(from)
+	toPosition, okTo := s.anchors.PositionOf(to)
+	if !okFrom || !okTo || math.IsNaN(float64(s.offset)) {
+		return 0
+	}
+	if fromPosition == toPosition {
+		return 1
+	}
+	return gesturestate.Clamp((s.offset-fromPosition)/(toPosition-fromPosition), 0, 1)
+}
+
+// UpdateAnchors sets the anchors. When they change while the state is not
+// dragging, the offset snaps to the anchor of the value closest to it, or of
+// the current value when the offset was not known yet.
+func (s *AnchoredDraggableState[T]) UpdateAnchors(anchors *DraggableAnchors[T]) {
+	if s.anchors != nil && s.anchors.Equal(anchors) {
+		return
+	}
+	s.anchors = anchors
+	if s.dragging {
+		s.offset = gesturestate.Clamp(s.offset, anchors.MinPosition(), anchors.MaxPosition())
+		s.revision.Bump()
+		return
+	}
+	target := s.currentValue
+	if s.animation != nil {
+		target = s.animationTarget
+	} else if !math.IsNaN(float64(s.offset)) {
+		if closest, ok := anchors.ClosestAnchor(s.offset); ok && !anchors.HasAnchorFor(target) {
+			target = closest
+		}
+	}
+	if position, ok := anchors.PositionOf(target); ok {
+		if s.animation != nil {
+			s.animation.target = position
+		} else {
+			s.offset = position
+			s.currentValue = target
+		}
+	}
+	s.revision.Bump()
+}
+
+// DispatchRawDelta moves the offset by delta, clamped to the anchors, and
+// returns the delta that was consumed. It stops a running animation.
+func (s *AnchoredDraggableState[T]) DispatchRawDelta(delta float32) float32 {
+	if math.IsNaN(float64(s.offset)) || s.anchors.Size() == 0 {
+		return 0
+	}
+	s.animation = nil
+	previous := s.offset
+	s.offset = gesturestate.Clamp(s.offset+delta, s.anchors.MinPosition(), s.anchors.MaxPosition())
+	s.revision.Bump()
+	return s.offset - previous
+}
+
+// Settle animates to the anchor picked from velocity, in pixels per second,
+// and the positional threshold.
+func (s *AnchoredDraggableState[T]) Settle(velocity float32) {
+	target := s.computeTarget(velocity)
+	if !s.options.ConfirmValueChange(target) {
+		target = s.currentValue
+	}
+	s.animateTo(target, velocity)
+}
+
+// AnimateTo animates the offset to the anchor of target. When target has no
+// anchor, the value is updated without moving.
+func (s *AnchoredDraggableState[T]) AnimateTo(target T) {
+	s.animateTo(target, 0)
+}
+
+// SnapTo moves the offset to the anchor of target without animation.
+func (s *AnchoredDraggableState[T]) SnapTo(target T) {
+	s.animation = nil
+	if position, ok := s.anchors.PositionOf(target); ok {
+		s.offset = position
+	}
+	s.currentValue = target
+	s.revision.Bump()
+}
+
+func (s *AnchoredDraggableState[T]) animateTo(target T, velocity float32) {
+	position, ok := s.anchors.PositionOf(target)
+	if !ok || math.IsNaN(float64(s.offset)) {
+		s.SnapTo(target)
+		return
+	}
+	s.animationTarget = target
+	s.animation = &springAnimation{
+		start:        s.offset,
+		target:       position,
+		velocity:     velocity,
+		stiffness:    s.options.Stiffness,
+		dampingRatio: s.options.DampingRatio,
+	}
+	s.revision.Bump()
+}
+
+// advance moves a running animation to now and reports whether it is still running.
+func (s *AnchoredDraggableState[T]) advance(now time.Time) bool {
+	if s.animation == nil {
+		return false
+	}
+	value, _, done := s.animation.valueAt(now)
+	s.offset = value
+	if done {
+		s.animation = nil
+		s.currentValue = s.animationTarget
+	}
+	s.revision.Bump()
+	return !done
+}
+
+func (s *AnchoredDraggableState[T]) computeTarget(velocity float32) T {
+	currentPosition, ok := s.anchors.PositionOf(s.currentValue)
+	if !ok || currentPosition == s.offset || math.IsNaN(float64(s.offset)) {
+		return s.currentValue
+	}
+	velocityThreshold := s.density.DpToPx(s.options.VelocityThreshold)
+	if gesturestate.Abs(velocity) >= velocityThreshold {
+		target, _ := s.anchors.ClosestAnchorInDirection(s.offset, velocity > 0)
+		return target
+	}
+	neighbor, _ := s.anchors.ClosestAnchorInDirection(s.offset, s.offset > currentPosition)
+	neighborPosition, _ := s.anchors.PositionOf(neighbor)
+	threshold := gesturestate.Abs(s.options.PositionalThreshold(gesturestate.Abs(currentPosition - neighborPosition)))
+	if gesturestate.Abs(currentPosition-s.offset) <= threshold {
+		return s.currentValue
+	}
+	return neighbor
+}
+
+// AnchoredDraggable makes the element draggable between the anchors of
+// state along orientation. On release, the state settles at an anchor.
+// Use AnchoredOffset to move content with the state.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/foundation/foundation/src/commonMain/kotlin/androidx/compose/foundation/gestures/AnchoredDraggable.kt
+func AnchoredDraggable[T comparable](state *AnchoredDraggableState[T], orientation Orientation, options ...DraggableOption) ui.Modifier {
+	opt := DefaultDraggableOptions()
+	for _, option := range options {
+		if option == nil {
+			continue
+		}
+		option(&opt)
+	}
+	state.dragOptions = opt
+
+	return pointer.PointerInput(func(scope pointer.PointerInputScope) {
+		scope.OnFrame(func(now time.Time) {
+			state.density = scope.Density()
+			if state.advance(now) {
+				scope.InvalidateAt(now)
+			}
+		})
+		if !opt.Enabled {
+			return
+		}
+		var tracker pointer.VelocityTracker
+		detectOrientedDrags(scope, orientation, orientedDragHandlers{
+			onStart: func(change *pointer.PointerInputChange) {
+				state.dragging = true
+				state.animation = nil
+				tracker.Reset()
+				tracker.AddPointerInputChange(change)
+				if state.dragOptions.OnDragStarted != nil {
+					state.dragOptions.OnDragStarted(change.Position)
+				}
+			},
+			onDelta: func(change *pointer.PointerInputChange, delta float32) {
+				tracker.AddPointerInputChange(change)
+				state.DispatchRawDelta(reverse(delta, state.dragOptions.ReverseDirection))
+			},
+			onStop: func(canceled bool) {
+				state.dragging = false
+				velocity := float32(0)
+				if !canceled {
+					velocity = reverse(axisValue(tracker.CalculateVelocity(), orientation), state.dragOptions.ReverseDirection)
+				}
+				if state.dragOptions.OnDragStopped != nil {
+					state.dragOptions.OnDragStopped(velocity)
+				}
+				state.Settle(velocity)
+			},
+		})
+	}, state, orientation, opt.Enabled)
+}
+
+// AnchoredOffset moves the element along orientation by the offset of state.
+// anchors is called at layout time with the element's size, and its result
+// is passed to state.UpdateAnchors, so anchors can depend on the size.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/foundation/foundation/src/commonMain/kotlin/androidx/compose/foundation/gestures/AnchoredDraggable.kt
+func AnchoredOffset[T comparable](state *AnchoredDraggableState[T], orientation Orientation, anchors func(size unit.IntSize) *DraggableAnchors[T]) ui.Modifier {
+	return offset.OffsetFunc(func(size unit.IntSize) geometry.Offset {
+		if anchors != nil {
+			state.UpdateAnchors(anchors(size))
+		}
+		o := state.offset
+		if math.IsNaN(float64(o)) {
+			o = 0
+		}
+		if orientation == OrientationHorizontal {
+			return geometry.NewOffset(o, 0)
+		}
+		return geometry.NewOffset(0, o)
+	})
+}
@@ -0,0 +1,151 @@
+package gestures
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+type sheetValue int
+
+const (
+	sheetHidden sheetValue = iota
+	sheetHalf
+	sheetExpanded
+)
+
+func newSheetState(options ...AnchoredDraggableStateOption) *AnchoredDraggableState[sheetValue] {
+	s := NewAnchoredDraggableState(sheetHidden, options...)
+	s.UpdateAnchors(NewDraggableAnchors(
+		AnchorAt(sheetHidden, 0),
+		AnchorAt(sheetHalf, 100),
+		AnchorAt(sheetExpanded, 200),
+	))
+	return s
+}
+
+// settle runs the settle animation to completion.
+func settle[T comparable](s *AnchoredDraggableState[T]) {
+	now := time.Unix(0, 0)
+	for i := 0; i < 1000 && s.advance(now); i++ {
+		now = now.Add(16 * time.Millisecond)
+	}
+}
+
+func TestDraggableAnchors(t *testing.T) {
+	anchors := NewDraggableAnchors(AnchorAt("b", 50), AnchorAt("a", 0), AnchorAt("c", 100))
+	if anchors.MinPosition() != 0 || anchors.MaxPosition() != 100 {
+		t.Errorf("unexpected bounds %v..%v", anchors.MinPosition(), anchors.MaxPosition())
+	}
+	if v, _ := anchors.ClosestAnchor(70); v != "b" {
+		t.Errorf("closest anchor to 70 should be b, got %s", v)
+	}
+	if v, _ := anchors.ClosestAnchorInDirection(60, true); v != "c" {
+		t.Errorf("closest anchor above 60 should be c, got %s", v)
+	}
+	if v, _ := anchors.ClosestAnchorInDirection(60, false); v != "b" {
+		t.Errorf("closest anchor below 60 should be b, got %s", v)
+	}
+	if !anchors.Equal(NewDraggableAnchors(AnchorAt("a", 0), AnchorAt("b", 50), AnchorAt("c", 100))) {
+		t.Error("anchors with the same positions should be equal")
+	}
+}
+
+func TestAnchoredDraggableState_OffsetFollowsAnchors(t *testing.T) {
+	s := NewAnchoredDraggableState(sheetHalf)
+	if !math.IsNaN(float64(s.Offset())) {
+		t.Fatal("offset should be unknown before the anchors are set")
+	}
+	s.UpdateAnchors(NewDraggableAnchors(AnchorAt(sheetHidden, 0), AnchorAt(sheetHalf, 100)))
+	if s.Offset() != 100 {
+		t.Errorf("offset should snap to the current value's anchor, got %v", s.Offset())
+	}
+	s.UpdateAnchors(NewDraggableAnchors(AnchorAt(sheetHidden, 0), AnchorAt(sheetHalf, 150)))
+	if s.Offset() != 150 || s.CurrentValue() != sheetHalf {
+		t.Errorf("offset should follow a moved anchor, got %v", s.Offset())
+	}
+}
+
+func TestAnchoredDraggableState_DispatchRawDeltaClamps(t *testing.T) {
+	s := newSheetState()
+	if consumed := s.DispatchRawDelta(-50); consumed != 0 || s.Offset() != 0 {
+		t.Errorf("offset should not go below the first anchor, got %v", s.Offset())
+	}
+	s.DispatchRawDelta(500)
+	if s.Offset() != 200 {
+		t.Errorf("offset should not go past the last anchor, got %v", s.Offset())
+	}
+}
+
+func TestAnchoredDraggableState_SettlePositionalThreshold(t *testing.T) {
+	s := newSheetState()
+	s.DispatchRawDelta(40)
+	s.Settle(0)
+	settle(s)
+	if s.CurrentValue() != sheetHidden || s.Offset() != 0 {
+		t.Errorf("a drag under the threshold should settle back, got %v at %v", s.CurrentValue(), s.Offset())
+	}
+
+	s.DispatchRawDelta(60)
+	if s.TargetValue() != sheetHalf {
+		t.Errorf("target while past the threshold should be the next anchor, got %v", s.TargetValue())
+	}
+	s.Settle(0)
+	settle(s)
+	if s.CurrentValue() != sheetHalf || s.Offset() != 100 {
+		t.Errorf("a drag past the threshold should settle at the next anchor, got %v at %v", s.CurrentValue(), s.Offset())
+	}
+}
+
+func TestAnchoredDraggableState_SettleVelocity(t *testing.T) {
+	s := newSheetState()
+	s.DispatchRawDelta(10)
+	s.Settle(1000)
+	settle(s)
+	if s.CurrentValue() != sheetHalf {
+		t.Errorf("a fling should settle at the next anchor in its direction, got %v", s.CurrentValue())
+	}
+}
+
+func TestAnchoredDraggableState_ConfirmValueChange(t *testing.T) {
+	s := newSheetState(WithConfirmValueChange(func(v sheetValue) bool { return v != sheetHalf }))
+	s.DispatchRawDelta(80)
+	s.Settle(0)
+	settle(s)
+	if s.CurrentValue() != sheetHidden {
+		t.Errorf("a vetoed value should settle back, got %v", s.CurrentValue())
+	}
+}
+
+func TestAnchoredDraggableState_AnimateTo(t *testing.T) {
+	s := newSheetState()
+	s.AnimateTo(sheetExpanded)
+	if !s.IsAnimationRunning() || s.TargetValue() != sheetExpanded {
+		t.Fatal("AnimateTo should start an animation to the target")
+	}
+	settle(s)
+	if s.IsAnimationRunning() || s.Offset() != 200 || s.CurrentValue() != sheetExpanded {
+		t.Errorf("animation should end at the target anchor, got %v at %v", s.CurrentValue(), s.Offset())
+	}
+	if p := s.Progress(sheetHidden, sheetExpanded); p != 1 {
+		t.Errorf("progress should be 1 at the end anchor, got %v", p)
+	}
+}
+
+func TestSpringAnimation_Settles(t *testing.T) {
+	for _, damping := range []float32{DampingRatioNoBouncy, DampingRatioMediumBouncy, 2} {
+		s := &springAnimation{start: 0, target: 100, stiffness: StiffnessMedium, dampingRatio: damping}
+		now := time.Unix(0, 0)
+		value, _, done := s.valueAt(now)
+		if value != 0 || done {
+			t.Fatalf("spring should start at its start value, got %v", value)
+		}
+		for i := 0; i < 500 && !done; i++ {
+			now = now.Add(16 * time.Millisecond)
+			value, _, done = s.valueAt(now)
+		}
+		if !done || value != 100 {
+			t.Errorf("spring with damping %v should settle at the target, got %v", damping, value)
+		}
+	}
+}
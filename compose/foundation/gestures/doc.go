@@ -0,0 +1,13 @@
+// Package gestures provides gesture detectors built on the PointerInput
+// modifier: taps, drags and multi-touch transforms.
+//
+// A detector installs handlers on the scope passed to pointer.PointerInput:
+//
+//	mod := pointer.PointerInput(func(scope pointer.PointerInputScope) {
+//		gestures.DetectDragGestures(scope, func(change *pointer.PointerInputChange, dragAmount geometry.Offset) {
+//			offset.Set(offset.Get().Plus(dragAmount))
+//		})
+//	}, "drag")
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/foundation/foundation/src/commonMain/kotlin/androidx/compose/foundation/gestures/
+package gestures
@@ -0,0 +1,204 @@
+package gestures
+
+import (
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+	"github.com/zodimo/go-compose/modifiers/pointer"
+)
+
+type DragGesturesOptions struct {
+	OnDragStart  func(offset geometry.Offset)
+	OnDragEnd    func()
+	OnDragCancel func()
+}
+
+type DragGesturesOption func(*DragGesturesOptions)
+
+func WithOnDragStart(onDragStart func(offset geometry.Offset)) DragGesturesOption {
+	return func(o *DragGesturesOptions) {
+		o.OnDragStart = onDragStart
+	}
+}
+
+func WithOnDragEnd(onDragEnd func()) DragGesturesOption {
+	return func(o *DragGesturesOptions) {
+		o.OnDragEnd = onDragEnd
+	}
+}
+
+func WithOnDragCancel(onDragCancel func()) DragGesturesOption {
+	return func(o *DragGesturesOptions) {
+		o.OnDragCancel = onDragCancel
+	}
+}
+
+func DefaultDragGesturesOptions() DragGesturesOptions {
+	return DragGesturesOptions{}
+}
+
+// DetectDragGestures detects drags in any direction. Once the pointer moves
+// past the touch slop, OnDragStart is called with the pointer position, the
+// pointer is grabbed from other handlers, and onDrag receives every movement
+// until OnDragEnd, or OnDragCancel if the gesture is canceled. The changes
+// passed to onDrag are consumed.
+//
+// When the dragging finger is lifted while others stay down, the drag
+// continues with one of the remaining fingers.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/foundation/foundation/src/commonMain/kotlin/androidx/compose/foundation/gestures/DragGestureDetector.kt
+func DetectDragGestures(scope pointer.PointerInputScope, onDrag func(change *pointer.PointerInputChange, dragAmount geometry.Offset), options ...DragGesturesOption) {
+	detectDragGestures(scope, dragAxisBoth, onDrag, options)
+}
+
+// DetectHorizontalDragGestures is like DetectDragGestures, but only horizontal
+// movement counts towards the touch slop and is reported.
+func DetectHorizontalDragGestures(scope pointer.PointerInputScope, onHorizontalDrag func(change *pointer.PointerInputChange, dragAmount float32), options ...DragGesturesOption) {
+	detectDragGestures(scope, dragAxisHorizontal, func(change *pointer.PointerInputChange, dragAmount geometry.Offset) {
+		onHorizontalDrag(change, dragAmount.X())
+	}, options)
+}
+
+// DetectVerticalDragGestures is like DetectDragGestures, but only vertical
+// movement counts towards the touch slop and is reported.
+func DetectVerticalDragGestures(scope pointer.PointerInputScope, onVerticalDrag func(change *pointer.PointerInputChange, dragAmount float32), options ...DragGesturesOption) {
+	detectDragGestures(scope, dragAxisVertical, func(change *pointer.PointerInputChange, dragAmount geometry.Offset) {
+		onVerticalDrag(change, dragAmount.Y())
+	}, options)
+}
+
+type dragAxis uint8
+
+const (
+	dragAxisBoth dragAxis = iota
+	dragAxisHorizontal
+	dragAxisVertical
+)
+
+// project keeps the part of offset along the axis.
+func (a dragAxis) project(offset geometry.Offset) geometry.Offset {
+	switch a {
+	case dragAxisHorizontal:
+		return geometry.NewOffset(offset.X(), 0)
+	case dragAxisVertical:
+		return geometry.NewOffset(0, offset.Y())
+	default:
+		return offset
+	}
+}
+
+func detectDragGestures(scope pointer.PointerInputScope, axis dragAxis, onDrag func(change *pointer.PointerInputChange, dragAmount geometry.Offset), options []DragGesturesOption) {
+	if onDrag == nil {
+		panic("DetectDragGestures: onDrag cannot be nil")
+	}
+	opt := DefaultDragGesturesOptions()
+	for _, option := range options {
+		if option == nil {
+			continue
+		}
+		option(&opt)
+	}
+	d := &dragDetector{scope: scope, axis: axis, onDrag: onDrag, options: opt}
+	scope.OnPointerEvent(d.onPointerEvent)
+	scope.OnCancel(d.onCancel)
+}
+
+type dragDetector struct {
+	scope   pointer.PointerInputScope
+	axis    dragAxis
+	onDrag  func(change *pointer.PointerInputChange, dragAmount geometry.Offset)
+	options DragGesturesOptions
+
+	tracking bool
+	id       pointer.PointerID
+	dragging bool
+	// slop accumulates the movement until it passes the touch slop.
+	slop geometry.Offset
+}
+
+func (d *dragDetector) onPointerEvent(event *pointer.PointerEvent) {
+	if !d.tracking {
+		change := event.ChangedPointer()
+		if event.Type == pointer.PointerEventTypePress && change.ChangedToDown() {
+			d.tracking = true
+			d.id = change.ID
+			d.slop = geometry.OffsetZero
+		}
+		return
+	}
+
+	change, ok := event.Change(d.id)
+	if !ok {
+		return
+	}
+	if change.ChangedToUp() {
+		if next, ok := otherPressed(event, d.id); ok && d.dragging {
+			// Hand the drag over to a finger that is still down.
+			change.Consume()
+			d.id = next.ID
+			return
+		}
+		if d.dragging {
+			change.Consume()
+			if d.options.OnDragEnd != nil {
+				d.options.OnDragEnd()
+			}
+		}
+		d.reset()
+		return
+	}
+	if !change.Pressed || event.Type != pointer.PointerEventTypeMove {
+		return
+	}
+
+	delta := d.axis.project(change.PositionChange())
+	if d.dragging {
+		if delta.GetDistanceSquared() > 0 {
+			d.onDrag(change, delta)
+			change.Consume()
+		}
+		return
+	}
+	if change.IsConsumed() {
+		// Another detector, such as a scrollable parent, took the gesture.
+		d.reset()
+		return
+	}
+	d.slop = d.slop.Plus(delta)
+	touchSlop := d.scope.Density().DpToPx(d.scope.ViewConfiguration().TouchSlop)
+	distance := d.slop.GetDistance()
+	if distance <= touchSlop {
+		return
+	}
+	d.dragging = true
+	d.scope.Grab(d.id)
+	if d.options.OnDragStart != nil {
+		d.options.OnDragStart(change.Position)
+	}
+	// Report the movement past the slop so the content follows the pointer
+	// from where the drag was recognized.
+	overSlop := d.slop.Times((distance - touchSlop) / distance)
+	d.onDrag(change, overSlop)
+	change.Consume()
+}
+
+func (d *dragDetector) onCancel() {
+	if d.dragging && d.options.OnDragCancel != nil {
+		d.options.OnDragCancel()
+	}
+	d.reset()
+}
+
+func (d *dragDetector) reset() {
+	d.tracking = false
+	d.dragging = false
+	d.slop = geometry.OffsetZero
+}
+
+// otherPressed returns a change for a pointer other than id that is still down.
+func otherPressed(event *pointer.PointerEvent, id pointer.PointerID) (*pointer.PointerInputChange, bool) {
+	for _, c := range event.Changes {
+		if c.ID != id && c.Pressed {
+			return c, true
+		}
+	}
+	return nil, false
+}
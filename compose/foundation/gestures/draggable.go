@@ -0,0 +1,186 @@
+package gestures
+
+import (
+	"fmt"
+
+	"github.com/zodimo/go-compose/compose"
+	"github.com/zodimo/go-compose/compose/ui"
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+	"github.com/zodimo/go-compose/modifiers/pointer"
+)
+
+// DraggableState receives the deltas of a Draggable modifier.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/foundation/foundation/src/commonMain/kotlin/androidx/compose/foundation/gestures/Draggable.kt
+type DraggableState struct {
+	onDelta  func(delta float32)
+	dragging bool
+	// options are those of the last Draggable modifier using the state, so
+	// that installed handlers see callbacks from the latest composition.
+	options DraggableOptions
+}
+
+// NewDraggableState creates a DraggableState calling onDelta with every drag
+// delta, in pixels along the drag orientation.
+func NewDraggableState(onDelta func(delta float32)) *DraggableState {
+	if onDelta == nil {
+		panic("NewDraggableState: onDelta cannot be nil")
+	}
+	return &DraggableState{onDelta: onDelta}
+}
+
+// RememberDraggableState returns a DraggableState that survives
+// recompositions. onDelta is updated on every composition, so it can capture
+// values from the current one.
+func RememberDraggableState(c compose.Composer, onDelta func(delta float32)) *DraggableState {
+	key := fmt.Sprintf("draggableState-%v", c.GenerateID())
+	s := c.State(key, func() any { return NewDraggableState(onDelta) }).Get().(*DraggableState)
+	s.onDelta = onDelta
+	return s
+}
+
+// DispatchRawDelta sends delta to the state as if it came from a drag.
+func (s *DraggableState) DispatchRawDelta(delta float32) {
+	s.onDelta(delta)
+}
+
+// IsDragging reports whether a drag is in progress.
+func (s *DraggableState) IsDragging() bool {
+	return s.dragging
+}
+
+type DraggableOptions struct {
+	Enabled bool
+	// ReverseDirection reverses the deltas, e.g. for right-to-left layouts.
+	ReverseDirection bool
+	OnDragStarted    func(startedPosition geometry.Offset)
+	// OnDragStopped receives the velocity at the end of the drag, in pixels
+	// per second along the drag orientation.
+	OnDragStopped func(velocity float32)
+}
+
+type DraggableOption func(*DraggableOptions)
+
+func WithEnabled(enabled bool) DraggableOption {
+	return func(o *DraggableOptions) {
+		o.Enabled = enabled
+	}
+}
+
+func WithReverseDirection(reverseDirection bool) DraggableOption {
+	return func(o *DraggableOptions) {
+		o.ReverseDirection = reverseDirection
+	}
+}
+
+func WithOnDragStarted(onDragStarted func(startedPosition geometry.Offset)) DraggableOption {
+	return func(o *DraggableOptions) {
+		o.OnDragStarted = onDragStarted
+	}
+}
+
+func WithOnDragStopped(onDragStopped func(velocity float32)) DraggableOption {
+	return func(o *DraggableOptions) {
+		o.OnDragStopped = onDragStopped
+	}
+}
+
+func DefaultDraggableOptions() DraggableOptions {
+	return DraggableOptions{
+		Enabled: true,
+	}
+}
+
+// Draggable makes the element draggable along orientation, sending the drag
+// deltas to state. Unlike DetectDragGestures it only reports the movement
+// along one axis, and computes the release velocity for flings.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/foundation/foundation/src/commonMain/kotlin/androidx/compose/foundation/gestures/Draggable.kt
+func Draggable(state *DraggableState, orientation Orientation, options ...DraggableOption) ui.Modifier {
+	opt := DefaultDraggableOptions()
+	for _, option := range options {
+		if option == nil {
+			continue
+		}
+		option(&opt)
+	}
+	state.options = opt
+
+	return pointer.PointerInput(func(scope pointer.PointerInputScope) {
+		if !opt.Enabled {
+			return
+		}
+		var tracker pointer.VelocityTracker
+		detectOrientedDrags(scope, orientation, orientedDragHandlers{
+			onStart: func(change *pointer.PointerInputChange) {
+				state.dragging = true
+				tracker.Reset()
+				tracker.AddPointerInputChange(change)
+				if state.options.OnDragStarted != nil {
+					state.options.OnDragStarted(change.Position)
+				}
+			},
+			onDelta: func(change *pointer.PointerInputChange, delta float32) {
+				tracker.AddPointerInputChange(change)
+				state.DispatchRawDelta(reverse(delta, state.options.ReverseDirection))
+			},
+			onStop: func(canceled bool) {
+				state.dragging = false
+				velocity := float32(0)
+				if !canceled {
+					velocity = reverse(axisValue(tracker.CalculateVelocity(), orientation), state.options.ReverseDirection)
+				}
+				if state.options.OnDragStopped != nil {
+					state.options.OnDragStopped(velocity)
+				}
+			},
+		})
+	}, state, orientation, opt.Enabled)
+}
+
+// orientedDragHandlers are the callbacks shared by the drag based modifiers.
+type orientedDragHandlers struct {
+	onStart func(change *pointer.PointerInputChange)
+	onDelta func(change *pointer.PointerInputChange, delta float32)
+	onStop  func(canceled bool)
+}
+
+// detectOrientedDrags installs a drag detector along orientation.
+func detectOrientedDrags(scope pointer.PointerInputScope, orientation Orientation, h orientedDragHandlers) {
+	active := false
+	onDrag := func(change *pointer.PointerInputChange, delta float32) {
+		if !active {
+			active = true
+			h.onStart(change)
+		}
+		h.onDelta(change, delta)
+	}
+	stop := func(canceled bool) func() {
+		return func() {
+			if active {
+				active = false
+				h.onStop(canceled)
+			}
+		}
+	}
+	options := []DragGesturesOption{WithOnDragEnd(stop(false)), WithOnDragCancel(stop(true))}
+	if orientation == OrientationHorizontal {
+		DetectHorizontalDragGestures(scope, onDrag, options...)
+	} else {
+		DetectVerticalDragGestures(scope, onDrag, options...)
+	}
+}
+
+func axisValue(offset geometry.Offset, orientation Orientation) float32 {
+	if orientation == OrientationHorizontal {
+		return offset.X()
+	}
+	return offset.Y()
+}
+
+func reverse(value float32, reversed bool) float32 {
+	if reversed {
+		return -value
+	}
+	return value
+}
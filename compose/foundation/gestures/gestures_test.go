@@ -0,0 +1,266 @@
+package gestures
+
+import (
+	"testing"
+	"time"
+
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+	"github.com/zodimo/go-compose/modifiers/pointer"
+)
+
+// fakeScope records handlers and lets tests replay pointer events.
+type fakeScope struct {
+	now      time.Time
+	handlers []pointer.PointerEventHandler
+	cancels  []func()
+	frames   []func(now time.Time)
+	grabbed  []pointer.PointerID
+	scroll   bool
+
+	positions map[pointer.PointerID]geometry.Offset
+}
+
+func newFakeScope() *fakeScope {
+	return &fakeScope{now: time.Unix(0, 0), positions: map[pointer.PointerID]geometry.Offset{}}
+}
+
+func (s *fakeScope) Size() unit.IntSize    { return unit.IntSize{Width: 100, Height: 100} }
+func (s *fakeScope) Density() unit.Density { return unit.NewDensity(1, 1) }
+func (s *fakeScope) ViewConfiguration() pointer.ViewConfiguration {
+	return pointer.DefaultViewConfiguration()
+}
+func (s *fakeScope) OnPointerEvent(h pointer.PointerEventHandler) { s.handlers = append(s.handlers, h) }
+func (s *fakeScope) OnCancel(h func())                            { s.cancels = append(s.cancels, h) }
+func (s *fakeScope) OnFrame(h func(now time.Time))                { s.frames = append(s.frames, h) }
+func (s *fakeScope) InvalidateAt(time.Time)                       {}
+func (s *fakeScope) Grab(id pointer.PointerID)                    { s.grabbed = append(s.grabbed, id) }
+func (s *fakeScope) ReceiveScrollEvents()                         { s.scroll = true }
+
+func (s *fakeScope) advance(d time.Duration) {
+	s.now = s.now.Add(d)
+	for _, h := range s.frames {
+		h(s.now)
+	}
+}
+
+// send dispatches an event for pointer id, including the other pointers that are down.
+func (s *fakeScope) send(eventType pointer.PointerEventType, id pointer.PointerID, x, y float32) *pointer.PointerEvent {
+	position := geometry.NewOffset(x, y)
+	var changes []*pointer.PointerInputChange
+	for other, p := range s.positions {
+		if other != id {
+			changes = append(changes, &pointer.PointerInputChange{ID: other, Position: p, PreviousPosition: p, Pressed: true, PreviousPressed: true})
+		}
+	}
+	previous, wasDown := s.positions[id]
+	if !wasDown {
+		previous = position
+	}
+	pressed := eventType == pointer.PointerEventTypePress || (eventType == pointer.PointerEventTypeMove && wasDown)
+	changes = append(changes, &pointer.PointerInputChange{
+		ID:               id,
+		Type:             pointer.PointerTypeTouch,
+		Position:         position,
+		PreviousPosition: previous,
+		Pressed:          pressed,
+		PreviousPressed:  wasDown,
+	})
+	if pressed {
+		s.positions[id] = position
+	} else {
+		delete(s.positions, id)
+	}
+	event := &pointer.PointerEvent{Type: eventType, Changes: changes, Time: s.now}
+	for _, h := range s.handlers {
+		h(event)
+	}
+	return event
+}
+
+func TestDetectTapGestures_Tap(t *testing.T) {
+	s := newFakeScope()
+	var taps []geometry.Offset
+	var released []bool
+	DetectTapGestures(s,
+		WithOnTap(func(offset geometry.Offset) { taps = append(taps, offset) }),
+		WithOnPress(func(scope PressGestureScope, offset geometry.Offset) {
+			scope.TryAwaitRelease(func(r bool) { released = append(released, r) })
+		}),
+	)
+
+	s.send(pointer.PointerEventTypePress, 1, 10, 10)
+	s.advance(50 * time.Millisecond)
+	s.send(pointer.PointerEventTypeRelease, 1, 12, 10)
+
+	if len(taps) != 1 || !taps[0].Equal(geometry.NewOffset(12, 10)) {
+		t.Fatalf("expected one tap at (12, 10), got %v", taps)
+	}
+	if len(released) != 1 || !released[0] {
+		t.Fatalf("press should end with a release, got %v", released)
+	}
+}
+
+func TestDetectTapGestures_DoubleTap(t *testing.T) {
+	s := newFakeScope()
+	taps, doubleTaps := 0, 0
+	DetectTapGestures(s,
+		WithOnTap(func(geometry.Offset) { taps++ }),
+		WithOnDoubleTap(func(geometry.Offset) { doubleTaps++ }),
+	)
+
+	s.send(pointer.PointerEventTypePress, 1, 10, 10)
+	s.send(pointer.PointerEventTypeRelease, 1, 10, 10)
+	s.advance(100 * time.Millisecond)
+	if taps != 0 {
+		t.Fatal("tap should wait for the double tap timeout")
+	}
+	s.send(pointer.PointerEventTypePress, 2, 12, 12)
+	s.send(pointer.PointerEventTypeRelease, 2, 12, 12)
+	if doubleTaps != 1 || taps != 0 {
+		t.Fatalf("expected a double tap, got %d taps and %d double taps", taps, doubleTaps)
+	}
+
+	s.send(pointer.PointerEventTypePress, 3, 10, 10)
+	s.send(pointer.PointerEventTypeRelease, 3, 10, 10)
+	s.advance(time.Second)
+	if taps != 1 {
+		t.Fatalf("a single tap should be reported after the timeout, got %d", taps)
+	}
+}
+
+func TestDetectTapGestures_LongPress(t *testing.T) {
+	s := newFakeScope()
+	taps, longPresses := 0, 0
+	DetectTapGestures(s,
+		WithOnTap(func(geometry.Offset) { taps++ }),
+		WithOnLongPress(func(geometry.Offset) { longPresses++ }),
+	)
+
+	s.send(pointer.PointerEventTypePress, 1, 10, 10)
+	s.advance(500 * time.Millisecond)
+	s.send(pointer.PointerEventTypeRelease, 1, 10, 10)
+
+	if longPresses != 1 || taps != 0 {
+		t.Fatalf("expected only a long press, got %d taps and %d long presses", taps, longPresses)
+	}
+}
+
+func TestDetectTapGestures_CanceledByDrag(t *testing.T) {
+	s := newFakeScope()
+	taps := 0
+	var released []bool
+	DetectDragGestures(s, func(*pointer.PointerInputChange, geometry.Offset) {})
+	DetectTapGestures(s,
+		WithOnTap(func(geometry.Offset) { taps++ }),
+		WithOnPress(func(scope PressGestureScope, offset geometry.Offset) {
+			scope.TryAwaitRelease(func(r bool) { released = append(released, r) })
+		}),
+	)
+
+	s.send(pointer.PointerEventTypePress, 1, 10, 10)
+	s.send(pointer.PointerEventTypeMove, 1, 40, 10)
+	s.send(pointer.PointerEventTypeRelease, 1, 40, 10)
+
+	if taps != 0 {
+		t.Fatalf("a drag should cancel the tap, got %d taps", taps)
+	}
+	if len(released) != 1 || released[0] {
+		t.Fatalf("press should be canceled, got %v", released)
+	}
+}
+
+func TestDetectDragGestures(t *testing.T) {
+	s := newFakeScope()
+	var total geometry.Offset
+	started, ended := 0, 0
+	DetectDragGestures(s,
+		func(change *pointer.PointerInputChange, dragAmount geometry.Offset) { total = total.Plus(dragAmount) },
+		WithOnDragStart(func(geometry.Offset) { started++ }),
+		WithOnDragEnd(func() { ended++ }),
+	)
+
+	s.send(pointer.PointerEventTypePress, 1, 0, 0)
+	s.send(pointer.PointerEventTypeMove, 1, 5, 0)
+	if started != 0 {
+		t.Fatal("drag should not start within the touch slop")
+	}
+	event := s.send(pointer.PointerEventTypeMove, 1, 20, 0)
+	if started != 1 || len(s.grabbed) != 1 {
+		t.Fatalf("drag should start and grab the pointer past the slop")
+	}
+	if !event.ChangedPointer().IsConsumed() {
+		t.Error("drag changes should be consumed")
+	}
+	s.send(pointer.PointerEventTypeMove, 1, 30, 0)
+	s.send(pointer.PointerEventTypeRelease, 1, 30, 0)
+
+	// 30px moved, minus the 8px slop.
+	if total.X() != 22 || total.Y() != 0 {
+		t.Errorf("expected a total drag of (22, 0), got %s", total)
+	}
+	if ended != 1 {
+		t.Errorf("expected the drag to end once, got %d", ended)
+	}
+}
+
+func TestDetectVerticalDragGestures_IgnoresHorizontal(t *testing.T) {
+	s := newFakeScope()
+	var total float32
+	DetectVerticalDragGestures(s, func(change *pointer.PointerInputChange, dragAmount float32) { total += dragAmount })
+
+	s.send(pointer.PointerEventTypePress, 1, 0, 0)
+	s.send(pointer.PointerEventTypeMove, 1, 50, 2)
+	if total != 0 {
+		t.Fatal("horizontal movement should not start a vertical drag")
+	}
+	s.send(pointer.PointerEventTypeMove, 1, 50, 20)
+	if total != 12 {
+		t.Errorf("expected a vertical drag of 12, got %v", total)
+	}
+}
+
+func TestDetectTransformGestures_Pinch(t *testing.T) {
+	s := newFakeScope()
+	zoom := float32(1)
+	DetectTransformGestures(s, func(centroid, pan geometry.Offset, z, rotation float32) { zoom *= z })
+
+	s.send(pointer.PointerEventTypePress, 1, 40, 50)
+	s.send(pointer.PointerEventTypePress, 2, 60, 50)
+	s.send(pointer.PointerEventTypeMove, 1, 20, 50)
+	s.send(pointer.PointerEventTypeMove, 2, 80, 50)
+
+	// The fingers moved from 20px apart to 60px apart.
+	if zoom < 2.9 || zoom > 3.1 {
+		t.Errorf("expected a zoom of 3, got %v", zoom)
+	}
+}
+
+func TestDetectTransformGestures_ScrollZoom(t *testing.T) {
+	s := newFakeScope()
+	DetectTransformGestures(s, func(centroid, pan geometry.Offset, zoom, rotation float32) {})
+	if s.scroll {
+		t.Error("scroll events should be left to scrollable parents by default")
+	}
+
+	s = newFakeScope()
+	DetectTransformGestures(s, func(centroid, pan geometry.Offset, zoom, rotation float32) {}, WithScrollZoom(true))
+	if !s.scroll {
+		t.Error("WithScrollZoom should receive scroll events")
+	}
+}
+
+func TestDetectTransformGestures_Rotation(t *testing.T) {
+	s := newFakeScope()
+	var rotation float32
+	DetectTransformGestures(s, func(centroid, pan geometry.Offset, zoom, r float32) { rotation += r })
+
+	s.send(pointer.PointerEventTypePress, 1, 30, 50)
+	s.send(pointer.PointerEventTypePress, 2, 70, 50)
+	s.send(pointer.PointerEventTypeMove, 1, 50, 30)
+	s.send(pointer.PointerEventTypeMove, 2, 50, 70)
+
+	if rotation < 89 || rotation > 91 {
+		t.Errorf("expected a rotation of 90 degrees, got %v", rotation)
+	}
+}
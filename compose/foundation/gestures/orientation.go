@@ -0,0 +1,22 @@
+package gestures
+
+// Orientation is the axis along which a component scrolls or drags.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/foundation/foundation/src/commonMain/kotlin/androidx/compose/foundation/gestures/Orientation.kt
+type Orientation uint8
+
+const (
+	OrientationVertical Orientation = iota
+	OrientationHorizontal
+)
+
+func (o Orientation) String() string {
+	switch o {
+	case OrientationVertical:
+		return "Vertical"
+	case OrientationHorizontal:
+		return "Horizontal"
+	default:
+		return "Unknown"
+	}
+}
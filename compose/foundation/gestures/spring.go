@@ -0,0 +1,81 @@
+package gestures
+
+import (
+	"math"
+	"time"
+)
+
+// Spring parameters matching Compose's Spring constants.
+const (
+	StiffnessHigh      float32 = 10_000
+	StiffnessMedium    float32 = 1500
+	StiffnessMediumLow float32 = 400
+	StiffnessLow       float32 = 200
+
+	DampingRatioNoBouncy     float32 = 1
+	DampingRatioLowBouncy    float32 = 0.75
+	DampingRatioMediumBouncy float32 = 0.5
+)
+
+// springVisibilityThreshold is the distance, in pixels, under which a spring
+// is considered settled.
+const springVisibilityThreshold = 0.5
+
+// springAnimation animates a value towards target with a damped spring,
+// starting from start with the given initial velocity, in units per second.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/animation/animation-core/src/commonMain/kotlin/androidx/compose/animation/core/SpringSimulation.kt
+type springAnimation struct {
+	start, target, velocity float32
+	stiffness, dampingRatio float32
+	// startTime is set on the first frame of the animation.
+	startTime time.Time
+}
+
+// valueAt returns the animated value and velocity at now, and whether the
+// spring has settled.
+func (s *springAnimation) valueAt(now time.Time) (value, velocity float32, done bool) {
+	if s.startTime.IsZero() {
+		s.startTime = now
+	}
+	t := now.Sub(s.startTime).Seconds()
+	x, v := s.displacement(t)
+	if math.Abs(x) < springVisibilityThreshold && math.Abs(v) < springVisibilityThreshold*10 {
+		return s.target, 0, true
+	}
+	return s.target + float32(x), float32(v), false
+}
+
+// displacement returns the distance to target and the velocity at t seconds.
+func (s *springAnimation) displacement(t float64) (x, v float64) {
+	x0 := float64(s.start - s.target)
+	v0 := float64(s.velocity)
+	omega := math.Sqrt(float64(s.stiffness))
+	zeta := float64(s.dampingRatio)
+
+	switch {
+	case zeta == 1:
+		a := x0
+		b := v0 + omega*x0
+		e := math.Exp(-omega * t)
+		x = (a + b*t) * e
+		v = b*e - omega*(a+b*t)*e
+	case zeta < 1:
+		wd := omega * math.Sqrt(1-zeta*zeta)
+		a := x0
+		b := (v0 + zeta*omega*x0) / wd
+		e := math.Exp(-zeta * omega * t)
+		cos, sin := math.Cos(wd*t), math.Sin(wd*t)
+		x = e * (a*cos + b*sin)
+		v = -zeta*omega*x + e*(-a*wd*sin+b*wd*cos)
+	default:
+		root := math.Sqrt(zeta*zeta - 1)
+		r1 := -omega * (zeta - root)
+		r2 := -omega * (zeta + root)
+		c2 := (v0 - r1*x0) / (r2 - r1)
+		c1 := x0 - c2
+		x = c1*math.Exp(r1*t) + c2*math.Exp(r2*t)
+		v = c1*r1*math.Exp(r1*t) + c2*r2*math.Exp(r2*t)
+	}
+	return x, v
+}
@@ -0,0 +1,249 @@
+package gestures
+
+import (
+	"time"
+
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+	"github.com/zodimo/go-compose/modifiers/pointer"
+)
+
+// PressGestureScope is passed to the OnPress callback of DetectTapGestures.
+type PressGestureScope interface {
+	// TryAwaitRelease calls fn once the press ends. released is true when the
+	// pointer was released, and false when the press was canceled, for
+	// example because the pointer left the element or a drag took over.
+	TryAwaitRelease(fn func(released bool))
+}
+
+type TapGesturesOptions struct {
+	OnDoubleTap func(offset geometry.Offset)
+	OnLongPress func(offset geometry.Offset)
+	OnPress     func(scope PressGestureScope, offset geometry.Offset)
+	OnTap       func(offset geometry.Offset)
+}
+
+type TapGesturesOption func(*TapGesturesOptions)
+
+func WithOnDoubleTap(onDoubleTap func(offset geometry.Offset)) TapGesturesOption {
+	return func(o *TapGesturesOptions) {
+		o.OnDoubleTap = onDoubleTap
+	}
+}
+
+func WithOnLongPress(onLongPress func(offset geometry.Offset)) TapGesturesOption {
+	return func(o *TapGesturesOptions) {
+		o.OnLongPress = onLongPress
+	}
+}
+
+func WithOnPress(onPress func(scope PressGestureScope, offset geometry.Offset)) TapGesturesOption {
+	return func(o *TapGesturesOptions) {
+		o.OnPress = onPress
+	}
+}
+
+func WithOnTap(onTap func(offset geometry.Offset)) TapGesturesOption {
+	return func(o *TapGesturesOptions) {
+		o.OnTap = onTap
+	}
+}
+
+func DefaultTapGesturesOptions() TapGesturesOptions {
+	return TapGesturesOptions{}
+}
+
+// DetectTapGestures detects taps, double taps, long presses and presses.
+//
+// OnPress is called when the pointer goes down; its PressGestureScope tells
+// whether the press ended in a release or was canceled. When OnDoubleTap is
+// set, OnTap is delayed by the double tap timeout to wait for a second tap.
+// When a long press is detected, no tap is reported for that press.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/foundation/foundation/src/commonMain/kotlin/androidx/compose/foundation/gestures/TapGestureDetector.kt
+func DetectTapGestures(scope pointer.PointerInputScope, options ...TapGesturesOption) {
+	opt := DefaultTapGesturesOptions()
+	for _, option := range options {
+		if option == nil {
+			continue
+		}
+		option(&opt)
+	}
+	d := &tapDetector{scope: scope, options: opt}
+	scope.OnPointerEvent(d.onPointerEvent)
+	scope.OnCancel(d.onCancel)
+	scope.OnFrame(d.onFrame)
+}
+
+type pressScope struct {
+	done      bool
+	released  bool
+	callbacks []func(released bool)
+}
+
+func (p *pressScope) TryAwaitRelease(fn func(released bool)) {
+	if p.done {
+		fn(p.released)
+		return
+	}
+	p.callbacks = append(p.callbacks, fn)
+}
+
+func (p *pressScope) finish(released bool) {
+	if p.done {
+		return
+	}
+	p.done = true
+	p.released = released
+	for _, fn := range p.callbacks {
+		fn(released)
+	}
+	p.callbacks = nil
+}
+
+type tapDown struct {
+	id       pointer.PointerID
+	position geometry.Offset
+	press    *pressScope
+	// longPressAt is when a long press fires, zero when long presses are not detected.
+	longPressAt time.Time
+	longPressed bool
+	// second is set when this press is the second tap of a double tap.
+	second bool
+}
+
+type pendingTap struct {
+	position geometry.Offset
+	upTime   time.Time
+}
+
+type tapDetector struct {
+	scope   pointer.PointerInputScope
+	options TapGesturesOptions
+
+	down *tapDown
+	// pending is a tap waiting for the double tap timeout.
+	pending *pendingTap
+}
+
+func (d *tapDetector) onPointerEvent(event *pointer.PointerEvent) {
+	if d.down == nil {
+		change := event.ChangedPointer()
+		if event.Type == pointer.PointerEventTypePress && change.ChangedToDown() && !change.IsConsumed() && len(event.Changes) == 1 {
+			d.startPress(event, change)
+		}
+		return
+	}
+
+	change, ok := event.Change(d.down.id)
+	if !ok {
+		return
+	}
+	switch {
+	case change.ChangedToUp():
+		if change.IsConsumed() {
+			d.cancelPress()
+			return
+		}
+		change.Consume()
+		d.release(event, change)
+	case change.Pressed:
+		if d.down.longPressed {
+			change.Consume()
+			return
+		}
+		if change.IsConsumed() || d.isOutOfBounds(change.Position) {
+			d.cancelPress()
+		}
+	}
+}
+
+func (d *tapDetector) startPress(event *pointer.PointerEvent, change *pointer.PointerInputChange) {
+	cfg := d.scope.ViewConfiguration()
+	down := &tapDown{id: change.ID, position: change.Position, press: &pressScope{}}
+
+	if d.pending != nil {
+		elapsed := event.Time.Sub(d.pending.upTime)
+		slop := d.scope.Density().DpToPx(cfg.DoubleTapSlop)
+		if elapsed >= cfg.DoubleTapMinTime && change.Position.Minus(d.pending.position).GetDistance() <= slop {
+			down.second = true
+		} else {
+			d.firePendingTap()
+		}
+	}
+	if d.options.OnLongPress != nil {
+		down.longPressAt = event.Time.Add(cfg.LongPressTimeout)
+		d.scope.InvalidateAt(down.longPressAt)
+	}
+	d.down = down
+	change.Consume()
+	if d.options.OnPress != nil {
+		d.options.OnPress(down.press, change.Position)
+	}
+}
+
+func (d *tapDetector) release(event *pointer.PointerEvent, change *pointer.PointerInputChange) {
+	down := d.down
+	d.down = nil
+	down.press.finish(true)
+
+	switch {
+	case down.longPressed:
+	case down.second:
+		d.pending = nil
+		d.options.OnDoubleTap(change.Position)
+	case d.options.OnDoubleTap == nil:
+		if d.options.OnTap != nil {
+			d.options.OnTap(change.Position)
+		}
+	default:
+		d.pending = &pendingTap{position: change.Position, upTime: event.Time}
+		d.scope.InvalidateAt(event.Time.Add(d.scope.ViewConfiguration().DoubleTapTimeout))
+	}
+}
+
+// cancelPress ends the current press without a tap. When it was the second
+// press of a double tap, the first one still counts as a tap.
+func (d *tapDetector) cancelPress() {
+	down := d.down
+	d.down = nil
+	down.press.finish(false)
+	if down.second {
+		d.firePendingTap()
+	}
+}
+
+func (d *tapDetector) onCancel() {
+	if d.down != nil {
+		d.cancelPress()
+	}
+}
+
+func (d *tapDetector) onFrame(now time.Time) {
+	if down := d.down; down != nil {
+		if !down.longPressAt.IsZero() && !down.longPressed && !now.Before(down.longPressAt) {
+			if down.second {
+				down.second = false
+				d.firePendingTap()
+			}
+			down.longPressed = true
+			d.options.OnLongPress(down.position)
+		}
+		return
+	}
+	if d.pending != nil && !now.Before(d.pending.upTime.Add(d.scope.ViewConfiguration().DoubleTapTimeout)) {
+		d.firePendingTap()
+	}
+}
+
+func (d *tapDetector) firePendingTap() {
+	pending := d.pending
+	d.pending = nil
+	if pending != nil && d.options.OnTap != nil {
+		d.options.OnTap(pending.position)
+	}
+}
+
+func (d *tapDetector) isOutOfBounds(position geometry.Offset) bool {
+	size := d.scope.Size()
+	return position.X() < 0 || position.Y() < 0 || position.X() > float32(size.Width) || position.Y() > float32(size.Height)
+}
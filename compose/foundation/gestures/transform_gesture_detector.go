@@ -0,0 +1,295 @@
+package gestures
+
+import (
+	"math"
+
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+	"github.com/zodimo/go-compose/modifiers/pointer"
+
+	"gioui.org/io/key"
+)
+
+// TransformGestureHandler receives the changes of a transform gesture since
+// the previous event: pan in pixels, zoom as a scale factor and rotation in
+// degrees, all around centroid.
+type TransformGestureHandler func(centroid, pan geometry.Offset, zoom, rotation float32)
+
+type TransformGesturesOptions struct {
+	// PanZoomLock disables rotation when the gesture started as a pan or zoom.
+	PanZoomLock bool
+	// ScrollZoom zooms on scroll events while Ctrl is held, which is how
+	// trackpad pinches are reported on desktop platforms. It is off by default:
+	// enabling it makes the element receive all scroll events, with or without
+	// Ctrl, so the scrollable parents of the element no longer scroll over it.
+	ScrollZoom bool
+}
+
+type TransformGesturesOption func(*TransformGesturesOptions)
+
+func WithPanZoomLock(panZoomLock bool) TransformGesturesOption {
+	return func(o *TransformGesturesOptions) {
+		o.PanZoomLock = panZoomLock
+	}
+}
+
+func WithScrollZoom(scrollZoom bool) TransformGesturesOption {
+	return func(o *TransformGesturesOptions) {
+		o.ScrollZoom = scrollZoom
+	}
+}
+
+func DefaultTransformGesturesOptions() TransformGesturesOptions {
+	return TransformGesturesOptions{
+		PanZoomLock: false,
+		ScrollZoom:  false,
+	}
+}
+
+// scrollZoomFactor is the zoom applied per pixel of Ctrl+scroll.
+const scrollZoomFactor = 0.005
+
+// DetectTransformGestures detects pan, pinch zoom and rotation gestures with
+// any number of fingers. Once the accumulated motion passes the touch slop,
+// the pointers are grabbed and onGesture is called for every change; the
+// changes are consumed.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/foundation/foundation/src/commonMain/kotlin/androidx/compose/foundation/gestures/TransformGestureDetector.kt
+func DetectTransformGestures(scope pointer.PointerInputScope, onGesture TransformGestureHandler, options ...TransformGesturesOption) {
+	if onGesture == nil {
+		panic("DetectTransformGestures: onGesture cannot be nil")
+	}
+	opt := DefaultTransformGesturesOptions()
+	for _, option := range options {
+		if option == nil {
+			continue
+		}
+		option(&opt)
+	}
+	if opt.ScrollZoom {
+		scope.ReceiveScrollEvents()
+	}
+	d := &transformDetector{scope: scope, onGesture: onGesture, options: opt}
+	scope.OnPointerEvent(d.onPointerEvent)
+	scope.OnCancel(d.reset)
+}
+
+type transformDetector struct {
+	scope     pointer.PointerInputScope
+	onGesture TransformGestureHandler
+	options   TransformGesturesOptions
+
+	active          bool
+	canceled        bool
+	pastTouchSlop   bool
+	lockedToPanZoom bool
+
+	zoom     float32
+	rotation float32
+	pan      geometry.Offset
+}
+
+func (d *transformDetector) onPointerEvent(event *pointer.PointerEvent) {
+	if event.Type == pointer.PointerEventTypeScroll {
+		d.onScroll(event)
+		return
+	}
+
+	anyPressed := false
+	for _, c := range event.Changes {
+		if c.Pressed {
+			anyPressed = true
+			break
+		}
+	}
+	if !anyPressed {
+		d.reset()
+		return
+	}
+	if !d.active {
+		d.active = true
+		d.zoom = 1
+	}
+	if d.canceled || event.Type != pointer.PointerEventTypeMove {
+		return
+	}
+
+	for _, c := range event.Changes {
+		if c.IsConsumed() {
+			if !d.pastTouchSlop {
+				d.canceled = true
+			}
+			return
+		}
+	}
+
+	zoomChange := calculateZoom(event)
+	rotationChange := calculateRotation(event)
+	panChange := calculatePan(event)
+
+	if !d.pastTouchSlop {
+		d.zoom *= zoomChange
+		d.rotation += rotationChange
+		d.pan = d.pan.Plus(panChange)
+
+		centroidSize := centroidSize(event, false)
+		touchSlop := d.scope.Density().DpToPx(d.scope.ViewConfiguration().TouchSlop)
+		zoomMotion := float32(math.Abs(float64(1-d.zoom))) * centroidSize
+		rotationMotion := float32(math.Abs(float64(d.rotation) * math.Pi * float64(centroidSize) / 180))
+		panMotion := d.pan.GetDistance()
+
+		if zoomMotion > touchSlop || rotationMotion > touchSlop || panMotion > touchSlop {
+			d.pastTouchSlop = true
+			d.lockedToPanZoom = d.options.PanZoomLock && rotationMotion < touchSlop
+			for _, c := range event.Changes {
+				if c.Pressed {
+					d.scope.Grab(c.ID)
+				}
+			}
+		}
+	}
+	if !d.pastTouchSlop {
+		return
+	}
+
+	if d.lockedToPanZoom {
+		rotationChange = 0
+	}
+	if rotationChange != 0 || zoomChange != 1 || panChange.GetDistanceSquared() != 0 {
+		d.onGesture(calculateCentroid(event, false), panChange, zoomChange, rotationChange)
+	}
+	for _, c := range event.Changes {
+		if c.PositionChange().GetDistanceSquared() != 0 {
+			c.Consume()
+		}
+	}
+}
+
+func (d *transformDetector) onScroll(event *pointer.PointerEvent) {
+	if !d.options.ScrollZoom || !event.KeyboardModifiers.Contain(key.ModCtrl) {
+		return
+	}
+	change := event.ChangedPointer()
+	if change.IsConsumed() || change.ScrollDelta.Y() == 0 {
+		return
+	}
+	zoom := float32(math.Exp(float64(-change.ScrollDelta.Y() * scrollZoomFactor)))
+	d.onGesture(change.Position, geometry.OffsetZero, zoom, 0)
+	change.Consume()
+}
+
+func (d *transformDetector) reset() {
+	d.active = false
+	d.canceled = false
+	d.pastTouchSlop = false
+	d.lockedToPanZoom = false
+	d.zoom = 1
+	d.rotation = 0
+	d.pan = geometry.OffsetZero
+}
+
+// calculateCentroid returns the average position of the pointers that were
+// down before and after the event.
+func calculateCentroid(event *pointer.PointerEvent, previous bool) geometry.Offset {
+	sum := geometry.OffsetZero
+	count := 0
+	for _, c := range event.Changes {
+		if c.Pressed && c.PreviousPressed {
+			if previous {
+				sum = sum.Plus(c.PreviousPosition)
+			} else {
+				sum = sum.Plus(c.Position)
+			}
+			count++
+		}
+	}
+	if count == 0 {
+		return geometry.OffsetUnspecified
+	}
+	return sum.Div(float32(count))
+}
+
+// centroidSize returns the average distance of the pointers to the centroid.
+func centroidSize(event *pointer.PointerEvent, previous bool) float32 {
+	centroid := calculateCentroid(event, previous)
+	if centroid.IsUnspecified() {
+		return 0
+	}
+	var distance float32
+	count := 0
+	for _, c := range event.Changes {
+		if c.Pressed && c.PreviousPressed {
+			position := c.Position
+			if previous {
+				position = c.PreviousPosition
+			}
+			distance += position.Minus(centroid).GetDistance()
+			count++
+		}
+	}
+	return distance / float32(count)
+}
+
+func calculatePan(event *pointer.PointerEvent) geometry.Offset {
+	current := calculateCentroid(event, false)
+	if current.IsUnspecified() {
+		return geometry.OffsetZero
+	}
+	return current.Minus(calculateCentroid(event, true))
+}
+
+func calculateZoom(event *pointer.PointerEvent) float32 {
+	current := centroidSize(event, false)
+	previous := centroidSize(event, true)
+	if current == 0 || previous == 0 {
+		return 1
+	}
+	return current / previous
+}
+
+// calculateRotation returns the average change of the pointers' angle around
+// the centroid, in degrees.
+func calculateRotation(event *pointer.PointerEvent) float32 {
+	pressed := 0
+	for _, c := range event.Changes {
+		if c.Pressed && c.PreviousPressed {
+			pressed++
+		}
+	}
+	if pressed < 2 {
+		return 0
+	}
+	currentCentroid := calculateCentroid(event, false)
+	previousCentroid := calculateCentroid(event, true)
+
+	var rotation, weight float64
+	for _, c := range event.Changes {
+		if !c.Pressed || !c.PreviousPressed {
+			continue
+		}
+		previous := c.PreviousPosition.Minus(previousCentroid)
+		current := c.Position.Minus(currentCentroid)
+		angle := angleOf(current) - angleOf(previous)
+		// Keep the change in (-180, 180].
+		if angle > 180 {
+			angle -= 360
+		} else if angle <= -180 {
+			angle += 360
+		}
+		// Weight by distance, so that fingers close to the centroid, whose
+		// angle is unstable, count less.
+		w := float64(current.Plus(previous).GetDistance() / 2)
+		rotation += angle * w
+		weight += w
+	}
+	if weight == 0 {
+		return 0
+	}
+	return float32(rotation / weight)
+}
+
+func angleOf(o geometry.Offset) float64 {
+	if o.X() == 0 && o.Y() == 0 {
+		return 0
+	}
+	return math.Atan2(float64(o.Y()), float64(o.X())) * 180 / math.Pi
+}
@@ -0,0 +1,129 @@
+package gestures
+
+import (
+	"fmt"
+
+	"github.com/zodimo/go-compose/compose"
+	"github.com/zodimo/go-compose/compose/ui"
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+	"github.com/zodimo/go-compose/modifiers/pointer"
+)
+
+// TransformationHandler receives the changes of a transform gesture: zoom as
+// a scale factor, pan in pixels and rotation in degrees.
+type TransformationHandler func(zoomChange float32, panChange geometry.Offset, rotationChange float32)
+
+// TransformableState receives the changes of a Transformable modifier.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/foundation/foundation/src/commonMain/kotlin/androidx/compose/foundation/gestures/TransformableState.kt
+type TransformableState struct {
+	onTransformation TransformationHandler
+	inProgress       bool
+}
+
+// NewTransformableState creates a TransformableState calling onTransformation
+// with every change.
+func NewTransformableState(onTransformation TransformationHandler) *TransformableState {
+	if onTransformation == nil {
+		panic("NewTransformableState: onTransformation cannot be nil")
+	}
+	return &TransformableState{onTransformation: onTransformation}
+}
+
+// RememberTransformableState returns a TransformableState that survives
+// recompositions. onTransformation is updated on every composition, so it can
+// capture values from the current one.
+func RememberTransformableState(c compose.Composer, onTransformation TransformationHandler) *TransformableState {
+	key := fmt.Sprintf("transformableState-%v", c.GenerateID())
+	s := c.State(key, func() any { return NewTransformableState(onTransformation) }).Get().(*TransformableState)
+	s.onTransformation = onTransformation
+	return s
+}
+
+// Transform applies a change as if it came from a gesture.
+func (s *TransformableState) Transform(zoomChange float32, panChange geometry.Offset, rotationChange float32) {
+	s.onTransformation(zoomChange, panChange, rotationChange)
+}
+
+// ZoomBy zooms by zoomFactor.
+func (s *TransformableState) ZoomBy(zoomFactor float32) {
+	s.Transform(zoomFactor, geometry.OffsetZero, 0)
+}
+
+// PanBy pans by offset, in pixels.
+func (s *TransformableState) PanBy(offset geometry.Offset) {
+	s.Transform(1, offset, 0)
+}
+
+// RotateBy rotates by degrees.
+func (s *TransformableState) RotateBy(degrees float32) {
+	s.Transform(1, geometry.OffsetZero, degrees)
+}
+
+// IsTransformInProgress reports whether a transform gesture is in progress.
+func (s *TransformableState) IsTransformInProgress() bool {
+	return s.inProgress
+}
+
+type TransformableOptions struct {
+	Enabled bool
+	// LockRotationOnZoomPan ignores rotation when the gesture started as a
+	// zoom or pan.
+	LockRotationOnZoomPan bool
+}
+
+type TransformableOption func(*TransformableOptions)
+
+func WithTransformEnabled(enabled bool) TransformableOption {
+	return func(o *TransformableOptions) {
+		o.Enabled = enabled
+	}
+}
+
+func WithLockRotationOnZoomPan(lock bool) TransformableOption {
+	return func(o *TransformableOptions) {
+		o.LockRotationOnZoomPan = lock
+	}
+}
+
+func DefaultTransformableOptions() TransformableOptions {
+	return TransformableOptions{
+		Enabled: true,
+	}
+}
+
+// Transformable makes the element respond to pan, pinch zoom and rotation
+// gestures, including trackpad pinches, sending the changes to state.
+//
+//	zoom := compose.MustState(c, "zoom", func() float32 { return 1 })
+//	state := gestures.RememberTransformableState(c, func(zoomChange float32, pan geometry.Offset, rotation float32) {
+//		zoom.Set(zoom.Get() * zoomChange)
+//	})
+//	mod := gestures.Transformable(state)
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/foundation/foundation/src/commonMain/kotlin/androidx/compose/foundation/gestures/Transformable.kt
+func Transformable(state *TransformableState, options ...TransformableOption) ui.Modifier {
+	opt := DefaultTransformableOptions()
+	for _, option := range options {
+		if option == nil {
+			continue
+		}
+		option(&opt)
+	}
+	return pointer.PointerInput(func(scope pointer.PointerInputScope) {
+		if !opt.Enabled {
+			return
+		}
+		scope.OnPointerEvent(func(event *pointer.PointerEvent) {
+			pressed := false
+			for _, c := range event.Changes {
+				pressed = pressed || c.Pressed
+			}
+			state.inProgress = state.inProgress && pressed
+		})
+		DetectTransformGestures(scope, func(centroid, pan geometry.Offset, zoom, rotation float32) {
+			state.inProgress = true
+			state.Transform(zoom, pan, rotation)
+		}, WithPanZoomLock(opt.LockRotationOnZoomPan))
+	}, state, opt)
+}
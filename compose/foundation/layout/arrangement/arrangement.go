@@ -0,0 +1,172 @@
+package arrangement
+
+import (
+	"math"
+
+	"github.com/zodimo/go-compose/compose/ui/unit"
+)
+
+// Horizontal places children along a horizontal axis.
+type Horizontal interface {
+	// Spacing returns the space the arrangement leaves between children,
+	// which layouts reserve when measuring them.
+	Spacing() unit.Dp
+	// ArrangeHorizontally sets outPositions to the x of the children of the
+	// given sizes, in a layout totalSize pixels wide.
+	ArrangeHorizontally(density unit.Density, totalSize int, sizes []int, layoutDirection unit.LayoutDirection, outPositions []int)
+}
+
+// Vertical places children along a vertical axis.
+type Vertical interface {
+	// Spacing returns the space the arrangement leaves between children,
+	// which layouts reserve when measuring them.
+	Spacing() unit.Dp
+	// ArrangeVertically sets outPositions to the y of the children of the
+	// given sizes, in a layout totalSize pixels high.
+	ArrangeVertically(density unit.Density, totalSize int, sizes []int, outPositions []int)
+}
+
+// HorizontalOrVertical is an arrangement for both axes.
+type HorizontalOrVertical interface {
+	Horizontal
+	Vertical
+}
+
+var (
+	// Start places the children next to each other from the start edge.
+	Start Horizontal = start{}
+	// End places the children next to each other against the end edge.
+	End Horizontal = end{}
+	// Top places the children next to each other from the top.
+	Top Vertical = start{}
+	// Bottom places the children next to each other against the bottom.
+	Bottom Vertical = end{}
+	// Center places the children next to each other in the middle.
+	Center HorizontalOrVertical = center{}
+	// SpaceEvenly spreads the free space evenly between the children and
+	// before and after them.
+	SpaceEvenly HorizontalOrVertical = spaceEvenly{}
+	// SpaceBetween spreads the free space evenly between the children, with
+	// no space before the first one and after the last one.
+	SpaceBetween HorizontalOrVertical = spaceBetween{}
+	// SpaceAround spreads the free space evenly between the children, with
+	// half as much before the first one and after the last one.
+	SpaceAround HorizontalOrVertical = spaceAround{}
+
+	// AbsoluteLeft is Start, whatever the layout direction.
+	AbsoluteLeft Horizontal = absolute{start{}}
+	// AbsoluteRight is End, whatever the layout direction.
+	AbsoluteRight Horizontal = absolute{end{}}
+	// AbsoluteCenter is Center, whatever the layout direction.
+	AbsoluteCenter Horizontal = absolute{center{}}
+)
+
+// Alignment places a group of children smaller than the layout.
+type Alignment int
+
+const (
+	// AlignmentStart places the group at the start, or at the top.
+	AlignmentStart Alignment = iota
+	// AlignmentCenter places the group in the middle.
+	AlignmentCenter
+	// AlignmentEnd places the group at the end, or at the bottom.
+	AlignmentEnd
+)
+
+// align returns the offset of a group of size in a layout of totalSize.
+func (a Alignment) align(size, totalSize int, rtl bool) int {
+	free := float32(totalSize - size)
+	switch a {
+	case AlignmentCenter:
+		return round(free / 2)
+	case AlignmentEnd:
+		if rtl {
+			return 0
+		}
+		return int(free)
+	}
+	if rtl {
+		return int(free)
+	}
+	return 0
+}
+
+// SpacedBy places the children space apart, as a group placed with
+// alignment. The space is reduced when the children do not fit.
+func SpacedBy(space unit.Dp, alignment Alignment) HorizontalOrVertical {
+	return spacedAligned{space: space, alignment: alignment}
+}
+
+// Aligned places the children next to each other, as a group placed with
+// alignment.
+func Aligned(alignment Alignment) HorizontalOrVertical {
+	return spacedAligned{alignment: alignment}
+}
+
+// arrangement places the children from the start, in the order of sizes.
+// Horizontal arrangements are mirrored in right to left layouts.
+type arrangement interface {
+	arrange(totalSize int, sizes []int, outPositions []int)
+}
+
+type start struct{}
+type end struct{}
+type center struct{}
+type spaceEvenly struct{}
+type spaceBetween struct{}
+type spaceAround struct{}
+
+func (start) arrange(totalSize int, sizes []int, outPositions []int) {
+	place(sizes, outPositions, 0, 0)
+}
+
+func (end) arrange(totalSize int, sizes []int, outPositions []int) {
+	place(sizes, outPositions, float32(totalSize-consumed(sizes)), 0)
+}
+
+func (center) arrange(totalSize int, sizes []int, outPositions []int) {
+	place(sizes, outPositions, float32(totalSize-consumed(sizes))/2, 0)
+}
+
+func (spaceEvenly) arrange(totalSize int, sizes []int, outPositions []int) {
+	gap := float32(totalSize-consumed(sizes)) / float32(len(sizes)+1)
+	place(sizes, outPositions, gap, gap)
+}
+
+func (spaceBetween) arrange(totalSize int, sizes []int, outPositions []int) {
+	if len(sizes) < 2 {
+		place(sizes, outPositions, 0, 0)
+		return
+	}
+	gap := float32(totalSize-consumed(sizes)) / float32(len(sizes)-1)
+	place(sizes, outPositions, 0, gap)
+}
+
+func (spaceAround) arrange(totalSize int, sizes []int, outPositions []int) {
+	if len(sizes) == 0 {
+		return
+	}
+	gap := float32(totalSize-consumed(sizes)) / float32(len(sizes))
+	place(sizes, outPositions, gap/2, gap)
+}
+
+// place places the children one after the other from start, gap apart.
+func place(sizes []int, outPositions []int, start, gap float32) {
+	current := start
+	for i := range sizes {
+		outPositions[i] = round(current)
+		current += float32(sizes[i]) + gap
+	}
+}
+
+func consumed(sizes []int) int {
+	total := 0
+	for _, size := range sizes {
+		total += size
+	}
+	return total
+}
+
+func round(v float32) int {
+	return int(math.Round(float64(v)))
+}
@@ -0,0 +1,59 @@
+package arrangement
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/zodimo/go-compose/compose/ui/unit"
+)
+
+var density = unit.NewDensity(2, 1)
+
+func TestArrangeHorizontally(t *testing.T) {
+	sizes := []int{10, 20, 30}
+	for _, test := range []struct {
+		name        string
+		arrangement Horizontal
+		ltr, rtl    []int
+	}{
+		{"Start", Start, []int{0, 10, 30}, []int{90, 70, 40}},
+		{"End", End, []int{40, 50, 70}, []int{50, 30, 0}},
+		{"Center", Center, []int{20, 30, 50}, []int{70, 50, 20}},
+		{"SpaceEvenly", SpaceEvenly, []int{10, 30, 60}, []int{80, 50, 10}},
+		{"SpaceBetween", SpaceBetween, []int{0, 30, 70}, []int{90, 50, 0}},
+		{"SpaceAround", SpaceAround, []int{7, 30, 63}, []int{83, 50, 7}},
+		{"AbsoluteLeft", AbsoluteLeft, []int{0, 10, 30}, []int{0, 10, 30}},
+		// 4dp is 8px.
+		{"SpacedBy", SpacedBy(4, AlignmentStart), []int{0, 18, 46}, []int{90, 62, 24}},
+		{"SpacedBy center", SpacedBy(4, AlignmentCenter), []int{12, 30, 58}, []int{78, 50, 12}},
+	} {
+		for _, direction := range []unit.LayoutDirection{unit.LayoutDirectionLtr, unit.LayoutDirectionRtl} {
+			want := test.ltr
+			if direction == unit.LayoutDirectionRtl {
+				want = test.rtl
+			}
+			got := make([]int, len(sizes))
+			test.arrangement.ArrangeHorizontally(density, 100, sizes, direction, got)
+			if !slices.Equal(got, want) {
+				t.Errorf("%s %v: positions = %v, want %v", test.name, direction, got, want)
+			}
+		}
+	}
+}
+
+func TestArrangeVertically(t *testing.T) {
+	sizes := []int{10, 20}
+	got := make([]int, len(sizes))
+	Bottom.ArrangeVertically(density, 100, sizes, got)
+	if want := []int{70, 80}; !slices.Equal(got, want) {
+		t.Errorf("Bottom: positions = %v, want %v", got, want)
+	}
+	// The space shrinks when the children do not fit.
+	SpacedBy(10, AlignmentEnd).ArrangeVertically(density, 40, sizes, got)
+	if want := []int{0, 20}; !slices.Equal(got, want) {
+		t.Errorf("SpacedBy: positions = %v, want %v", got, want)
+	}
+	if got := SpacedBy(10, AlignmentEnd).Spacing(); got != 10 {
+		t.Errorf("Spacing = %v, want 10", got)
+	}
+}
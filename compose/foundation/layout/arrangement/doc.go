@@ -0,0 +1,32 @@
+/*
+Package arrangement places the children of a Row, Column or flow layout along
+its main axis.
+
+	row.Row(content, row.WithHorizontalArrangement(arrangement.SpacedBy(8, arrangement.AlignmentCenter)))
+	column.Column(content, column.WithVerticalArrangement(arrangement.SpaceBetween))
+
+Horizontal arrangements follow the layout direction: Start places the
+children from the right edge in right to left layouts. The Absolute ones
+ignore it.
+
+Custom arrangements implement Horizontal or Vertical:
+
+	// lastAtEnd places the last child at the end, and the others at the start.
+	type lastAtEnd struct{}
+
+	func (lastAtEnd) Spacing() unit.Dp { return 0 }
+
+	func (lastAtEnd) ArrangeVertically(density unit.Density, totalSize int, sizes []int, outPositions []int) {
+		position := 0
+		for i, size := range sizes {
+			outPositions[i] = position
+			position += size
+		}
+		if n := len(sizes); n > 0 {
+			outPositions[n-1] = totalSize - sizes[n-1]
+		}
+	}
+
+Reference: https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/foundation/foundation-layout/src/commonMain/kotlin/androidx/compose/foundation/layout/Arrangement.kt
+*/
+package arrangement
@@ -0,0 +1,45 @@
+package arrangement
+
+import "github.com/zodimo/go-compose/compose/ui/unit"
+
+var _ HorizontalOrVertical = spacedAligned{}
+
+type spacedAligned struct {
+	space     unit.Dp
+	alignment Alignment
+}
+
+func (s spacedAligned) Spacing() unit.Dp {
+	return s.space
+}
+
+func (s spacedAligned) ArrangeHorizontally(density unit.Density, totalSize int, sizes []int, layoutDirection unit.LayoutDirection, outPositions []int) {
+	s.arrange(density, totalSize, sizes, layoutDirection == unit.LayoutDirectionRtl, outPositions)
+}
+
+func (s spacedAligned) ArrangeVertically(density unit.Density, totalSize int, sizes []int, outPositions []int) {
+	s.arrange(density, totalSize, sizes, false, outPositions)
+}
+
+func (s spacedAligned) arrange(density unit.Density, totalSize int, sizes []int, rtl bool, outPositions []int) {
+	if len(sizes) == 0 {
+		return
+	}
+	space := density.DpRoundToPx(s.space)
+	occupied, lastSpace := 0, 0
+	for k := range sizes {
+		i := k
+		if rtl {
+			i = len(sizes) - 1 - k
+		}
+		outPositions[i] = min(occupied, totalSize-sizes[i])
+		lastSpace = min(space, totalSize-outPositions[i]-sizes[i])
+		occupied = outPositions[i] + sizes[i] + lastSpace
+	}
+	if group := occupied - lastSpace; group < totalSize {
+		offset := s.alignment.align(group, totalSize, rtl)
+		for i := range outPositions[:len(sizes)] {
+			outPositions[i] += offset
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package arrangement
+
+import "github.com/zodimo/go-compose/compose/ui/unit"
+
+// The standard arrangements leave no space between children, and are
+// mirrored in right to left layouts.
+
+func (start) Spacing() unit.Dp        { return 0 }
+func (end) Spacing() unit.Dp          { return 0 }
+func (center) Spacing() unit.Dp       { return 0 }
+func (spaceEvenly) Spacing() unit.Dp  { return 0 }
+func (spaceBetween) Spacing() unit.Dp { return 0 }
+func (spaceAround) Spacing() unit.Dp  { return 0 }
+
+func (a start) ArrangeHorizontally(_ unit.Density, totalSize int, sizes []int, layoutDirection unit.LayoutDirection, outPositions []int) {
+	arrangeHorizontally(a, totalSize, sizes, layoutDirection, outPositions)
+}
+func (a end) ArrangeHorizontally(_ unit.Density, totalSize int, sizes []int, layoutDirection unit.LayoutDirection, outPositions []int) {
+	arrangeHorizontally(a, totalSize, sizes, layoutDirection, outPositions)
+}
+func (a center) ArrangeHorizontally(_ unit.Density, totalSize int, sizes []int, layoutDirection unit.LayoutDirection, outPositions []int) {
+	arrangeHorizontally(a, totalSize, sizes, layoutDirection, outPositions)
+}
+func (a spaceEvenly) ArrangeHorizontally(_ unit.Density, totalSize int, sizes []int, layoutDirection unit.LayoutDirection, outPositions []int) {
+	arrangeHorizontally(a, totalSize, sizes, layoutDirection, outPositions)
+}
+func (a spaceBetween) ArrangeHorizontally(_ unit.Density, totalSize int, sizes []int, layoutDirection unit.LayoutDirection, outPositions []int) {
+	arrangeHorizontally(a, totalSize, sizes, layoutDirection, outPositions)
+}
+func (a spaceAround) ArrangeHorizontally(_ unit.Density, totalSize int, sizes []int, layoutDirection unit.LayoutDirection, outPositions []int) {
+	arrangeHorizontally(a, totalSize, sizes, layoutDirection, outPositions)
+}
+
+func (a start) ArrangeVertically(_ unit.Density, totalSize int, sizes []int, outPositions []int) {
+	a.arrange(totalSize, sizes, outPositions)
+}
+func (a end) ArrangeVertically(_ unit.Density, totalSize int, sizes []int, outPositions []int) {
+	a.arrange(totalSize, sizes, outPositions)
+}
+func (a center) ArrangeVertically(_ unit.Density, totalSize int, sizes []int, outPositions []int) {
+	a.arrange(totalSize, sizes, outPositions)
+}
+func (a spaceEvenly) ArrangeVertically(_ unit.Density, totalSize int, sizes []int, outPositions []int) {
+	a.arrange(totalSize, sizes, outPositions)
+}
+func (a spaceBetween) ArrangeVertically(_ unit.Density, totalSize int, sizes []int, outPositions []int) {
+	a.arrange(totalSize, sizes, outPositions)
+}
+func (a spaceAround) ArrangeVertically(_ unit.Density, totalSize int, sizes []int, outPositions []int) {
+	a.arrange(totalSize, sizes, outPositions)
+}
+
+// arrangeHorizontally mirrors a in right to left layouts, where the first
+// child is at the right.
+func arrangeHorizontally(a arrangement, totalSize int, sizes []int, layoutDirection unit.LayoutDirection, outPositions []int) {
+	a.arrange(totalSize, sizes, outPositions)
+	if layoutDirection != unit.LayoutDirectionRtl {
+		return
+	}
+	for i := range sizes {
+		outPositions[i] = totalSize - outPositions[i] - sizes[i]
+	}
+}
+
+// absolute is a horizontal arrangement that ignores the layout direction.
+type absolute struct {
+	arrangement
+}
+
+func (absolute) Spacing() unit.Dp { return 0 }
+
+func (a absolute) ArrangeHorizontally(_ unit.Density, totalSize int, sizes []int, _ unit.LayoutDirection, outPositions []int) {
+	a.arrange(totalSize, sizes, outPositions)
+}
@@ -2,6 +2,7 @@ package box
 
 import (
 	"github.com/zodimo/go-compose/compose/ui"
+	uilayout "github.com/zodimo/go-compose/compose/ui/layout"
 	"github.com/zodimo/go-compose/internal/layoutnode"
 
 	"gioui.org/layout"
@@ -30,6 +31,9 @@ func Box(content Composable, options ...BoxOption) Composable {
 func boxWidgetConstructor(options BoxOptions) layoutnode.LayoutNodeWidgetConstructor {
 	return layoutnode.NewLayoutNodeWidgetConstructor(func(node layoutnode.LayoutNode) layoutnode.GioLayoutWidget {
 		return func(gtx layoutnode.LayoutContext) layoutnode.LayoutDimensions {
+			if q, ok := uilayout.IntrinsicQueryOf(gtx); ok {
+				return boxIntrinsic(gtx, q, node)
+			}
 
 			stackChildren := []StackChild{}
 			for _, child := range node.Children() {
@@ -59,3 +63,18 @@ func boxWidgetConstructor(options BoxOptions) layoutnode.LayoutNodeWidgetConstru
 	})
 
 }
+
+// boxIntrinsic answers the intrinsic query q of a box with the largest
+// measurement of its children. Children that match the size of the box do
+// not take part.
+func boxIntrinsic(gtx LayoutContext, q uilayout.IntrinsicQuery, node layoutnode.LayoutNode) LayoutDimensions {
+	value := 0
+	for _, child := range node.Children() {
+		childLayoutNode := child.(layoutnode.NodeCoordinator)
+		if childLayoutNode.Elements().GetElement(MatchParentSizeKey).IsSome() {
+			continue
+		}
+		value = max(value, uilayout.MeasureIntrinsic(gtx, childLayoutNode.Layout, q.Intrinsic, q.Size))
+	}
+	return q.Dimensions(value)
+}
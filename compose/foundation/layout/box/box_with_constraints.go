@@ -0,0 +1,90 @@
+package box
+
+import (
+	uilayout "github.com/zodimo/go-compose/compose/ui/layout"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+)
+
+// BoxWithConstraintsScope gives the content of a BoxWithConstraints the
+// constraints it is measured with. Unbounded maximums are unit.DpInfinity.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/foundation/foundation-layout/src/commonMain/kotlin/androidx/compose/foundation/layout/BoxWithConstraints.kt
+type BoxWithConstraintsScope interface {
+	// Constraints are the constraints of the box, in pixels.
+	Constraints() unit.Constraints
+	MinWidth() unit.Dp
+	MaxWidth() unit.Dp
+	MinHeight() unit.Dp
+	MaxHeight() unit.Dp
+}
+
+// BoxWithConstraints is a Box whose content is composed with the constraints
+// of the box, to show different content depending on the space available:
+//
+//	box.BoxWithConstraints(func(scope box.BoxWithConstraintsScope) box.Composable {
+//		if scope.MaxWidth() < 600 {
+//			return CompactContent()
+//		}
+//		return ExpandedContent()
+//	})
+//
+// The content is composed during the measurement of the box, with
+// uilayout.SubcomposeLayout.
+func BoxWithConstraints(content func(scope BoxWithConstraintsScope) Composable, options ...BoxOption) Composable {
+	opts := DefaultBoxOptions()
+	for _, option := range options {
+		if option == nil {
+			continue
+		}
+		option(&opts)
+	}
+	return uilayout.SubcomposeLayout(func(scope uilayout.SubcomposeMeasureScope, constraints unit.Constraints) uilayout.MeasureResult {
+		boxScope := &boxWithConstraintsScope{density: scope, constraints: constraints}
+		measurables := scope.Subcompose(nil, Box(content(boxScope), WithAlignment(opts.Alignment)))
+		placeables := make([]*uilayout.Placeable, len(measurables))
+		width, height := constraints.MinWidth(), constraints.MinHeight()
+		for i, m := range measurables {
+			placeables[i] = m.Measure(constraints)
+			width = max(width, placeables[i].Width())
+			height = max(height, placeables[i].Height())
+		}
+		return scope.Layout(width, height, func() {
+			for _, p := range placeables {
+				p.PlaceRelative(0, 0)
+			}
+		})
+	}, uilayout.WithModifier(opts.Modifier))
+}
+
+var _ BoxWithConstraintsScope = (*boxWithConstraintsScope)(nil)
+
+type boxWithConstraintsScope struct {
+	density     unit.Density
+	constraints unit.Constraints
+}
+
+func (s *boxWithConstraintsScope) Constraints() unit.Constraints {
+	return s.constraints
+}
+
+func (s *boxWithConstraintsScope) MinWidth() unit.Dp {
+	return s.density.IntToDp(s.constraints.MinWidth())
+}
+
+func (s *boxWithConstraintsScope) MaxWidth() unit.Dp {
+	if !s.constraints.HasBoundedWidth() {
+		return unit.DpInfinity
+	}
+	return s.density.IntToDp(s.constraints.MaxWidth())
+}
+
+func (s *boxWithConstraintsScope) MinHeight() unit.Dp {
+	return s.density.IntToDp(s.constraints.MinHeight())
+}
+
+func (s *boxWithConstraintsScope) MaxHeight() unit.Dp {
+	if !s.constraints.HasBoundedHeight() {
+		return unit.DpInfinity
+	}
+	return s.density.IntToDp(s.constraints.MaxHeight())
+}
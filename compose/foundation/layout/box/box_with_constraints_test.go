@@ -0,0 +1,70 @@
+package box
+
+import (
+	"image"
+	"testing"
+
+	"github.com/zodimo/go-compose/compose"
+	uilayout "github.com/zodimo/go-compose/compose/ui/layout"
+	"github.com/zodimo/go-compose/modifiers/size"
+	"github.com/zodimo/go-compose/runtime"
+	"github.com/zodimo/go-compose/state"
+	"github.com/zodimo/go-compose/store"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/unit"
+)
+
+func frame(store state.PersistentState, width, height int, content Composable) {
+	c := compose.NewComposer(store)
+	node := content(c).Build()
+	gtx := layout.Context{
+		Ops:         new(op.Ops),
+		Constraints: layout.Constraints{Max: image.Pt(width, height)},
+		Metric:      unit.Metric{PxPerDp: 2, PxPerSp: 2},
+	}
+	runtime.NewRuntime().Run(gtx, node).Add(gtx.Ops)
+}
+
+func TestBoxWithConstraints(t *testing.T) {
+	ps := store.NewPersistentState(map[string]state.MutableValue{})
+	var maxWidth, maxHeight float32
+	var bounds image.Rectangle
+	compositions := 0
+	content := BoxWithConstraints(func(scope BoxWithConstraintsScope) Composable {
+		maxWidth, maxHeight = float32(scope.MaxWidth()), float32(scope.MaxHeight())
+		width := 20
+		if scope.MaxWidth() < 100 {
+			width = 10
+		}
+		return func(c Composer) Composer {
+			count := c.State("compositions", func() any { return 0 })
+			compositions = count.Get().(int) + 1
+			count.Set(compositions)
+			return Box(func(c Composer) Composer { return c }, WithModifier(size.Size(width, 10).Then(
+				uilayout.OnGloballyPositioned(func(coordinates uilayout.LayoutCoordinates) {
+					r := coordinates.BoundsInWindow()
+					bounds = image.Rect(int(r.Left), int(r.Top), int(r.Right), int(r.Bottom))
+				}),
+			)))(c)
+		}
+	}, WithAlignment(Center), WithModifier(size.FillMax()))
+
+	frame(ps, 300, 100, content)
+	if maxWidth != 150 || maxHeight != 50 {
+		t.Errorf("scope max size = %vx%v, want 150x50", maxWidth, maxHeight)
+	}
+	if want := image.Rect(140, 45, 160, 55); bounds != want {
+		t.Errorf("content bounds = %v, want %v", bounds, want)
+	}
+
+	before := compositions
+	frame(ps, 100, 100, content)
+	if want := image.Rect(45, 45, 55, 55); bounds != want {
+		t.Errorf("content bounds = %v, want %v", bounds, want)
+	}
+	if compositions <= before {
+		t.Errorf("content state should be kept across frames, got %d compositions after %d", compositions, before)
+	}
+}
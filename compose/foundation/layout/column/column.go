@@ -1,18 +1,20 @@
 package column
 
 import (
+	"github.com/zodimo/go-compose/compose/foundation/layout/arrangement"
+	"github.com/zodimo/go-compose/compose/foundation/layout/internal/rowcolumn"
 	"github.com/zodimo/go-compose/compose/ui"
+	uilayout "github.com/zodimo/go-compose/compose/ui/layout"
+	"github.com/zodimo/go-compose/compose/ui/platform"
+	"github.com/zodimo/go-compose/compose/ui/unit"
 	"github.com/zodimo/go-compose/internal/layoutnode"
-	"github.com/zodimo/go-compose/modifiers/weight"
-
-	"gioui.org/layout"
 )
 
 func DefaultColumnOptions() ColumnOptions {
 	return ColumnOptions{
-		Modifier:  ui.EmptyModifier,
-		Spacing:   SpaceEnd, // 0
-		Alignment: Start,    // 0
+		Modifier:            ui.EmptyModifier,
+		VerticalArrangement: arrangement.Top,
+		Alignment:           Start, // 0
 	}
 }
 
@@ -30,44 +32,24 @@ func Column(content Composable, options ...ColumnOption) Composable {
 			return modifier.Then(opts.Modifier)
 		})
 		c.WithComposable(content)
-		c.SetWidgetConstructor(columnWidgetConstructor(opts))
+		c.SetWidgetConstructor(columnWidgetConstructor(opts, platform.LocalLayoutDirection.Current(c)))
 
 		return c.EndBlock()
 	}
 }
 
-func columnWidgetConstructor(options ColumnOptions) layoutnode.LayoutNodeWidgetConstructor {
+func columnWidgetConstructor(options ColumnOptions, layoutDirection unit.LayoutDirection) layoutnode.LayoutNodeWidgetConstructor {
 	return layoutnode.NewLayoutNodeWidgetConstructor(func(node layoutnode.LayoutNode) layoutnode.GioLayoutWidget {
 		return func(gtx layoutnode.LayoutContext) layoutnode.LayoutDimensions {
-
-			flexedChildren := []layout.FlexChild{}
-			for _, child := range node.Children() {
-
-				childLayoutNode := child.(layoutnode.NodeCoordinator)
-
-				elementStore := childLayoutNode.Elements()
-
-				maybeWeightElement := elementStore.GetElement(weight.WeightElementKey)
-				if maybeWeightElement.IsNone() {
-					flexedChildren = append(flexedChildren, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-						// Compose behavior: Cross axis constraints Min is 0
-						gtx.Constraints.Min.X = 0
-						return childLayoutNode.Layout(gtx)
-					}))
-				} else {
-					weightElement := maybeWeightElement.UnwrapUnsafe().(weight.WeightElement)
-					flexedChildren = append(flexedChildren, layout.Flexed(weightElement.WeightData().Weight, func(gtx layout.Context) layout.Dimensions {
-						return childLayoutNode.Layout(gtx)
-					}))
-				}
+			density := unit.DensityFromMetric(gtx.Metric)
+			spacing := density.DpRoundToPx(options.VerticalArrangement.Spacing())
+			if q, ok := uilayout.IntrinsicQueryOf(gtx); ok {
+				return rowcolumn.Intrinsic(gtx, q, false, rowcolumn.Children(node), spacing)
 			}
-
-			return layout.Flex{
-				Axis:      layout.Vertical,
-				Spacing:   options.Spacing,
-				Alignment: options.Alignment,
-			}.Layout(gtx, flexedChildren...)
+			arrange := func(totalSize int, sizes []int, outPositions []int) {
+				options.VerticalArrangement.ArrangeVertically(density, totalSize, sizes, outPositions)
+			}
+			return rowcolumn.Layout(gtx, false, rowcolumn.Children(node), spacing, arrange, options.Alignment, layoutDirection)
 		}
 	})
-
 }
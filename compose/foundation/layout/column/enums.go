@@ -1,6 +1,10 @@
 package column
 
-import "gioui.org/layout"
+import (
+	"github.com/zodimo/go-compose/compose/foundation/layout/arrangement"
+
+	"gioui.org/layout"
+)
 
 type Spacing = layout.Spacing
 type Alignment = layout.Alignment
@@ -29,3 +33,20 @@ const (
 	Middle
 	Baseline
 )
+
+// verticalArrangement returns the arrangement matching spacing.
+func verticalArrangement(spacing Spacing) arrangement.Vertical {
+	switch spacing {
+	case SpaceStart:
+		return arrangement.Bottom
+	case SpaceSides:
+		return arrangement.Center
+	case SpaceAround:
+		return arrangement.SpaceAround
+	case SpaceBetween:
+		return arrangement.SpaceBetween
+	case SpaceEvenly:
+		return arrangement.SpaceEvenly
+	}
+	return arrangement.Top
+}
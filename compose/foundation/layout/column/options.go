@@ -1,14 +1,17 @@
 package column
 
-import "github.com/zodimo/go-compose/compose/ui"
+import (
+	"github.com/zodimo/go-compose/compose/foundation/layout/arrangement"
+	"github.com/zodimo/go-compose/compose/ui"
+)
 
 type ColumnOptions struct {
 	Modifier ui.Modifier
 
-	// Spacing controls the distribution of space left after
-	// layout.
-	Spacing Spacing
-	// Alignment is the alignment in the cross axis.
+	// VerticalArrangement places the children along the main axis.
+	VerticalArrangement arrangement.Vertical
+	// Alignment is the alignment in the cross axis, whose Start and End
+	// follow the layout direction.
 	Alignment Alignment
 }
 
@@ -20,12 +23,20 @@ func WithModifier(modifier ui.Modifier) ColumnOption {
 	}
 }
 
-func WithSpacing(spacing Spacing) ColumnOption {
+// WithVerticalArrangement places the children with verticalArrangement.
+func WithVerticalArrangement(verticalArrangement arrangement.Vertical) ColumnOption {
 	return func(o *ColumnOptions) {
-		o.Spacing = spacing
+		o.VerticalArrangement = verticalArrangement
 	}
 }
 
+// WithSpacing places the children with the arrangement matching spacing.
+//
+// Deprecated: use WithVerticalArrangement.
+func WithSpacing(spacing Spacing) ColumnOption {
+	return WithVerticalArrangement(verticalArrangement(spacing))
+}
+
 func WithAlignment(alignment Alignment) ColumnOption {
 	return func(o *ColumnOptions) {
 		o.Alignment = alignment
@@ -0,0 +1,117 @@
+package constraintlayout
+
+import (
+	"github.com/zodimo/go-compose/compose/ui/unit"
+)
+
+type dimensionKind int
+
+const (
+	dimensionWrapContent dimensionKind = iota
+	dimensionValue
+	dimensionFillToConstraints
+	dimensionPercent
+	dimensionMatchParent
+)
+
+// Dimension is how the width or height of a constrained element is decided.
+//
+// https://cs.android.com/androidx/constraintlayout/+/main:constraintlayout/compose/src/main/java/androidx/constraintlayout/compose/Dimension.kt
+type Dimension struct {
+	kind    dimensionKind
+	value   unit.Dp
+	percent float32
+}
+
+var (
+	// DimensionWrapContent sizes the element to its content, within the
+	// layout.
+	DimensionWrapContent = Dimension{kind: dimensionWrapContent}
+	// DimensionFillToConstraints sizes the element to the space between the
+	// anchors its edges link to, or to its share of a chain.
+	DimensionFillToConstraints = Dimension{kind: dimensionFillToConstraints}
+	// DimensionMatchParent sizes the element to the layout, ignoring the
+	// links of its edges.
+	DimensionMatchParent = Dimension{kind: dimensionMatchParent}
+)
+
+// DimensionValue sizes the element to value.
+func DimensionValue(value unit.Dp) Dimension {
+	return Dimension{kind: dimensionValue, value: value}
+}
+
+// DimensionPercentOf sizes the element to fraction of the layout.
+func DimensionPercentOf(fraction float32) Dimension {
+	return Dimension{kind: dimensionPercent, percent: fraction}
+}
+
+// decided reports whether the solver decides the size, which is then not
+// measured.
+func (d Dimension) decided() bool {
+	return d.kind == dimensionFillToConstraints || d.kind == dimensionPercent || d.kind == dimensionMatchParent
+}
+
+// ConstrainScope is the receiver of the constraints of an element, set with
+// ConstraintLayoutScope.ConstrainAs.
+//
+// https://cs.android.com/androidx/constraintlayout/+/main:constraintlayout/compose/src/main/java/androidx/constraintlayout/compose/ConstrainScope.kt
+type ConstrainScope struct {
+	// Parent refers to the ConstraintLayout.
+	Parent *ConstrainedLayoutReference
+
+	Start, End  VerticalAnchorLinkable
+	Top, Bottom HorizontalAnchorLinkable
+	Baseline    BaselineAnchorLinkable
+
+	Width, Height Dimension
+	// HorizontalBias and VerticalBias place the element between the anchors
+	// of its edges, from 0 at the start or top to 1 at the end or bottom.
+	HorizontalBias, VerticalBias float32
+	// HorizontalChainWeight and VerticalChainWeight are the share of the
+	// element in the free space of its chain, when it fills its constraints.
+	HorizontalChainWeight, VerticalChainWeight float32
+}
+
+func newConstrainScope() *ConstrainScope {
+	return &ConstrainScope{
+		Parent:         parentReference,
+		Width:          DimensionWrapContent,
+		Height:         DimensionWrapContent,
+		HorizontalBias: 0.5,
+		VerticalBias:   0.5,
+	}
+}
+
+// LinkHorizontallyTo links the start and end edges to start and end, and
+// places the element between them with bias.
+func (c *ConstrainScope) LinkHorizontallyTo(start, end VerticalAnchor, startMargin, endMargin unit.Dp, bias float32) {
+	c.Start.LinkTo(start, startMargin)
+	c.End.LinkTo(end, endMargin)
+	c.HorizontalBias = bias
+}
+
+// LinkVerticallyTo links the top and bottom edges to top and bottom, and
+// places the element between them with bias.
+func (c *ConstrainScope) LinkVerticallyTo(top, bottom HorizontalAnchor, topMargin, bottomMargin unit.Dp, bias float32) {
+	c.Top.LinkTo(top, topMargin)
+	c.Bottom.LinkTo(bottom, bottomMargin)
+	c.VerticalBias = bias
+}
+
+// CenterHorizontallyTo links the start and end edges to the ones of other.
+func (c *ConstrainScope) CenterHorizontallyTo(other *ConstrainedLayoutReference) {
+	c.Start.LinkTo(other.Start, 0)
+	c.End.LinkTo(other.End, 0)
+}
+
+// CenterVerticallyTo links the top and bottom edges to the ones of other.
+func (c *ConstrainScope) CenterVerticallyTo(other *ConstrainedLayoutReference) {
+	c.Top.LinkTo(other.Top, 0)
+	c.Bottom.LinkTo(other.Bottom, 0)
+}
+
+// CenterTo links all the edges to the ones of other.
+func (c *ConstrainScope) CenterTo(other *ConstrainedLayoutReference) {
+	c.CenterHorizontallyTo(other)
+	c.CenterVerticallyTo(other)
+}
@@ -0,0 +1,222 @@
+package constraintlayout
+
+import (
+	"github.com/zodimo/go-compose/compose/foundation/layout/constraintlayout/solver"
+	uilayout "github.com/zodimo/go-compose/compose/ui/layout"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+)
+
+// ConstraintLayout places the elements content emits relative to each other
+// and to the layout, with the constraints set by ConstraintLayoutScope.ConstrainAs.
+// Elements without constraints are placed at the top start corner.
+//
+// The layout wraps its elements, within its constraints, unless its size is
+// fixed by a modifier.
+//
+// https://cs.android.com/androidx/constraintlayout/+/main:constraintlayout/compose/src/main/java/androidx/constraintlayout/compose/ConstraintLayout.kt
+func ConstraintLayout(content func(scope *ConstraintLayoutScope) Composable, options ...ConstraintLayoutOption) Composable {
+	opts := DefaultConstraintLayoutOptions()
+	for _, option := range options {
+		if option == nil {
+			continue
+		}
+		option(&opts)
+	}
+	return func(c Composer) Composer {
+		scope := newConstraintLayoutScope()
+		policy := uilayout.MeasurePolicyFunc(func(measureScope uilayout.MeasureScope, measurables []uilayout.Measurable, constraints unit.Constraints) uilayout.MeasureResult {
+			return scope.measure(measureScope, measurables, constraints)
+		})
+		return uilayout.Layout(content(scope), policy, uilayout.WithModifier(opts.Modifier))(c)
+	}
+}
+
+// element is a child of the layout, with the constraints of its reference.
+type element struct {
+	measurable  uilayout.Measurable
+	constraints *ConstrainScope
+	placeable   *uilayout.Placeable
+	// width is the width the element is measured with once the vertical
+	// axis is solved, when its height is decided by the solver.
+	width int
+}
+
+// measure solves the horizontal axis first, with the measured widths, then
+// the vertical one, with the heights of the elements measured to their
+// solved width. An element whose size is decided by the solver is measured
+// once its axes are solved, with its intrinsic size standing in before.
+func (s *ConstraintLayoutScope) measure(scope uilayout.MeasureScope, measurables []uilayout.Measurable, constraints unit.Constraints) uilayout.MeasureResult {
+	// The elements of the references come first, in the order of the
+	// solver widgets; children without a reference follow.
+	elements := make([]*element, len(s.constraints))
+	for i := range elements {
+		elements[i] = &element{constraints: s.constraints[i]}
+	}
+	for _, m := range measurables {
+		if ref, ok := m.LayoutId().(*ConstrainedLayoutReference); ok && ref.index < len(elements) && elements[ref.index].measurable == nil {
+			elements[ref.index].measurable = m
+			continue
+		}
+		elements = append(elements, &element{measurable: m})
+	}
+	for _, e := range elements {
+		if e.constraints == nil {
+			e.constraints = newConstrainScope()
+		}
+	}
+
+	measure := func(e *element, width, height unit.Constraints) {
+		e.placeable = e.measurable.Measure(unit.NewConstraints(width.MinWidth(), width.MaxWidth(), height.MinHeight(), height.MaxHeight()))
+	}
+	exact := func(size int) unit.Constraints {
+		return unit.NewConstraints(size, size, size, size)
+	}
+	wrap := func(d Dimension) unit.Constraints {
+		if d.kind == dimensionValue {
+			return exact(scope.DpRoundToPx(d.value))
+		}
+		return constraints.CopyMaxDimensions()
+	}
+
+	// Elements with no size decided by the solver are measured first.
+	for _, e := range elements {
+		if e.measurable != nil && !e.constraints.Width.decided() && !e.constraints.Height.decided() {
+			measure(e, wrap(e.constraints.Width), wrap(e.constraints.Height))
+		}
+	}
+
+	h := &solver.Axis{}
+	for _, e := range elements {
+		c := e.constraints
+		w := s.widget(scope, c.Width, c.Start.link, c.End.link, c.HorizontalBias, c.HorizontalChainWeight)
+		switch {
+		case e.placeable != nil:
+			w.Size = e.placeable.Width()
+		case e.measurable == nil:
+		case c.Width.kind == dimensionValue:
+			w.Size = scope.DpRoundToPx(c.Width.value)
+		default:
+			w.Size = min(e.measurable.MaxIntrinsicWidth(unit.Infinity), constraints.MaxWidth())
+		}
+		h.Widgets = append(h.Widgets, w)
+	}
+	s.complete(scope, h, horizontal)
+	width := constraints.MinWidth()
+	if !constraints.HasFixedWidth() {
+		width = h.WrapSize(constraints.MinWidth(), constraints.MaxWidth())
+	}
+	hs := h.Solve(width)
+
+	// Elements whose width only is decided are measured to their width.
+	for i, e := range elements {
+		e.width = hs.Sizes[i]
+		if e.measurable != nil && e.placeable == nil && !e.constraints.Height.decided() {
+			measure(e, exact(e.width), wrap(e.constraints.Height))
+		}
+	}
+
+	v := &solver.Axis{}
+	for _, e := range elements {
+		c := e.constraints
+		w := s.widget(scope, c.Height, c.Top.link, c.Bottom.link, c.VerticalBias, c.VerticalChainWeight)
+		if c.Baseline.link != nil {
+			w.BaselineLink = &solver.Link{Target: c.Baseline.link.target, Margin: scope.DpRoundToPx(c.Baseline.link.margin)}
+		}
+		switch {
+		case e.placeable != nil:
+			w.Size = e.placeable.Height()
+			w.BaselineOffset = e.placeable.Baseline()
+		case e.measurable == nil:
+		case c.Height.kind == dimensionValue:
+			w.Size = scope.DpRoundToPx(c.Height.value)
+		default:
+			w.Size = min(e.measurable.MaxIntrinsicHeight(e.width), constraints.MaxHeight())
+		}
+		if e.placeable == nil {
+			w.BaselineOffset = w.Size
+		}
+		v.Widgets = append(v.Widgets, w)
+	}
+	s.complete(scope, v, vertical)
+	height := constraints.MinHeight()
+	if !constraints.HasFixedHeight() {
+		height = v.WrapSize(constraints.MinHeight(), constraints.MaxHeight())
+	}
+	vs := v.Solve(height)
+
+	for i, e := range elements {
+		if e.measurable != nil && e.placeable == nil {
+			measure(e, exact(e.width), exact(vs.Sizes[i]))
+		}
+	}
+
+	return scope.Layout(width, height, func() {
+		for i, e := range elements {
+			if e.placeable != nil {
+				e.placeable.PlaceRelative(hs.Positions[i], vs.Positions[i])
+			}
+		}
+	})
+}
+
+// widget returns the solver widget of an element along an axis.
+func (s *ConstraintLayoutScope) widget(scope uilayout.MeasureScope, d Dimension, start, end *anchorLink, bias, weight float32) solver.Widget {
+	link := func(l *anchorLink) *solver.Link {
+		if l == nil {
+			return nil
+		}
+		return &solver.Link{Target: l.target, Margin: scope.DpRoundToPx(l.margin)}
+	}
+	w := solver.Widget{
+		Start:  link(start),
+		End:    link(end),
+		Bias:   bias,
+		Weight: weight,
+	}
+	switch d.kind {
+	case dimensionFillToConstraints:
+		w.Dimension = solver.DimensionFill
+	case dimensionPercent:
+		w.Dimension = solver.DimensionPercent
+		w.Percent = d.percent
+	case dimensionMatchParent:
+		w.Dimension = solver.DimensionPercent
+		w.Percent = 1
+		w.Start = &solver.Link{Target: solver.ParentAnchor(solver.Start)}
+		w.End = nil
+	}
+	return w
+}
+
+// complete adds the guidelines, barriers and chains of axis a to sa.
+func (s *ConstraintLayoutScope) complete(scope uilayout.MeasureScope, sa *solver.Axis, a axis) {
+	for _, g := range s.guidelines[a] {
+		sa.Guidelines = append(sa.Guidelines, solver.Guideline{
+			Fraction: g.fraction,
+			Offset:   scope.DpRoundToPx(g.offset),
+			FromEnd:  g.fromEnd,
+		})
+	}
+	for _, b := range s.barriers[a] {
+		sa.Barriers = append(sa.Barriers, solver.Barrier{
+			Side:    b.side,
+			Widgets: indices(b.refs),
+			Margin:  scope.DpRoundToPx(b.margin),
+		})
+	}
+	for _, c := range s.chains[a] {
+		sa.Chains = append(sa.Chains, solver.Chain{
+			Widgets: indices(c.refs),
+			Style:   c.style.style,
+			Bias:    c.style.bias,
+		})
+	}
+}
+
+func indices(refs []*ConstrainedLayoutReference) []int {
+	out := make([]int, len(refs))
+	for i, ref := range refs {
+		out[i] = ref.index
+	}
+	return out
+}
@@ -0,0 +1,131 @@
+package constraintlayout
+
+import (
+	"image"
+	"testing"
+
+	"github.com/zodimo/go-compose/compose"
+	"github.com/zodimo/go-compose/compose/foundation/layout/box"
+	"github.com/zodimo/go-compose/compose/ui"
+	uilayout "github.com/zodimo/go-compose/compose/ui/layout"
+	"github.com/zodimo/go-compose/modifiers/size"
+	"github.com/zodimo/go-compose/runtime"
+	"github.com/zodimo/go-compose/state"
+	"github.com/zodimo/go-compose/store"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/unit"
+)
+
+// layoutTest composes and lays out a frame, and records the bounds of the
+// elements.
+type layoutTest struct {
+	bounds map[string]image.Rectangle
+}
+
+func (l *layoutTest) frame(width, height int, content Composable) {
+	l.bounds = map[string]image.Rectangle{}
+	c := compose.NewComposer(store.NewPersistentState(map[string]state.MutableValue{}))
+	node := content(c).Build()
+	gtx := layout.Context{
+		Ops:         new(op.Ops),
+		Constraints: layout.Constraints{Max: image.Pt(width, height)},
+		Metric:      unit.Metric{PxPerDp: 1, PxPerSp: 1},
+	}
+	runtime.NewRuntime().Run(gtx, node).Add(gtx.Ops)
+}
+
+// record records the bounds of the element under name.
+func (l *layoutTest) record(name string) ui.Modifier {
+	return uilayout.OnGloballyPositioned(func(coordinates uilayout.LayoutCoordinates) {
+		r := coordinates.BoundsInWindow()
+		l.bounds[name] = image.Rect(int(r.Left), int(r.Top), int(r.Right), int(r.Bottom))
+	})
+}
+
+func (l *layoutTest) item(name string, modifier ui.Modifier) Composable {
+	return box.Box(func(c Composer) Composer { return c }, box.WithModifier(modifier.Then(l.record(name))))
+}
+
+func (l *layoutTest) expect(t *testing.T, want map[string]image.Rectangle) {
+	t.Helper()
+	for name, r := range want {
+		if got, ok := l.bounds[name]; !ok || got != r {
+			t.Errorf("%s bounds = %v, want %v", name, got, r)
+		}
+	}
+}
+
+func TestConstraintLayout(t *testing.T) {
+	l := &layoutTest{}
+	l.frame(300, 300, ConstraintLayout(func(scope *ConstraintLayoutScope) Composable {
+		refs := scope.CreateRefs(7)
+		a, b, c, d, e, f, g := refs[0], refs[1], refs[2], refs[3], refs[4], refs[5], refs[6]
+		half := scope.CreateGuidelineFromStart(0.5)
+		barrier := scope.CreateBottomBarrier(5, a, c)
+		scope.CreateHorizontalChain(ChainStylePacked, e, f)
+		return compose.Sequence(
+			l.item("a", size.Size(20, 10).Then(scope.ConstrainAs(a, func(s *ConstrainScope) {
+				s.Top.LinkTo(s.Parent.Top, 5)
+				s.Start.LinkTo(s.Parent.Start, 10)
+			}))),
+			l.item("b", size.Size(30, 10).Then(scope.ConstrainAs(b, func(s *ConstrainScope) {
+				s.Start.LinkTo(a.End, 5)
+				s.Baseline.LinkTo(a.Baseline, 0)
+			}))),
+			l.item("c", scope.ConstrainAs(c, func(s *ConstrainScope) {
+				s.LinkHorizontallyTo(b.End, s.Parent.End, 0, 10, 0.5)
+				s.Top.LinkTo(a.Bottom, 0)
+				s.Width = DimensionFillToConstraints
+				s.Height = DimensionValue(10)
+			})),
+			l.item("d", size.Size(10, 10).Then(scope.ConstrainAs(d, func(s *ConstrainScope) {
+				s.Start.LinkTo(half, 0)
+				s.Top.LinkTo(barrier, 0)
+			}))),
+			l.item("e", size.Size(20, 10).Then(scope.ConstrainAs(e, func(s *ConstrainScope) {
+				s.Top.LinkTo(s.Parent.Top, 80)
+			}))),
+			l.item("f", size.Size(20, 10).Then(scope.ConstrainAs(f, func(s *ConstrainScope) {
+				s.Top.LinkTo(e.Top, 0)
+			}))),
+			l.item("g", scope.ConstrainAs(g, func(s *ConstrainScope) {
+				s.End.LinkTo(s.Parent.End, 0)
+				s.Bottom.LinkTo(s.Parent.Bottom, 0)
+				s.Width = DimensionPercentOf(0.25)
+				s.Height = DimensionValue(10)
+			})),
+		)
+	}, WithModifier(size.Size(200, 100))))
+	l.expect(t, map[string]image.Rectangle{
+		"a": image.Rect(10, 5, 30, 15),
+		"b": image.Rect(35, 5, 65, 15),
+		"c": image.Rect(65, 15, 190, 25),
+		"d": image.Rect(100, 30, 110, 40),
+		"e": image.Rect(80, 80, 100, 90),
+		"f": image.Rect(100, 80, 120, 90),
+		"g": image.Rect(150, 90, 200, 100),
+	})
+}
+
+func TestConstraintLayout_WrapContent(t *testing.T) {
+	l := &layoutTest{}
+	l.frame(300, 300, ConstraintLayout(func(scope *ConstraintLayoutScope) Composable {
+		a, b := scope.CreateRef(), scope.CreateRef()
+		return compose.Sequence(
+			l.item("a", size.Size(20, 10).Then(scope.ConstrainAs(a, func(s *ConstrainScope) {
+				s.Start.LinkTo(s.Parent.Start, 10)
+				s.CenterVerticallyTo(s.Parent)
+			}))),
+			l.item("b", size.Size(30, 40).Then(scope.ConstrainAs(b, func(s *ConstrainScope) {
+				s.LinkHorizontallyTo(a.End, s.Parent.End, 5, 10, 0.5)
+			}))),
+		)
+	}, WithModifier(l.record("layout"))))
+	l.expect(t, map[string]image.Rectangle{
+		"layout": image.Rect(0, 0, 75, 40),
+		"a":      image.Rect(10, 15, 30, 25),
+		"b":      image.Rect(35, 0, 65, 40),
+	})
+}
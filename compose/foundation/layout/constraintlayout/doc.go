@@ -0,0 +1,31 @@
+/*
+Package constraintlayout contains ConstraintLayout, which places its elements
+relative to each other and to the layout, without nesting rows, columns and
+boxes.
+
+	constraintlayout.ConstraintLayout(func(scope *constraintlayout.ConstraintLayoutScope) constraintlayout.Composable {
+		refs := scope.CreateRefs(3)
+		avatar, name, date := refs[0], refs[1], refs[2]
+		content := scope.CreateGuidelineFromStart(0.25)
+		return func(c constraintlayout.Composer) constraintlayout.Composer {
+			c = Avatar(scope.ConstrainAs(avatar, func(c *constraintlayout.ConstrainScope) {
+				c.Top.LinkTo(c.Parent.Top, 16)
+				c.End.LinkTo(content, 8)
+			}))(c)
+			c = text.Text(title, text.WithModifier(scope.ConstrainAs(name, func(c *constraintlayout.ConstrainScope) {
+				c.LinkHorizontallyTo(content, c.Parent.End, 0, 16, 0)
+				c.Top.LinkTo(avatar.Top, 0)
+				c.Width = constraintlayout.DimensionFillToConstraints
+			})))(c)
+			return text.Text(when, text.WithModifier(scope.ConstrainAs(date, func(c *constraintlayout.ConstrainScope) {
+				c.Start.LinkTo(name.Start, 0)
+				c.Top.LinkTo(name.Bottom, 4)
+			})))(c)
+		}
+	})
+
+The positions are solved along each axis by the solver package.
+
+Reference: https://cs.android.com/androidx/constraintlayout/+/main:constraintlayout/compose/src/main/java/androidx/constraintlayout/compose/ConstraintLayout.kt
+*/
+package constraintlayout
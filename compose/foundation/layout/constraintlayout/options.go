@@ -0,0 +1,21 @@
+package constraintlayout
+
+import "github.com/zodimo/go-compose/compose/ui"
+
+type ConstraintLayoutOptions struct {
+	Modifier ui.Modifier
+}
+
+type ConstraintLayoutOption func(*ConstraintLayoutOptions)
+
+func DefaultConstraintLayoutOptions() ConstraintLayoutOptions {
+	return ConstraintLayoutOptions{
+		Modifier: ui.EmptyModifier,
+	}
+}
+
+func WithModifier(m ui.Modifier) ConstraintLayoutOption {
+	return func(o *ConstraintLayoutOptions) {
+		o.Modifier = m
+	}
+}
@@ -0,0 +1,93 @@
+package constraintlayout
+
+import (
+	"github.com/zodimo/go-compose/compose/foundation/layout/constraintlayout/solver"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+)
+
+// VerticalAnchor is a vertical line that start and end edges link to: the
+// start or end of an element, a vertical guideline or barrier.
+type VerticalAnchor struct {
+	anchor solver.Anchor
+}
+
+// HorizontalAnchor is a horizontal line that top and bottom edges link to: the
+// top or bottom of an element, a horizontal guideline or barrier.
+type HorizontalAnchor struct {
+	anchor solver.Anchor
+}
+
+// BaselineAnchor is the baseline of an element.
+type BaselineAnchor struct {
+	anchor solver.Anchor
+}
+
+// ConstrainedLayoutReference refers to an element of a ConstraintLayout, or
+// to the layout itself, whose anchors other elements link to.
+//
+// https://cs.android.com/androidx/constraintlayout/+/main:constraintlayout/compose/src/main/java/androidx/constraintlayout/compose/ConstraintLayoutBaseScope.kt
+type ConstrainedLayoutReference struct {
+	Start, End  VerticalAnchor
+	Top, Bottom HorizontalAnchor
+	Baseline    BaselineAnchor
+
+	index int
+}
+
+func newReference(index int) *ConstrainedLayoutReference {
+	return &ConstrainedLayoutReference{
+		Start:    VerticalAnchor{solver.WidgetAnchor(index, solver.Start)},
+		End:      VerticalAnchor{solver.WidgetAnchor(index, solver.End)},
+		Top:      HorizontalAnchor{solver.WidgetAnchor(index, solver.Start)},
+		Bottom:   HorizontalAnchor{solver.WidgetAnchor(index, solver.End)},
+		Baseline: BaselineAnchor{solver.WidgetAnchor(index, solver.Baseline)},
+		index:    index,
+	}
+}
+
+// parentReference refers to the ConstraintLayout itself.
+var parentReference = &ConstrainedLayoutReference{
+	Start:    VerticalAnchor{solver.ParentAnchor(solver.Start)},
+	End:      VerticalAnchor{solver.ParentAnchor(solver.End)},
+	Top:      HorizontalAnchor{solver.ParentAnchor(solver.Start)},
+	Bottom:   HorizontalAnchor{solver.ParentAnchor(solver.End)},
+	Baseline: BaselineAnchor{solver.ParentAnchor(solver.Start)},
+	index:    -1,
+}
+
+// anchorLink is a link of an edge, with a margin in dp.
+type anchorLink struct {
+	target solver.Anchor
+	margin unit.Dp
+}
+
+// VerticalAnchorLinkable is the start or end edge of a constrained element.
+type VerticalAnchorLinkable struct {
+	link *anchorLink
+}
+
+// LinkTo links the edge to anchor, margin away from it.
+func (l *VerticalAnchorLinkable) LinkTo(anchor VerticalAnchor, margin unit.Dp) {
+	l.link = &anchorLink{target: anchor.anchor, margin: margin}
+}
+
+// HorizontalAnchorLinkable is the top or bottom edge of a constrained element.
+type HorizontalAnchorLinkable struct {
+	link *anchorLink
+}
+
+// LinkTo links the edge to anchor, margin away from it.
+func (l *HorizontalAnchorLinkable) LinkTo(anchor HorizontalAnchor, margin unit.Dp) {
+	l.link = &anchorLink{target: anchor.anchor, margin: margin}
+}
+
+// BaselineAnchorLinkable is the baseline of a constrained element.
+type BaselineAnchorLinkable struct {
+	link *anchorLink
+}
+
+// LinkTo aligns the baseline with anchor, margin below it. It takes
+// precedence over the links of the top and bottom edges.
+func (l *BaselineAnchorLinkable) LinkTo(anchor BaselineAnchor, margin unit.Dp) {
+	l.link = &anchorLink{target: anchor.anchor, margin: margin}
+}
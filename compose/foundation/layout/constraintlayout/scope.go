@@ -0,0 +1,196 @@
+package constraintlayout
+
+import (
+	"github.com/zodimo/go-compose/compose/foundation/layout/constraintlayout/solver"
+	"github.com/zodimo/go-compose/compose/ui"
+	uilayout "github.com/zodimo/go-compose/compose/ui/layout"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+)
+
+// ChainStyle is how a chain shares the free space between its elements.
+type ChainStyle struct {
+	style solver.ChainStyle
+	bias  float32
+}
+
+var (
+	// ChainStyleSpread spreads the space evenly around the elements.
+	ChainStyleSpread = ChainStyle{style: solver.ChainSpread}
+	// ChainStyleSpreadInside spreads the space evenly between the elements,
+	// the first and last ones being flush with the ends of the chain.
+	ChainStyleSpreadInside = ChainStyle{style: solver.ChainSpreadInside}
+	// ChainStylePacked packs the elements together in the middle of the chain.
+	ChainStylePacked = ChainStyle{style: solver.ChainPacked, bias: 0.5}
+)
+
+// ChainStylePackedWithBias packs the elements together, placed with bias from
+// 0 at the start of the chain to 1 at its end.
+func ChainStylePackedWithBias(bias float32) ChainStyle {
+	return ChainStyle{style: solver.ChainPacked, bias: bias}
+}
+
+// axis indexes the horizontal and vertical specs of a scope.
+type axis int
+
+const (
+	horizontal axis = iota
+	vertical
+)
+
+type guidelineSpec struct {
+	fraction float32
+	offset   unit.Dp
+	fromEnd  bool
+}
+
+type barrierSpec struct {
+	side   solver.Side
+	refs   []*ConstrainedLayoutReference
+	margin unit.Dp
+}
+
+type chainSpec struct {
+	refs  []*ConstrainedLayoutReference
+	style ChainStyle
+}
+
+// ConstraintLayoutScope is the receiver of the content of a ConstraintLayout,
+// which creates the references of its elements, guidelines, barriers and
+// chains.
+//
+// https://cs.android.com/androidx/constraintlayout/+/main:constraintlayout/compose/src/main/java/androidx/constraintlayout/compose/ConstraintLayoutBaseScope.kt
+type ConstraintLayoutScope struct {
+	// constraints are the constraints of each reference, nil until set.
+	constraints []*ConstrainScope
+	guidelines  [2][]guidelineSpec
+	barriers    [2][]barrierSpec
+	chains      [2][]chainSpec
+}
+
+func newConstraintLayoutScope() *ConstraintLayoutScope {
+	return &ConstraintLayoutScope{}
+}
+
+// CreateRef creates a reference for an element of the layout.
+func (s *ConstraintLayoutScope) CreateRef() *ConstrainedLayoutReference {
+	ref := newReference(len(s.constraints))
+	s.constraints = append(s.constraints, nil)
+	return ref
+}
+
+// CreateRefs creates count references.
+func (s *ConstraintLayoutScope) CreateRefs(count int) []*ConstrainedLayoutReference {
+	refs := make([]*ConstrainedLayoutReference, count)
+	for i := range refs {
+		refs[i] = s.CreateRef()
+	}
+	return refs
+}
+
+// ConstrainAs returns the modifier of the element ref refers to, constrained
+// by block.
+func (s *ConstraintLayoutScope) ConstrainAs(ref *ConstrainedLayoutReference, block func(c *ConstrainScope)) ui.Modifier {
+	c := newConstrainScope()
+	if block != nil {
+		block(c)
+	}
+	s.constraints[ref.index] = c
+	return uilayout.LayoutId(ref)
+}
+
+// CreateGuidelineFromStart creates a vertical guideline at fraction of the
+// width of the layout from its start.
+func (s *ConstraintLayoutScope) CreateGuidelineFromStart(fraction float32) VerticalAnchor {
+	return VerticalAnchor{s.guideline(horizontal, guidelineSpec{fraction: fraction})}
+}
+
+// CreateGuidelineFromEnd creates a vertical guideline at fraction of the
+// width of the layout from its end.
+func (s *ConstraintLayoutScope) CreateGuidelineFromEnd(fraction float32) VerticalAnchor {
+	return VerticalAnchor{s.guideline(horizontal, guidelineSpec{fraction: fraction, fromEnd: true})}
+}
+
+// CreateGuidelineFromTop creates a horizontal guideline at fraction of the
+// height of the layout from its top.
+func (s *ConstraintLayoutScope) CreateGuidelineFromTop(fraction float32) HorizontalAnchor {
+	return HorizontalAnchor{s.guideline(vertical, guidelineSpec{fraction: fraction})}
+}
+
+// CreateGuidelineFromBottom creates a horizontal guideline at fraction of
+// the height of the layout from its bottom.
+func (s *ConstraintLayoutScope) CreateGuidelineFromBottom(fraction float32) HorizontalAnchor {
+	return HorizontalAnchor{s.guideline(vertical, guidelineSpec{fraction: fraction, fromEnd: true})}
+}
+
+// CreateGuidelineFromStartOffset creates a vertical guideline offset from
+// the start of the layout.
+func (s *ConstraintLayoutScope) CreateGuidelineFromStartOffset(offset unit.Dp) VerticalAnchor {
+	return VerticalAnchor{s.guideline(horizontal, guidelineSpec{offset: offset})}
+}
+
+// CreateGuidelineFromEndOffset creates a vertical guideline offset from the
+// end of the layout.
+func (s *ConstraintLayoutScope) CreateGuidelineFromEndOffset(offset unit.Dp) VerticalAnchor {
+	return VerticalAnchor{s.guideline(horizontal, guidelineSpec{offset: offset, fromEnd: true})}
+}
+
+// CreateGuidelineFromTopOffset creates a horizontal guideline offset from
+// the top of the layout.
+func (s *ConstraintLayoutScope) CreateGuidelineFromTopOffset(offset unit.Dp) HorizontalAnchor {
+	return HorizontalAnchor{s.guideline(vertical, guidelineSpec{offset: offset})}
+}
+
+// CreateGuidelineFromBottomOffset creates a horizontal guideline offset from
+// the bottom of the layout.
+func (s *ConstraintLayoutScope) CreateGuidelineFromBottomOffset(offset unit.Dp) HorizontalAnchor {
+	return HorizontalAnchor{s.guideline(vertical, guidelineSpec{offset: offset, fromEnd: true})}
+}
+
+func (s *ConstraintLayoutScope) guideline(a axis, spec guidelineSpec) solver.Anchor {
+	s.guidelines[a] = append(s.guidelines[a], spec)
+	return solver.GuidelineAnchor(len(s.guidelines[a]) - 1)
+}
+
+// CreateStartBarrier creates a vertical barrier at the start-most start edge
+// of refs, margin before it.
+func (s *ConstraintLayoutScope) CreateStartBarrier(margin unit.Dp, refs ...*ConstrainedLayoutReference) VerticalAnchor {
+	return VerticalAnchor{s.barrier(horizontal, barrierSpec{side: solver.Start, refs: refs, margin: margin})}
+}
+
+// CreateEndBarrier creates a vertical barrier at the end-most end edge of
+// refs, margin after it.
+func (s *ConstraintLayoutScope) CreateEndBarrier(margin unit.Dp, refs ...*ConstrainedLayoutReference) VerticalAnchor {
+	return VerticalAnchor{s.barrier(horizontal, barrierSpec{side: solver.End, refs: refs, margin: margin})}
+}
+
+// CreateTopBarrier creates a horizontal barrier at the top-most top edge of
+// refs, margin above it.
+func (s *ConstraintLayoutScope) CreateTopBarrier(margin unit.Dp, refs ...*ConstrainedLayoutReference) HorizontalAnchor {
+	return HorizontalAnchor{s.barrier(vertical, barrierSpec{side: solver.Start, refs: refs, margin: margin})}
+}
+
+// CreateBottomBarrier creates a horizontal barrier at the bottom-most bottom
+// edge of refs, margin below it.
+func (s *ConstraintLayoutScope) CreateBottomBarrier(margin unit.Dp, refs ...*ConstrainedLayoutReference) HorizontalAnchor {
+	return HorizontalAnchor{s.barrier(vertical, barrierSpec{side: solver.End, refs: refs, margin: margin})}
+}
+
+func (s *ConstraintLayoutScope) barrier(a axis, spec barrierSpec) solver.Anchor {
+	s.barriers[a] = append(s.barriers[a], spec)
+	return solver.BarrierAnchor(len(s.barriers[a]) - 1)
+}
+
+// CreateHorizontalChain lays out refs one after the other, between the
+// anchors the start of the first one and the end of the last one link to,
+// the edges of the layout by default. The margins of the links between them
+// are kept, and the elements that fill their constraints share the free
+// space by HorizontalChainWeight.
+func (s *ConstraintLayoutScope) CreateHorizontalChain(style ChainStyle, refs ...*ConstrainedLayoutReference) {
+	s.chains[horizontal] = append(s.chains[horizontal], chainSpec{refs: refs, style: style})
+}
+
+// CreateVerticalChain lays out refs one below the other, like
+// CreateHorizontalChain.
+func (s *ConstraintLayoutScope) CreateVerticalChain(style ChainStyle, refs ...*ConstrainedLayoutReference) {
+	s.chains[vertical] = append(s.chains[vertical], chainSpec{refs: refs, style: style})
+}
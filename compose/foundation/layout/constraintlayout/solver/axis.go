@@ -0,0 +1,470 @@
+package solver
+
+import "math"
+
+// Side is an edge of a widget, or of the parent, along an axis.
+type Side int
+
+const (
+	Start Side = iota
+	End
+	// Baseline is the baseline of a widget, on the vertical axis.
+	Baseline
+)
+
+// AnchorKind is the kind of element an Anchor belongs to.
+type AnchorKind int
+
+const (
+	AnchorParent AnchorKind = iota
+	AnchorWidget
+	AnchorGuideline
+	AnchorBarrier
+)
+
+// Anchor is a position on the axis that widgets link to.
+type Anchor struct {
+	Kind AnchorKind
+	// Index is the index of the widget, guideline or barrier.
+	Index int
+	// Side is the edge of the parent or widget.
+	Side Side
+}
+
+func ParentAnchor(side Side) Anchor {
+	return Anchor{Kind: AnchorParent, Side: side}
+}
+
+func WidgetAnchor(index int, side Side) Anchor {
+	return Anchor{Kind: AnchorWidget, Index: index, Side: side}
+}
+
+func GuidelineAnchor(index int) Anchor {
+	return Anchor{Kind: AnchorGuideline, Index: index}
+}
+
+func BarrierAnchor(index int) Anchor {
+	return Anchor{Kind: AnchorBarrier, Index: index}
+}
+
+// Link links an edge of a widget to Target, Margin pixels away from it,
+// towards the inside of the link.
+type Link struct {
+	Target Anchor
+	Margin int
+}
+
+// Dimension is how the size of a widget is decided.
+type Dimension int
+
+const (
+	// DimensionFixed keeps the measured Size of the widget.
+	DimensionFixed Dimension = iota
+	// DimensionFill sizes the widget to the space between the anchors of its
+	// start and end links, or to its share of a chain. Widgets without both
+	// links keep their Size.
+	DimensionFill
+	// DimensionPercent sizes the widget to Percent of the parent.
+	DimensionPercent
+)
+
+// Widget is a child of the layout along the axis.
+type Widget struct {
+	// Size is the measured size of the widget.
+	Size      int
+	Dimension Dimension
+	Percent   float32
+	// BaselineOffset is the distance from the start of the widget to its
+	// baseline.
+	BaselineOffset int
+
+	Start, End *Link
+	// BaselineLink aligns the baseline of the widget, and takes precedence
+	// over the start and end links.
+	BaselineLink *Link
+	// Bias places a widget linked on both sides between its anchors, from 0 at
+	// the start to 1 at the end.
+	Bias float32
+	// Weight is the share of a DimensionFill widget in the free space of its
+	// chain, 1 when zero.
+	Weight float32
+}
+
+// Guideline is a line at Fraction of the parent plus Offset pixels, from the
+// start of the parent or, with FromEnd, from its end.
+type Guideline struct {
+	Fraction float32
+	Offset   int
+	FromEnd  bool
+}
+
+// Barrier is a line at the start-most start edge of Widgets, minus Margin,
+// or at their end-most end edge, plus Margin.
+type Barrier struct {
+	Side    Side
+	Widgets []int
+	Margin  int
+}
+
+// ChainStyle is how a chain shares the free space between its widgets.
+type ChainStyle int
+
+const (
+	// ChainSpread spreads the space evenly around the widgets.
+	ChainSpread ChainStyle = iota
+	// ChainSpreadInside spreads the space evenly between the widgets, the
+	// first and last ones being flush with the ends of the chain.
+	ChainSpreadInside
+	// ChainPacked packs the widgets together, placed with Bias.
+	ChainPacked
+)
+
+// Chain lays out Widgets one after the other, between the anchor the start
+// of the first widget links to and the anchor the end of the last widget
+// links to, the edges of the parent by default. The links between the
+// widgets of a chain are ignored, except for their margins.
+type Chain struct {
+	Widgets []int
+	Style   ChainStyle
+	// Bias places a packed chain, from 0 at the start to 1 at the end.
+	Bias float32
+}
+
+// Axis is the system of widgets, guidelines, barriers and chains along an
+// axis.
+type Axis struct {
+	Widgets    []Widget
+	Guidelines []Guideline
+	Barriers   []Barrier
+	Chains     []Chain
+}
+
+// Solution is the position and size of each widget of an Axis, in pixels from
+// the start of the parent.
+type Solution struct {
+	Positions []int
+	Sizes     []int
+}
+
+// End returns the end edge of widget i.
+func (s Solution) End(i int) int {
+	return s.Positions[i] + s.Sizes[i]
+}
+
+// Solve positions the widgets in a parent of the given size.
+func (a *Axis) Solve(parent int) Solution {
+	s := newSolve(a, parent)
+	for i := range a.Widgets {
+		s.widget(i)
+	}
+	return Solution{Positions: s.positions, Sizes: s.sizes}
+}
+
+// maxWrapIterations bounds the growth steps of WrapSize, which usually finds
+// a size that fits in one or two.
+const maxWrapIterations = 8
+
+// WrapSize returns the smallest parent size, between minSize and maxSize, in
+// which the widgets fit: they do not overflow the parent, margins included,
+// and have room between the anchors they link to. It grows the parent by the
+// overflow of the previous solution until the widgets fit, then searches the
+// smallest size that fits below it.
+func (a *Axis) WrapSize(minSize, maxSize int) int {
+	overflow := a.overflow(minSize)
+	if overflow == 0 {
+		return minSize
+	}
+	fits := minSize
+	for range maxWrapIterations {
+		fits = min(fits+overflow, maxSize)
+		if overflow = a.overflow(fits); overflow == 0 || fits == maxSize {
+			break
+		}
+	}
+	if overflow > 0 {
+		return fits
+	}
+	lo, hi := minSize, fits
+	for hi-lo > 1 {
+		mid := lo + (hi-lo)/2
+		if a.overflow(mid) == 0 {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+	return hi
+}
+
+// overflow returns by how much the widgets do not fit in a parent of the
+// given size, 0 when they fit.
+func (a *Axis) overflow(parent int) int {
+	s := newSolve(a, parent)
+	for i := range a.Widgets {
+		s.widget(i)
+	}
+	before, after := 0, 0
+	for i, w := range a.Widgets {
+		startMargin, endMargin := 0, 0
+		if w.Start != nil && w.Start.Target == ParentAnchor(Start) {
+			startMargin = w.Start.Margin
+		}
+		if w.End != nil && w.End.Target == ParentAnchor(End) {
+			endMargin = w.End.Margin
+		}
+		before = max(before, startMargin-s.positions[i])
+		after = max(after, s.positions[i]+s.sizes[i]+endMargin-parent)
+	}
+	return max(before+after, s.missing)
+}
+
+type resolution int
+
+const (
+	unresolved resolution = iota
+	resolving
+	resolved
+)
+
+type solve struct {
+	axis      *Axis
+	parent    int
+	positions []int
+	sizes     []int
+	states    []resolution
+	// chains maps the widgets of chains to their chain.
+	chains map[int]int
+	// missing is the largest lack of room between the anchors of a widget or
+	// a chain.
+	missing int
+}
+
+func newSolve(a *Axis, parent int) *solve {
+	s := &solve{
+		axis:      a,
+		parent:    parent,
+		positions: make([]int, len(a.Widgets)),
+		sizes:     make([]int, len(a.Widgets)),
+		states:    make([]resolution, len(a.Widgets)),
+		chains:    map[int]int{},
+	}
+	for c, chain := range a.Chains {
+		for _, w := range chain.Widgets {
+			s.chains[w] = c
+		}
+	}
+	return s
+}
+
+// anchor returns the position of anchor, and false when it depends on a
+// widget being resolved.
+func (s *solve) anchor(anchor Anchor) (int, bool) {
+	switch anchor.Kind {
+	case AnchorParent:
+		if anchor.Side == End {
+			return s.parent, true
+		}
+		return 0, true
+	case AnchorWidget:
+		if !s.widget(anchor.Index) {
+			return 0, false
+		}
+		switch anchor.Side {
+		case End:
+			return s.positions[anchor.Index] + s.sizes[anchor.Index], true
+		case Baseline:
+			return s.positions[anchor.Index] + s.axis.Widgets[anchor.Index].BaselineOffset, true
+		}
+		return s.positions[anchor.Index], true
+	case AnchorGuideline:
+		g := s.axis.Guidelines[anchor.Index]
+		position := round(g.Fraction*float32(s.parent)) + g.Offset
+		if g.FromEnd {
+			position = s.parent - position
+		}
+		return position, true
+	case AnchorBarrier:
+		b := s.axis.Barriers[anchor.Index]
+		position, found := 0, false
+		for _, w := range b.Widgets {
+			if !s.widget(w) {
+				continue
+			}
+			edge := s.positions[w]
+			if b.Side == End {
+				edge += s.sizes[w]
+			}
+			if !found || (b.Side == End && edge > position) || (b.Side != End && edge < position) {
+				position = edge
+			}
+			found = true
+		}
+		if b.Side == End {
+			return position + b.Margin, true
+		}
+		return position - b.Margin, true
+	}
+	return 0, true
+}
+
+// link returns the position an edge linked with l is at, margin included.
+func (s *solve) link(l *Link, side Side) (int, bool) {
+	if l == nil {
+		return 0, false
+	}
+	position, ok := s.anchor(l.Target)
+	if !ok {
+		return 0, false
+	}
+	if side == End {
+		return position - l.Margin, true
+	}
+	return position + l.Margin, true
+}
+
+// size returns the size of widget i, unless it fills its constraints.
+func (s *solve) size(i int) int {
+	w := s.axis.Widgets[i]
+	if w.Dimension == DimensionPercent {
+		return round(w.Percent * float32(s.parent))
+	}
+	return w.Size
+}
+
+// widget resolves widget i, and returns false when it is being resolved.
+func (s *solve) widget(i int) bool {
+	switch s.states[i] {
+	case resolved:
+		return true
+	case resolving:
+		return false
+	}
+	if c, ok := s.chains[i]; ok {
+		s.chain(c)
+		return s.states[i] == resolved
+	}
+	s.states[i] = resolving
+
+	w := s.axis.Widgets[i]
+	size := s.size(i)
+	var position int
+	if w.BaselineLink != nil {
+		if p, ok := s.link(w.BaselineLink, Start); ok {
+			position = p - w.BaselineOffset
+		}
+	} else {
+		start, hasStart := s.link(w.Start, Start)
+		end, hasEnd := s.link(w.End, End)
+		switch {
+		case hasStart && hasEnd:
+			if w.Dimension == DimensionFill {
+				size = max(end-start, 0)
+			}
+			position = start + round(w.Bias*float32(end-start-size))
+			s.missing = max(s.missing, size-(end-start))
+		case hasStart:
+			position = start
+		case hasEnd:
+			position = end - size
+		}
+	}
+	s.positions[i], s.sizes[i] = position, size
+	s.states[i] = resolved
+	return true
+}
+
+// chain resolves the widgets of chain c together.
+func (s *solve) chain(c int) {
+	chain := s.axis.Chains[c]
+	if len(chain.Widgets) == 0 {
+		return
+	}
+	for _, i := range chain.Widgets {
+		s.states[i] = resolving
+	}
+	first, last := chain.Widgets[0], chain.Widgets[len(chain.Widgets)-1]
+	start, ok := s.link(s.axis.Widgets[first].Start, Start)
+	if !ok {
+		start = 0
+	}
+	end, ok := s.link(s.axis.Widgets[last].End, End)
+	if !ok {
+		end = s.parent
+	}
+
+	// The margins between widgets are the ones of their links.
+	gaps := make([]int, len(chain.Widgets))
+	used := 0
+	var weights float32
+	for n, i := range chain.Widgets {
+		w := s.axis.Widgets[i]
+		if n > 0 {
+			if w.Start != nil {
+				gaps[n] += w.Start.Margin
+			}
+			if prev := s.axis.Widgets[chain.Widgets[n-1]]; prev.End != nil {
+				gaps[n] += prev.End.Margin
+			}
+		}
+		used += gaps[n]
+		if w.Dimension == DimensionFill {
+			weights += weight(w)
+			continue
+		}
+		s.sizes[i] = s.size(i)
+		used += s.sizes[i]
+	}
+	free := end - start - used
+	s.missing = max(s.missing, -free)
+
+	if weights > 0 {
+		// The free space goes to the widgets filling the chain.
+		remaining, remainingWeights := max(free, 0), weights
+		for _, i := range chain.Widgets {
+			w := s.axis.Widgets[i]
+			if w.Dimension != DimensionFill {
+				continue
+			}
+			share := round(float32(remaining) * weight(w) / remainingWeights)
+			s.sizes[i] = share
+			remaining -= share
+			remainingWeights -= weight(w)
+		}
+		free = 0
+	}
+
+	n := len(chain.Widgets)
+	position := start
+	between := 0
+	switch {
+	case free <= 0 || chain.Style == ChainPacked:
+		position += round(chain.Bias * float32(free))
+	case chain.Style == ChainSpreadInside && n > 1:
+		between = free / (n - 1)
+	case chain.Style == ChainSpreadInside:
+		position += free / 2
+	default:
+		between = free / (n + 1)
+		position += between
+	}
+	for k, i := range chain.Widgets {
+		if k > 0 {
+			position += between
+		}
+		position += gaps[k]
+		s.positions[i] = position
+		position += s.sizes[i]
+		s.states[i] = resolved
+	}
+}
+
+func weight(w Widget) float32 {
+	if w.Weight > 0 {
+		return w.Weight
+	}
+	return 1
+}
+
+func round(v float32) int {
+	return int(math.Round(float64(v)))
+}
@@ -0,0 +1,117 @@
+package solver
+
+import (
+	"slices"
+	"testing"
+)
+
+func link(target Anchor, margin int) *Link {
+	return &Link{Target: target, Margin: margin}
+}
+
+func expect(t *testing.T, got Solution, positions, sizes []int) {
+	t.Helper()
+	if !slices.Equal(got.Positions, positions) || !slices.Equal(got.Sizes, sizes) {
+		t.Errorf("solution = %v %v, want %v %v", got.Positions, got.Sizes, positions, sizes)
+	}
+}
+
+func TestSolve_Links(t *testing.T) {
+	axis := &Axis{Widgets: []Widget{
+		// Centred in the parent, with a bias.
+		{Size: 20, Start: link(ParentAnchor(Start), 0), End: link(ParentAnchor(End), 0), Bias: 0.25},
+		// After widget 0, linked before it is resolved.
+		{Size: 10, Start: link(WidgetAnchor(0, End), 5)},
+		// Before the end of the parent.
+		{Size: 10, End: link(ParentAnchor(End), 4)},
+		// Filling the space between widget 1 and widget 2.
+		{Size: 99, Dimension: DimensionFill, Start: link(WidgetAnchor(1, End), 0), End: link(WidgetAnchor(2, Start), 0)},
+		// Half of the parent, unlinked.
+		{Dimension: DimensionPercent, Percent: 0.5},
+	}}
+	expect(t, axis.Solve(100), []int{20, 45, 86, 55, 0}, []int{20, 10, 10, 31, 50})
+}
+
+func TestSolve_Cycle(t *testing.T) {
+	axis := &Axis{Widgets: []Widget{
+		{Size: 10, Start: link(ParentAnchor(Start), 0), End: link(WidgetAnchor(1, Start), 0), Bias: 0.5},
+		{Size: 10, Start: link(WidgetAnchor(0, End), 0), End: link(ParentAnchor(End), 0), Bias: 0.5},
+	}}
+	// The link of widget 1 back to widget 0 is ignored.
+	expect(t, axis.Solve(100), []int{40, 90}, []int{10, 10})
+}
+
+func TestSolve_GuidelinesAndBarriers(t *testing.T) {
+	axis := &Axis{
+		Widgets: []Widget{
+			{Size: 10, Start: link(GuidelineAnchor(0), 0)},
+			{Size: 30, Start: link(GuidelineAnchor(1), 0)},
+			{Size: 5, Start: link(BarrierAnchor(0), 0)},
+			{Size: 5, End: link(BarrierAnchor(1), 0)},
+		},
+		Guidelines: []Guideline{{Fraction: 0.2}, {Offset: 10, FromEnd: true}},
+		Barriers: []Barrier{
+			{Side: End, Widgets: []int{0, 1}, Margin: 2},
+			{Side: Start, Widgets: []int{0, 1}},
+		},
+	}
+	expect(t, axis.Solve(200), []int{40, 190, 222, 35}, []int{10, 30, 5, 5})
+}
+
+func TestSolve_Baseline(t *testing.T) {
+	axis := &Axis{Widgets: []Widget{
+		{Size: 40, BaselineOffset: 30, Start: link(ParentAnchor(Start), 10)},
+		{Size: 20, BaselineOffset: 15, BaselineLink: link(WidgetAnchor(0, Baseline), 0), Start: link(ParentAnchor(Start), 0)},
+	}}
+	expect(t, axis.Solve(100), []int{10, 25}, []int{40, 20})
+}
+
+func TestSolve_Chains(t *testing.T) {
+	widgets := func(first, last *Link) []Widget {
+		return []Widget{{Size: 10, Start: first}, {Size: 20}, {Size: 10, End: last}}
+	}
+	for _, test := range []struct {
+		name      string
+		chain     Chain
+		positions []int
+	}{
+		{"spread", Chain{Style: ChainSpread}, []int{15, 40, 75}},
+		{"spread inside", Chain{Style: ChainSpreadInside}, []int{0, 40, 90}},
+		{"packed", Chain{Style: ChainPacked, Bias: 0.5}, []int{30, 40, 60}},
+	} {
+		test.chain.Widgets = []int{0, 1, 2}
+		axis := &Axis{Widgets: widgets(nil, nil), Chains: []Chain{test.chain}}
+		got := axis.Solve(100)
+		if !slices.Equal(got.Positions, test.positions) {
+			t.Errorf("%s: positions = %v, want %v", test.name, got.Positions, test.positions)
+		}
+	}
+
+	// A weighted chain between margins: the fill widgets share the free space.
+	axis := &Axis{
+		Widgets: []Widget{
+			{Size: 15, Start: link(ParentAnchor(Start), 10), End: link(WidgetAnchor(1, Start), 5)},
+			{Dimension: DimensionFill, Weight: 1},
+			{Dimension: DimensionFill, Weight: 2, End: link(ParentAnchor(End), 10)},
+		},
+		Chains: []Chain{{Widgets: []int{0, 1, 2}}},
+	}
+	expect(t, axis.Solve(100), []int{10, 30, 50}, []int{15, 20, 40})
+}
+
+func TestWrapSize(t *testing.T) {
+	axis := &Axis{Widgets: []Widget{
+		{Size: 20, Start: link(ParentAnchor(Start), 8), End: link(ParentAnchor(End), 8), Bias: 0.5},
+		{Size: 10, Start: link(WidgetAnchor(0, End), 4)},
+	}}
+	// Widget 1 fits after the centred widget 0 once the parent is 48 wide.
+	if got := axis.WrapSize(0, 1000); got != 48 {
+		t.Errorf("WrapSize = %d, want 48", got)
+	}
+	if got := axis.WrapSize(0, 30); got != 30 {
+		t.Errorf("WrapSize should be bounded, got %d", got)
+	}
+	if got := axis.WrapSize(100, 1000); got != 100 {
+		t.Errorf("WrapSize should be at least the minimum, got %d", got)
+	}
+}
@@ -0,0 +1,21 @@
+/*
+Package solver positions the widgets of a constraint layout along one axis.
+
+Each widget links its start and end edges, or its baseline, to anchors: the
+edges of the parent, of other widgets, guidelines and barriers. The solver
+resolves the anchors in dependency order, so that a widget is positioned once
+the anchors it links to are. A link that would close a cycle is ignored.
+
+	axis := &solver.Axis{
+		Widgets: []solver.Widget{
+			{Size: 20, Start: &solver.Link{Target: solver.ParentAnchor(solver.Start), Margin: 8}},
+			{Size: 30, Start: &solver.Link{Target: solver.WidgetAnchor(0, solver.End)}, End: &solver.Link{Target: solver.ParentAnchor(solver.End)}, Bias: 0.5},
+		},
+	}
+	solution := axis.Solve(100)
+
+The horizontal and vertical axes of a layout are solved independently, the
+horizontal one first, so that the widgets whose width it decides can be
+measured before the vertical one is solved.
+*/
+package solver
@@ -0,0 +1,18 @@
+package flow
+
+import (
+	"github.com/zodimo/go-compose/pkg/api"
+
+	"gioui.org/layout"
+)
+
+type Composable = api.Composable
+type Composer = api.Composer
+
+type Alignment = layout.Alignment
+
+const (
+	Start  Alignment = layout.Start
+	End    Alignment = layout.End
+	Middle Alignment = layout.Middle
+)
@@ -0,0 +1,22 @@
+/*
+Package flow contains FlowRow and FlowColumn, which lay out their items in
+lines, starting a new line when an item does not fit in the current one.
+
+	flow.FlowRow(
+		func(c flow.Composer) flow.Composer {
+			for _, tag := range tags {
+				c = chip.AssistChip(onClick, tag)(c)
+			}
+			return c
+		},
+		flow.WithHorizontalArrangement(arrangement.SpacedBy(8, arrangement.AlignmentStart)),
+		flow.WithVerticalArrangement(arrangement.SpacedBy(8, arrangement.AlignmentStart)),
+		flow.WithMaxLines(2),
+		flow.WithOverflow(flow.FlowOverflowExpandIndicator(func(scope flow.FlowOverflowScope) flow.Composable {
+			return text.Text(fmt.Sprintf("+%d more", scope.TotalItemCount()-scope.ShownItemCount()))
+		})),
+	)
+
+Reference: https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/foundation/foundation-layout/src/commonMain/kotlin/androidx/compose/foundation/layout/FlowLayout.kt
+*/
+package flow
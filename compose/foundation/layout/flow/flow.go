@@ -0,0 +1,66 @@
+package flow
+
+import (
+	"fmt"
+
+	"github.com/zodimo/go-compose/compose/foundation/layout/box"
+	uilayout "github.com/zodimo/go-compose/compose/ui/layout"
+)
+
+// overflowIndicatorId is the layout id of the overflow indicator, laid out
+// after the items.
+type overflowIndicatorId struct{}
+
+// FlowRow lays out the items emitted by content from the start to the end of
+// rows, starting a new row below when an item does not fit in the width of
+// the layout. Items with a weight modifier share the width left in their row.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/foundation/foundation-layout/src/commonMain/kotlin/androidx/compose/foundation/layout/FlowLayout.kt
+func FlowRow(content Composable, options ...FlowOption) Composable {
+	return flowLayout("FlowRow", true, content, options)
+}
+
+// FlowColumn lays out the items emitted by content from the top to the
+// bottom of columns, starting a new column after when an item does not fit
+// in the height of the layout. Items with a weight modifier share the height
+// left in their column.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/foundation/foundation-layout/src/commonMain/kotlin/androidx/compose/foundation/layout/FlowLayout.kt
+func FlowColumn(content Composable, options ...FlowOption) Composable {
+	return flowLayout("FlowColumn", false, content, options)
+}
+
+func flowLayout(name string, horizontal bool, content Composable, options []FlowOption) Composable {
+	opts := DefaultFlowOptions()
+	for _, option := range options {
+		if option == nil {
+			continue
+		}
+		option(&opts)
+	}
+	return func(c Composer) Composer {
+		key := c.GenerateID()
+		path := c.GetPath()
+		overflowState := c.State(fmt.Sprintf("%d/%s/%s/overflow", key, path, name), func() any { return flowOverflowState{} })
+
+		policy := &flowMeasurePolicy{
+			horizontal:    horizontal,
+			options:       opts,
+			overflowState: overflowState,
+		}
+		items := func(c Composer) Composer {
+			if content != nil {
+				c = content(c)
+			}
+			if opts.Overflow.indicator != nil {
+				scope := overflowState.Get().(flowOverflowState)
+				c = box.Box(
+					opts.Overflow.indicator(scope),
+					box.WithModifier(uilayout.LayoutId(overflowIndicatorId{})),
+				)(c)
+			}
+			return c
+		}
+		return uilayout.Layout(items, policy, uilayout.WithModifier(opts.Modifier))(c)
+	}
+}
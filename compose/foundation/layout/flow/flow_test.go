@@ -0,0 +1,149 @@
+package flow
+
+import (
+	"image"
+	"testing"
+
+	"github.com/zodimo/go-compose/compose"
+	"github.com/zodimo/go-compose/compose/foundation/layout/arrangement"
+	"github.com/zodimo/go-compose/compose/foundation/layout/box"
+	"github.com/zodimo/go-compose/compose/ui"
+	uilayout "github.com/zodimo/go-compose/compose/ui/layout"
+	"github.com/zodimo/go-compose/modifiers/size"
+	"github.com/zodimo/go-compose/modifiers/weight"
+	"github.com/zodimo/go-compose/runtime"
+	"github.com/zodimo/go-compose/state"
+	"github.com/zodimo/go-compose/store"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/unit"
+)
+
+// flowTest composes and lays out frames of a flow layout, and records the
+// bounds of its items.
+type flowTest struct {
+	store  state.PersistentState
+	bounds map[string]image.Rectangle
+}
+
+func newFlowTest() *flowTest {
+	return &flowTest{store: store.NewPersistentState(map[string]state.MutableValue{})}
+}
+
+func (f *flowTest) frame(width, height int, content Composable) {
+	f.bounds = map[string]image.Rectangle{}
+	c := compose.NewComposer(f.store)
+	node := content(c).Build()
+	gtx := layout.Context{
+		Ops:         new(op.Ops),
+		Constraints: layout.Constraints{Max: image.Pt(width, height)},
+		Metric:      unit.Metric{PxPerDp: 1, PxPerSp: 1},
+	}
+	runtime.NewRuntime().Run(gtx, node).Add(gtx.Ops)
+}
+
+// item is a box of modifier, whose bounds are recorded under name.
+func (f *flowTest) item(name string, modifier ui.Modifier) Composable {
+	return box.Box(func(c Composer) Composer { return c }, box.WithModifier(modifier.Then(
+		uilayout.OnGloballyPositioned(func(coordinates uilayout.LayoutCoordinates) {
+			r := coordinates.BoundsInWindow()
+			f.bounds[name] = image.Rect(int(r.Left), int(r.Top), int(r.Right), int(r.Bottom))
+		}),
+	)))
+}
+
+func (f *flowTest) items(names ...string) Composable {
+	return func(c Composer) Composer {
+		for _, name := range names {
+			c = f.item(name, size.Size(30, 10))(c)
+		}
+		return c
+	}
+}
+
+func (f *flowTest) expect(t *testing.T, want map[string]image.Rectangle) {
+	t.Helper()
+	if len(f.bounds) != len(want) {
+		t.Errorf("laid out %d items, want %d: %v", len(f.bounds), len(want), f.bounds)
+	}
+	for name, r := range want {
+		if got, ok := f.bounds[name]; !ok || got != r {
+			t.Errorf("%s bounds = %v, want %v", name, got, r)
+		}
+	}
+}
+
+func TestFlowRow_Wrap(t *testing.T) {
+	f := newFlowTest()
+	f.frame(100, 100, FlowRow(f.items("a", "b", "c", "d"), WithHorizontalArrangement(arrangement.SpacedBy(5, arrangement.AlignmentStart)), WithVerticalArrangement(arrangement.SpacedBy(2, arrangement.AlignmentStart))))
+	f.expect(t, map[string]image.Rectangle{
+		"a": image.Rect(0, 0, 30, 10),
+		"b": image.Rect(35, 0, 65, 10),
+		"c": image.Rect(70, 0, 100, 10),
+		"d": image.Rect(0, 12, 30, 22),
+	})
+
+	f = newFlowTest()
+	f.frame(100, 100, FlowRow(f.items("a", "b", "c", "d"), WithMaxItemsInEachRow(2), WithMaxLines(1)))
+	f.expect(t, map[string]image.Rectangle{
+		"a": image.Rect(0, 0, 30, 10),
+		"b": image.Rect(30, 0, 60, 10),
+	})
+}
+
+func TestFlowColumn_Wrap(t *testing.T) {
+	f := newFlowTest()
+	f.frame(100, 25, FlowColumn(f.items("a", "b", "c"), WithHorizontalArrangement(arrangement.SpacedBy(4, arrangement.AlignmentStart))))
+	f.expect(t, map[string]image.Rectangle{
+		"a": image.Rect(0, 0, 30, 10),
+		"b": image.Rect(0, 10, 30, 20),
+		"c": image.Rect(34, 0, 64, 10),
+	})
+}
+
+func TestFlowRow_Weight(t *testing.T) {
+	f := newFlowTest()
+	f.frame(100, 100, FlowRow(func(c Composer) Composer {
+		c = f.item("a", size.Size(30, 10))(c)
+		c = f.item("b", weight.Weight(1).Then(size.Height(10)))(c)
+		c = f.item("c", size.Size(80, 10))(c)
+		return c
+	}))
+	f.expect(t, map[string]image.Rectangle{
+		"a": image.Rect(0, 0, 30, 10),
+		"b": image.Rect(30, 0, 100, 10),
+		"c": image.Rect(0, 10, 80, 20),
+	})
+}
+
+func TestFlowRow_OverflowIndicator(t *testing.T) {
+	f := newFlowTest()
+	var hidden []int
+	ui := FlowRow(f.items("a", "b", "c", "d", "e"),
+		WithMaxLines(1),
+		WithOverflow(FlowOverflowExpandIndicator(func(scope FlowOverflowScope) Composable {
+			hidden = append(hidden, scope.TotalItemCount()-scope.ShownItemCount())
+			return f.item("more", size.Size(20, 10))
+		})),
+	)
+	f.frame(100, 100, ui)
+	// The indicator takes the place of the third item.
+	f.expect(t, map[string]image.Rectangle{
+		"a":    image.Rect(0, 0, 30, 10),
+		"b":    image.Rect(30, 0, 60, 10),
+		"more": image.Rect(60, 0, 80, 10),
+	})
+	f.frame(100, 100, ui)
+	if got := hidden[len(hidden)-1]; got != 3 {
+		t.Errorf("the indicator should be composed with 3 hidden items, got %d", got)
+	}
+
+	f = newFlowTest()
+	f.frame(200, 100, FlowRow(f.items("a", "b"), WithOverflow(FlowOverflowExpandIndicator(func(scope FlowOverflowScope) Composable {
+		return f.item("more", size.Size(20, 10))
+	}))))
+	if _, ok := f.bounds["more"]; ok {
+		t.Error("the indicator should not be shown when every item fits")
+	}
+}
@@ -0,0 +1,160 @@
+package flow
+
+import (
+	uilayout "github.com/zodimo/go-compose/compose/ui/layout"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+)
+
+var _ uilayout.IntrinsicMeasurePolicy = (*flowMeasurePolicy)(nil)
+
+func (p *flowMeasurePolicy) MinIntrinsicWidth(scope uilayout.IntrinsicMeasureScope, measurables []uilayout.IntrinsicMeasurable, height int) int {
+	if p.horizontal {
+		return p.minIntrinsicMain(measurables, height)
+	}
+	return p.intrinsicCross(scope, measurables, height, false)
+}
+
+func (p *flowMeasurePolicy) MaxIntrinsicWidth(scope uilayout.IntrinsicMeasureScope, measurables []uilayout.IntrinsicMeasurable, height int) int {
+	if p.horizontal {
+		return p.maxIntrinsicMain(scope, measurables, height)
+	}
+	return p.intrinsicCross(scope, measurables, height, true)
+}
+
+func (p *flowMeasurePolicy) MinIntrinsicHeight(scope uilayout.IntrinsicMeasureScope, measurables []uilayout.IntrinsicMeasurable, width int) int {
+	if !p.horizontal {
+		return p.minIntrinsicMain(measurables, width)
+	}
+	return p.intrinsicCross(scope, measurables, width, false)
+}
+
+func (p *flowMeasurePolicy) MaxIntrinsicHeight(scope uilayout.IntrinsicMeasureScope, measurables []uilayout.IntrinsicMeasurable, width int) int {
+	if !p.horizontal {
+		return p.maxIntrinsicMain(scope, measurables, width)
+	}
+	return p.intrinsicCross(scope, measurables, width, true)
+}
+
+// minIntrinsicMain is the size of the largest item: the layout can take it
+// with one item per line.
+func (p *flowMeasurePolicy) minIntrinsicMain(measurables []uilayout.IntrinsicMeasurable, cross int) int {
+	main := 0
+	for _, m := range intrinsicItems(measurables) {
+		main = max(main, p.minMain(m, cross))
+	}
+	return main
+}
+
+// maxIntrinsicMain is the size of the longest line when lines only break
+// after MaxItemsInEachLine items.
+func (p *flowMeasurePolicy) maxIntrinsicMain(scope uilayout.IntrinsicMeasureScope, measurables []uilayout.IntrinsicMeasurable, cross int) int {
+	spacing := p.mainSpacing(scope)
+	main, line, count := 0, 0, 0
+	for _, m := range intrinsicItems(measurables) {
+		if p.options.MaxItemsInEachLine > 0 && count == p.options.MaxItemsInEachLine {
+			line, count = 0, 0
+		}
+		if count > 0 {
+			line += spacing
+		}
+		line += p.maxMain(m, cross)
+		count++
+		main = max(main, line)
+	}
+	return main
+}
+
+// intrinsicCross is the size of the lines the items break into on a main
+// axis of size main.
+func (p *flowMeasurePolicy) intrinsicCross(scope uilayout.IntrinsicMeasureScope, measurables []uilayout.IntrinsicMeasurable, main int, maximum bool) int {
+	mainSpacing, crossSpacing := p.mainSpacing(scope), p.crossSpacing(scope)
+	cross, lines := 0, 0
+	lineMain, lineCross, count := 0, 0, 0
+	endLine := func() {
+		if lines > 0 {
+			cross += crossSpacing
+		}
+		cross += lineCross
+		lines++
+		lineMain, lineCross, count = 0, 0, 0
+	}
+	for _, m := range intrinsicItems(measurables) {
+		if p.options.MaxLines > 0 && lines == p.options.MaxLines {
+			return cross
+		}
+		itemMain := p.maxMain(m, unit.Infinity)
+		if main != unit.Infinity {
+			itemMain = min(itemMain, main)
+		}
+		full := p.options.MaxItemsInEachLine > 0 && count == p.options.MaxItemsInEachLine
+		if count > 0 && (full || main != unit.Infinity && lineMain+mainSpacing+itemMain > main) {
+			endLine()
+			if p.options.MaxLines > 0 && lines == p.options.MaxLines {
+				return cross
+			}
+		}
+		if count > 0 {
+			lineMain += mainSpacing
+		}
+		lineMain += itemMain
+		lineCross = max(lineCross, p.crossOfMain(m, itemMain, maximum))
+		count++
+	}
+	if count > 0 {
+		endLine()
+	}
+	return cross
+}
+
+// intrinsicItems returns measurables without the overflow indicator.
+func intrinsicItems(measurables []uilayout.IntrinsicMeasurable) []uilayout.IntrinsicMeasurable {
+	items := make([]uilayout.IntrinsicMeasurable, 0, len(measurables))
+	for _, m := range measurables {
+		if m, ok := m.(uilayout.Measurable); ok && m.LayoutId() == (overflowIndicatorId{}) {
+			continue
+		}
+		items = append(items, m)
+	}
+	return items
+}
+
+func (p *flowMeasurePolicy) minMain(m uilayout.IntrinsicMeasurable, cross int) int {
+	if p.horizontal {
+		return m.MinIntrinsicWidth(cross)
+	}
+	return m.MinIntrinsicHeight(cross)
+}
+
+func (p *flowMeasurePolicy) maxMain(m uilayout.IntrinsicMeasurable, cross int) int {
+	if p.horizontal {
+		return m.MaxIntrinsicWidth(cross)
+	}
+	return m.MaxIntrinsicHeight(cross)
+}
+
+func (p *flowMeasurePolicy) crossOfMain(m uilayout.IntrinsicMeasurable, main int, maximum bool) int {
+	switch {
+	case p.horizontal && maximum:
+		return m.MaxIntrinsicHeight(main)
+	case p.horizontal:
+		return m.MinIntrinsicHeight(main)
+	case maximum:
+		return m.MaxIntrinsicWidth(main)
+	default:
+		return m.MinIntrinsicWidth(main)
+	}
+}
+
+func (p *flowMeasurePolicy) mainSpacing(density unit.Density) int {
+	if p.horizontal {
+		return density.DpRoundToPx(p.options.HorizontalArrangement.Spacing())
+	}
+	return density.DpRoundToPx(p.options.VerticalArrangement.Spacing())
+}
+
+func (p *flowMeasurePolicy) crossSpacing(density unit.Density) int {
+	if p.horizontal {
+		return density.DpRoundToPx(p.options.VerticalArrangement.Spacing())
+	}
+	return density.DpRoundToPx(p.options.HorizontalArrangement.Spacing())
+}
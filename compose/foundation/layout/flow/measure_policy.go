@@ -0,0 +1,296 @@
+package flow
+
+import (
+	"math"
+
+	uilayout "github.com/zodimo/go-compose/compose/ui/layout"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+	"github.com/zodimo/go-compose/modifiers/weight"
+	"github.com/zodimo/go-compose/state"
+)
+
+// flowMeasurePolicy lays out the items of FlowRow, when horizontal is set,
+// and of FlowColumn. Sizes and positions are computed on the main axis, the
+// one of the lines, and the cross axis, the one the lines stack on.
+type flowMeasurePolicy struct {
+	horizontal    bool
+	options       FlowOptions
+	overflowState state.MutableValue
+}
+
+type flowItem struct {
+	measurable uilayout.Measurable
+	placeable  *uilayout.Placeable
+	// weight is 0 for items without a weight, and on unbounded lines, where
+	// there is no space left to fill.
+	weight float32
+	// main is the size of the item on the main axis, the minimum intrinsic
+	// one for weighted items until they are measured.
+	main int
+}
+
+type flowLine struct {
+	items []*flowItem
+	cross int
+}
+
+func (p *flowMeasurePolicy) Measure(scope uilayout.MeasureScope, measurables []uilayout.Measurable, constraints unit.Constraints) uilayout.MeasureResult {
+	opts := p.options
+	mainMin, mainMax := constraints.MinWidth(), constraints.MaxWidth()
+	crossMin, crossMax := constraints.MinHeight(), constraints.MaxHeight()
+	mainSpacing, crossSpacing := p.mainSpacing(scope), p.crossSpacing(scope)
+	rtl := scope.LayoutDirection() == unit.LayoutDirectionRtl
+	// The horizontal positions are absolute, mirrored in right to left
+	// layouts by the arrangement.
+	arrangeHorizontally := func(totalSize int, sizes []int) []int {
+		positions := make([]int, len(sizes))
+		opts.HorizontalArrangement.ArrangeHorizontally(scope, totalSize, sizes, scope.LayoutDirection(), positions)
+		return positions
+	}
+	arrangeVertically := func(totalSize int, sizes []int) []int {
+		positions := make([]int, len(sizes))
+		opts.VerticalArrangement.ArrangeVertically(scope, totalSize, sizes, positions)
+		return positions
+	}
+	arrangeMain, arrangeCross := arrangeHorizontally, arrangeVertically
+	if !p.horizontal {
+		mainMin, mainMax, crossMin, crossMax = crossMin, crossMax, mainMin, mainMax
+		arrangeMain, arrangeCross = arrangeVertically, arrangeHorizontally
+	}
+	mainBounded := mainMax != unit.Infinity
+
+	var indicator uilayout.Measurable
+	items := make([]*flowItem, 0, len(measurables))
+	for _, m := range measurables {
+		if m.LayoutId() == (overflowIndicatorId{}) {
+			indicator = m
+			continue
+		}
+		item := &flowItem{measurable: m}
+		if element, ok := m.ParentData(weight.WeightElementKey); ok && mainBounded {
+			item.weight = element.(weight.WeightElement).WeightData().Weight
+		}
+		if item.weight > 0 {
+			item.main = p.intrinsicMain(m, crossMax)
+		} else {
+			item.placeable = m.Measure(p.constraints(0, mainMax, 0, crossMax))
+			item.main = p.mainOf(item.placeable)
+		}
+		items = append(items, item)
+	}
+
+	// Break the items into lines.
+	var lines []*flowLine
+	line, lineMain := &flowLine{}, 0
+	for _, item := range items {
+		full := opts.MaxItemsInEachLine > 0 && len(line.items) == opts.MaxItemsInEachLine
+		if len(line.items) > 0 && (full || mainBounded && lineMain+mainSpacing+item.main > mainMax) {
+			lines = append(lines, line)
+			line, lineMain = &flowLine{}, 0
+		}
+		if len(line.items) > 0 {
+			lineMain += mainSpacing
+		}
+		lineMain += item.main
+		line.items = append(line.items, item)
+	}
+	if len(line.items) > 0 {
+		lines = append(lines, line)
+	}
+
+	// Leave out the lines beyond MaxLines, and the ones that do not fit.
+	if opts.MaxLines > 0 && len(lines) > opts.MaxLines {
+		lines = lines[:opts.MaxLines]
+	}
+	if !opts.Overflow.visible && crossMax != unit.Infinity {
+		cross := 0
+		for i, line := range lines {
+			if i > 0 {
+				cross += crossSpacing
+			}
+			cross += p.estimateCross(line, mainMax, mainSpacing, crossMax)
+			if cross > crossMax {
+				lines = lines[:i]
+				break
+			}
+		}
+	}
+	shown := 0
+	for _, line := range lines {
+		shown += len(line.items)
+	}
+
+	// Make room for the overflow indicator after the last item shown.
+	var indicatorItem *flowItem
+	if indicator != nil && shown < len(items) {
+		indicatorItem = &flowItem{measurable: indicator}
+		indicatorItem.placeable = indicator.Measure(p.constraints(0, mainMax, 0, crossMax))
+		indicatorItem.main = p.mainOf(indicatorItem.placeable)
+		if len(lines) == 0 {
+			lines = append(lines, &flowLine{})
+		}
+		last := lines[len(lines)-1]
+		for len(last.items) > 0 && mainBounded && mainOfLine(last.items, mainSpacing)+mainSpacing+indicatorItem.main > mainMax {
+			last.items = last.items[:len(last.items)-1]
+			shown--
+		}
+		last.items = append(last.items, indicatorItem)
+	}
+	if indicator != nil {
+		p.overflowState.Set(flowOverflowState{total: len(items), shown: shown})
+	}
+
+	// Measure the weighted items with the space left in their line.
+	layoutMain, layoutCross := 0, 0
+	for i, line := range lines {
+		p.measureWeighted(line, mainMax, mainSpacing, crossMax)
+		for _, item := range line.items {
+			line.cross = max(line.cross, p.crossOf(item.placeable))
+		}
+		layoutMain = max(layoutMain, mainOfLine(line.items, mainSpacing))
+		if i > 0 {
+			layoutCross += crossSpacing
+		}
+		layoutCross += line.cross
+	}
+	layoutMain = max(layoutMain, mainMin)
+	layoutCross = max(layoutCross, crossMin)
+
+	width, height := layoutMain, layoutCross
+	if !p.horizontal {
+		width, height = height, width
+	}
+	return scope.Layout(width, height, func() {
+		lineCrosses := make([]int, len(lines))
+		for i, line := range lines {
+			lineCrosses[i] = line.cross
+		}
+		lineOffsets := arrangeCross(layoutCross, lineCrosses)
+		for i, line := range lines {
+			sizes := make([]int, len(line.items))
+			for j, item := range line.items {
+				sizes[j] = item.main
+			}
+			offsets := arrangeMain(layoutMain, sizes)
+			for j, item := range line.items {
+				if p.horizontal {
+					cross := lineOffsets[i] + align(opts.ItemAlignment, line.cross-item.placeable.Height(), false)
+					item.placeable.PlaceAt(offsets[j], cross)
+				} else {
+					cross := lineOffsets[i] + align(opts.ItemAlignment, line.cross-item.placeable.Width(), rtl)
+					item.placeable.PlaceAt(cross, offsets[j])
+				}
+			}
+		}
+	})
+}
+
+// measureWeighted measures the weighted items of line, which share the main
+// axis space the other items leave.
+func (p *flowMeasurePolicy) measureWeighted(line *flowLine, mainMax, mainSpacing, crossMax int) {
+	var totalWeight float32
+	for _, item := range line.items {
+		totalWeight += item.weight
+	}
+	if totalWeight == 0 {
+		return
+	}
+	remaining := float32(max(mainMax-p.fixedMain(line.items, mainSpacing), 0))
+	for _, item := range line.items {
+		if item.weight == 0 {
+			continue
+		}
+		item.main = round(remaining * item.weight / totalWeight)
+		item.placeable = item.measurable.Measure(p.constraints(item.main, item.main, 0, crossMax))
+	}
+}
+
+// estimateCross returns the size of line on the cross axis, with the
+// intrinsic size of the weighted items that are not measured yet.
+func (p *flowMeasurePolicy) estimateCross(line *flowLine, mainMax, mainSpacing, crossMax int) int {
+	var totalWeight float32
+	for _, item := range line.items {
+		totalWeight += item.weight
+	}
+	remaining := float32(max(mainMax-p.fixedMain(line.items, mainSpacing), 0))
+	cross := 0
+	for _, item := range line.items {
+		if item.weight == 0 {
+			cross = max(cross, p.crossOf(item.placeable))
+			continue
+		}
+		main := round(remaining * item.weight / totalWeight)
+		if p.horizontal {
+			cross = max(cross, min(item.measurable.MaxIntrinsicHeight(main), crossMax))
+		} else {
+			cross = max(cross, min(item.measurable.MaxIntrinsicWidth(main), crossMax))
+		}
+	}
+	return cross
+}
+
+// fixedMain returns the main axis space of the items of a line without a
+// weight, with the spacing between all the items.
+func (p *flowMeasurePolicy) fixedMain(items []*flowItem, mainSpacing int) int {
+	main := mainSpacing * max(len(items)-1, 0)
+	for _, item := range items {
+		if item.weight == 0 {
+			main += item.main
+		}
+	}
+	return main
+}
+
+// mainOfLine returns the main axis size of a line of items.
+func mainOfLine(items []*flowItem, mainSpacing int) int {
+	main := mainSpacing * max(len(items)-1, 0)
+	for _, item := range items {
+		main += item.main
+	}
+	return main
+}
+
+func (p *flowMeasurePolicy) intrinsicMain(m uilayout.Measurable, crossMax int) int {
+	if p.horizontal {
+		return m.MinIntrinsicWidth(crossMax)
+	}
+	return m.MinIntrinsicHeight(crossMax)
+}
+
+func (p *flowMeasurePolicy) constraints(mainMin, mainMax, crossMin, crossMax int) unit.Constraints {
+	if p.horizontal {
+		return unit.NewConstraints(mainMin, mainMax, crossMin, crossMax)
+	}
+	return unit.NewConstraints(crossMin, crossMax, mainMin, mainMax)
+}
+
+func (p *flowMeasurePolicy) mainOf(placeable *uilayout.Placeable) int {
+	if p.horizontal {
+		return placeable.Width()
+	}
+	return placeable.Height()
+}
+
+func (p *flowMeasurePolicy) crossOf(placeable *uilayout.Placeable) int {
+	if p.horizontal {
+		return placeable.Height()
+	}
+	return placeable.Width()
+}
+
+// align returns the offset of an item within a line, given the space the
+// item leaves. Start and End are swapped when rtl is set.
+func align(alignment Alignment, free int, rtl bool) int {
+	switch {
+	case alignment == Middle:
+		return free / 2
+	case (alignment == End) != rtl:
+		return free
+	default:
+		return 0
+	}
+}
+
+func round(v float32) int {
+	return int(math.Round(float64(v)))
+}
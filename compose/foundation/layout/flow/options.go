@@ -0,0 +1,96 @@
+package flow
+
+import (
+	"github.com/zodimo/go-compose/compose/foundation/layout/arrangement"
+	"github.com/zodimo/go-compose/compose/ui"
+)
+
+// FlowOptions configure FlowRow and FlowColumn. The arrangements are given
+// for each axis: for FlowRow the horizontal one applies within a line and the
+// vertical one between lines, and the other way around for FlowColumn. The
+// spacing of an arrangement, set with arrangement.SpacedBy, is the gap
+// between items on its axis.
+type FlowOptions struct {
+	Modifier ui.Modifier
+
+	// HorizontalArrangement places the items on the horizontal axis.
+	HorizontalArrangement arrangement.Horizontal
+	// VerticalArrangement places the items on the vertical axis.
+	VerticalArrangement arrangement.Vertical
+
+	// ItemAlignment aligns the items of a line across it: vertically in a
+	// FlowRow, horizontally in a FlowColumn.
+	ItemAlignment Alignment
+
+	// MaxItemsInEachLine is the maximum number of items in a line, 0 for no
+	// limit.
+	MaxItemsInEachLine int
+	// MaxLines is the maximum number of lines, 0 for no limit. The items
+	// beyond are not shown.
+	MaxLines int
+	// Overflow handles the items that do not fit.
+	Overflow FlowOverflow
+}
+
+type FlowOption func(o *FlowOptions)
+
+func DefaultFlowOptions() FlowOptions {
+	return FlowOptions{
+		Modifier:              ui.EmptyModifier,
+		HorizontalArrangement: arrangement.Start,
+		VerticalArrangement:   arrangement.Top,
+		ItemAlignment:         Start,
+		Overflow:              FlowOverflowClip,
+	}
+}
+
+func WithModifier(modifier ui.Modifier) FlowOption {
+	return func(o *FlowOptions) {
+		o.Modifier = o.Modifier.Then(modifier)
+	}
+}
+
+func WithHorizontalArrangement(horizontalArrangement arrangement.Horizontal) FlowOption {
+	return func(o *FlowOptions) {
+		o.HorizontalArrangement = horizontalArrangement
+	}
+}
+
+func WithVerticalArrangement(verticalArrangement arrangement.Vertical) FlowOption {
+	return func(o *FlowOptions) {
+		o.VerticalArrangement = verticalArrangement
+	}
+}
+
+func WithItemAlignment(alignment Alignment) FlowOption {
+	return func(o *FlowOptions) {
+		o.ItemAlignment = alignment
+	}
+}
+
+// WithMaxItemsInEachRow limits the number of items in each row of a FlowRow.
+func WithMaxItemsInEachRow(maxItems int) FlowOption {
+	return func(o *FlowOptions) {
+		o.MaxItemsInEachLine = maxItems
+	}
+}
+
+// WithMaxItemsInEachColumn limits the number of items in each column of a
+// FlowColumn.
+func WithMaxItemsInEachColumn(maxItems int) FlowOption {
+	return func(o *FlowOptions) {
+		o.MaxItemsInEachLine = maxItems
+	}
+}
+
+func WithMaxLines(maxLines int) FlowOption {
+	return func(o *FlowOptions) {
+		o.MaxLines = maxLines
+	}
+}
+
+func WithOverflow(overflow FlowOverflow) FlowOption {
+	return func(o *FlowOptions) {
+		o.Overflow = overflow
+	}
+}
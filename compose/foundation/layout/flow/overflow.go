@@ -0,0 +1,51 @@
+package flow
+
+// FlowOverflow handles the items of a flow layout beyond its MaxLines, or
+// beyond its maximum size on the axis its lines stack on.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/foundation/foundation-layout/src/commonMain/kotlin/androidx/compose/foundation/layout/FlowLayoutOverflow.kt
+type FlowOverflow struct {
+	visible   bool
+	indicator func(scope FlowOverflowScope) Composable
+}
+
+var (
+	// FlowOverflowVisible shows the lines that do not fit in the maximum size
+	// of the layout, past its bounds. MaxLines still applies.
+	FlowOverflowVisible = FlowOverflow{visible: true}
+	// FlowOverflowClip leaves out the lines that do not fit.
+	FlowOverflowClip = FlowOverflow{}
+)
+
+// FlowOverflowExpandIndicator leaves out the lines that do not fit, and
+// shows the content of indicator after the last item shown, such as a
+// "+3 more" button. Items are left out of the last line to make room for it.
+//
+// The counts of the scope are the ones of the last layout: the indicator is
+// composed before the items are laid out, and composed again once the counts
+// change.
+func FlowOverflowExpandIndicator(indicator func(scope FlowOverflowScope) Composable) FlowOverflow {
+	return FlowOverflow{indicator: indicator}
+}
+
+// FlowOverflowScope gives the overflow indicator the number of items shown.
+type FlowOverflowScope interface {
+	// TotalItemCount returns the number of items of the layout.
+	TotalItemCount() int
+	// ShownItemCount returns the number of items shown.
+	ShownItemCount() int
+}
+
+// flowOverflowState is the FlowOverflowScope of the last layout.
+type flowOverflowState struct {
+	total int
+	shown int
+}
+
+func (s flowOverflowState) TotalItemCount() int {
+	return s.total
+}
+
+func (s flowOverflowState) ShownItemCount() int {
+	return s.shown
+}
@@ -0,0 +1,115 @@
+// Package rowcolumn holds the measurements Row and Column share.
+package rowcolumn
+
+import (
+	"math"
+
+	uilayout "github.com/zodimo/go-compose/compose/ui/layout"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+	"github.com/zodimo/go-compose/internal/layoutnode"
+	"github.com/zodimo/go-compose/modifiers/weight"
+
+	"gioui.org/layout"
+)
+
+// Child is a child of a row or column, with its weight, 0 if it has none.
+type Child struct {
+	Layout layout.Widget
+	Weight float32
+}
+
+// Children returns the children of node with their weights.
+func Children(node layoutnode.LayoutNode) []Child {
+	var children []Child
+	for _, child := range node.Children() {
+		coordinator := child.(layoutnode.NodeCoordinator)
+		c := Child{Layout: coordinator.Layout}
+		if element := coordinator.Elements().GetElement(weight.WeightElementKey); element.IsSome() {
+			c.Weight = element.UnwrapUnsafe().(weight.WeightElement).WeightData().Weight
+		}
+		children = append(children, c)
+	}
+	return children
+}
+
+// Intrinsic answers the intrinsic query q of a row, when horizontal is set,
+// or of a column, whose children are spacing pixels apart. Weighted children
+// are measured as if they shared the space left by the others in proportion
+// to their weights.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/foundation/foundation-layout/src/commonMain/kotlin/androidx/compose/foundation/layout/RowColumnMeasurePolicy.kt
+func Intrinsic(gtx layout.Context, q uilayout.IntrinsicQuery, horizontal bool, children []Child, spacing int) layout.Dimensions {
+	totalSpacing := 0
+	if len(children) > 1 {
+		totalSpacing = spacing * (len(children) - 1)
+	}
+	if q.Intrinsic.IsWidth() == horizontal {
+		return q.Dimensions(mainAxisSize(gtx, q, children) + totalSpacing)
+	}
+	return q.Dimensions(crossAxisSize(gtx, q, horizontal, children, totalSpacing))
+}
+
+func mainAxisSize(gtx layout.Context, q uilayout.IntrinsicQuery, children []Child) int {
+	fixedSpace, weightUnitSpace := 0, 0
+	var totalWeight float32
+	for _, child := range children {
+		size := uilayout.MeasureIntrinsic(gtx, child.Layout, q.Intrinsic, q.Size)
+		if child.Weight <= 0 {
+			fixedSpace += size
+			continue
+		}
+		totalWeight += child.Weight
+		weightUnitSpace = max(weightUnitSpace, round(float32(size)/child.Weight))
+	}
+	return round(float32(weightUnitSpace)*totalWeight) + fixedSpace
+}
+
+func crossAxisSize(gtx layout.Context, q uilayout.IntrinsicQuery, horizontal bool, children []Child, totalSpacing int) int {
+	available := q.Size
+	bounded := available != unit.Infinity
+	if bounded {
+		available = max(available-totalSpacing, 0)
+	}
+	mainAxisMax := uilayout.IntrinsicMaxHeight
+	if horizontal {
+		mainAxisMax = uilayout.IntrinsicMaxWidth
+	}
+
+	fixedSpace, crossAxisMax := 0, 0
+	var totalWeight float32
+	for _, child := range children {
+		if child.Weight > 0 {
+			totalWeight += child.Weight
+			continue
+		}
+		size := uilayout.MeasureIntrinsic(gtx, child.Layout, mainAxisMax, unit.Infinity)
+		if bounded {
+			size = min(size, max(available-fixedSpace, 0))
+		}
+		fixedSpace += size
+		crossAxisMax = max(crossAxisMax, uilayout.MeasureIntrinsic(gtx, child.Layout, q.Intrinsic, size))
+	}
+	if totalWeight == 0 {
+		return crossAxisMax
+	}
+
+	weightUnitSpace := float32(unit.Infinity)
+	if bounded {
+		weightUnitSpace = float32(max(available-fixedSpace, 0)) / totalWeight
+	}
+	for _, child := range children {
+		if child.Weight <= 0 {
+			continue
+		}
+		size := unit.Infinity
+		if bounded {
+			size = round(weightUnitSpace * child.Weight)
+		}
+		crossAxisMax = max(crossAxisMax, uilayout.MeasureIntrinsic(gtx, child.Layout, q.Intrinsic, size))
+	}
+	return crossAxisMax
+}
+
+func round(v float32) int {
+	return int(math.Round(float64(v)))
+}
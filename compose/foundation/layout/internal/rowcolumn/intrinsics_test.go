@@ -0,0 +1,95 @@
+package rowcolumn
+
+import (
+	"image"
+	"testing"
+
+	uilayout "github.com/zodimo/go-compose/compose/ui/layout"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+)
+
+// text is laid out like a text of words of 10 by 10 pixels, which wraps
+// between words.
+func text(words int) layout.Widget {
+	return func(gtx layout.Context) layout.Dimensions {
+		if q, ok := uilayout.IntrinsicQueryOf(gtx); ok && q.Intrinsic == uilayout.IntrinsicMinWidth {
+			return q.Dimensions(10)
+		}
+		perLine := max(min(words, gtx.Constraints.Max.X/10), 1)
+		lines := (words + perLine - 1) / perLine
+		return layout.Dimensions{Size: gtx.Constraints.Constrain(image.Pt(perLine*10, lines*10))}
+	}
+}
+
+// fill fills the constraints, like a divider of width 1.
+func fill(gtx layout.Context) layout.Dimensions {
+	size := gtx.Constraints.Max
+	if size.Y >= unit.GioInfinity {
+		size.Y = 0
+	}
+	return layout.Dimensions{Size: image.Pt(1, size.Y)}
+}
+
+func measure(children []Child, horizontal bool, intrinsic uilayout.Intrinsic, size int) int {
+	gtx := layout.Context{Ops: new(op.Ops)}
+	return uilayout.MeasureIntrinsic(gtx, func(gtx layout.Context) layout.Dimensions {
+		q, _ := uilayout.IntrinsicQueryOf(gtx)
+		return Intrinsic(gtx, q, horizontal, children, 0)
+	}, intrinsic, size)
+}
+
+func TestIntrinsic_Row(t *testing.T) {
+	children := []Child{{Layout: text(3)}, {Layout: fill}, {Layout: text(5)}}
+	if got := measure(children, true, uilayout.IntrinsicMaxWidth, unit.Infinity); got != 81 {
+		t.Errorf("IntrinsicMaxWidth = %d, want 81", got)
+	}
+	if got := measure(children, true, uilayout.IntrinsicMinWidth, unit.Infinity); got != 21 {
+		t.Errorf("IntrinsicMinWidth = %d, want 21", got)
+	}
+	// The divider does not add to the height, the texts take their width.
+	if got := measure(children, true, uilayout.IntrinsicMinHeight, unit.Infinity); got != 10 {
+		t.Errorf("IntrinsicMinHeight = %d, want 10", got)
+	}
+	// The last text is left 20 pixels, on 3 lines.
+	if got := measure(children, true, uilayout.IntrinsicMinHeight, 51); got != 30 {
+		t.Errorf("IntrinsicMinHeight(51) = %d, want 30", got)
+	}
+}
+
+func TestIntrinsic_Weights(t *testing.T) {
+	children := []Child{{Layout: text(2), Weight: 1}, {Layout: text(6), Weight: 2}}
+	// The second child needs 30 pixels per unit of weight.
+	if got := measure(children, true, uilayout.IntrinsicMaxWidth, unit.Infinity); got != 90 {
+		t.Errorf("IntrinsicMaxWidth = %d, want 90", got)
+	}
+	// The children share 60 pixels: 20 for 2 lines, and 40 for 2 lines.
+	if got := measure(children, true, uilayout.IntrinsicMaxHeight, 60); got != 20 {
+		t.Errorf("IntrinsicMaxHeight(60) = %d, want 20", got)
+	}
+	// In a column 20 pixels wide, the second child needs 15 pixels per unit
+	// of weight for its 3 lines.
+	if got := measure(children, false, uilayout.IntrinsicMaxHeight, 20); got != 45 {
+		t.Errorf("column IntrinsicMaxHeight(20) = %d, want 45", got)
+	}
+}
+
+func TestIntrinsic_Spacing(t *testing.T) {
+	children := []Child{{Layout: text(3)}, {Layout: text(5)}}
+	measure := func(intrinsic uilayout.Intrinsic, size int) int {
+		gtx := layout.Context{Ops: new(op.Ops)}
+		return uilayout.MeasureIntrinsic(gtx, func(gtx layout.Context) layout.Dimensions {
+			q, _ := uilayout.IntrinsicQueryOf(gtx)
+			return Intrinsic(gtx, q, true, children, 4)
+		}, intrinsic, size)
+	}
+	if got := measure(uilayout.IntrinsicMaxWidth, unit.Infinity); got != 84 {
+		t.Errorf("IntrinsicMaxWidth = %d, want 84", got)
+	}
+	// The spacing leaves the last text 20 pixels, on 3 lines.
+	if got := measure(uilayout.IntrinsicMinHeight, 54); got != 30 {
+		t.Errorf("IntrinsicMinHeight(54) = %d, want 30", got)
+	}
+}
@@ -0,0 +1,115 @@
+package rowcolumn
+
+import (
+	"image"
+
+	"github.com/zodimo/go-compose/compose/ui/unit"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+)
+
+// Arrange sets outPositions to the positions of the children of sizes along
+// the main axis, in a layout of totalSize.
+type Arrange func(totalSize int, sizes []int, outPositions []int)
+
+// Layout lays out the children of a row, when horizontal is set, or of a
+// column, spacing pixels apart at least, placed by arrange. Children without
+// weight are measured first, with the space left by the previous ones;
+// weighted children share what remains in proportion to their weights.
+// alignment places the children on the cross axis, where Start and End
+// follow layoutDirection in columns.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/foundation/foundation-layout/src/commonMain/kotlin/androidx/compose/foundation/layout/RowColumnMeasurePolicy.kt
+func Layout(gtx layout.Context, horizontal bool, children []Child, spacing int, arrange Arrange, alignment layout.Alignment, layoutDirection unit.LayoutDirection) layout.Dimensions {
+	axis := layout.Vertical
+	if horizontal {
+		axis = layout.Horizontal
+	}
+	cs := gtx.Constraints
+	mainMin, mainMax := axis.Convert(cs.Min).X, axis.Convert(cs.Max).X
+	crossMin, crossMax := axis.Convert(cs.Min).Y, axis.Convert(cs.Max).Y
+
+	n := len(children)
+	calls := make([]op.CallOp, n)
+	dims := make([]layout.Dimensions, n)
+	measure := func(i int, mainMin, mainMax, crossMin int) int {
+		cgtx := gtx
+		cgtx.Constraints = layout.Constraints{
+			Min: axis.Convert(image.Pt(mainMin, crossMin)),
+			Max: axis.Convert(image.Pt(mainMax, crossMax)),
+		}
+		macro := op.Record(gtx.Ops)
+		dims[i] = children[i].Layout(cgtx)
+		calls[i] = macro.Stop()
+		return axis.Convert(dims[i].Size).X
+	}
+
+	totalSpacing := 0
+	if n > 1 {
+		totalSpacing = spacing * (n - 1)
+	}
+	remaining := max(mainMax-totalSpacing, 0)
+	size := 0
+	var totalWeight float32
+	for i, child := range children {
+		if child.Weight > 0 {
+			totalWeight += child.Weight
+			continue
+		}
+		// Compose behavior: the cross axis minimum of children is 0.
+		sz := measure(i, 0, remaining, 0)
+		size += sz
+		remaining = max(remaining-sz, 0)
+	}
+	// fraction is the rounding error carried from one weighted child to
+	// the next.
+	var fraction float32
+	weightedTotal := remaining
+	for i, child := range children {
+		if child.Weight <= 0 {
+			continue
+		}
+		weightedSize := 0
+		if remaining > 0 && totalWeight > 0 {
+			childSize := float32(weightedTotal) * child.Weight / totalWeight
+			weightedSize = min(int(childSize+fraction+.5), remaining)
+			fraction = childSize - float32(weightedSize)
+		}
+		sz := measure(i, weightedSize, weightedSize, crossMin)
+		size += sz
+		remaining = max(remaining-sz, 0)
+	}
+
+	crossSize, maxBaseline := crossMin, 0
+	sizes := make([]int, n)
+	for i, d := range dims {
+		sizes[i] = axis.Convert(d.Size).X
+		crossSize = max(crossSize, axis.Convert(d.Size).Y)
+		maxBaseline = max(maxBaseline, d.Size.Y-d.Baseline)
+	}
+	mainSize := min(max(size+totalSpacing, mainMin), mainMax)
+	crossSize = min(crossSize, crossMax)
+
+	positions := make([]int, n)
+	arrange(mainSize, sizes, positions)
+	rtl := !horizontal && layoutDirection == unit.LayoutDirectionRtl
+	for i, d := range dims {
+		free := crossSize - axis.Convert(d.Size).Y
+		cross := 0
+		switch {
+		case alignment == layout.Middle:
+			cross = free / 2
+		case alignment == layout.Baseline && horizontal:
+			cross = maxBaseline - (d.Size.Y - d.Baseline)
+		case (alignment == layout.End) != rtl:
+			cross = free
+		}
+		stack := op.Offset(axis.Convert(image.Pt(positions[i], cross))).Push(gtx.Ops)
+		calls[i].Add(gtx.Ops)
+		stack.Pop()
+	}
+
+	sz := axis.Convert(image.Pt(mainSize, crossSize))
+	return layout.Dimensions{Size: sz, Baseline: sz.Y - maxBaseline}
+}
@@ -0,0 +1,77 @@
+package rowcolumn
+
+import (
+	"image"
+	"testing"
+
+	"github.com/zodimo/go-compose/compose/ui/unit"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+)
+
+// box is laid out at size, within its constraints.
+func box(w, h int) layout.Widget {
+	return func(gtx layout.Context) layout.Dimensions {
+		return layout.Dimensions{Size: gtx.Constraints.Constrain(image.Pt(w, h))}
+	}
+}
+
+// recording returns children recording in sizes the sizes they are laid out
+// at.
+func recording(children []Child, sizes []image.Point) []Child {
+	out := make([]Child, len(children))
+	for i, child := range children {
+		out[i] = Child{Weight: child.Weight, Layout: func(gtx layout.Context) layout.Dimensions {
+			dims := child.Layout(gtx)
+			sizes[i] = dims.Size
+			return dims
+		}}
+	}
+	return out
+}
+
+func TestLayout_Weights(t *testing.T) {
+	gtx := layout.Context{
+		Ops:         new(op.Ops),
+		Constraints: layout.Exact(image.Pt(100, 50)),
+	}
+	sizes := make([]image.Point, 3)
+	children := recording([]Child{{Layout: box(20, 10)}, {Layout: box(0, 0), Weight: 1}, {Layout: box(0, 0), Weight: 2}}, sizes)
+	var positions []int
+	dims := Layout(gtx, true, children, 5, func(totalSize int, sizes, outPositions []int) {
+		for i := range sizes {
+			if i > 0 {
+				outPositions[i] = outPositions[i-1] + sizes[i-1] + 5
+			}
+		}
+		positions = append(positions, outPositions...)
+	}, layout.Start, unit.LayoutDirectionLtr)
+
+	if dims.Size != image.Pt(100, 50) {
+		t.Errorf("Size = %v, want (100,50)", dims.Size)
+	}
+	// The weighted children share the 70 pixels left by the first child and
+	// the spacing.
+	want := []image.Point{{20, 10}, {23, 50}, {47, 50}}
+	for i := range want {
+		if sizes[i] != want[i] {
+			t.Errorf("child %d size = %v, want %v", i, sizes[i], want[i])
+		}
+	}
+	if got, want := positions, []int{0, 25, 53}; len(got) != 3 || got[0] != want[0] || got[1] != want[1] || got[2] != want[2] {
+		t.Errorf("positions = %v, want %v", got, want)
+	}
+}
+
+func TestLayout_Wrap(t *testing.T) {
+	gtx := layout.Context{
+		Ops:         new(op.Ops),
+		Constraints: layout.Constraints{Max: image.Pt(100, 100)},
+	}
+	children := []Child{{Layout: box(10, 20)}, {Layout: box(30, 5)}}
+	dims := Layout(gtx, false, children, 8, func(int, []int, []int) {}, layout.End, unit.LayoutDirectionRtl)
+	if dims.Size != image.Pt(30, 33) {
+		t.Errorf("Size = %v, want (30,33)", dims.Size)
+	}
+}
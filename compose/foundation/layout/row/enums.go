@@ -1,6 +1,10 @@
 package row
 
-import "gioui.org/layout"
+import (
+	"github.com/zodimo/go-compose/compose/foundation/layout/arrangement"
+
+	"gioui.org/layout"
+)
 
 type Spacing = layout.Spacing
 type Alignment = layout.Alignment
@@ -29,3 +33,20 @@ const (
 	Middle
 	Baseline
 )
+
+// horizontalArrangement returns the arrangement matching spacing.
+func horizontalArrangement(spacing Spacing) arrangement.Horizontal {
+	switch spacing {
+	case SpaceStart:
+		return arrangement.End
+	case SpaceSides:
+		return arrangement.Center
+	case SpaceAround:
+		return arrangement.SpaceAround
+	case SpaceBetween:
+		return arrangement.SpaceBetween
+	case SpaceEvenly:
+		return arrangement.SpaceEvenly
+	}
+	return arrangement.Start
+}
@@ -1,13 +1,15 @@
 package row
 
-import "github.com/zodimo/go-compose/compose/ui"
+import (
+	"github.com/zodimo/go-compose/compose/foundation/layout/arrangement"
+	"github.com/zodimo/go-compose/compose/ui"
+)
 
 type RowOptions struct {
 	Modifier ui.Modifier
 
-	// Spacing controls the distribution of space left after
-	// layout.
-	Spacing Spacing
+	// HorizontalArrangement places the children along the main axis.
+	HorizontalArrangement arrangement.Horizontal
 	// Alignment is the alignment in the cross axis.
 	Alignment Alignment
 }
@@ -20,12 +22,21 @@ func WithModifier(modifier ui.Modifier) RowOption {
 	}
 }
 
-func WithSpacing(spacing Spacing) RowOption {
+// WithHorizontalArrangement places the children with horizontalArrangement,
+// whose Start and End follow the layout direction.
+func WithHorizontalArrangement(horizontalArrangement arrangement.Horizontal) RowOption {
 	return func(o *RowOptions) {
-		o.Spacing = spacing
+		o.HorizontalArrangement = horizontalArrangement
 	}
 }
 
+// WithSpacing places the children with the arrangement matching spacing.
+//
+// Deprecated: use WithHorizontalArrangement.
+func WithSpacing(spacing Spacing) RowOption {
+	return WithHorizontalArrangement(horizontalArrangement(spacing))
+}
+
 func WithAlignment(alignment Alignment) RowOption {
 	return func(o *RowOptions) {
 		o.Alignment = alignment
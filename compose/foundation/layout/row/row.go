@@ -1,18 +1,20 @@
 package row
 
 import (
+	"github.com/zodimo/go-compose/compose/foundation/layout/arrangement"
+	"github.com/zodimo/go-compose/compose/foundation/layout/internal/rowcolumn"
 	"github.com/zodimo/go-compose/compose/ui"
+	uilayout "github.com/zodimo/go-compose/compose/ui/layout"
+	"github.com/zodimo/go-compose/compose/ui/platform"
+	"github.com/zodimo/go-compose/compose/ui/unit"
 	"github.com/zodimo/go-compose/internal/layoutnode"
-	"github.com/zodimo/go-compose/modifiers/weight"
-
-	"gioui.org/layout"
 )
 
 func DefaultRowOptions() RowOptions {
 	return RowOptions{
-		Modifier:  ui.EmptyModifier,
-		Spacing:   SpaceEnd, // 0
-		Alignment: Start,    // 0
+		Modifier:              ui.EmptyModifier,
+		HorizontalArrangement: arrangement.Start,
+		Alignment:             Start, // 0
 	}
 }
 
@@ -30,43 +32,24 @@ func Row(content Composable, options ...RowOption) Composable {
 			return modifier.Then(opts.Modifier)
 		})
 		c.WithComposable(content)
-		c.SetWidgetConstructor(rowWidgetConstructor(opts))
+		c.SetWidgetConstructor(rowWidgetConstructor(opts, platform.LocalLayoutDirection.Current(c)))
 
 		return c.EndBlock()
 	}
 }
 
-func rowWidgetConstructor(options RowOptions) layoutnode.LayoutNodeWidgetConstructor {
+func rowWidgetConstructor(options RowOptions, layoutDirection unit.LayoutDirection) layoutnode.LayoutNodeWidgetConstructor {
 	return layoutnode.NewLayoutNodeWidgetConstructor(func(node layoutnode.LayoutNode) layoutnode.GioLayoutWidget {
 		return func(gtx layoutnode.LayoutContext) layoutnode.LayoutDimensions {
-			flexedChildren := []layout.FlexChild{}
-			for _, child := range node.Children() {
-
-				childLayoutNode := child.(layoutnode.NodeCoordinator)
-
-				elementStore := childLayoutNode.Elements()
-
-				maybeWeightElement := elementStore.GetElement(weight.WeightElementKey)
-				if maybeWeightElement.IsNone() {
-					flexedChildren = append(flexedChildren, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-						// Compose behavior: Cross axis constraints Min is 0
-						gtx.Constraints.Min.Y = 0
-						return childLayoutNode.Layout(gtx)
-					}))
-				} else {
-					weightElement := maybeWeightElement.UnwrapUnsafe().(weight.WeightElement)
-					flexedChildren = append(flexedChildren, layout.Flexed(weightElement.WeightData().Weight, func(gtx layout.Context) layout.Dimensions {
-						return childLayoutNode.Layout(gtx)
-					}))
-				}
+			density := unit.DensityFromMetric(gtx.Metric)
+			spacing := density.DpRoundToPx(options.HorizontalArrangement.Spacing())
+			if q, ok := uilayout.IntrinsicQueryOf(gtx); ok {
+				return rowcolumn.Intrinsic(gtx, q, true, rowcolumn.Children(node), spacing)
 			}
-
-			return layout.Flex{
-				Axis:      layout.Horizontal,
-				Spacing:   options.Spacing,
-				Alignment: options.Alignment,
-			}.Layout(gtx, flexedChildren...)
+			arrange := func(totalSize int, sizes []int, outPositions []int) {
+				options.HorizontalArrangement.ArrangeHorizontally(density, totalSize, sizes, layoutDirection, outPositions)
+			}
+			return rowcolumn.Layout(gtx, true, rowcolumn.Children(node), spacing, arrange, options.Alignment, layoutDirection)
 		}
 	})
-
 }
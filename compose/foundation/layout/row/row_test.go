@@ -0,0 +1,81 @@
+package row
+
+import (
+	"image"
+	"testing"
+
+	"github.com/zodimo/go-compose/compose"
+	"github.com/zodimo/go-compose/compose/foundation/layout/arrangement"
+	"github.com/zodimo/go-compose/compose/foundation/layout/box"
+	uilayout "github.com/zodimo/go-compose/compose/ui/layout"
+	"github.com/zodimo/go-compose/compose/ui/platform"
+	composeunit "github.com/zodimo/go-compose/compose/ui/unit"
+	"github.com/zodimo/go-compose/modifiers/size"
+	"github.com/zodimo/go-compose/runtime"
+	"github.com/zodimo/go-compose/state"
+	"github.com/zodimo/go-compose/store"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/unit"
+)
+
+// layoutRow lays out a row of three boxes of 10 by 10 pixels, in a window
+// 100 pixels wide, and returns the x of each box.
+func layoutRow(layoutDirection composeunit.LayoutDirection, options ...RowOption) []int {
+	xs := make([]int, 3)
+	item := func(i int) Composable {
+		return box.Box(func(c Composer) Composer { return c }, box.WithModifier(size.Size(10, 10).Then(
+			uilayout.OnGloballyPositioned(func(coordinates uilayout.LayoutCoordinates) {
+				xs[i] = int(coordinates.BoundsInWindow().Left)
+			}),
+		)))
+	}
+	content := compose.CompositionLocalProvider1(platform.LocalLayoutDirection, layoutDirection,
+		Row(compose.Sequence(item(0), item(1), item(2)), append(options, WithModifier(size.FillMaxWidth()))...),
+	)
+	c := compose.NewComposer(store.NewPersistentState(map[string]state.MutableValue{}))
+	node := content(c).Build()
+	gtx := layout.Context{
+		Ops:         new(op.Ops),
+		Constraints: layout.Constraints{Max: image.Pt(100, 100)},
+		Metric:      unit.Metric{PxPerDp: 1, PxPerSp: 1},
+	}
+	runtime.NewRuntime().Run(gtx, node).Add(gtx.Ops)
+	return xs
+}
+
+func TestRow_HorizontalArrangement(t *testing.T) {
+	tests := []struct {
+		name            string
+		arrangement     arrangement.Horizontal
+		layoutDirection composeunit.LayoutDirection
+		want            []int
+	}{
+		{"Start", arrangement.Start, composeunit.LayoutDirectionLtr, []int{0, 10, 20}},
+		{"Start RTL", arrangement.Start, composeunit.LayoutDirectionRtl, []int{90, 80, 70}},
+		{"AbsoluteLeft RTL", arrangement.AbsoluteLeft, composeunit.LayoutDirectionRtl, []int{0, 10, 20}},
+		{"SpaceBetween", arrangement.SpaceBetween, composeunit.LayoutDirectionLtr, []int{0, 45, 90}},
+		{"SpacedBy", arrangement.SpacedBy(5, arrangement.AlignmentStart), composeunit.LayoutDirectionLtr, []int{0, 15, 30}},
+		{"SpacedBy RTL", arrangement.SpacedBy(5, arrangement.AlignmentStart), composeunit.LayoutDirectionRtl, []int{90, 75, 60}},
+		{"SpacedBy Center", arrangement.SpacedBy(5, arrangement.AlignmentCenter), composeunit.LayoutDirectionLtr, []int{30, 45, 60}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := layoutRow(tt.layoutDirection, WithHorizontalArrangement(tt.arrangement))
+			for i := range tt.want {
+				if got[i] != tt.want[i] {
+					t.Errorf("x = %v, want %v", got, tt.want)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestRow_WithSpacing(t *testing.T) {
+	got := layoutRow(composeunit.LayoutDirectionLtr, WithSpacing(SpaceStart))
+	if want := []int{70, 80, 90}; got[0] != want[0] || got[1] != want[1] || got[2] != want[2] {
+		t.Errorf("x = %v, want %v", got, want)
+	}
+}
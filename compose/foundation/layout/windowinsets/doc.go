@@ -0,0 +1,25 @@
+/*
+Package windowinsets keeps content clear of the system UI drawn over the
+window: status and navigation bars, display cutouts and the soft keyboard.
+
+Apps lay out each frame edge to edge and provide its insets, once the theme
+is set up. app.NewContext already insets the frame by e.Insets, so they are
+taken out of the event first; otherwise the content is inset twice:
+
+	case app.FrameEvent:
+		insets := windowinsets.FrameInsets(e.Insets)
+		e.Insets = app.Insets{}
+		gtx := app.NewContext(&ops, e)
+		gtx = themeManager.Material3ThemeInit(gtx)
+		gtx = windowinsets.ProvideFrame(gtx, insets)
+
+and elements are padded by them with WindowInsetsPadding and its shorthands:
+
+	column.Column(content, column.WithModifier(windowinsets.SafeDrawingPadding()))
+
+The insets an element is padded by are consumed for its content, so nested
+paddings pad them once.
+
+Reference: https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/foundation/foundation-layout/src/commonMain/kotlin/androidx/compose/foundation/layout/WindowInsets.kt
+*/
+package windowinsets
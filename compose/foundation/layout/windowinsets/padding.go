@@ -0,0 +1,128 @@
+package windowinsets
+
+import (
+	"github.com/zodimo/go-compose/compose/ui"
+	node "github.com/zodimo/go-compose/internal/Node"
+	"github.com/zodimo/go-compose/internal/layoutnode"
+	"github.com/zodimo/go-compose/internal/layoutvalues"
+	"github.com/zodimo/go-compose/internal/modifier"
+
+	"gioui.org/layout"
+	gioUnit "gioui.org/unit"
+)
+
+// WindowInsetsPadding pads the element by insets, less the insets its
+// ancestors consumed, and consumes insets for its content, so that nested
+// paddings do not pad the same insets twice.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/foundation/foundation-layout/src/commonMain/kotlin/androidx/compose/foundation/layout/WindowInsetsPadding.kt
+func WindowInsetsPadding(insets WindowInsets) ui.Modifier {
+	return modifier.NewInspectableModifier(
+		modifier.NewModifier(&InsetsElement{insets: insets, padding: true}),
+		modifier.NewInspectorInfo("windowInsetsPadding", map[string]any{
+			"insets": insets,
+		}),
+	)
+}
+
+// ConsumeWindowInsets consumes insets for the content of the element,
+// without padding it: the WindowInsetsPadding modifiers of the content
+// leave them out.
+func ConsumeWindowInsets(insets WindowInsets) ui.Modifier {
+	return modifier.NewInspectableModifier(
+		modifier.NewModifier(&InsetsElement{insets: insets}),
+		modifier.NewInspectorInfo("consumeWindowInsets", map[string]any{
+			"insets": insets,
+		}),
+	)
+}
+
+// SafeDrawingPadding pads the element by the SafeDrawing insets.
+func SafeDrawingPadding() ui.Modifier {
+	return WindowInsetsPadding(SafeDrawing)
+}
+
+// SystemBarsPadding pads the element by the SystemBars insets.
+func SystemBarsPadding() ui.Modifier {
+	return WindowInsetsPadding(SystemBars)
+}
+
+// StatusBarsPadding pads the element by the StatusBars insets.
+func StatusBarsPadding() ui.Modifier {
+	return WindowInsetsPadding(StatusBars)
+}
+
+// NavigationBarsPadding pads the element by the NavigationBars insets.
+func NavigationBarsPadding() ui.Modifier {
+	return WindowInsetsPadding(NavigationBars)
+}
+
+// ImePadding pads the element by the Ime insets, keeping it above the soft
+// keyboard.
+func ImePadding() ui.Modifier {
+	return WindowInsetsPadding(Ime)
+}
+
+type InsetsElement struct {
+	insets WindowInsets
+	// padding is set when the element is padded by the insets it consumes.
+	padding bool
+}
+
+func (e *InsetsElement) Create() node.Node {
+	return NewInsetsNode(e.insets, e.padding)
+}
+
+func (e *InsetsElement) Update(n node.Node) {
+	in := n.(*InsetsNode)
+	in.insets = e.insets
+	in.padding = e.padding
+}
+
+// Equals is always false, as insets may not be comparable.
+func (e *InsetsElement) Equals(other modifier.Element) bool {
+	return false
+}
+
+type InsetsNode struct {
+	node.ChainNode
+	insets  WindowInsets
+	padding bool
+}
+
+func NewInsetsNode(insets WindowInsets, padding bool) *InsetsNode {
+	n := &InsetsNode{
+		insets:  insets,
+		padding: padding,
+	}
+	n.ChainNode = node.NewChainNode(
+		node.NewNodeID(),
+		node.NodeKindLayout,
+		node.LayoutPhase,
+		func(t node.TreeNode) {
+			no := t.(layoutnode.LayoutModifierNode)
+			no.AttachLayoutModifier(func(widget layoutnode.LayoutWidget) layoutnode.LayoutWidget {
+				return layoutnode.NewLayoutWidget(func(gtx layoutnode.LayoutContext) layoutnode.LayoutDimensions {
+					return n.layout(gtx, widget.Layout)
+				})
+			})
+		},
+	)
+	return n
+}
+
+func (n *InsetsNode) layout(gtx layout.Context, widget layout.Widget) layout.Dimensions {
+	consumed := consumedOf(gtx)
+	insets := n.insets.Insets(gtx)
+	gtx.Values = layoutvalues.With(gtx.Values, consumedValueKey, consumed.Union(insets))
+	if !n.padding {
+		return widget(gtx)
+	}
+	pad := insets.Exclude(consumed)
+	return layout.Inset{
+		Top:    gioUnit.Dp(pad.Top),
+		Bottom: gioUnit.Dp(pad.Bottom),
+		Left:   gioUnit.Dp(pad.Left),
+		Right:  gioUnit.Dp(pad.Right),
+	}.Layout(gtx, widget)
+}
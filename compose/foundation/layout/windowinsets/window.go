@@ -0,0 +1,77 @@
+package windowinsets
+
+import (
+	"github.com/zodimo/go-compose/compose/ui/unit"
+	"github.com/zodimo/go-compose/internal/layoutvalues"
+
+	"gioui.org/layout"
+	gioUnit "gioui.org/unit"
+)
+
+// Keys of the values passed down the layout in layout.Context.Values.
+const (
+	windowValueKey   = "windowinsets/window"
+	consumedValueKey = "windowinsets/consumed"
+)
+
+// Window holds the insets of each type of system UI in a frame.
+type Window struct {
+	StatusBars     Insets
+	NavigationBars Insets
+	Ime            Insets
+	DisplayCutout  Insets
+}
+
+// FrameInsets are the insets of a Gio frame. app.FrameEvent.Insets converts
+// to them; the event's insets are cleared before app.NewContext, which would
+// otherwise inset the frame by them too:
+//
+//	insets := windowinsets.FrameInsets(e.Insets)
+//	e.Insets = app.Insets{}
+//	gtx := app.NewContext(&ops, e)
+//	...
+//	gtx = windowinsets.ProvideFrame(gtx, insets)
+type FrameInsets struct {
+	Top, Bottom, Left, Right gioUnit.Dp
+}
+
+// FromFrame returns the Window of the insets of a Gio frame. Gio reports
+// the system UI of each side as a whole: the top is taken for the status
+// bar, the other sides for the navigation bar. The soft keyboard shows up
+// at the bottom, with the navigation bar, and display cutouts on the sides
+// and top.
+func FromFrame(insets FrameInsets) Window {
+	left, top, right, bottom := unit.Dp(insets.Left), unit.Dp(insets.Top), unit.Dp(insets.Right), unit.Dp(insets.Bottom)
+	return Window{
+		StatusBars:     Insets{Top: top},
+		NavigationBars: Insets{Left: left, Right: right, Bottom: bottom},
+		Ime:            Insets{Bottom: bottom},
+		DisplayCutout:  Insets{Left: left, Top: top, Right: right},
+	}
+}
+
+// Provide returns gtx with the insets of w, for the frame laid out with it.
+// Apps provide them once the theme is set up, before running the frame.
+func Provide(gtx layout.Context, w Window) layout.Context {
+	gtx.Values = layoutvalues.With(gtx.Values, windowValueKey, w, consumedValueKey, Insets{})
+	return gtx
+}
+
+// ProvideFrame provides the insets of a Gio frame, as FromFrame maps them.
+// gtx must span the whole window, from a context made after the insets were
+// cleared from the frame event, or the insets are applied twice.
+func ProvideFrame(gtx layout.Context, insets FrameInsets) layout.Context {
+	return Provide(gtx, FromFrame(insets))
+}
+
+// windowOf returns the Window provided to gtx, without insets if none is.
+func windowOf(gtx layout.Context) Window {
+	w, _ := gtx.Values[windowValueKey].(Window)
+	return w
+}
+
+// consumedOf returns the insets the ancestors of the layout consumed.
+func consumedOf(gtx layout.Context) Insets {
+	consumed, _ := gtx.Values[consumedValueKey].(Insets)
+	return consumed
+}
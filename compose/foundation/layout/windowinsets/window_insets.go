@@ -0,0 +1,162 @@
+package windowinsets
+
+import (
+	"github.com/zodimo/go-compose/compose/ui/unit"
+
+	"gioui.org/layout"
+)
+
+// Insets are the sizes of the sides of the window that content should keep
+// clear of.
+type Insets struct {
+	Left, Top, Right, Bottom unit.Dp
+}
+
+// Union returns the larger of i and other on each side.
+func (i Insets) Union(other Insets) Insets {
+	return Insets{
+		Left:   max(i.Left, other.Left),
+		Top:    max(i.Top, other.Top),
+		Right:  max(i.Right, other.Right),
+		Bottom: max(i.Bottom, other.Bottom),
+	}
+}
+
+// Exclude returns what is left of i on each side once other is removed.
+func (i Insets) Exclude(other Insets) Insets {
+	return Insets{
+		Left:   max(i.Left-other.Left, 0),
+		Top:    max(i.Top-other.Top, 0),
+		Right:  max(i.Right-other.Right, 0),
+		Bottom: max(i.Bottom-other.Bottom, 0),
+	}
+}
+
+// WindowInsets are the parts of the window that system UI, like the status
+// bar or the soft keyboard, draws over.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/foundation/foundation-layout/src/commonMain/kotlin/androidx/compose/foundation/layout/WindowInsets.kt
+type WindowInsets interface {
+	// Insets returns the insets in the frame laid out with gtx.
+	Insets(gtx layout.Context) Insets
+}
+
+// windowInsets are insets of the Window of the frame.
+type windowInsets func(w Window) Insets
+
+func (f windowInsets) Insets(gtx layout.Context) Insets {
+	return f(windowOf(gtx))
+}
+
+var (
+	// StatusBars are the insets of the status bar, at the top of the window.
+	StatusBars WindowInsets = windowInsets(func(w Window) Insets { return w.StatusBars })
+	// NavigationBars are the insets of the navigation bar, at the bottom or
+	// on a side of the window.
+	NavigationBars WindowInsets = windowInsets(func(w Window) Insets { return w.NavigationBars })
+	// Ime are the insets of the soft keyboard.
+	Ime WindowInsets = windowInsets(func(w Window) Insets { return w.Ime })
+	// DisplayCutout are the insets of the cutouts of the display, like the
+	// notch of a camera.
+	DisplayCutout WindowInsets = windowInsets(func(w Window) Insets { return w.DisplayCutout })
+	// SystemBars are the union of StatusBars and NavigationBars.
+	SystemBars WindowInsets = windowInsets(func(w Window) Insets { return w.StatusBars.Union(w.NavigationBars) })
+	// SafeDrawing are the insets content is drawn clear of all system UI in:
+	// the union of SystemBars, Ime and DisplayCutout.
+	SafeDrawing WindowInsets = windowInsets(func(w Window) Insets {
+		return w.StatusBars.Union(w.NavigationBars).Union(w.Ime).Union(w.DisplayCutout)
+	})
+)
+
+// Fixed returns insets of constant sizes.
+func Fixed(left, top, right, bottom unit.Dp) WindowInsets {
+	return fixedInsets(Insets{Left: left, Top: top, Right: right, Bottom: bottom})
+}
+
+type fixedInsets Insets
+
+func (i fixedInsets) Insets(gtx layout.Context) Insets {
+	return Insets(i)
+}
+
+// Union returns the larger of a and b on each side.
+func Union(a, b WindowInsets) WindowInsets {
+	return unionInsets{a, b}
+}
+
+type unionInsets struct {
+	a, b WindowInsets
+}
+
+func (u unionInsets) Insets(gtx layout.Context) Insets {
+	return u.a.Insets(gtx).Union(u.b.Insets(gtx))
+}
+
+// Exclude returns what is left of insets on each side once excluded is
+// removed.
+func Exclude(insets, excluded WindowInsets) WindowInsets {
+	return excludeInsets{insets, excluded}
+}
+
+type excludeInsets struct {
+	insets, excluded WindowInsets
+}
+
+func (e excludeInsets) Insets(gtx layout.Context) Insets {
+	return e.insets.Insets(gtx).Exclude(e.excluded.Insets(gtx))
+}
+
+// Sides selects sides of insets.
+type Sides int
+
+const (
+	SidesLeft Sides = 1 << iota
+	SidesTop
+	SidesRight
+	SidesBottom
+	// SidesStart and SidesEnd are the left and right sides, swapped in
+	// right to left layouts.
+	SidesStart
+	SidesEnd
+
+	SidesHorizontal = SidesLeft | SidesRight
+	SidesVertical   = SidesTop | SidesBottom
+)
+
+// Only returns the sides of insets, the others being 0.
+func Only(insets WindowInsets, sides Sides) WindowInsets {
+	return onlyInsets{insets, sides}
+}
+
+type onlyInsets struct {
+	insets WindowInsets
+	sides  Sides
+}
+
+func (o onlyInsets) Insets(gtx layout.Context) Insets {
+	sides := o.sides
+	start, end := SidesLeft, SidesRight
+	if unit.LayoutDirectionFromTextDirection(gtx.Locale.Direction) == unit.LayoutDirectionRtl {
+		start, end = end, start
+	}
+	if sides&SidesStart != 0 {
+		sides |= start
+	}
+	if sides&SidesEnd != 0 {
+		sides |= end
+	}
+	i := o.insets.Insets(gtx)
+	if sides&SidesLeft == 0 {
+		i.Left = 0
+	}
+	if sides&SidesTop == 0 {
+		i.Top = 0
+	}
+	if sides&SidesRight == 0 {
+		i.Right = 0
+	}
+	if sides&SidesBottom == 0 {
+		i.Bottom = 0
+	}
+	return i
+}
@@ -0,0 +1,112 @@
+package windowinsets
+
+import (
+	"image"
+	"testing"
+
+	"github.com/zodimo/go-compose/compose"
+	"github.com/zodimo/go-compose/compose/foundation/layout/box"
+	"github.com/zodimo/go-compose/compose/ui"
+	uilayout "github.com/zodimo/go-compose/compose/ui/layout"
+	"github.com/zodimo/go-compose/modifiers/size"
+	"github.com/zodimo/go-compose/pkg/api"
+	"github.com/zodimo/go-compose/runtime"
+	"github.com/zodimo/go-compose/state"
+	"github.com/zodimo/go-compose/store"
+
+	"gioui.org/io/system"
+	"gioui.org/layout"
+	"gioui.org/op"
+	gioUnit "gioui.org/unit"
+)
+
+var frame = FrameInsets{Top: 24, Bottom: 48, Left: 8, Right: 0}
+
+func frameContext(direction system.TextDirection) layout.Context {
+	gtx := layout.Context{
+		Ops:         new(op.Ops),
+		Constraints: layout.Exact(image.Pt(200, 400)),
+		Metric:      gioUnit.Metric{PxPerDp: 1, PxPerSp: 1},
+		Locale:      system.Locale{Direction: direction},
+	}
+	return ProvideFrame(gtx, frame)
+}
+
+func TestWindowInsets(t *testing.T) {
+	gtx := frameContext(system.LTR)
+	tests := []struct {
+		name   string
+		insets WindowInsets
+		want   Insets
+	}{
+		{"StatusBars", StatusBars, Insets{Top: 24}},
+		{"NavigationBars", NavigationBars, Insets{Left: 8, Bottom: 48}},
+		{"SystemBars", SystemBars, Insets{Left: 8, Top: 24, Bottom: 48}},
+		{"Only", Only(SystemBars, SidesHorizontal|SidesTop), Insets{Left: 8, Top: 24}},
+		{"Only start", Only(SafeDrawing, SidesStart), Insets{Left: 8}},
+		{"Exclude", Exclude(SystemBars, Fixed(4, 30, 0, 8)), Insets{Left: 4, Bottom: 40}},
+		{"Union", Union(StatusBars, Fixed(0, 10, 0, 10)), Insets{Top: 24, Bottom: 10}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.insets.Insets(gtx); got != tt.want {
+				t.Errorf("Insets = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	// Start is on the right in right to left layouts.
+	if got := Only(SafeDrawing, SidesStart).Insets(frameContext(system.RTL)); got != (Insets{}) {
+		t.Errorf("RTL Only(SidesStart) = %+v, want none", got)
+	}
+	// Without provided insets, there are none.
+	if got := SafeDrawing.Insets(layout.Context{}); got != (Insets{}) {
+		t.Errorf("unprovided SafeDrawing = %+v, want none", got)
+	}
+}
+
+// layoutBounds lays out content in a frame, and returns the bounds of the
+// element of content with the modifier bounds returns.
+func layoutBounds(content func(bounds ui.Modifier) api.Composable) image.Rectangle {
+	var r image.Rectangle
+	bounds := uilayout.OnGloballyPositioned(func(coordinates uilayout.LayoutCoordinates) {
+		b := coordinates.BoundsInWindow()
+		r = image.Rect(int(b.Left), int(b.Top), int(b.Right), int(b.Bottom))
+	})
+	c := compose.NewComposer(store.NewPersistentState(map[string]state.MutableValue{}))
+	node := content(bounds)(c).Build()
+	runtime.NewRuntime().Run(frameContext(system.LTR), node)
+	return r
+}
+
+func empty(c api.Composer) api.Composer { return c }
+
+func TestWindowInsetsPadding(t *testing.T) {
+	got := layoutBounds(func(bounds ui.Modifier) api.Composable {
+		return box.Box(empty, box.WithModifier(SystemBarsPadding().Then(size.FillMax()).Then(bounds)))
+	})
+	if want := image.Rect(8, 24, 200, 352); got != want {
+		t.Errorf("bounds = %v, want %v", got, want)
+	}
+
+	// The insets padded by the outer box are not padded again.
+	got = layoutBounds(func(bounds ui.Modifier) api.Composable {
+		return box.Box(
+			box.Box(empty, box.WithModifier(SafeDrawingPadding().Then(ImePadding()).Then(size.FillMax()).Then(bounds))),
+			box.WithModifier(StatusBarsPadding().Then(size.FillMax())),
+		)
+	})
+	if want := image.Rect(8, 24, 200, 352); got != want {
+		t.Errorf("nested bounds = %v, want %v", got, want)
+	}
+
+	got = layoutBounds(func(bounds ui.Modifier) api.Composable {
+		return box.Box(
+			box.Box(empty, box.WithModifier(SystemBarsPadding().Then(size.FillMax()).Then(bounds))),
+			box.WithModifier(ConsumeWindowInsets(NavigationBars).Then(size.FillMax())),
+		)
+	})
+	if want := image.Rect(0, 24, 200, 400); got != want {
+		t.Errorf("consumed bounds = %v, want %v", got, want)
+	}
+}
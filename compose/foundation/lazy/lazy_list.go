@@ -88,155 +88,116 @@ func lazyListWidgetConstructor(state *LazyListState, axis layout.Axis, stickyInd
 			// Update axis configuration
 			state.List.List.Axis = axis
 
-			// Track item sizes for this frame
-			itemSizes := make(map[int]int)
-
-			dims := state.List.List.Layout(gtx, len(node.Children()), func(gtx C, i int) D {
-				if i < 0 || i >= len(node.Children()) {
-					return D{}
-				}
-				child := node.Children()[i].(layoutnode.NodeCoordinator)
-				d := child.Layout(gtx)
+			// The list scrolls through state.scroll, which dispatches the
+			// scrolling to the nested scroll modifiers around it.
+			source := gtx.Source
+			return state.scroll.layout(gtx, &state.List.List, len(node.Children()), func(listGtx C) D {
+				// Track item sizes for this frame
+				itemSizes := make(map[int]int)
+
+				dims := state.List.List.Layout(listGtx, len(node.Children()), func(gtx C, i int) D {
+					if i < 0 || i >= len(node.Children()) {
+						return D{}
+					}
+					// The list leaves the scrolling to state.scroll, the items
+					// still receive input.
+					gtx.Source = source
+					child := node.Children()[i].(layoutnode.NodeCoordinator)
+					d := child.Layout(gtx)
+
+					// Store size for main axis
+					size := d.Size.Y
+					if axis == layout.Horizontal {
+						size = d.Size.X
+					}
+					itemSizes[i] = size
+					return d
+				})
 
-				// Store size for main axis
-				size := d.Size.Y
-				if axis == layout.Horizontal {
-					size = d.Size.X
+				if len(stickyIndices) > 0 {
+					return layoutStickyHeader(gtx, node, axis, state.List.List.Position, stickyIndices, itemSizes, dims)
 				}
-				itemSizes[i] = size
-				return d
+				return dims
 			})
+		}
+	})
+}
 
-			// Handle Sticky Header
-			if len(stickyIndices) > 0 {
-				first := state.List.List.Position.First
-
-				// Find the active sticky header (last one <= first)
-				stickyIdx := -1
-				for _, idx := range stickyIndices {
-					if idx <= first {
-						stickyIdx = idx
-					} else {
-						break
-					}
-				}
+// layoutStickyHeader lays out the last sticky header at or before the first
+// visible item over the list of dims, pushed back by the next sticky header
+// once they meet. itemSizes are the sizes along axis of the items laid out.
+func layoutStickyHeader(gtx C, node layoutnode.LayoutNode, axis layout.Axis, position layout.Position, stickyIndices []int, itemSizes map[int]int, dims D) D {
+	first := position.First
+
+	// Find the active sticky header (last one <= first)
+	stickyIdx := -1
+	for _, idx := range stickyIndices {
+		if idx <= first {
+			stickyIdx = idx
+		} else {
+			break
+		}
+	}
+	if stickyIdx == -1 {
+		return dims
+	}
 
-				if stickyIdx != -1 {
-					// Check for processing next sticky header to push up
-					headerOffset := 0
-
-					// Find next sticky header index
-					nextStickyIdx := -1
-					for _, idx := range stickyIndices {
-						if idx > first { // simplified: we just need the first one > first?
-							// No, strictly > stickyIdx is enough?
-							// Actually it must be > first to be visible below?
-							// Logic: next sticky header that is "colliding".
-							// It must be visible.
-							nextStickyIdx = idx
-							break
-						}
-					}
-					// Wait, finding nextStickyIdx from the full list is correct.
-					// But we specifically care if it is *visible* and pushing up.
-					// Iterate to find next sticky index > stickyIdx (not just > first).
-					// Actually, if active is 3 (first=5), next sticky could be 7.
-					// If 7 is visible, we calculate its position.
-
-					// Let's refine nextSticky finding:
-					nextStickyIdx = -1
-					for _, idx := range stickyIndices {
-						if idx > stickyIdx {
-							nextStickyIdx = idx
-							break
-						}
-					}
+	headerOffset := 0
+	nextStickyIdx := -1
+	for _, idx := range stickyIndices {
+		if idx > stickyIdx {
+			nextStickyIdx = idx
+			break
+		}
+	}
 
-					// Refined approach:
-					// Always measure active sticky header first.
-					// Reset min constraints to allow header to be smaller than the list height
-					headerGtx := gtx
-					if axis == layout.Vertical {
-						headerGtx.Constraints.Min.Y = 0
-					} else {
-						headerGtx.Constraints.Min.X = 0
-					}
+	// Reset min constraints to allow header to be smaller than the list height
+	headerGtx := gtx
+	if axis == layout.Vertical {
+		headerGtx.Constraints.Min.Y = 0
+	} else {
+		headerGtx.Constraints.Min.X = 0
+	}
 
-					macro := op.Record(gtx.Ops)
-					headerNode := node.Children()[stickyIdx].(layoutnode.NodeCoordinator)
-					headerDims := headerNode.Layout(headerGtx)
-					call := macro.Stop()
+	macro := op.Record(gtx.Ops)
+	headerNode := node.Children()[stickyIdx].(layoutnode.NodeCoordinator)
+	headerDims := headerNode.Layout(headerGtx)
+	call := macro.Stop()
 
-					headerSize := headerDims.Size.Y
-					if axis == layout.Horizontal {
-						headerSize = headerDims.Size.X
-					}
+	headerSize := headerDims.Size.Y
+	if axis == layout.Horizontal {
+		headerSize = headerDims.Size.X
+	}
 
-					if nextStickyIdx != -1 {
-						// Calculate position of nextStickyIdx relative to top
-						// Start from First/Offset
-						pos := state.List.List.Position.Offset
-						found := false
-
-						// We need to traverse from First to nextStickyIdx-1
-						// But we only have sizes for *visible* items.
-						// If nextStickyIdx is NOT visible, we can't calculate its exact position
-						// (and it implies it's far away, so no push needed).
-
-						// So we iterate and check if we have the size.
-						current := first
-						for current < nextStickyIdx {
-							sz, ok := itemSizes[current]
-							if !ok {
-								// Item not visible/laid out, so nextSticky is far down
-								found = false
-								break
-							}
-							pos += sz
-							current++
-							// If pos becomes large, we can stop? No we need exact collision.
-							// But usually viewport is finite.
-						}
-
-						if current == nextStickyIdx {
-							// effectively found, pos is at the start of nextStickyIdx
-							// Now we need the height of the CURRENT sticky header to know if they overlap.
-							// We don't know the height of current sticky header unless we measure it!
-							// Use a macro to measure it?
-							// We will re-layout current sticky header anyway.
-							found = true
-						}
-
-						if found {
-							// Calculate overlap
-							// Position of next sticky is `pos`.
-							// Bottom of current sticky is `headerOffset + headerSize` (where headerOffset is usually 0).
-							// Overlap amount = (headerOffset + headerSize) - pos?
-							// Actually we want `headerOffset` such that `headerOffset + headerSize <= pos`.
-							// So `headerOffset = min(0, pos - headerSize)`.
-
-							if pos < headerSize {
-								headerOffset = pos - headerSize
-							}
-						}
-					}
+	if nextStickyIdx != -1 {
+		// The position of the next sticky header is only known when all the
+		// items before it were laid out; otherwise it is too far to push the
+		// active one.
+		pos := position.Offset
+		current := first
+		for current < nextStickyIdx {
+			sz, ok := itemSizes[current]
+			if !ok {
+				break
+			}
+			pos += sz
+			current++
+		}
 
-					// Draw
-					// apply offset
-					defer clip.Rect{Max: dims.Size}.Push(gtx.Ops).Pop()
+		// The header ends where the next one starts.
+		if current == nextStickyIdx && pos < headerSize {
+			headerOffset = pos - headerSize
+		}
+	}
 
-					pt := image.Pt(0, headerOffset)
-					if axis == layout.Horizontal {
-						pt = image.Pt(headerOffset, 0)
-					}
-					op.Offset(pt).Add(gtx.Ops)
-					call.Add(gtx.Ops)
+	defer clip.Rect{Max: dims.Size}.Push(gtx.Ops).Pop()
 
-					return dims
-				}
-			}
+	pt := image.Pt(0, headerOffset)
+	if axis == layout.Horizontal {
+		pt = image.Pt(headerOffset, 0)
+	}
+	op.Offset(pt).Add(gtx.Ops)
+	call.Add(gtx.Ops)
 
-			return dims
-		}
-	})
+	return dims
 }
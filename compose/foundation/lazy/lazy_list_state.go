@@ -11,6 +11,8 @@ import (
 
 type LazyListState struct {
 	List widget.List
+
+	scroll listScroll
 }
 
 func NewLazyListState() *LazyListState {
@@ -30,3 +32,15 @@ func RememberLazyListState(c compose.Composer) *LazyListState {
 		return NewLazyListState()
 	}).Get().(*LazyListState)
 }
+
+// FirstVisibleItemIndex returns the index of the first item in view, as of
+// the last layout.
+func (s *LazyListState) FirstVisibleItemIndex() int {
+	return s.List.Position.First
+}
+
+// FirstVisibleItemScrollOffset returns how far, in pixels, the first item in
+// view is scrolled out of it, as of the last layout.
+func (s *LazyListState) FirstVisibleItemScrollOffset() int {
+	return s.List.Position.Offset
+}
@@ -0,0 +1,199 @@
+package lazy
+
+import (
+	"time"
+
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+	"github.com/zodimo/go-compose/compose/ui/input/nestedscroll"
+	"github.com/zodimo/go-compose/modifiers/pointer"
+
+	"gioui.org/gesture"
+	gioPointer "gioui.org/io/pointer"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+)
+
+// wheelSettleDelay is how long after the last mouse wheel event a wheel
+// scroll ends, as a drag does when released.
+const wheelSettleDelay = 100 * time.Millisecond
+
+// listScroll scrolls a layout.List in place of the list itself, to dispatch
+// the scrolling to the nested scroll modifiers around it: they are offered
+// each delta before and after the list consumes it, and the velocity of
+// the drag as it ends.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/foundation/foundation/src/commonMain/kotlin/androidx/compose/foundation/gestures/Scrollable.kt
+type listScroll struct {
+	gesture    gesture.Scroll
+	dispatcher nestedscroll.NestedScrollDispatcher
+	state      gesture.ScrollState
+
+	// tracker follows the drag along the list, from dragStart, to compute
+	// its velocity as it ends.
+	tracker   pointer.VelocityTracker
+	dragStart time.Time
+	dragged   float32
+
+	// lastFrame and lastWheel are the times of the last scroll delta and
+	// of the last mouse wheel event of a wheel scroll in progress.
+	lastFrame time.Time
+	lastWheel time.Time
+	wheeling  bool
+}
+
+// layout lays out list, of count items, with content, scrolling it first by
+// the scroll gestures over it. content lays list out with the gtx it is
+// given, which receives no input for the list to leave the scrolling to s:
+// the items must be laid out with the source of gtx.
+func (s *listScroll) layout(gtx layout.Context, list *layout.List, count int, content func(gtx layout.Context) layout.Dimensions) layout.Dimensions {
+	s.dispatcher.Attach(gtx)
+	s.update(gtx, list, count)
+
+	macro := op.Record(gtx.Ops)
+	dims := content(gtx.Disabled())
+	call := macro.Stop()
+
+	defer clip.Rect{Max: dims.Size}.Push(gtx.Ops).Pop()
+	s.gesture.Add(gtx.Ops)
+	call.Add(gtx.Ops)
+	return dims
+}
+
+func (s *listScroll) update(gtx layout.Context, list *layout.List, count int) {
+	backward, forward := listScrollRange(list, count)
+	scrollRange := gioPointer.ScrollRange{Min: -backward, Max: forward}
+	if s.dispatcher.HasParent() {
+		// The parents may take what the list cannot.
+		scrollRange = gioPointer.ScrollRange{Min: -1e6, Max: 1e6}
+	}
+	axis, xrange, yrange := gesture.Vertical, gioPointer.ScrollRange{}, scrollRange
+	if list.Axis == layout.Horizontal {
+		axis, xrange, yrange = gesture.Horizontal, scrollRange, gioPointer.ScrollRange{}
+	}
+	distance := s.gesture.Update(gtx.Metric, gtx.Source, gtx.Now, axis, xrange, yrange)
+	previous, state := s.state, s.gesture.State()
+	s.state = state
+
+	if state == gesture.StateDragging && previous != gesture.StateDragging {
+		s.tracker.Reset()
+		s.dragStart, s.dragged = gtx.Now, 0
+		s.tracker.AddPosition(0, s.axisOffset(list, 0))
+	}
+
+	if distance != 0 {
+		source := nestedscroll.NestedScrollSourceUserInput
+		if state == gesture.StateFlinging {
+			source = nestedscroll.NestedScrollSourceSideEffect
+		}
+		// Scrolling towards the end moves the content towards the start.
+		delta := -float32(distance)
+		left := s.dispatch(list, count, delta, source)
+		if s.dispatcher.HasParent() {
+			// Parents laid out before the list show the scroll in the next
+			// frame.
+			gtx.Execute(op.InvalidateCmd{})
+		}
+		switch state {
+		case gesture.StateDragging:
+			s.dragged += delta
+			s.tracker.AddPosition(gtx.Now.Sub(s.dragStart), s.axisOffset(list, s.dragged))
+		case gesture.StateFlinging:
+			// The fling stops at the ends of the list and of its parents,
+			// handing them the velocity left.
+			if left != 0 {
+				s.gesture.Stop()
+				s.state = gesture.StateIdle
+				elapsed := max(gtx.Now.Sub(s.lastFrame).Seconds(), 1.0/60)
+				s.dispatcher.DispatchPostFling(geometry.OffsetZero, s.axisOffset(list, left/float32(elapsed)))
+			}
+		default:
+			s.wheeling, s.lastWheel = true, gtx.Now
+		}
+		s.lastFrame = gtx.Now
+	}
+
+	switch {
+	case previous == gesture.StateDragging && state != gesture.StateDragging:
+		s.endDrag(list, state == gesture.StateFlinging)
+	case previous == gesture.StateFlinging && state == gesture.StateIdle:
+		s.dispatcher.DispatchPostFling(geometry.OffsetZero, geometry.OffsetZero)
+	}
+
+	// A wheel scroll ends once the wheel rests, for the parents to settle.
+	if s.wheeling {
+		if gtx.Now.Sub(s.lastWheel) >= wheelSettleDelay {
+			s.wheeling = false
+			s.dispatcher.DispatchPreFling(geometry.OffsetZero)
+			s.dispatcher.DispatchPostFling(geometry.OffsetZero, geometry.OffsetZero)
+		} else {
+			gtx.Execute(op.InvalidateCmd{At: s.lastWheel.Add(wheelSettleDelay)})
+		}
+	}
+}
+
+// endDrag dispatches the velocity of the drag that ended. The parents may
+// consume it all, stopping the fling of the list.
+func (s *listScroll) endDrag(list *layout.List, flinging bool) {
+	velocity := s.tracker.CalculateVelocity()
+	consumed := s.dispatcher.DispatchPreFling(velocity)
+	left := velocity.Minus(consumed)
+	if !flinging {
+		s.dispatcher.DispatchPostFling(geometry.OffsetZero, left)
+		return
+	}
+	if abs(s.axisValue(list, left)) < 1 {
+		s.gesture.Stop()
+		s.state = gesture.StateIdle
+		s.dispatcher.DispatchPostFling(geometry.OffsetZero, geometry.OffsetZero)
+	}
+}
+
+// dispatch scrolls list, of count items, by delta pixels, towards its start when positive,
+// offering it to the parents before and after, and returns what is left.
+func (s *listScroll) dispatch(list *layout.List, count int, delta float32, source nestedscroll.NestedScrollSource) float32 {
+	available := delta - s.axisValue(list, s.dispatcher.DispatchPreScroll(s.axisOffset(list, delta), source))
+
+	backward, forward := listScrollRange(list, count)
+	consumed := min(max(available, -float32(forward)), float32(backward))
+	list.Position.Offset -= int(consumed)
+	consumed = float32(int(consumed))
+
+	left := available - consumed
+	postConsumed := s.dispatcher.DispatchPostScroll(s.axisOffset(list, consumed), s.axisOffset(list, left), source)
+	return left - s.axisValue(list, postConsumed)
+}
+
+// listScrollRange returns how far list, of count items, scrolls towards
+// its start and its end, as of its last layout.
+func listScrollRange(list *layout.List, count int) (backward, forward int) {
+	backward, forward = 1e6, 1e6
+	if list.Position.First == 0 {
+		backward = max(list.Position.Offset, 0)
+	}
+	if list.Position.First+list.Position.Count == count {
+		forward = max(-list.Position.OffsetLast, 0)
+	}
+	return backward, forward
+}
+
+func (s *listScroll) axisOffset(list *layout.List, value float32) geometry.Offset {
+	if list.Axis == layout.Horizontal {
+		return geometry.NewOffset(value, 0)
+	}
+	return geometry.NewOffset(0, value)
+}
+
+func (s *listScroll) axisValue(list *layout.List, offset geometry.Offset) float32 {
+	if list.Axis == layout.Horizontal {
+		return offset.X()
+	}
+	return offset.Y()
+}
+
+func abs(v float32) float32 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,17 @@
+/*
+Package pager contains HorizontalPager and VerticalPager, which show one page
+at a time and snap to the page the user swipes to. Only the pages in view, and
+BeyondViewportPageCount pages on each side of them, are composed:
+
+	state := pager.RememberPagerState(c, 0, func() int { return len(photos) })
+	pager.HorizontalPager(state, func(page int) pager.Composable {
+		return PhotoPage(photos[page])
+	}, pager.WithPageSpacing(8))
+
+The PagerState scrolls the pager from code, and tells which page is shown:
+
+	button.Text(func() { state.AnimateScrollToPage(state.CurrentPage() + 1) }, "Next")
+
+Reference: https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/foundation/foundation/src/commonMain/kotlin/androidx/compose/foundation/pager/Pager.kt
+*/
+package pager
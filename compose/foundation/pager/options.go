@@ -0,0 +1,81 @@
+package pager
+
+import (
+	"github.com/zodimo/go-compose/compose/ui"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+)
+
+type PagerOptions struct {
+	Modifier ui.Modifier
+	PageSize PageSize
+	// BeyondViewportPageCount is the number of pages composed and laid out
+	// on each side of the pages in view, so that they are ready when
+	// scrolled to.
+	BeyondViewportPageCount int
+	PageSpacing             unit.Dp
+	// SnapPosition is where the pages settle. The pages never scroll past
+	// the ends of the pager, so the first and last pages may settle
+	// elsewhere.
+	SnapPosition SnapPosition
+	// UserScrollEnabled lets the user swipe between pages. The PagerState
+	// scrolls the pager either way.
+	UserScrollEnabled bool
+	// Key returns the slot of a page, whose state the page keeps when pages
+	// move, e.g. when inserted. Defaults to the page index.
+	Key func(page int) any
+}
+
+type PagerOption func(*PagerOptions)
+
+func DefaultPagerOptions() PagerOptions {
+	return PagerOptions{
+		Modifier:                ui.EmptyModifier,
+		PageSize:                PageSizeFill,
+		BeyondViewportPageCount: PagerDefaults.BeyondViewportPageCount(),
+		SnapPosition:            SnapPositionStart,
+		UserScrollEnabled:       true,
+		Key:                     func(page int) any { return page },
+	}
+}
+
+func WithModifier(m ui.Modifier) PagerOption {
+	return func(o *PagerOptions) {
+		o.Modifier = m
+	}
+}
+
+func WithPageSize(pageSize PageSize) PagerOption {
+	return func(o *PagerOptions) {
+		o.PageSize = pageSize
+	}
+}
+
+func WithBeyondViewportPageCount(count int) PagerOption {
+	return func(o *PagerOptions) {
+		o.BeyondViewportPageCount = count
+	}
+}
+
+func WithPageSpacing(spacing unit.Dp) PagerOption {
+	return func(o *PagerOptions) {
+		o.PageSpacing = spacing
+	}
+}
+
+func WithSnapPosition(snapPosition SnapPosition) PagerOption {
+	return func(o *PagerOptions) {
+		o.SnapPosition = snapPosition
+	}
+}
+
+func WithUserScrollEnabled(enabled bool) PagerOption {
+	return func(o *PagerOptions) {
+		o.UserScrollEnabled = enabled
+	}
+}
+
+func WithKey(key func(page int) any) PagerOption {
+	return func(o *PagerOptions) {
+		o.Key = key
+	}
+}
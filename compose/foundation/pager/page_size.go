@@ -0,0 +1,36 @@
+package pager
+
+import (
+	"github.com/zodimo/go-compose/compose/ui/unit"
+)
+
+// PageSize is the size of the pages of a pager along its scrolling axis.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/foundation/foundation/src/commonMain/kotlin/androidx/compose/foundation/pager/Pager.kt
+type PageSize interface {
+	// CalculateMainAxisPageSize returns the size of a page, in pixels, in a
+	// pager of availableSpace pixels whose pages are pageSpacing pixels apart.
+	CalculateMainAxisPageSize(density unit.Density, availableSpace, pageSpacing int) int
+}
+
+// PageSizeFill makes pages as large as the pager, so that one page is shown
+// at a time.
+var PageSizeFill PageSize = pageSizeFill{}
+
+type pageSizeFill struct{}
+
+func (pageSizeFill) CalculateMainAxisPageSize(density unit.Density, availableSpace, pageSpacing int) int {
+	return availableSpace
+}
+
+// PageSizeFixed makes pages pageSize large, so that a pager larger than a
+// page shows several.
+func PageSizeFixed(pageSize unit.Dp) PageSize {
+	return pageSizeFixed(pageSize)
+}
+
+type pageSizeFixed unit.Dp
+
+func (s pageSizeFixed) CalculateMainAxisPageSize(density unit.Density, availableSpace, pageSpacing int) int {
+	return density.DpRoundToPx(unit.Dp(s))
+}
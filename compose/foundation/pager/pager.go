@@ -0,0 +1,143 @@
+package pager
+
+import (
+	"math"
+
+	"github.com/zodimo/go-compose/compose/foundation/gestures"
+	uilayout "github.com/zodimo/go-compose/compose/ui/layout"
+	"github.com/zodimo/go-compose/compose/ui/platform"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+	"github.com/zodimo/go-compose/modifiers/clip"
+)
+
+// HorizontalPager shows the pages pageContent returns side by side, scrolled
+// horizontally by state, and lets the user swipe between them. Pages follow
+// the layout direction: in right to left layouts, the first page is on the
+// right. Pages are measured at most as large as the pager and are centered
+// vertically in it.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/foundation/foundation/src/commonMain/kotlin/androidx/compose/foundation/pager/Pager.kt
+func HorizontalPager(state *PagerState, pageContent func(page int) Composable, options ...PagerOption) Composable {
+	return pager(gestures.OrientationHorizontal, state, pageContent, options)
+}
+
+// VerticalPager shows the pages pageContent returns one below the other,
+// scrolled vertically by state, and lets the user swipe between them. Pages
+// are measured at most as large as the pager and are centered horizontally
+// in it.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/foundation/foundation/src/commonMain/kotlin/androidx/compose/foundation/pager/Pager.kt
+func VerticalPager(state *PagerState, pageContent func(page int) Composable, options ...PagerOption) Composable {
+	return pager(gestures.OrientationVertical, state, pageContent, options)
+}
+
+func pager(orientation gestures.Orientation, state *PagerState, pageContent func(page int) Composable, options []PagerOption) Composable {
+	opts := DefaultPagerOptions()
+	for _, option := range options {
+		if option == nil {
+			continue
+		}
+		option(&opts)
+	}
+	return func(c Composer) Composer {
+		// Dragging towards the start scrolls to the next page, the scroll
+		// position growing from the first page to the last.
+		reverse := orientation == gestures.OrientationVertical ||
+			platform.LocalLayoutDirection.Current(c) == unit.LayoutDirectionLtr
+		modifier := opts.Modifier.
+			Then(clip.ClipToBounds()).
+			Then(gestures.AnchoredDraggable(state.draggable, orientation,
+				gestures.WithEnabled(opts.UserScrollEnabled),
+				gestures.WithReverseDirection(reverse),
+			))
+		return uilayout.SubcomposeLayout(func(scope uilayout.SubcomposeMeasureScope, constraints unit.Constraints) uilayout.MeasureResult {
+			return measurePager(scope, constraints, orientation, state, pageContent, opts)
+		}, uilayout.WithModifier(modifier))(c)
+	}
+}
+
+// measurePager composes and measures the pages in view, and those within
+// BeyondViewportPageCount of them, and places them at the scroll position
+// of state.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/foundation/foundation/src/commonMain/kotlin/androidx/compose/foundation/pager/PagerMeasure.kt
+func measurePager(scope uilayout.SubcomposeMeasureScope, constraints unit.Constraints, orientation gestures.Orientation, state *PagerState, pageContent func(page int) Composable, opts PagerOptions) uilayout.MeasureResult {
+	horizontal := orientation == gestures.OrientationHorizontal
+	mainMax, crossMin, crossMax := constraints.MaxHeight(), constraints.MinWidth(), constraints.MaxWidth()
+	bounded := constraints.HasBoundedHeight()
+	if horizontal {
+		mainMax, crossMin, crossMax = constraints.MaxWidth(), constraints.MinHeight(), constraints.MaxHeight()
+		bounded = constraints.HasBoundedWidth()
+	}
+	if !bounded {
+		panic("Pager: the pager was measured with an infinite size along its scrolling axis; give it a size, e.g. with size.FillMax")
+	}
+
+	spacing := scope.DpRoundToPx(opts.PageSpacing)
+	pageSize := max(opts.PageSize.CalculateMainAxisPageSize(scope, mainMax, spacing), 0)
+	stride := pageSize + spacing
+	state.updateLayout(pageSize, spacing, mainMax, opts.SnapPosition)
+	scroll := state.scrollOffset()
+
+	first, last := visiblePages(scroll, stride, mainMax, state.PageCount(), opts.BeyondViewportPageCount)
+	pageConstraints := unit.NewConstraints(0, pageSize, 0, crossMax)
+	if !horizontal {
+		pageConstraints = unit.NewConstraints(0, crossMax, 0, pageSize)
+	}
+	type measuredPage struct {
+		position   int
+		placeables []*uilayout.Placeable
+	}
+	var pages []measuredPage
+	crossSize := crossMin
+	for page := first; page <= last; page++ {
+		measured := measuredPage{position: int(math.Round(float64(float32(page*stride) - scroll)))}
+		for _, m := range scope.Subcompose(opts.Key(page), pageContent(page)) {
+			p := m.Measure(pageConstraints)
+			measured.placeables = append(measured.placeables, p)
+			if horizontal {
+				crossSize = max(crossSize, p.Height())
+			} else {
+				crossSize = max(crossSize, p.Width())
+			}
+		}
+		pages = append(pages, measured)
+	}
+	crossSize = min(crossSize, crossMax)
+
+	width, height := mainMax, crossSize
+	if !horizontal {
+		width, height = crossSize, mainMax
+	}
+	return scope.Layout(width, height, func() {
+		for _, page := range pages {
+			for _, p := range page.placeables {
+				if horizontal {
+					p.PlaceRelative(page.position, (crossSize-p.Height())/2)
+				} else {
+					p.PlaceRelative((crossSize-p.Width())/2, page.position)
+				}
+			}
+		}
+	})
+}
+
+// visiblePages returns the first and last pages of pageCount in view of a
+// pager of viewportSize pixels at scroll, with beyond pages more on each
+// side. last is less than first when there are none.
+func visiblePages(scroll float32, stride, viewportSize, pageCount, beyond int) (first, last int) {
+	if pageCount == 0 {
+		return 0, -1
+	}
+	if stride <= 0 {
+		first, last = 0, 0
+	} else {
+		first = int(math.Floor(float64(scroll / float32(stride))))
+		last = int(math.Ceil(float64((scroll+float32(viewportSize))/float32(stride)))) - 1
+	}
+	last = max(last, first)
+	beyond = max(beyond, 0)
+	first = max(min(first-beyond, pageCount-1), 0)
+	last = max(min(last+beyond, pageCount-1), first)
+	return first, last
+}
@@ -0,0 +1,199 @@
+package pager
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/zodimo/go-compose/compose/foundation/gestures"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+)
+
+// PagerDefaults holds the default values of the pagers.
+var PagerDefaults = pagerDefaults{}
+
+type pagerDefaults struct{}
+
+// BeyondViewportPageCount is the default number of pages composed on each
+// side of the pages in view.
+func (pagerDefaults) BeyondViewportPageCount() int {
+	return 0
+}
+
+// SnapVelocityThreshold is the release velocity, per second, above which a
+// swipe goes on to the next page in its direction.
+func (pagerDefaults) SnapVelocityThreshold() unit.Dp {
+	return 400
+}
+
+// SnapPositionalThreshold is the fraction of a page a slow swipe moves the
+// pager by to go on to the next page.
+func (pagerDefaults) SnapPositionalThreshold() float32 {
+	return 0.5
+}
+
+// snapOptions make the pages the anchors a pager settles at.
+func snapOptions() []gestures.AnchoredDraggableStateOption {
+	return []gestures.AnchoredDraggableStateOption{
+		gestures.WithPositionalThreshold(func(totalDistance float32) float32 {
+			return totalDistance * PagerDefaults.SnapPositionalThreshold()
+		}),
+		gestures.WithVelocityThreshold(PagerDefaults.SnapVelocityThreshold()),
+		gestures.WithSpring(gestures.StiffnessMediumLow, gestures.DampingRatioNoBouncy),
+	}
+}
+
+// PagerState holds the scroll position of a pager. It settles at a page
+// after a swipe, going on to the next page when the swipe was faster than
+// PagerDefaults.SnapVelocityThreshold or moved the pager by more than
+// PagerDefaults.SnapPositionalThreshold of a page.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/foundation/foundation/src/commonMain/kotlin/androidx/compose/foundation/pager/PagerState.kt
+type PagerState struct {
+	pageCount func() int
+	// draggable scrolls the pager, its offset being the scroll position in
+	// pixels and its anchors the positions of the pages.
+	draggable *gestures.AnchoredDraggableState[int]
+	// pageStride is the size of a page and of the spacing after it in the
+	// last layout, and anchored the layout the anchors were placed for.
+	pageStride int
+	anchored   pagerLayout
+}
+
+// pagerLayout is what the positions of the pages depend on.
+type pagerLayout struct {
+	pageSize, pageSpacing, viewportSize, pageCount int
+	snapPosition                                   SnapPosition
+}
+
+// NewPagerState creates a state showing initialPage, of the pages of a pager
+// of pageCount pages.
+func NewPagerState(initialPage int, pageCount func() int) *PagerState {
+	if pageCount == nil {
+		panic("NewPagerState: pageCount cannot be nil")
+	}
+	return &PagerState{
+		pageCount: pageCount,
+		draggable: gestures.NewAnchoredDraggableState(initialPage, snapOptions()...),
+	}
+}
+
+// RememberPagerState returns a PagerState that survives recompositions.
+// pageCount is updated on every composition, and changes to the state
+// schedule a new frame.
+func RememberPagerState(c Composer, initialPage int, pageCount func() int) *PagerState {
+	if pageCount == nil {
+		panic("RememberPagerState: pageCount cannot be nil")
+	}
+	key := fmt.Sprintf("pagerState-%v", c.GenerateID())
+	draggable := gestures.RememberAnchoredDraggableState(c, initialPage, snapOptions()...)
+	s := c.State(key, func() any {
+		return &PagerState{pageCount: pageCount, draggable: draggable}
+	}).Get().(*PagerState)
+	s.pageCount = pageCount
+	return s
+}
+
+// PageCount returns the number of pages.
+func (s *PagerState) PageCount() int {
+	return max(s.pageCount(), 0)
+}
+
+// CurrentPage returns the page closest to its snap position, the start of
+// the pager by default.
+func (s *PagerState) CurrentPage() int {
+	offset := s.draggable.Offset()
+	if s.pageStride == 0 || math.IsNaN(float64(offset)) {
+		return s.coercePage(s.draggable.CurrentValue())
+	}
+	page, ok := s.draggable.Anchors().ClosestAnchor(offset)
+	if !ok {
+		return s.coercePage(s.draggable.CurrentValue())
+	}
+	return s.coercePage(page)
+}
+
+// CurrentPageOffsetFraction returns how far the pager is scrolled from
+// CurrentPage, as a fraction of a page in [-0.5, 0.5].
+func (s *PagerState) CurrentPageOffsetFraction() float32 {
+	offset := s.draggable.Offset()
+	if s.pageStride == 0 || math.IsNaN(float64(offset)) {
+		return 0
+	}
+	position, ok := s.draggable.Anchors().PositionOf(s.CurrentPage())
+	if !ok {
+		return 0
+	}
+	return (offset - position) / float32(s.pageStride)
+}
+
+// TargetPage returns the page the pager is settling at, or would settle at
+// when released now.
+func (s *PagerState) TargetPage() int {
+	return s.coercePage(s.draggable.TargetValue())
+}
+
+// SettledPage returns the page the pager last settled at, which does not
+// change while it is scrolling.
+func (s *PagerState) SettledPage() int {
+	return s.coercePage(s.draggable.CurrentValue())
+}
+
+// IsScrollInProgress reports whether the pager is dragged or settling.
+func (s *PagerState) IsScrollInProgress() bool {
+	return s.draggable.IsDragging() || s.draggable.IsAnimationRunning()
+}
+
+// ScrollToPage shows page without animation.
+func (s *PagerState) ScrollToPage(page int) {
+	s.draggable.SnapTo(s.coercePage(page))
+}
+
+// AnimateScrollToPage scrolls to page with the animation the pager settles
+// with.
+func (s *PagerState) AnimateScrollToPage(page int) {
+	s.draggable.AnimateTo(s.coercePage(page))
+}
+
+// DispatchRawDelta scrolls the pager by delta pixels towards the last page,
+// without settling at a page, and returns the delta that was consumed.
+func (s *PagerState) DispatchRawDelta(delta float32) float32 {
+	return s.draggable.DispatchRawDelta(delta)
+}
+
+// scrollOffset returns the scroll position in pixels.
+func (s *PagerState) scrollOffset() float32 {
+	offset := s.draggable.Offset()
+	if math.IsNaN(float64(offset)) {
+		return float32(s.CurrentPage() * s.pageStride)
+	}
+	return offset
+}
+
+// updateLayout anchors the pages, pageSize pixels large and pageSpacing
+// pixels apart in a pager of viewportSize pixels, at their snap position.
+// The anchors stay within the scroll range of the pages, from the first
+// page at the start of the pager to the last page at its end.
+func (s *PagerState) updateLayout(pageSize, pageSpacing, viewportSize int, snapPosition SnapPosition) {
+	l := pagerLayout{pageSize, pageSpacing, viewportSize, s.PageCount(), snapPosition}
+	if l == s.anchored && s.draggable.Anchors() != nil {
+		return
+	}
+	s.pageStride, s.anchored = pageSize+pageSpacing, l
+	s.draggable.UpdateAnchors(gestures.NewDraggableAnchors(pageAnchors(l)...))
+}
+
+// pageAnchors returns the scroll positions the pages of l settle at.
+func pageAnchors(l pagerLayout) []gestures.Anchor[int] {
+	stride := l.pageSize + l.pageSpacing
+	maxScroll := max(l.pageCount*stride-l.pageSpacing-l.viewportSize, 0)
+	anchors := make([]gestures.Anchor[int], l.pageCount)
+	for page := range anchors {
+		position := page*stride - l.snapPosition.Position(l.viewportSize, l.pageSize, page, l.pageCount)
+		anchors[page] = gestures.AnchorAt(page, float32(max(min(position, maxScroll), 0)))
+	}
+	return anchors
+}
+
+func (s *PagerState) coercePage(page int) int {
+	return max(min(page, s.PageCount()-1), 0)
+}
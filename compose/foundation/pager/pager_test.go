@@ -0,0 +1,191 @@
+package pager
+
+import (
+	"image"
+	"slices"
+	"testing"
+
+	"github.com/zodimo/go-compose/compose"
+	"github.com/zodimo/go-compose/compose/foundation/layout/box"
+	uilayout "github.com/zodimo/go-compose/compose/ui/layout"
+	"github.com/zodimo/go-compose/compose/ui/platform"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+	"github.com/zodimo/go-compose/modifiers/size"
+	"github.com/zodimo/go-compose/runtime"
+	"github.com/zodimo/go-compose/state"
+	"github.com/zodimo/go-compose/store"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+	gioUnit "gioui.org/unit"
+)
+
+func TestVisiblePages(t *testing.T) {
+	tests := []struct {
+		scroll              float32
+		stride, viewport    int
+		count, beyond       int
+		wantFirst, wantLast int
+	}{
+		{0, 100, 100, 5, 0, 0, 0},
+		{50, 100, 100, 5, 0, 0, 1},
+		{50, 100, 100, 5, 1, 0, 2},
+		{400, 100, 100, 5, 2, 2, 4},
+		{0, 110, 300, 10, 0, 0, 2},
+		{0, 100, 100, 0, 0, 0, -1},
+	}
+	for _, tt := range tests {
+		first, last := visiblePages(tt.scroll, tt.stride, tt.viewport, tt.count, tt.beyond)
+		if first != tt.wantFirst || last != tt.wantLast {
+			t.Errorf("visiblePages(%v, %d, %d, %d, %d) = %d, %d, want %d, %d",
+				tt.scroll, tt.stride, tt.viewport, tt.count, tt.beyond, first, last, tt.wantFirst, tt.wantLast)
+		}
+	}
+}
+
+func TestPagerState(t *testing.T) {
+	s := NewPagerState(2, func() int { return 5 })
+	if got := s.CurrentPage(); got != 2 {
+		t.Fatalf("CurrentPage() before layout = %d, want 2", got)
+	}
+	s.updateLayout(100, 0, 100, SnapPositionStart)
+	if got := s.scrollOffset(); got != 200 {
+		t.Fatalf("scroll offset = %v, want 200", got)
+	}
+
+	s.DispatchRawDelta(40)
+	if page, fraction := s.CurrentPage(), s.CurrentPageOffsetFraction(); page != 2 || fraction != 0.4 {
+		t.Errorf("after 40px: page %d, fraction %v, want 2, 0.4", page, fraction)
+	}
+	s.DispatchRawDelta(20)
+	if page, fraction := s.CurrentPage(), s.CurrentPageOffsetFraction(); page != 3 || abs(fraction+0.4) > 1e-6 {
+		t.Errorf("after 60px: page %d, fraction %v, want 3, -0.4", page, fraction)
+	}
+	if got := s.SettledPage(); got != 2 {
+		t.Errorf("SettledPage() while scrolled = %d, want 2", got)
+	}
+	if got := s.TargetPage(); got != 3 {
+		t.Errorf("TargetPage() past half a page = %d, want 3", got)
+	}
+
+	s.ScrollToPage(10)
+	if page, settled := s.CurrentPage(), s.SettledPage(); page != 4 || settled != 4 {
+		t.Errorf("after ScrollToPage(10): page %d, settled %d, want 4, 4", page, settled)
+	}
+
+	// Pages removed from the end move the pager to the last page left.
+	s.pageCount = func() int { return 2 }
+	s.updateLayout(100, 0, 100, SnapPositionStart)
+	if got := s.CurrentPage(); got != 1 {
+		t.Errorf("CurrentPage() with 2 pages = %d, want 1", got)
+	}
+}
+
+func TestPageAnchors(t *testing.T) {
+	positions := func(l pagerLayout) []float32 {
+		var got []float32
+		for _, a := range pageAnchors(l) {
+			got = append(got, a.Position)
+		}
+		return got
+	}
+	// Pages of 100 pixels, 10 apart, in a pager of 300: the last pages stop
+	// at the end of the pager.
+	got := positions(pagerLayout{100, 10, 300, 5, SnapPositionStart})
+	if want := []float32{0, 110, 220, 240, 240}; !slices.Equal(got, want) {
+		t.Errorf("start anchors = %v, want %v", got, want)
+	}
+	got = positions(pagerLayout{100, 10, 300, 5, SnapPositionCenter})
+	if want := []float32{0, 10, 120, 230, 240}; !slices.Equal(got, want) {
+		t.Errorf("center anchors = %v, want %v", got, want)
+	}
+	got = positions(pagerLayout{300, 0, 300, 3, SnapPositionStart})
+	if want := []float32{0, 300, 600}; !slices.Equal(got, want) {
+		t.Errorf("filled anchors = %v, want %v", got, want)
+	}
+}
+
+func abs(v float32) float32 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// layoutPager lays out a horizontal pager of 10 pages in a frame 300 pixels
+// wide, and returns the bounds of the pages it composed.
+func layoutPager(s *PagerState, direction unit.LayoutDirection, options ...PagerOption) map[int]image.Rectangle {
+	bounds := map[int]image.Rectangle{}
+	pageContent := func(page int) Composable {
+		positioned := uilayout.OnGloballyPositioned(func(coordinates uilayout.LayoutCoordinates) {
+			b := coordinates.BoundsInWindow()
+			bounds[page] = image.Rect(int(b.Left), int(b.Top), int(b.Right), int(b.Bottom))
+		})
+		return box.Box(func(c Composer) Composer { return c }, box.WithModifier(size.FillMax().Then(positioned)))
+	}
+	content := HorizontalPager(s, pageContent, append([]PagerOption{WithModifier(size.FillMax())}, options...)...)
+	c := compose.NewComposer(store.NewPersistentState(map[string]state.MutableValue{}))
+	node := compose.CompositionLocalProvider1(platform.LocalLayoutDirection, direction, content)(c).Build()
+	gtx := layout.Context{
+		Ops:         new(op.Ops),
+		Constraints: layout.Exact(image.Pt(300, 200)),
+		Metric:      gioUnit.Metric{PxPerDp: 1, PxPerSp: 1},
+	}
+	runtime.NewRuntime().Run(gtx, node).Add(gtx.Ops)
+	return bounds
+}
+
+func TestHorizontalPager(t *testing.T) {
+	s := NewPagerState(0, func() int { return 10 })
+	got := layoutPager(s, unit.LayoutDirectionLtr)
+	if len(got) != 1 || got[0] != image.Rect(0, 0, 300, 200) {
+		t.Errorf("filled pages = %v, want page 0 filling the pager", got)
+	}
+
+	s.ScrollToPage(3)
+	got = layoutPager(s, unit.LayoutDirectionLtr, WithBeyondViewportPageCount(1))
+	want := map[int]image.Rectangle{
+		2: image.Rect(-300, 0, 0, 200),
+		3: image.Rect(0, 0, 300, 200),
+		4: image.Rect(300, 0, 600, 200),
+	}
+	if len(got) != len(want) {
+		t.Errorf("pages beyond the viewport = %v, want %v", got, want)
+	}
+	for page, r := range want {
+		if got[page] != r {
+			t.Errorf("page %d bounds = %v, want %v", page, got[page], r)
+		}
+	}
+}
+
+func TestHorizontalPager_FixedPageSize(t *testing.T) {
+	s := NewPagerState(1, func() int { return 10 })
+	got := layoutPager(s, unit.LayoutDirectionLtr, WithPageSize(PageSizeFixed(100)), WithPageSpacing(10))
+	want := map[int]image.Rectangle{
+		1: image.Rect(0, 0, 100, 200),
+		2: image.Rect(110, 0, 210, 200),
+		3: image.Rect(220, 0, 320, 200),
+	}
+	if len(got) != len(want) {
+		t.Errorf("fixed size pages = %v, want %v", got, want)
+	}
+	for page, r := range want {
+		if got[page] != r {
+			t.Errorf("page %d bounds = %v, want %v", page, got[page], r)
+		}
+	}
+
+	// Right to left, the pages run from the right edge.
+	got = layoutPager(s, unit.LayoutDirectionRtl, WithPageSize(PageSizeFixed(100)), WithPageSpacing(10))
+	want = map[int]image.Rectangle{
+		1: image.Rect(200, 0, 300, 200),
+		2: image.Rect(90, 0, 190, 200),
+		3: image.Rect(-20, 0, 80, 200),
+	}
+	for page, r := range want {
+		if got[page] != r {
+			t.Errorf("RTL page %d bounds = %v, want %v", page, got[page], r)
+		}
+	}
+}
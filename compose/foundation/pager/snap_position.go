@@ -0,0 +1,39 @@
+package pager
+
+// SnapPosition is where, along the scrolling axis of a pager, its pages
+// settle.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/foundation/foundation/src/commonMain/kotlin/androidx/compose/foundation/gestures/snapping/SnapPosition.kt
+type SnapPosition interface {
+	// Position returns the offset, in pixels from the start of a pager of
+	// layoutSize pixels, at which page itemIndex of itemCount, itemSize
+	// pixels large, settles.
+	Position(layoutSize, itemSize, itemIndex, itemCount int) int
+}
+
+// SnapPositionStart settles pages at the start of the pager.
+var SnapPositionStart SnapPosition = snapPositionStart{}
+
+// SnapPositionCenter settles pages at the center of the pager.
+var SnapPositionCenter SnapPosition = snapPositionCenter{}
+
+// SnapPositionEnd settles pages at the end of the pager.
+var SnapPositionEnd SnapPosition = snapPositionEnd{}
+
+type snapPositionStart struct{}
+
+func (snapPositionStart) Position(layoutSize, itemSize, itemIndex, itemCount int) int {
+	return 0
+}
+
+type snapPositionCenter struct{}
+
+func (snapPositionCenter) Position(layoutSize, itemSize, itemIndex, itemCount int) int {
+	return (layoutSize - itemSize) / 2
+}
+
+type snapPositionEnd struct{}
+
+func (snapPositionEnd) Position(layoutSize, itemSize, itemIndex, itemCount int) int {
+	return layoutSize - itemSize
+}
@@ -3,18 +3,22 @@ package text
 import (
 	"fmt"
 	"image"
+	"strings"
 
 	"github.com/zodimo/go-compose/compose"
 	"github.com/zodimo/go-compose/compose/foundation/text/selection"
 	"github.com/zodimo/go-compose/compose/ui"
 	"github.com/zodimo/go-compose/compose/ui/graphics"
+	uilayout "github.com/zodimo/go-compose/compose/ui/layout"
 	"github.com/zodimo/go-compose/compose/ui/platform"
 	"github.com/zodimo/go-compose/compose/ui/text"
 	"github.com/zodimo/go-compose/compose/ui/text/font"
 	"github.com/zodimo/go-compose/compose/ui/text/style"
+	"github.com/zodimo/go-compose/compose/ui/unit"
 	"github.com/zodimo/go-compose/internal/layoutnode"
 	"github.com/zodimo/go-compose/state"
 
+	"gioui.org/layout"
 	"gioui.org/op"
 	"gioui.org/op/clip"
 	"gioui.org/op/paint"
@@ -140,25 +144,33 @@ func textWidgetConstructor(constructorArgs BasicTextConstructorArgs) layoutnode.
 			}
 
 			// fmt.Printf("textStyle [%s]: %s\n", textValue, text.StringTextStyle(textStyle))
-			dims = widget.Label{
-				Alignment:       style.TextAlignToGioTextAlignment(textStyle.TextAlign()),
-				MaxLines:        textOptions.MaxLines,
-				Truncator:       textOptions.Truncator,
-				WrapPolicy:      style.LineBreakToGioWrapPolicy(textStyle.LineBreak()),
-				LineHeight:      textStyle.LineHeight().AsGioSp(),
-				LineHeightScale: 0, // TODO how should this be handled?
-			}.Layout(
-				gtx,
-				constructorArgs.textShaper.Shaper,
-				font.ToGioFont(
-					textStyle.FontFamily(),
-					textStyle.FontWeight(),
-					textStyle.FontStyle(),
-				),
-				textStyle.FontSize().AsGioSp(),
-				textValue,
-				textColor,
-			)
+			label := func(gtx layoutnode.LayoutContext, value string) layoutnode.LayoutDimensions {
+				return widget.Label{
+					Alignment:       style.TextAlignToGioTextAlignment(textStyle.TextAlign()),
+					MaxLines:        textOptions.MaxLines,
+					Truncator:       textOptions.Truncator,
+					WrapPolicy:      style.LineBreakToGioWrapPolicy(textStyle.LineBreak()),
+					LineHeight:      textStyle.LineHeight().AsGioSp(),
+					LineHeightScale: 0, // TODO how should this be handled?
+				}.Layout(
+					gtx,
+					constructorArgs.textShaper.Shaper,
+					font.ToGioFont(
+						textStyle.FontFamily(),
+						textStyle.FontWeight(),
+						textStyle.FontStyle(),
+					),
+					textStyle.FontSize().AsGioSp(),
+					value,
+					textColor,
+				)
+			}
+			// The other intrinsic measurements are the size of the text laid
+			// out in the constraints of the query.
+			if q, ok := uilayout.IntrinsicQueryOf(gtx); ok && q.Intrinsic == uilayout.IntrinsicMinWidth {
+				return q.Dimensions(minIntrinsicWidth(gtx, textValue, label))
+			}
+			dims = label(gtx, textValue)
 			// }
 
 			textDecoration := style.TakeOrElseTextDecoration(textOptions.TextStyle.TextDecoration(), style.TextDecorationNone)
@@ -178,3 +190,15 @@ func textWidgetConstructor(constructorArgs BasicTextConstructorArgs) layoutnode.
 	})
 
 }
+
+// minIntrinsicWidth returns the width of the longest word of value, which the
+// text cannot be narrower than without breaking words.
+func minIntrinsicWidth(gtx layoutnode.LayoutContext, value string, label func(gtx layoutnode.LayoutContext, value string) layoutnode.LayoutDimensions) int {
+	gtx.Ops = new(op.Ops)
+	gtx.Constraints = layout.Constraints{Max: image.Pt(unit.GioInfinity, unit.GioInfinity)}
+	width := 0
+	for _, word := range strings.Fields(value) {
+		width = max(width, label(gtx, word).Size.X)
+	}
+	return width
+}
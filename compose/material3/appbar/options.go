@@ -1,22 +1,31 @@
 package appbar
 
-import "github.com/zodimo/go-compose/compose/ui"
+import (
+	"github.com/zodimo/go-compose/compose/foundation/layout/windowinsets"
+	"github.com/zodimo/go-compose/compose/ui"
+)
 
-// TopAppBarOptions configuration
 // TopAppBarOptions configuration
 type TopAppBarOptions struct {
 	Modifier       ui.Modifier
 	NavigationIcon Composable
 	Actions        []Composable
 	Colors         TopAppBarColors
+	// WindowInsets pad the content of the app bar, whose container extends
+	// behind them.
+	WindowInsets windowinsets.WindowInsets
+	// ScrollBehavior collapses the app bar and changes its container color
+	// as the content below it scrolls. Nil keeps the app bar static.
+	ScrollBehavior TopAppBarScrollBehavior
 }
 
 type TopAppBarOption func(*TopAppBarOptions)
 
 func DefaultTopAppBarOptions(c Composer) TopAppBarOptions {
 	return TopAppBarOptions{
-		Modifier: ui.EmptyModifier,
-		Colors:   TopAppBarDefaults.Colors(c),
+		Modifier:     ui.EmptyModifier,
+		Colors:       TopAppBarDefaults.Colors(c),
+		WindowInsets: TopAppBarDefaults.WindowInsets(),
 	}
 }
 
@@ -43,3 +52,44 @@ func WithColors(colors TopAppBarColors) TopAppBarOption {
 		o.Colors = colors
 	}
 }
+
+func WithWindowInsets(insets windowinsets.WindowInsets) TopAppBarOption {
+	return func(o *TopAppBarOptions) {
+		o.WindowInsets = insets
+	}
+}
+
+func WithScrollBehavior(behavior TopAppBarScrollBehavior) TopAppBarOption {
+	return func(o *TopAppBarOptions) {
+		o.ScrollBehavior = behavior
+	}
+}
+
+type TopAppBarScrollBehaviorOptions struct {
+	// State is the state the behavior updates, remembered with
+	// RememberTopAppBarState when not set.
+	State *TopAppBarState
+	// CanScroll reports whether the app bar follows the scrolling of the
+	// content at all.
+	CanScroll func() bool
+}
+
+type TopAppBarScrollBehaviorOption func(*TopAppBarScrollBehaviorOptions)
+
+func DefaultTopAppBarScrollBehaviorOptions() TopAppBarScrollBehaviorOptions {
+	return TopAppBarScrollBehaviorOptions{
+		CanScroll: func() bool { return true },
+	}
+}
+
+func WithScrollBehaviorState(state *TopAppBarState) TopAppBarScrollBehaviorOption {
+	return func(o *TopAppBarScrollBehaviorOptions) {
+		o.State = state
+	}
+}
+
+func WithCanScroll(canScroll func() bool) TopAppBarScrollBehaviorOption {
+	return func(o *TopAppBarScrollBehaviorOptions) {
+		o.CanScroll = canScroll
+	}
+}
@@ -0,0 +1,99 @@
+package appbar
+
+import (
+	"image"
+	"math"
+
+	"github.com/zodimo/go-compose/compose/ui"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+	node "github.com/zodimo/go-compose/internal/Node"
+	"github.com/zodimo/go-compose/internal/animation"
+	"github.com/zodimo/go-compose/internal/layoutnode"
+	"github.com/zodimo/go-compose/internal/modifier"
+
+	"gioui.org/op"
+	"gioui.org/op/clip"
+)
+
+// scrollLayoutModifier lays an app bar out collapsed by the height offset of
+// state, which it limits to collapsible, sliding the app bar out when
+// slideOut is set and clipping its bottom otherwise. It settles state and
+// requests frames for as long as state or colorAnim, when not nil, animate.
+func scrollLayoutModifier(state *TopAppBarState, collapsible unit.Dp, slideOut bool, colorAnim *animation.VisibilityAnimation) ui.Modifier {
+	return modifier.NewInspectableModifier(
+		modifier.NewModifier(&scrollLayoutElement{state: state, collapsible: collapsible, slideOut: slideOut, colorAnim: colorAnim}),
+		modifier.NewInspectorInfo("appBarScrollLayout", map[string]any{
+			"state":       state,
+			"collapsible": collapsible,
+			"slideOut":    slideOut,
+		}),
+	)
+}
+
+type scrollLayoutElement struct {
+	state       *TopAppBarState
+	collapsible unit.Dp
+	slideOut    bool
+	colorAnim   *animation.VisibilityAnimation
+}
+
+func (e *scrollLayoutElement) Create() node.Node {
+	return newScrollLayoutNode(e)
+}
+
+func (e *scrollLayoutElement) Update(n node.Node) {
+	n.(*scrollLayoutNode).element = e
+}
+
+func (e *scrollLayoutElement) Equals(other modifier.Element) bool {
+	o, ok := other.(*scrollLayoutElement)
+	return ok && *o == *e
+}
+
+type scrollLayoutNode struct {
+	node.ChainNode
+	element *scrollLayoutElement
+}
+
+func newScrollLayoutNode(element *scrollLayoutElement) *scrollLayoutNode {
+	n := &scrollLayoutNode{element: element}
+	n.ChainNode = node.NewChainNode(
+		node.NewNodeID(),
+		node.NodeKindLayout,
+		node.LayoutPhase,
+		func(t node.TreeNode) {
+			t.(layoutnode.LayoutModifierNode).AttachLayoutModifier(func(widget layoutnode.LayoutWidget) layoutnode.LayoutWidget {
+				return layoutnode.NewLayoutWidget(func(gtx layoutnode.LayoutContext) layoutnode.LayoutDimensions {
+					e := n.element
+					e.state.SetHeightOffsetLimit(-float32(gtx.Dp(unit.DpToGioUnit(e.collapsible))))
+					if e.state.advance(gtx.Now) {
+						gtx.Execute(op.InvalidateCmd{})
+					}
+					if e.colorAnim != nil {
+						e.colorAnim.Revealed(gtx)
+					}
+					heightOffset := int(math.Round(float64(e.state.heightOffset)))
+					return collapsedLayout(gtx, heightOffset, e.slideOut, widget)
+				})
+			})
+		},
+	)
+	return n
+}
+
+// collapsedLayout lays widget out heightOffset shorter, heightOffset being
+// negative: it moves widget up by heightOffset when slideOut is set, and
+// clips its bottom otherwise.
+func collapsedLayout(gtx layoutnode.LayoutContext, heightOffset int, slideOut bool, widget layoutnode.LayoutWidget) layoutnode.LayoutDimensions {
+	macro := op.Record(gtx.Ops)
+	dims := widget.Layout(gtx)
+	call := macro.Stop()
+
+	size := image.Pt(dims.Size.X, max(dims.Size.Y+heightOffset, 0))
+	defer clip.Rect{Max: size}.Push(gtx.Ops).Pop()
+	if slideOut {
+		defer op.Offset(image.Pt(0, heightOffset)).Push(gtx.Ops).Pop()
+	}
+	call.Add(gtx.Ops)
+	return layoutnode.LayoutDimensions{Size: size}
+}
@@ -1,15 +1,22 @@
 package appbar
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/zodimo/go-compose/compose"
 	"github.com/zodimo/go-compose/compose/foundation/layout/box"
 	"github.com/zodimo/go-compose/compose/foundation/layout/column"
 	"github.com/zodimo/go-compose/compose/foundation/layout/row"
 	"github.com/zodimo/go-compose/compose/foundation/layout/spacer"
+	"github.com/zodimo/go-compose/compose/foundation/layout/windowinsets"
 	"github.com/zodimo/go-compose/compose/material3"
 	"github.com/zodimo/go-compose/compose/material3/surface"
 	"github.com/zodimo/go-compose/compose/ui"
 	"github.com/zodimo/go-compose/compose/ui/graphics"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+	"github.com/zodimo/go-compose/internal/animation"
+	"github.com/zodimo/go-compose/modifiers/alpha"
 	"github.com/zodimo/go-compose/modifiers/padding"
 	"github.com/zodimo/go-compose/modifiers/size"
 	"github.com/zodimo/go-compose/modifiers/weight"
@@ -20,14 +27,18 @@ import (
 
 // SingleRowTopAppBar is an internal component to layout the TopAppBar content in a single row.
 // It is used by SmallTopAppBar and CenterAlignedTopAppBar.
+// scrollBehavior, when not nil, slides the app bar out and changes its container color.
 func SingleRowTopAppBar(
 	modifier ui.Modifier,
 	title Composable,
 	navigationIcon Composable,
 	actions []Composable,
 	colors TopAppBarColors,
+	windowInsets windowinsets.WindowInsets,
+	scrollBehavior TopAppBarScrollBehavior,
 ) Composable {
 	return func(c Composer) Composer {
+		modifier, containerColor := singleRowScroll(c, modifier, colors, scrollBehavior)
 		return surface.Surface(
 			row.Row(
 				c.Sequence(
@@ -78,17 +89,42 @@ func SingleRowTopAppBar(
 					),
 				),
 				row.WithModifier(
-					size.FillMaxWidth().
+					windowinsets.WindowInsetsPadding(windowInsets).
+						Then(size.FillMaxWidth()).
 						Then(size.Height(64)), // Standard Height
 				),
 				row.WithAlignment(row.Middle), // Vertical Alignment
 			),
 			surface.WithModifier(modifier),
-			surface.WithColor(colors.ContainerColor),
+			surface.WithColor(containerColor),
 		)(c)
 	}
 }
 
+// singleRowScroll returns modifier, sliding a single row app bar out as
+// scrollBehavior collapses it, and the container color of the app bar,
+// changing once content scrolls under it.
+func singleRowScroll(c Composer, modifier ui.Modifier, colors TopAppBarColors, scrollBehavior TopAppBarScrollBehavior) (ui.Modifier, graphics.Color) {
+	if scrollBehavior == nil {
+		return modifier, colors.ContainerColor
+	}
+	state := scrollBehavior.State()
+	colorAnim := c.State(fmt.Sprintf("topAppBarColor-%v", c.GenerateID()), func() any {
+		return &animation.VisibilityAnimation{
+			Duration: TopAppBarDefaults.ColorDuration(),
+			State:    animation.Invisible,
+		}
+	}).Get().(*animation.VisibilityAnimation)
+	now := time.Now()
+	if state.OverlappedFraction() > 0.01 {
+		colorAnim.Appear(now)
+	} else {
+		colorAnim.Disappear(now)
+	}
+	containerColor := graphics.Lerp(colors.ContainerColor, colors.ScrolledContainerColor, colorAnim.RevealedAt(now))
+	return modifier.Then(scrollLayoutModifier(state, TopAppBarDefaults.TopAppBarExpandedHeight(), true, colorAnim)), containerColor
+}
+
 // TopAppBar displays information and actions at the top of a screen.
 // This is equivalent to SmallTopAppBar in Material 3.
 func TopAppBar(
@@ -110,6 +146,8 @@ func TopAppBar(
 			opts.NavigationIcon,
 			opts.Actions,
 			opts.Colors,
+			opts.WindowInsets,
+			opts.ScrollBehavior,
 		)(c)
 	}
 }
@@ -129,6 +167,7 @@ func CenterAlignedTopAppBar(
 			option(&opts)
 		}
 
+		modifier, containerColor := singleRowScroll(c, opts.Modifier, opts.Colors, opts.ScrollBehavior)
 		return surface.Surface(
 			box.Box(
 				c.Sequence(
@@ -180,15 +219,24 @@ func CenterAlignedTopAppBar(
 						box.WithModifier(size.FillMax()), // Consume space to allow centering
 					),
 				),
-				box.WithModifier(size.FillMaxWidth().Then(size.Height(64))),
+				box.WithModifier(windowinsets.WindowInsetsPadding(opts.WindowInsets).
+					Then(size.FillMaxWidth()).
+					Then(size.Height(64)),
+				),
 			),
-			surface.WithModifier(opts.Modifier),
-			surface.WithColor(opts.Colors.ContainerColor),
+			surface.WithModifier(modifier),
+			surface.WithColor(containerColor),
 		)(c)
 	}
 }
 
+// topTitleAlphaEasing fades the title of the top row of a collapsing
+// MediumTopAppBar or LargeTopAppBar in.
+var topTitleAlphaEasing = material3.NewCubicBezierEasing(0.8, 0.0, 0.8, 0.15)
+
 // TwoRowsTopAppBar is an internal component to layout Medium and Large TopAppBars.
+// scrollBehavior, when not nil, collapses the app bar to its top row, showing the
+// title there, and changes its container color.
 func TwoRowsTopAppBar(
 	modifier ui.Modifier,
 	title Composable,
@@ -197,15 +245,30 @@ func TwoRowsTopAppBar(
 	navigationIcon Composable,
 	actions []Composable,
 	colors TopAppBarColors,
+	windowInsets windowinsets.WindowInsets,
+	scrollBehavior TopAppBarScrollBehavior,
 ) Composable {
 	return func(c Composer) Composer {
+		var collapsedFraction float32
+		if scrollBehavior != nil {
+			state := scrollBehavior.State()
+			collapsedFraction = state.CollapsedFraction()
+			collapsible := unit.Dp(maxHeight) - TopAppBarDefaults.TopAppBarExpandedHeight()
+			modifier = modifier.Then(scrollLayoutModifier(state, collapsible, false, nil))
+		}
+		containerColor := graphics.Lerp(colors.ContainerColor, colors.ScrolledContainerColor, material3.EasingLegacyAccelerate.Transform(collapsedFraction))
+		var topTitle Composable
+		if title != nil && collapsedFraction > 0 {
+			topTitle = box.Box(title, box.WithModifier(alpha.Alpha(topTitleAlphaEasing.Transform(collapsedFraction))))
+		}
+
 		return surface.Surface(
 			column.Column(
 				c.Sequence(
 					// Top Row: Nav Icon + Actions (No Title)
 					SingleRowTopAppBar(
 						ui.EmptyModifier,
-						nil, // No title in top row for expanded state
+						topTitle, // The title shows in the top row as the app bar collapses
 						navigationIcon,
 						actions,
 						TopAppBarColors{
@@ -213,8 +276,11 @@ func TwoRowsTopAppBar(
 							// Or use same colors. SingleRowTopAppBar sets container color.
 							ContainerColor:             graphics.ColorTransparent, // Transparent, let parent surface color show
 							NavigationIconContentColor: colors.NavigationIconContentColor,
+							TitleContentColor:          colors.TitleContentColor,
 							ActionIconContentColor:     colors.ActionIconContentColor,
 						},
+						windowinsets.Fixed(0, 0, 0, 0), // The insets pad the whole app bar
+						nil,                            // The whole app bar collapses
 					),
 					// Bottom Row: Title
 					box.Box(
@@ -240,16 +306,20 @@ func TwoRowsTopAppBar(
 						),
 						box.WithModifier(size.FillMaxWidth().
 							Then(weight.Weight(1)). // Fill remaining height
-							Then(padding.Padding(16, 0, 16, titleBottomPadding)),
+							Then(padding.Padding(16, 0, 16, titleBottomPadding)).
+							Then(alpha.Alpha(1-collapsedFraction)),
 						),
 
 						box.WithAlignment(layout.SW), // Start, Bottom
 					),
 				),
-				column.WithModifier(size.FillMaxWidth().Then(size.Height(maxHeight))),
+				column.WithModifier(windowinsets.WindowInsetsPadding(windowInsets).
+					Then(size.FillMaxWidth()).
+					Then(size.Height(maxHeight)),
+				),
 			),
 			surface.WithModifier(modifier),
-			surface.WithColor(colors.ContainerColor),
+			surface.WithColor(containerColor),
 		)(c)
 	}
 }
@@ -272,11 +342,13 @@ func MediumTopAppBar(
 		return TwoRowsTopAppBar(
 			opts.Modifier,
 			title,
-			16,  // Bottom padding - Reduced from 24 to avoid cutoff
-			112, // Max height (64 + 48)
+			16, // Bottom padding - Reduced from 24 to avoid cutoff
+			int(TopAppBarDefaults.MediumAppBarExpandedHeight()),
 			opts.NavigationIcon,
 			opts.Actions,
 			opts.Colors,
+			opts.WindowInsets,
+			opts.ScrollBehavior,
 		)(c)
 	}
 }
@@ -299,11 +371,13 @@ func LargeTopAppBar(
 		return TwoRowsTopAppBar(
 			opts.Modifier,
 			title,
-			28,  // Bottom padding
-			152, // Max height (64 + 88)
+			28, // Bottom padding
+			int(TopAppBarDefaults.LargeAppBarExpandedHeight()),
 			opts.NavigationIcon,
 			opts.Actions,
 			opts.Colors,
+			opts.WindowInsets,
+			opts.ScrollBehavior,
 		)(c)
 	}
 }
@@ -1,8 +1,12 @@
 package appbar
 
 import (
+	"time"
+
+	"github.com/zodimo/go-compose/compose/foundation/layout/windowinsets"
 	"github.com/zodimo/go-compose/compose/material3"
 	"github.com/zodimo/go-compose/compose/ui/graphics"
+	"github.com/zodimo/go-compose/compose/ui/unit"
 	"github.com/zodimo/go-compose/pkg/api"
 )
 
@@ -46,3 +50,37 @@ func (d topAppBarDefaults) MediumTopAppBarColors(c api.Composer) TopAppBarColors
 func (d topAppBarDefaults) LargeTopAppBarColors(c api.Composer) TopAppBarColors {
 	return d.Colors(c)
 }
+
+// WindowInsets returns the default insets of a TopAppBar: the sides and top
+// of the system bars.
+func (d topAppBarDefaults) WindowInsets() windowinsets.WindowInsets {
+	return windowinsets.Only(windowinsets.SystemBars, windowinsets.SidesHorizontal|windowinsets.SidesTop)
+}
+
+// TopAppBarExpandedHeight is the height of a TopAppBar or a
+// CenterAlignedTopAppBar, and of the collapsed MediumTopAppBar and
+// LargeTopAppBar.
+func (d topAppBarDefaults) TopAppBarExpandedHeight() unit.Dp {
+	return 64
+}
+
+// MediumAppBarExpandedHeight is the height of an expanded MediumTopAppBar.
+func (d topAppBarDefaults) MediumAppBarExpandedHeight() unit.Dp {
+	return 112
+}
+
+// LargeAppBarExpandedHeight is the height of an expanded LargeTopAppBar.
+func (d topAppBarDefaults) LargeAppBarExpandedHeight() unit.Dp {
+	return 152
+}
+
+// SnapDuration is how long a partly collapsed app bar takes to settle.
+func (d topAppBarDefaults) SnapDuration() time.Duration {
+	return material3.DefaultMotionTokens.DurationMedium2
+}
+
+// ColorDuration is how long a TopAppBar takes to change its container
+// color once content scrolls under it, or back.
+func (d topAppBarDefaults) ColorDuration() time.Duration {
+	return material3.DefaultMotionTokens.DurationMedium1
+}
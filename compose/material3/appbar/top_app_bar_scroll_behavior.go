@@ -0,0 +1,234 @@
+package appbar
+
+import (
+	"fmt"
+
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+	"github.com/zodimo/go-compose/compose/ui/input/nestedscroll"
+)
+
+// TopAppBarScrollBehavior collapses, expands or tints a top app bar as the
+// content below it scrolls. Pass its NestedScrollConnection to a
+// nestedscroll.NestedScroll modifier around the scrolling content, usually
+// that of the Scaffold, and the behavior to the app bar with
+// WithScrollBehavior.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/AppBar.kt
+type TopAppBarScrollBehavior interface {
+	// State returns the state the behavior updates.
+	State() *TopAppBarState
+	// IsPinned reports whether the app bar stays expanded.
+	IsPinned() bool
+	// NestedScrollConnection returns the connection to the scrolling of
+	// the content.
+	NestedScrollConnection() nestedscroll.NestedScrollConnection
+}
+
+// PinnedScrollBehavior returns a behavior keeping the app bar in place,
+// changing only its container color once content scrolls under it.
+func (d topAppBarDefaults) PinnedScrollBehavior(c Composer, options ...TopAppBarScrollBehaviorOption) TopAppBarScrollBehavior {
+	return rememberScrollBehavior(c, "pinned", options, func() scrollBehavior {
+		return &pinnedScrollBehavior{}
+	})
+}
+
+// EnterAlwaysScrollBehavior returns a behavior collapsing the app bar as
+// the content scrolls towards its end, and expanding it as soon as the
+// content scrolls back.
+func (d topAppBarDefaults) EnterAlwaysScrollBehavior(c Composer, options ...TopAppBarScrollBehaviorOption) TopAppBarScrollBehavior {
+	return rememberScrollBehavior(c, "enterAlways", options, func() scrollBehavior {
+		return &enterAlwaysScrollBehavior{}
+	})
+}
+
+// ExitUntilCollapsedScrollBehavior returns a behavior collapsing the app
+// bar as the content scrolls towards its end, and expanding it only once
+// the content scrolled back to its start.
+func (d topAppBarDefaults) ExitUntilCollapsedScrollBehavior(c Composer, options ...TopAppBarScrollBehaviorOption) TopAppBarScrollBehavior {
+	return rememberScrollBehavior(c, "exitUntilCollapsed", options, func() scrollBehavior {
+		return &exitUntilCollapsedScrollBehavior{}
+	})
+}
+
+// scrollBehavior is a TopAppBarScrollBehavior taking its options from the
+// last composition.
+type scrollBehavior interface {
+	TopAppBarScrollBehavior
+	setOptions(opts TopAppBarScrollBehaviorOptions)
+}
+
+// rememberScrollBehavior remembers the behavior created by create, for its
+// connection to stay the same across recompositions, and sets the options
+// of every composition on it.
+func rememberScrollBehavior(c Composer, name string, options []TopAppBarScrollBehaviorOption, create func() scrollBehavior) TopAppBarScrollBehavior {
+	opts := DefaultTopAppBarScrollBehaviorOptions()
+	for _, option := range options {
+		if option == nil {
+			continue
+		}
+		option(&opts)
+	}
+	if opts.State == nil {
+		opts.State = RememberTopAppBarState(c)
+	}
+	key := fmt.Sprintf("%sScrollBehavior-%v", name, c.GenerateID())
+	behavior := c.State(key, func() any {
+		return create()
+	}).Get().(scrollBehavior)
+	behavior.setOptions(opts)
+	return behavior
+}
+
+// pinnedScrollBehavior tracks the content offset only.
+type pinnedScrollBehavior struct {
+	state     *TopAppBarState
+	canScroll func() bool
+}
+
+func (b *pinnedScrollBehavior) State() *TopAppBarState { return b.state }
+
+func (b *pinnedScrollBehavior) setOptions(opts TopAppBarScrollBehaviorOptions) {
+	b.state, b.canScroll = opts.State, opts.CanScroll
+}
+
+func (b *pinnedScrollBehavior) IsPinned() bool { return true }
+
+func (b *pinnedScrollBehavior) NestedScrollConnection() nestedscroll.NestedScrollConnection {
+	return b
+}
+
+func (b *pinnedScrollBehavior) OnPreScroll(available geometry.Offset, source nestedscroll.NestedScrollSource) geometry.Offset {
+	return geometry.OffsetZero
+}
+
+func (b *pinnedScrollBehavior) OnPostScroll(consumed, available geometry.Offset, source nestedscroll.NestedScrollSource) geometry.Offset {
+	if !b.canScroll() {
+		return geometry.OffsetZero
+	}
+	if consumed.Y() == 0 && available.Y() > 0 {
+		// The content scrolled back to its start.
+		b.state.SetContentOffset(0)
+	} else {
+		b.state.SetContentOffset(b.state.contentOffset + consumed.Y())
+	}
+	return geometry.OffsetZero
+}
+
+func (b *pinnedScrollBehavior) OnPreFling(available geometry.Offset) geometry.Offset {
+	return geometry.OffsetZero
+}
+
+func (b *pinnedScrollBehavior) OnPostFling(consumed, available geometry.Offset) geometry.Offset {
+	return geometry.OffsetZero
+}
+
+// enterAlwaysScrollBehavior collapses the app bar before the content
+// scrolls, in either direction.
+type enterAlwaysScrollBehavior struct {
+	state     *TopAppBarState
+	canScroll func() bool
+}
+
+func (b *enterAlwaysScrollBehavior) State() *TopAppBarState { return b.state }
+
+func (b *enterAlwaysScrollBehavior) setOptions(opts TopAppBarScrollBehaviorOptions) {
+	b.state, b.canScroll = opts.State, opts.CanScroll
+}
+
+func (b *enterAlwaysScrollBehavior) IsPinned() bool { return false }
+
+func (b *enterAlwaysScrollBehavior) NestedScrollConnection() nestedscroll.NestedScrollConnection {
+	return b
+}
+
+func (b *enterAlwaysScrollBehavior) OnPreScroll(available geometry.Offset, source nestedscroll.NestedScrollSource) geometry.Offset {
+	if !b.canScroll() {
+		return geometry.OffsetZero
+	}
+	previous := b.state.heightOffset
+	b.state.SetHeightOffset(previous + available.Y())
+	return geometry.NewOffset(0, b.state.heightOffset-previous)
+}
+
+func (b *enterAlwaysScrollBehavior) OnPostScroll(consumed, available geometry.Offset, source nestedscroll.NestedScrollSource) geometry.Offset {
+	if !b.canScroll() {
+		return geometry.OffsetZero
+	}
+	b.state.SetContentOffset(b.state.contentOffset + consumed.Y())
+	if b.state.heightOffset == 0 || b.state.heightOffset == b.state.heightOffsetLimit {
+		if consumed.Y() == 0 && available.Y() > 0 {
+			// The content scrolled back to its start.
+			b.state.SetContentOffset(0)
+		}
+	}
+	b.state.SetHeightOffset(b.state.heightOffset + consumed.Y())
+	return geometry.OffsetZero
+}
+
+func (b *enterAlwaysScrollBehavior) OnPreFling(available geometry.Offset) geometry.Offset {
+	return geometry.OffsetZero
+}
+
+func (b *enterAlwaysScrollBehavior) OnPostFling(consumed, available geometry.Offset) geometry.Offset {
+	return geometry.NewOffset(0, b.state.settle(available.Y()))
+}
+
+// exitUntilCollapsedScrollBehavior collapses the app bar before the content
+// scrolls towards its end, and expands it with what the content leaves of
+// a scroll towards its start.
+type exitUntilCollapsedScrollBehavior struct {
+	state     *TopAppBarState
+	canScroll func() bool
+}
+
+func (b *exitUntilCollapsedScrollBehavior) State() *TopAppBarState { return b.state }
+
+func (b *exitUntilCollapsedScrollBehavior) setOptions(opts TopAppBarScrollBehaviorOptions) {
+	b.state, b.canScroll = opts.State, opts.CanScroll
+}
+
+func (b *exitUntilCollapsedScrollBehavior) IsPinned() bool { return false }
+
+func (b *exitUntilCollapsedScrollBehavior) NestedScrollConnection() nestedscroll.NestedScrollConnection {
+	return b
+}
+
+func (b *exitUntilCollapsedScrollBehavior) OnPreScroll(available geometry.Offset, source nestedscroll.NestedScrollSource) geometry.Offset {
+	// Scrolls towards the start go to the content first.
+	if !b.canScroll() || available.Y() > 0 {
+		return geometry.OffsetZero
+	}
+	previous := b.state.heightOffset
+	b.state.SetHeightOffset(previous + available.Y())
+	return geometry.NewOffset(0, b.state.heightOffset-previous)
+}
+
+func (b *exitUntilCollapsedScrollBehavior) OnPostScroll(consumed, available geometry.Offset, source nestedscroll.NestedScrollSource) geometry.Offset {
+	if !b.canScroll() {
+		return geometry.OffsetZero
+	}
+	b.state.SetContentOffset(b.state.contentOffset + consumed.Y())
+	if available.Y() < 0 || consumed.Y() < 0 {
+		previous := b.state.heightOffset
+		b.state.SetHeightOffset(previous + consumed.Y())
+		return geometry.NewOffset(0, b.state.heightOffset-previous)
+	}
+	if consumed.Y() == 0 && available.Y() > 0 {
+		// The content scrolled back to its start.
+		b.state.SetContentOffset(0)
+	}
+	if available.Y() > 0 {
+		previous := b.state.heightOffset
+		b.state.SetHeightOffset(previous + available.Y())
+		return geometry.NewOffset(0, b.state.heightOffset-previous)
+	}
+	return geometry.OffsetZero
+}
+
+func (b *exitUntilCollapsedScrollBehavior) OnPreFling(available geometry.Offset) geometry.Offset {
+	return geometry.OffsetZero
+}
+
+func (b *exitUntilCollapsedScrollBehavior) OnPostFling(consumed, available geometry.Offset) geometry.Offset {
+	return geometry.NewOffset(0, b.state.settle(available.Y()))
+}
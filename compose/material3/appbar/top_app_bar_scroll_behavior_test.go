@@ -0,0 +1,144 @@
+package appbar
+
+import (
+	"image"
+	"testing"
+
+	"github.com/zodimo/go-compose/compose"
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+	"github.com/zodimo/go-compose/compose/ui/input/nestedscroll"
+	"github.com/zodimo/go-compose/internal/layoutnode"
+	"github.com/zodimo/go-compose/state"
+	"github.com/zodimo/go-compose/store"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+	gioUnit "gioui.org/unit"
+)
+
+const drag = nestedscroll.NestedScrollSourceUserInput
+
+func canScroll() bool { return true }
+
+func TestTopAppBarStateFractions(t *testing.T) {
+	state := NewTopAppBarState(-100, -25, 0)
+	if got := state.CollapsedFraction(); got != 0.25 {
+		t.Errorf("collapsed fraction = %v, want 0.25", got)
+	}
+	state.SetHeightOffset(-300)
+	if got := state.HeightOffset(); got != -100 {
+		t.Errorf("height offset = %v, want it clamped to the limit", got)
+	}
+	state.SetContentOffset(-50)
+	if got := state.OverlappedFraction(); got != 0.5 {
+		t.Errorf("overlapped fraction = %v, want 0.5", got)
+	}
+	state.SetHeightOffsetLimit(-40)
+	if got := state.HeightOffset(); got != -40 {
+		t.Errorf("height offset = %v, want it clamped to the new limit", got)
+	}
+}
+
+func TestPinnedScrollBehavior(t *testing.T) {
+	state := NewTopAppBarState(-100, 0, 0)
+	connection := (&pinnedScrollBehavior{state: state, canScroll: canScroll}).NestedScrollConnection()
+
+	connection.OnPostScroll(geometry.NewOffset(0, -60), geometry.OffsetZero, drag)
+	if state.HeightOffset() != 0 || state.ContentOffset() != -60 {
+		t.Errorf("height offset %v, content offset %v; want 0, -60", state.HeightOffset(), state.ContentOffset())
+	}
+	// Reaching the start of the content resets its offset.
+	connection.OnPostScroll(geometry.OffsetZero, geometry.NewOffset(0, 10), drag)
+	if state.ContentOffset() != 0 {
+		t.Errorf("content offset = %v at the start of the content, want 0", state.ContentOffset())
+	}
+}
+
+func TestEnterAlwaysScrollBehavior(t *testing.T) {
+	state := NewTopAppBarState(-100, 0, 0)
+	connection := (&enterAlwaysScrollBehavior{state: state, canScroll: canScroll}).NestedScrollConnection()
+
+	// The app bar collapses before the content scrolls, up to its limit.
+	if got := connection.OnPreScroll(geometry.NewOffset(0, -60), drag); got.Y() != -60 {
+		t.Errorf("pre scroll consumed %v, want the whole scroll", got)
+	}
+	if got := connection.OnPreScroll(geometry.NewOffset(0, -60), drag); got.Y() != -40 {
+		t.Errorf("pre scroll consumed %v, want up to the limit", got)
+	}
+	// It expands as soon as the content scrolls back.
+	if got := connection.OnPreScroll(geometry.NewOffset(0, 30), drag); got.Y() != 30 || state.HeightOffset() != -70 {
+		t.Errorf("pre scroll consumed %v to %v, want 30 to -70", got, state.HeightOffset())
+	}
+}
+
+func TestExitUntilCollapsedScrollBehavior(t *testing.T) {
+	state := NewTopAppBarState(-100, 0, 0)
+	connection := (&exitUntilCollapsedScrollBehavior{state: state, canScroll: canScroll}).NestedScrollConnection()
+
+	connection.OnPreScroll(geometry.NewOffset(0, -100), drag)
+	if state.CollapsedFraction() != 1 {
+		t.Fatalf("collapsed fraction = %v, want 1", state.CollapsedFraction())
+	}
+	// Scrolls back go to the content first.
+	if got := connection.OnPreScroll(geometry.NewOffset(0, 30), drag); got != geometry.OffsetZero {
+		t.Errorf("pre scroll consumed %v of a scroll back, want none", got)
+	}
+	if got := connection.OnPostScroll(geometry.NewOffset(0, 30), geometry.OffsetZero, drag); got != geometry.OffsetZero || state.HeightOffset() != -100 {
+		t.Errorf("post scroll consumed %v to %v while the content scrolled, want none", got, state.HeightOffset())
+	}
+	// What the content leaves expands the app bar.
+	if got := connection.OnPostScroll(geometry.OffsetZero, geometry.NewOffset(0, 30), drag); got.Y() != 30 || state.HeightOffset() != -70 {
+		t.Errorf("post scroll consumed %v to %v, want 30 to -70", got, state.HeightOffset())
+	}
+}
+
+func TestScrollBehaviorOptionsPerComposition(t *testing.T) {
+	s := store.NewPersistentState(map[string]state.MutableValue{})
+	behaviorOf := func(appBarState *TopAppBarState, canScroll bool) TopAppBarScrollBehavior {
+		return TopAppBarDefaults.EnterAlwaysScrollBehavior(compose.NewComposer(s),
+			WithScrollBehaviorState(appBarState),
+			WithCanScroll(func() bool { return canScroll }),
+		)
+	}
+	first := behaviorOf(NewTopAppBarState(-100, 0, 0), true)
+	appBarState := NewTopAppBarState(-100, 0, 0)
+	behavior := behaviorOf(appBarState, false)
+	if behavior.NestedScrollConnection() != first.NestedScrollConnection() {
+		t.Fatal("the connection should stay the same across recompositions")
+	}
+	if behavior.State() != appBarState {
+		t.Error("the behavior should update the state of the last composition")
+	}
+	behavior.NestedScrollConnection().OnPreScroll(geometry.NewOffset(0, -40), drag)
+	if got := appBarState.HeightOffset(); got != 0 {
+		t.Errorf("height offset = %v, want 0 once the last composition stopped the scrolling", got)
+	}
+}
+
+func TestTopAppBarStateSettle(t *testing.T) {
+	state := NewTopAppBarState(-100, -70, 0)
+	if got := state.settle(0); got != 0 || state.snap == nil || state.snap.To != -100 {
+		t.Fatalf("settle from -70 should animate to the limit, snap %+v", state.snap)
+	}
+	expanded := NewTopAppBarState(-100, 0, 0)
+	if expanded.settle(-1000); expanded.snap != nil {
+		t.Errorf("an expanded app bar should not settle")
+	}
+}
+
+func TestCollapsedLayout(t *testing.T) {
+	gtx := layout.Context{
+		Ops:         new(op.Ops),
+		Constraints: layout.Constraints{Max: image.Pt(400, 800)},
+		Metric:      gioUnit.Metric{PxPerDp: 1, PxPerSp: 1},
+	}
+	widget := layoutnode.NewLayoutWidget(func(gtx layoutnode.LayoutContext) layoutnode.LayoutDimensions {
+		return layoutnode.LayoutDimensions{Size: image.Pt(400, 152)}
+	})
+	if dims := collapsedLayout(gtx, -88, false, widget); dims.Size != image.Pt(400, 64) {
+		t.Errorf("size = %v, want the collapsed height", dims.Size)
+	}
+	if dims := collapsedLayout(gtx, -200, true, widget); dims.Size != image.Pt(400, 0) {
+		t.Errorf("size = %v, want no height once slid out", dims.Size)
+	}
+}
@@ -0,0 +1,170 @@
+package appbar
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/zodimo/go-compose/compose/material3"
+	"github.com/zodimo/go-compose/internal/animation"
+	"github.com/zodimo/go-compose/internal/gesturestate"
+)
+
+// flingProjection is how far ahead, in seconds, the velocity of a fling is
+// followed to pick the value an app bar settles at.
+const flingProjection = 0.1
+
+// TopAppBarState is the state of a top app bar that collapses with the
+// scrolling of the content below it, as set by a TopAppBarScrollBehavior.
+// Offsets are in pixels.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/AppBar.kt
+type TopAppBarState struct {
+	heightOffsetLimit float32
+	heightOffset      float32
+	contentOffset     float32
+
+	// snap is the animation settling a partly collapsed app bar.
+	snap *animation.FloatAnimation
+
+	revision gesturestate.Revision
+}
+
+// NewTopAppBarState creates a TopAppBarState. Use RememberTopAppBarState to
+// keep it across recompositions.
+func NewTopAppBarState(initialHeightOffsetLimit, initialHeightOffset, initialContentOffset float32) *TopAppBarState {
+	s := &TopAppBarState{
+		heightOffsetLimit: initialHeightOffsetLimit,
+		contentOffset:     initialContentOffset,
+		revision:          gesturestate.NewRevision(),
+	}
+	s.heightOffset = gesturestate.Clamp(initialHeightOffset, initialHeightOffsetLimit, 0)
+	return s
+}
+
+// RememberTopAppBarState returns an expanded TopAppBarState that survives
+// recompositions. The app bar it is set on sets its height offset limit.
+func RememberTopAppBarState(c Composer) *TopAppBarState {
+	key := fmt.Sprintf("topAppBarState-%v", c.GenerateID())
+	revision := gesturestate.RememberRevision(c, key+"/revision")
+	return c.State(key, func() any {
+		s := NewTopAppBarState(-math.MaxFloat32, 0, 0)
+		s.revision = revision
+		return s
+	}).Get().(*TopAppBarState)
+}
+
+// HeightOffsetLimit returns the height offset of the fully collapsed app
+// bar: minus the height it collapses by.
+func (s *TopAppBarState) HeightOffsetLimit() float32 {
+	s.revision.Observe()
+	return s.heightOffsetLimit
+}
+
+// SetHeightOffsetLimit sets the height offset of the fully collapsed app
+// bar. App bars set it as they are laid out.
+func (s *TopAppBarState) SetHeightOffsetLimit(limit float32) {
+	if limit == s.heightOffsetLimit {
+		return
+	}
+	s.heightOffsetLimit = limit
+	s.heightOffset = gesturestate.Clamp(s.heightOffset, limit, 0)
+	s.revision.Bump()
+}
+
+// HeightOffset returns how far the app bar is collapsed, between the height
+// offset limit when collapsed and 0 when expanded.
+func (s *TopAppBarState) HeightOffset() float32 {
+	s.revision.Observe()
+	return s.heightOffset
+}
+
+// SetHeightOffset collapses the app bar to offset, clamped between the
+// height offset limit and 0. It stops the app bar settling.
+func (s *TopAppBarState) SetHeightOffset(offset float32) {
+	s.snap = nil
+	s.setHeightOffset(offset)
+}
+
+func (s *TopAppBarState) setHeightOffset(offset float32) {
+	offset = gesturestate.Clamp(offset, s.heightOffsetLimit, 0)
+	if offset == s.heightOffset {
+		return
+	}
+	s.heightOffset = offset
+	s.revision.Bump()
+}
+
+// ContentOffset returns how far the content below the app bar scrolled,
+// negative once scrolled towards its end.
+func (s *TopAppBarState) ContentOffset() float32 {
+	s.revision.Observe()
+	return s.contentOffset
+}
+
+// SetContentOffset sets how far the content below the app bar scrolled.
+func (s *TopAppBarState) SetContentOffset(offset float32) {
+	if offset == s.contentOffset {
+		return
+	}
+	s.contentOffset = offset
+	s.revision.Bump()
+}
+
+// CollapsedFraction returns how far the app bar is collapsed, from 0 when
+// expanded to 1 when collapsed.
+func (s *TopAppBarState) CollapsedFraction() float32 {
+	s.revision.Observe()
+	if s.heightOffsetLimit == 0 {
+		return 0
+	}
+	return s.heightOffset / s.heightOffsetLimit
+}
+
+// OverlappedFraction returns how far the content scrolled under the app
+// bar, from 0 when it did not to 1 once it scrolled by the height offset
+// limit.
+func (s *TopAppBarState) OverlappedFraction() float32 {
+	s.revision.Observe()
+	if s.heightOffsetLimit == 0 {
+		return 0
+	}
+	return 1 - gesturestate.Clamp(s.heightOffsetLimit-s.contentOffset, s.heightOffsetLimit, 0)/s.heightOffsetLimit
+}
+
+// settle animates a partly collapsed app bar to collapsed or expanded,
+// whichever the fling of velocity takes it nearer to, and returns the
+// velocity it consumed.
+func (s *TopAppBarState) settle(velocity float32) float32 {
+	fraction := s.CollapsedFraction()
+	if fraction < 0.01 || fraction == 1 {
+		return 0
+	}
+	target := float32(0)
+	if projected := gesturestate.Clamp(s.heightOffset+velocity*flingProjection, s.heightOffsetLimit, 0); projected/s.heightOffsetLimit >= 0.5 {
+		target = s.heightOffsetLimit
+	}
+	s.snap = &animation.FloatAnimation{
+		From:     s.heightOffset,
+		To:       target,
+		Duration: TopAppBarDefaults.SnapDuration(),
+		Started:  time.Now(),
+		Easing:   material3.EasingEmphasizedDecelerate.Transform,
+	}
+	s.revision.Bump()
+	return velocity
+}
+
+// advance moves a settling app bar to now and reports whether it is still
+// settling.
+func (s *TopAppBarState) advance(now time.Time) bool {
+	if s.snap == nil {
+		return false
+	}
+	offset, done := s.snap.ValueAt(now)
+	if done {
+		s.snap = nil
+	}
+	s.setHeightOffset(offset)
+	return !done
+}
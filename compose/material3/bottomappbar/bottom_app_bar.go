@@ -13,6 +13,7 @@ import (
 )
 
 // BottomAppBar displays navigation and key actions at the bottom of the screen.
+// With WithScrollBehavior, it hides as the content above it scrolls.
 func BottomAppBar(
 	actions Composable,
 	options ...BottomAppBarOption,
@@ -26,6 +27,11 @@ func BottomAppBar(
 			option(&opts)
 		}
 
+		modifier := opts.Modifier
+		if opts.ScrollBehavior != nil {
+			modifier = modifier.Then(scrollLayoutModifier(opts.ScrollBehavior.State()))
+		}
+
 		return surface.Surface(
 			func(c Composer) Composer {
 				return row.Row(
@@ -73,7 +79,7 @@ func BottomAppBar(
 
 				)(c)
 			},
-			surface.WithModifier(opts.Modifier),
+			surface.WithModifier(modifier),
 			surface.WithColor(opts.ContainerColor),
 			surface.WithTonalElevation(opts.TonalElevation),
 			// Check Surface implementation. It usually supports WithShadow (elevation).
@@ -0,0 +1,82 @@
+package bottomappbar
+
+import (
+	"fmt"
+
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+	"github.com/zodimo/go-compose/compose/ui/input/nestedscroll"
+)
+
+// BottomAppBarScrollBehavior hides or shows a bottom app bar as the content
+// above it scrolls. Pass its NestedScrollConnection to a
+// nestedscroll.NestedScroll modifier around the scrolling content, usually
+// that of the Scaffold, and the behavior to the app bar with
+// WithScrollBehavior.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/AppBar.kt
+type BottomAppBarScrollBehavior interface {
+	// State returns the state the behavior updates.
+	State() *BottomAppBarState
+	// NestedScrollConnection returns the connection to the scrolling of
+	// the content.
+	NestedScrollConnection() nestedscroll.NestedScrollConnection
+}
+
+// ExitAlwaysScrollBehavior returns a behavior hiding the app bar as the
+// content scrolls towards its end, and showing it as the content scrolls
+// back.
+func (d bottomAppBarDefaults) ExitAlwaysScrollBehavior(c Composer, options ...BottomAppBarScrollBehaviorOption) BottomAppBarScrollBehavior {
+	opts := DefaultBottomAppBarScrollBehaviorOptions()
+	for _, option := range options {
+		if option == nil {
+			continue
+		}
+		option(&opts)
+	}
+	if opts.State == nil {
+		opts.State = RememberBottomAppBarState(c)
+	}
+	key := fmt.Sprintf("exitAlwaysScrollBehavior-%v", c.GenerateID())
+	// The behavior is remembered for its connection to stay the same across
+	// recompositions, and takes the options of every composition.
+	behavior := c.State(key, func() any {
+		return &exitAlwaysScrollBehavior{}
+	}).Get().(*exitAlwaysScrollBehavior)
+	behavior.state, behavior.canScroll = opts.State, opts.CanScroll
+	return behavior
+}
+
+// exitAlwaysScrollBehavior hides the app bar by what the content scrolls.
+type exitAlwaysScrollBehavior struct {
+	state     *BottomAppBarState
+	canScroll func() bool
+}
+
+var _ nestedscroll.NestedScrollConnection = (*exitAlwaysScrollBehavior)(nil)
+
+func (b *exitAlwaysScrollBehavior) State() *BottomAppBarState { return b.state }
+
+func (b *exitAlwaysScrollBehavior) NestedScrollConnection() nestedscroll.NestedScrollConnection {
+	return b
+}
+
+func (b *exitAlwaysScrollBehavior) OnPreScroll(available geometry.Offset, source nestedscroll.NestedScrollSource) geometry.Offset {
+	return geometry.OffsetZero
+}
+
+func (b *exitAlwaysScrollBehavior) OnPostScroll(consumed, available geometry.Offset, source nestedscroll.NestedScrollSource) geometry.Offset {
+	b.state.SetContentOffset(b.state.contentOffset + consumed.Y())
+	if !b.canScroll() {
+		return geometry.OffsetZero
+	}
+	b.state.SetHeightOffset(b.state.heightOffset + consumed.Y())
+	return geometry.OffsetZero
+}
+
+func (b *exitAlwaysScrollBehavior) OnPreFling(available geometry.Offset) geometry.Offset {
+	return geometry.OffsetZero
+}
+
+func (b *exitAlwaysScrollBehavior) OnPostFling(consumed, available geometry.Offset) geometry.Offset {
+	return geometry.NewOffset(0, b.state.settle(available.Y()))
+}
@@ -0,0 +1,44 @@
+package bottomappbar
+
+import (
+	"testing"
+
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+	"github.com/zodimo/go-compose/compose/ui/input/nestedscroll"
+)
+
+func TestExitAlwaysScrollBehavior(t *testing.T) {
+	state := NewBottomAppBarState(-80, 0, 0)
+	canScroll := true
+	connection := (&exitAlwaysScrollBehavior{state: state, canScroll: func() bool { return canScroll }}).NestedScrollConnection()
+	drag := nestedscroll.NestedScrollSourceUserInput
+
+	// The app bar hides by what the content scrolls, up to its height.
+	connection.OnPostScroll(geometry.NewOffset(0, -50), geometry.OffsetZero, drag)
+	if got := state.HeightOffset(); got != -50 {
+		t.Errorf("height offset = %v, want -50", got)
+	}
+	connection.OnPostScroll(geometry.NewOffset(0, -50), geometry.OffsetZero, drag)
+	if got := state.CollapsedFraction(); got != 1 {
+		t.Errorf("collapsed fraction = %v, want 1", got)
+	}
+	connection.OnPostScroll(geometry.NewOffset(0, 20), geometry.OffsetZero, drag)
+	if got := state.HeightOffset(); got != -60 {
+		t.Errorf("height offset = %v after scrolling back, want -60", got)
+	}
+
+	// A partly hidden app bar settles after a fling.
+	connection.OnPostFling(geometry.OffsetZero, geometry.OffsetZero)
+	if state.snap == nil || state.snap.To != -80 {
+		t.Errorf("settle from -60 should animate to hidden, snap %+v", state.snap)
+	}
+
+	canScroll = false
+	connection.OnPostScroll(geometry.NewOffset(0, 40), geometry.OffsetZero, drag)
+	if got := state.ContentOffset(); got != -40 {
+		t.Errorf("content offset = %v, want it tracked while the app bar stays", got)
+	}
+	if got := state.HeightOffset(); got != -60 {
+		t.Errorf("height offset = %v, want it unchanged when the app bar cannot scroll", got)
+	}
+}
@@ -0,0 +1,159 @@
+package bottomappbar
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/zodimo/go-compose/compose/material3"
+	"github.com/zodimo/go-compose/internal/animation"
+	"github.com/zodimo/go-compose/internal/gesturestate"
+)
+
+// flingProjection is how far ahead, in seconds, the velocity of a fling is
+// followed to pick the value a bottom app bar settles at.
+const flingProjection = 0.1
+
+// BottomAppBarState is the state of a bottom app bar that hides with the
+// scrolling of the content above it, as set by a BottomAppBarScrollBehavior.
+// Offsets are in pixels.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/AppBar.kt
+type BottomAppBarState struct {
+	heightOffsetLimit float32
+	heightOffset      float32
+	contentOffset     float32
+
+	// snap is the animation settling a partly hidden app bar.
+	snap *animation.FloatAnimation
+
+	revision gesturestate.Revision
+}
+
+// NewBottomAppBarState creates a BottomAppBarState. Use
+// RememberBottomAppBarState to keep it across recompositions.
+func NewBottomAppBarState(initialHeightOffsetLimit, initialHeightOffset, initialContentOffset float32) *BottomAppBarState {
+	s := &BottomAppBarState{
+		heightOffsetLimit: initialHeightOffsetLimit,
+		contentOffset:     initialContentOffset,
+		revision:          gesturestate.NewRevision(),
+	}
+	s.heightOffset = gesturestate.Clamp(initialHeightOffset, initialHeightOffsetLimit, 0)
+	return s
+}
+
+// RememberBottomAppBarState returns a shown BottomAppBarState that survives
+// recompositions. The app bar it is set on sets its height offset limit.
+func RememberBottomAppBarState(c Composer) *BottomAppBarState {
+	key := fmt.Sprintf("bottomAppBarState-%v", c.GenerateID())
+	revision := gesturestate.RememberRevision(c, key+"/revision")
+	return c.State(key, func() any {
+		s := NewBottomAppBarState(-math.MaxFloat32, 0, 0)
+		s.revision = revision
+		return s
+	}).Get().(*BottomAppBarState)
+}
+
+// HeightOffsetLimit returns the height offset of the hidden app bar: minus
+// its height.
+func (s *BottomAppBarState) HeightOffsetLimit() float32 {
+	s.revision.Observe()
+	return s.heightOffsetLimit
+}
+
+// SetHeightOffsetLimit sets the height offset of the hidden app bar. App
+// bars set it as they are laid out.
+func (s *BottomAppBarState) SetHeightOffsetLimit(limit float32) {
+	if limit == s.heightOffsetLimit {
+		return
+	}
+	s.heightOffsetLimit = limit
+	s.heightOffset = gesturestate.Clamp(s.heightOffset, limit, 0)
+	s.revision.Bump()
+}
+
+// HeightOffset returns how far the app bar is hidden, between the height
+// offset limit when hidden and 0 when shown.
+func (s *BottomAppBarState) HeightOffset() float32 {
+	s.revision.Observe()
+	return s.heightOffset
+}
+
+// SetHeightOffset hides the app bar by offset, clamped between the height
+// offset limit and 0. It stops the app bar settling.
+func (s *BottomAppBarState) SetHeightOffset(offset float32) {
+	s.snap = nil
+	s.setHeightOffset(offset)
+}
+
+func (s *BottomAppBarState) setHeightOffset(offset float32) {
+	offset = gesturestate.Clamp(offset, s.heightOffsetLimit, 0)
+	if offset == s.heightOffset {
+		return
+	}
+	s.heightOffset = offset
+	s.revision.Bump()
+}
+
+// ContentOffset returns how far the content above the app bar scrolled,
+// negative once scrolled towards its end.
+func (s *BottomAppBarState) ContentOffset() float32 {
+	s.revision.Observe()
+	return s.contentOffset
+}
+
+// SetContentOffset sets how far the content above the app bar scrolled.
+func (s *BottomAppBarState) SetContentOffset(offset float32) {
+	if offset == s.contentOffset {
+		return
+	}
+	s.contentOffset = offset
+	s.revision.Bump()
+}
+
+// CollapsedFraction returns how far the app bar is hidden, from 0 when
+// shown to 1 when hidden.
+func (s *BottomAppBarState) CollapsedFraction() float32 {
+	s.revision.Observe()
+	if s.heightOffsetLimit == 0 {
+		return 0
+	}
+	return s.heightOffset / s.heightOffsetLimit
+}
+
+// settle animates a partly hidden app bar to hidden or shown, whichever the
+// fling of velocity takes it nearer to, and returns the velocity it
+// consumed.
+func (s *BottomAppBarState) settle(velocity float32) float32 {
+	fraction := s.CollapsedFraction()
+	if fraction < 0.01 || fraction == 1 {
+		return 0
+	}
+	target := float32(0)
+	if projected := gesturestate.Clamp(s.heightOffset+velocity*flingProjection, s.heightOffsetLimit, 0); projected/s.heightOffsetLimit >= 0.5 {
+		target = s.heightOffsetLimit
+	}
+	s.snap = &animation.FloatAnimation{
+		From:     s.heightOffset,
+		To:       target,
+		Duration: BottomAppBarDefaults.SnapDuration(),
+		Started:  time.Now(),
+		Easing:   material3.EasingEmphasizedDecelerate.Transform,
+	}
+	s.revision.Bump()
+	return velocity
+}
+
+// advance moves a settling app bar to now and reports whether it is still
+// settling.
+func (s *BottomAppBarState) advance(now time.Time) bool {
+	if s.snap == nil {
+		return false
+	}
+	offset, done := s.snap.ValueAt(now)
+	if done {
+		s.snap = nil
+	}
+	s.setHeightOffset(offset)
+	return !done
+}
@@ -1,6 +1,8 @@
 package bottomappbar
 
 import (
+	"time"
+
 	"github.com/zodimo/go-compose/compose/material3"
 	"github.com/zodimo/go-compose/compose/ui/graphics"
 	"github.com/zodimo/go-compose/compose/ui/unit"
@@ -38,3 +40,8 @@ func (d bottomAppBarDefaults) ContainerElevation() unit.Dp {
 func (d bottomAppBarDefaults) ContentPadding() (start, top, end, bottom unit.Dp) {
 	return unit.Dp(16), unit.Dp(12), unit.Dp(16), unit.Dp(12)
 }
+
+// SnapDuration is how long a partly hidden BottomAppBar takes to settle.
+func (d bottomAppBarDefaults) SnapDuration() time.Duration {
+	return material3.DefaultMotionTokens.DurationMedium2
+}
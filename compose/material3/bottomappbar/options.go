@@ -19,6 +19,7 @@ type BottomAppBarOptions struct {
 	TonalElevation       unit.Dp
 	ContentPadding       PaddingValues
 	FloatingActionButton Composable
+	ScrollBehavior       BottomAppBarScrollBehavior
 }
 
 // BottomAppBarOption is a function that configures a BottomAppBar.
@@ -77,3 +78,39 @@ func WithFloatingActionButton(fab Composable) BottomAppBarOption {
 		o.FloatingActionButton = fab
 	}
 }
+
+func WithScrollBehavior(scrollBehavior BottomAppBarScrollBehavior) BottomAppBarOption {
+	return func(o *BottomAppBarOptions) {
+		o.ScrollBehavior = scrollBehavior
+	}
+}
+
+// BottomAppBarScrollBehaviorOptions configures a BottomAppBarScrollBehavior.
+type BottomAppBarScrollBehaviorOptions struct {
+	// State is the state the behavior updates, remembered with
+	// RememberBottomAppBarState when not set.
+	State *BottomAppBarState
+	// CanScroll reports whether the app bar follows the scrolling of the
+	// content at all.
+	CanScroll func() bool
+}
+
+type BottomAppBarScrollBehaviorOption func(*BottomAppBarScrollBehaviorOptions)
+
+func DefaultBottomAppBarScrollBehaviorOptions() BottomAppBarScrollBehaviorOptions {
+	return BottomAppBarScrollBehaviorOptions{
+		CanScroll: func() bool { return true },
+	}
+}
+
+func WithScrollBehaviorState(state *BottomAppBarState) BottomAppBarScrollBehaviorOption {
+	return func(o *BottomAppBarScrollBehaviorOptions) {
+		o.State = state
+	}
+}
+
+func WithCanScroll(canScroll func() bool) BottomAppBarScrollBehaviorOption {
+	return func(o *BottomAppBarScrollBehaviorOptions) {
+		o.CanScroll = canScroll
+	}
+}
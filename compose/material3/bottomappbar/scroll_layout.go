@@ -0,0 +1,81 @@
+package bottomappbar
+
+import (
+	"image"
+	"math"
+
+	"github.com/zodimo/go-compose/compose/ui"
+	node "github.com/zodimo/go-compose/internal/Node"
+	"github.com/zodimo/go-compose/internal/layoutnode"
+	"github.com/zodimo/go-compose/internal/modifier"
+
+	"gioui.org/op"
+	"gioui.org/op/clip"
+)
+
+// scrollLayoutModifier lays a bottom app bar out hidden by the height offset
+// of state, which it limits to the height of the app bar: the app bar
+// reports a shorter size and its bottom is clipped, so that it slides down
+// out of the layout it is placed at the bottom of. It settles state and
+// requests frames for as long as state animates.
+func scrollLayoutModifier(state *BottomAppBarState) ui.Modifier {
+	return modifier.NewInspectableModifier(
+		modifier.NewModifier(&scrollLayoutElement{state: state}),
+		modifier.NewInspectorInfo("bottomAppBarScrollLayout", map[string]any{
+			"state": state,
+		}),
+	)
+}
+
+type scrollLayoutElement struct {
+	state *BottomAppBarState
+}
+
+func (e *scrollLayoutElement) Create() node.Node {
+	return newScrollLayoutNode(e)
+}
+
+func (e *scrollLayoutElement) Update(n node.Node) {
+	n.(*scrollLayoutNode).element = e
+}
+
+func (e *scrollLayoutElement) Equals(other modifier.Element) bool {
+	o, ok := other.(*scrollLayoutElement)
+	return ok && *o == *e
+}
+
+type scrollLayoutNode struct {
+	node.ChainNode
+	element *scrollLayoutElement
+}
+
+func newScrollLayoutNode(element *scrollLayoutElement) *scrollLayoutNode {
+	n := &scrollLayoutNode{element: element}
+	n.ChainNode = node.NewChainNode(
+		node.NewNodeID(),
+		node.NodeKindLayout,
+		node.LayoutPhase,
+		func(t node.TreeNode) {
+			t.(layoutnode.LayoutModifierNode).AttachLayoutModifier(func(widget layoutnode.LayoutWidget) layoutnode.LayoutWidget {
+				return layoutnode.NewLayoutWidget(func(gtx layoutnode.LayoutContext) layoutnode.LayoutDimensions {
+					state := n.element.state
+					if state.advance(gtx.Now) {
+						gtx.Execute(op.InvalidateCmd{})
+					}
+
+					macro := op.Record(gtx.Ops)
+					dims := widget.Layout(gtx)
+					call := macro.Stop()
+
+					state.SetHeightOffsetLimit(-float32(dims.Size.Y))
+					heightOffset := int(math.Round(float64(state.heightOffset)))
+					size := image.Pt(dims.Size.X, max(dims.Size.Y+heightOffset, 0))
+					defer clip.Rect{Max: size}.Push(gtx.Ops).Pop()
+					call.Add(gtx.Ops)
+					return layoutnode.LayoutDimensions{Size: size}
+				})
+			})
+		},
+	)
+	return n
+}
@@ -0,0 +1,238 @@
+package bottomsheet
+
+import (
+	"fmt"
+	"image"
+	"math"
+
+	"github.com/zodimo/go-compose/compose/foundation/gestures"
+	"github.com/zodimo/go-compose/compose/foundation/layout/box"
+	"github.com/zodimo/go-compose/compose/foundation/layout/column"
+	"github.com/zodimo/go-compose/compose/material3"
+	"github.com/zodimo/go-compose/compose/material3/surface"
+	"github.com/zodimo/go-compose/compose/ui"
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+	"github.com/zodimo/go-compose/compose/ui/input/nestedscroll"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+	node "github.com/zodimo/go-compose/internal/Node"
+	"github.com/zodimo/go-compose/internal/layoutnode"
+	"github.com/zodimo/go-compose/internal/modifier"
+	"github.com/zodimo/go-compose/modifiers/offset"
+	"github.com/zodimo/go-compose/modifiers/padding"
+	"github.com/zodimo/go-compose/modifiers/size"
+	"github.com/zodimo/go-compose/modifiers/weight"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+)
+
+// BottomSheetScaffold lays content out with a standard bottom sheet over it:
+// a persistent sheet showing sheetContent that peeks from the bottom edge,
+// and that can be dragged, by itself or by the scrollable content in it,
+// between its partially expanded and expanded values. content is padded at
+// the bottom by the peek height, so the sheet does not cover its end.
+//
+// Use RememberStandardBottomSheetState and WithScaffoldSheetState to expand,
+// partially expand or hide the sheet.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/BottomSheetScaffold.kt
+func BottomSheetScaffold(sheetContent Composable, content Composable, options ...BottomSheetScaffoldOption) Composable {
+	return func(c Composer) Composer {
+		opts := DefaultBottomSheetScaffoldOptions()
+		for _, option := range options {
+			if option == nil {
+				continue
+			}
+			option(&opts)
+		}
+
+		key := fmt.Sprintf("bottomSheetScaffold-%v", c.GenerateID())
+		state := opts.SheetState
+		if state == nil {
+			state = RememberStandardBottomSheetState(c)
+		}
+		connection := c.State(key+"/nestedScroll", func() any {
+			return &sheetNestedScrollConnection{}
+		}).Get().(*sheetNestedScrollConnection)
+		connection.state = state
+
+		colorScheme := material3.Theme(c).ColorScheme()
+		containerColor := opts.ContainerColor.TakeOrElse(colorScheme.Surface)
+		contentColor := opts.ContentColor.TakeOrElse(colorScheme.OnSurface)
+		sheetContainerColor := opts.SheetContainerColor.TakeOrElse(colorScheme.SurfaceContainerLow)
+		sheetContentColor := opts.SheetContentColor.TakeOrElse(colorScheme.OnSurface)
+
+		sheet := surface.Surface(
+			column.Column(
+				c.Sequence(
+					c.When(opts.SheetDragHandle != nil, opts.SheetDragHandle),
+					sheetContent,
+				),
+				column.WithAlignment(column.Middle),
+				column.WithModifier(size.FillMaxWidth()),
+			),
+			surface.WithShape(opts.SheetShape),
+			surface.WithColor(sheetContainerColor),
+			surface.WithContentColor(sheetContentColor),
+			surface.WithTonalElevation(BottomSheetDefaults.Elevation()),
+			surface.WithModifier(
+				sheetLayoutModifier(state, opts.SheetPeekHeight, opts.SheetMaxWidth).
+					Then(nestedscroll.NestedScroll(connection, nil)).
+					Then(gestures.AnchoredDraggable(
+						state.anchoredDraggableState,
+						gestures.OrientationVertical,
+						gestures.WithEnabled(opts.SheetSwipeEnabled),
+					)),
+			),
+		)
+
+		return surface.Surface(
+			box.Box(
+				c.Sequence(
+					// Layer 1: The top bar and the content
+					column.Column(
+						c.Sequence(
+							c.When(opts.TopBar != nil, opts.TopBar),
+							box.Box(
+								content,
+								box.WithModifier(
+									weight.Weight(1).
+										Then(padding.Padding(0, 0, 0, int(opts.SheetPeekHeight))),
+								),
+							),
+						),
+						column.WithModifier(size.FillMax()),
+					),
+					// Layer 2: The sheet
+					sheet,
+					// Layer 3: The snackbars, above the sheet
+					c.When(
+						opts.SnackbarHost != nil,
+						box.Box(
+							opts.SnackbarHost,
+							box.WithAlignment(box.S),
+							box.WithModifier(size.FillMax().Then(offset.OffsetFunc(func(hostSize unit.IntSize) geometry.Offset {
+								sheetOffset := state.anchoredDraggableState.Offset()
+								if math.IsNaN(float64(sheetOffset)) {
+									return geometry.OffsetZero
+								}
+								return geometry.NewOffset(0, min(sheetOffset-float32(hostSize.Height), 0))
+							}))),
+						),
+					),
+				),
+				box.WithModifier(size.FillMax()),
+			),
+			surface.WithColor(containerColor),
+			surface.WithContentColor(contentColor),
+			surface.WithModifier(opts.Modifier),
+		)(c)
+	}
+}
+
+// sheetLayoutModifier lays a sheet out at the offset of state from the top
+// of the layout, updating the anchors of state with the sizes of the sheet
+// and of the layout.
+func sheetLayoutModifier(state *SheetState, peekHeight, maxWidth unit.Dp) ui.Modifier {
+	return modifier.NewInspectableModifier(
+		modifier.NewModifier(&sheetLayoutElement{state: state, peekHeight: peekHeight, maxWidth: maxWidth}),
+		modifier.NewInspectorInfo("sheetLayout", map[string]any{
+			"state":      state,
+			"peekHeight": peekHeight,
+			"maxWidth":   maxWidth,
+		}),
+	)
+}
+
+type sheetLayoutElement struct {
+	state      *SheetState
+	peekHeight unit.Dp
+	maxWidth   unit.Dp
+}
+
+func (e *sheetLayoutElement) Create() node.Node {
+	return newSheetLayoutNode(e)
+}
+
+func (e *sheetLayoutElement) Update(n node.Node) {
+	n.(*sheetLayoutNode).element = e
+}
+
+func (e *sheetLayoutElement) Equals(other modifier.Element) bool {
+	o, ok := other.(*sheetLayoutElement)
+	return ok && *o == *e
+}
+
+type sheetLayoutNode struct {
+	node.ChainNode
+	element *sheetLayoutElement
+}
+
+func newSheetLayoutNode(element *sheetLayoutElement) *sheetLayoutNode {
+	n := &sheetLayoutNode{element: element}
+	n.ChainNode = node.NewChainNode(
+		node.NewNodeID(),
+		node.NodeKindLayout,
+		node.LayoutPhase,
+		func(t node.TreeNode) {
+			t.(layoutnode.LayoutModifierNode).AttachLayoutModifier(func(widget layoutnode.LayoutWidget) layoutnode.LayoutWidget {
+				return layoutnode.NewLayoutWidget(func(gtx layoutnode.LayoutContext) layoutnode.LayoutDimensions {
+					e := n.element
+					peekHeight := gtx.Dp(unit.DpToGioUnit(e.peekHeight))
+					maxWidth := gtx.Dp(unit.DpToGioUnit(e.maxWidth))
+					return layoutSheet(gtx, e.state, peekHeight, maxWidth, widget)
+				})
+			})
+		},
+	)
+	return n
+}
+
+// layoutSheet lays widget out as a sheet, at least peekHeight tall and at
+// most maxWidth wide, centered horizontally in the layout and at the offset
+// of state from its top. The sheet takes the whole layout.
+func layoutSheet(gtx layoutnode.LayoutContext, state *SheetState, peekHeight, maxWidth int, widget layoutnode.LayoutWidget) layoutnode.LayoutDimensions {
+	layoutSize := gtx.Constraints.Max
+	width := min(layoutSize.X, maxWidth)
+	sheetGtx := gtx
+	sheetGtx.Constraints = layout.Constraints{
+		Min: image.Pt(width, min(peekHeight, layoutSize.Y)),
+		Max: image.Pt(width, layoutSize.Y),
+	}
+
+	macro := op.Record(gtx.Ops)
+	dims := widget.Layout(sheetGtx)
+	call := macro.Stop()
+
+	draggable := state.anchoredDraggableState
+	draggable.UpdateAnchors(sheetAnchors(state, layoutSize.Y, dims.Size.Y, peekHeight))
+	sheetOffset := draggable.Offset()
+	if math.IsNaN(float64(sheetOffset)) {
+		sheetOffset = float32(layoutSize.Y)
+	}
+
+	pt := image.Pt((layoutSize.X-dims.Size.X)/2, int(math.Round(float64(sheetOffset))))
+	stack := op.Offset(pt).Push(gtx.Ops)
+	call.Add(gtx.Ops)
+	stack.Pop()
+
+	return layoutnode.LayoutDimensions{Size: layoutSize}
+}
+
+// sheetAnchors returns the offsets from the top of a layout layoutHeight
+// tall of a sheet sheetHeight tall at each of the values of state.
+func sheetAnchors(state *SheetState, layoutHeight, sheetHeight, peekHeight int) *gestures.DraggableAnchors[SheetValue] {
+	var anchors []gestures.Anchor[SheetValue]
+	if !state.skipPartiallyExpanded {
+		anchors = append(anchors, gestures.AnchorAt(PartiallyExpanded, float32(layoutHeight-peekHeight)))
+	}
+	// A sheet no taller than its peek height is fully shown when partially
+	// expanded.
+	if sheetHeight != peekHeight {
+		anchors = append(anchors, gestures.AnchorAt(Expanded, float32(max(layoutHeight-sheetHeight, 0))))
+	}
+	if !state.skipHiddenState {
+		anchors = append(anchors, gestures.AnchorAt(Hidden, float32(layoutHeight)))
+	}
+	return gestures.NewDraggableAnchors(anchors...)
+}
@@ -0,0 +1,152 @@
+package bottomsheet
+
+import (
+	"fmt"
+	"image"
+	"testing"
+
+	"github.com/zodimo/go-compose/compose"
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+	"github.com/zodimo/go-compose/compose/ui/input/nestedscroll"
+	"github.com/zodimo/go-compose/internal/layoutnode"
+	"github.com/zodimo/go-compose/state"
+	"github.com/zodimo/go-compose/store"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+	gioUnit "gioui.org/unit"
+)
+
+func testContext() layoutnode.LayoutContext {
+	return layout.Context{
+		Ops:         new(op.Ops),
+		Constraints: layout.Constraints{Max: image.Pt(400, 800)},
+		Metric:      gioUnit.Metric{PxPerDp: 1, PxPerSp: 1},
+	}
+}
+
+func sizedWidget(width, height int) layoutnode.LayoutWidget {
+	return layoutnode.NewLayoutWidget(func(gtx layoutnode.LayoutContext) layoutnode.LayoutDimensions {
+		return layoutnode.LayoutDimensions{Size: image.Pt(max(width, gtx.Constraints.Min.X), max(height, gtx.Constraints.Min.Y))}
+	})
+}
+
+func TestSheetAnchors(t *testing.T) {
+	tests := []struct {
+		name        string
+		state       *SheetState
+		sheetHeight int
+		want        map[SheetValue]float32
+	}{
+		{"all values", NewSheetState(), 300, map[SheetValue]float32{PartiallyExpanded: 744, Expanded: 500, Hidden: 800}},
+		{"skip hidden", NewSheetState(WithInitialValue(Expanded), WithSkipHiddenState(true)), 300, map[SheetValue]float32{PartiallyExpanded: 744, Expanded: 500}},
+		{"skip partially expanded", NewSheetState(WithSkipPartiallyExpanded(true)), 300, map[SheetValue]float32{Expanded: 500, Hidden: 800}},
+		{"peek sized sheet", NewSheetState(), 56, map[SheetValue]float32{PartiallyExpanded: 744, Hidden: 800}},
+		{"sheet taller than layout", NewSheetState(), 1000, map[SheetValue]float32{PartiallyExpanded: 744, Expanded: 0, Hidden: 800}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			anchors := sheetAnchors(tt.state, 800, tt.sheetHeight, 56)
+			if anchors.Size() != len(tt.want) {
+				t.Fatalf("anchors = %v, want %v", anchors, tt.want)
+			}
+			for value, want := range tt.want {
+				if got, ok := anchors.PositionOf(value); !ok || got != want {
+					t.Errorf("%v at %v, want %v", value, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestLayoutSheet(t *testing.T) {
+	state := NewSheetState(WithInitialValue(PartiallyExpanded))
+	dims := layoutSheet(testContext(), state, 56, 640, sizedWidget(0, 300))
+	if dims.Size != image.Pt(400, 800) {
+		t.Errorf("size = %v, want the layout size", dims.Size)
+	}
+	if got := state.RequireOffset(); got != 744 {
+		t.Errorf("offset = %v, want the peek height from the bottom", got)
+	}
+	if !state.HasExpandedState() || !state.HasPartiallyExpandedState() {
+		t.Error("a sheet taller than its peek height should expand and partially expand")
+	}
+
+	state.SnapTo(Expanded)
+	if got := state.RequireOffset(); got != 500 {
+		t.Errorf("expanded offset = %v, want 500", got)
+	}
+}
+
+func TestSheetStateHide(t *testing.T) {
+	state := NewSheetState(WithInitialValue(PartiallyExpanded), WithSkipHiddenState(true))
+	state.anchoredDraggableState.UpdateAnchors(sheetAnchors(state, 800, 300, 56))
+	state.Hide()
+	if state.TargetValue() != PartiallyExpanded {
+		t.Errorf("a sheet skipping the hidden value should not hide, target %v", state.TargetValue())
+	}
+	state.Expand()
+	if !state.IsAnimationRunning() || state.TargetValue() != Expanded {
+		t.Errorf("Expand should animate to Expanded, target %v", state.TargetValue())
+	}
+
+	hideable := NewSheetState(WithInitialValue(PartiallyExpanded))
+	hideable.anchoredDraggableState.UpdateAnchors(sheetAnchors(hideable, 800, 300, 56))
+	hideable.Hide()
+	if hideable.TargetValue() != Hidden {
+		t.Errorf("Hide should animate to Hidden, target %v", hideable.TargetValue())
+	}
+}
+
+func TestSheetNestedScrollConnection(t *testing.T) {
+	state := NewSheetState(WithInitialValue(PartiallyExpanded))
+	state.anchoredDraggableState.UpdateAnchors(sheetAnchors(state, 800, 300, 56))
+	connection := &sheetNestedScrollConnection{state: state}
+	drag := nestedscroll.NestedScrollSourceUserInput
+
+	// A drag up expands the sheet before the content scrolls, up to the
+	// expanded offset.
+	if got := connection.OnPreScroll(geometry.NewOffset(0, -100), drag); got.Y() != -100 {
+		t.Errorf("pre scroll consumed %v, want the whole drag", got)
+	}
+	if got := connection.OnPreScroll(geometry.NewOffset(0, -400), drag); got.Y() != -144 {
+		t.Errorf("pre scroll consumed %v, want up to the expanded offset", got)
+	}
+	// A drag down goes to the content first.
+	if got := connection.OnPreScroll(geometry.NewOffset(0, 50), drag); got != geometry.OffsetZero {
+		t.Errorf("pre scroll consumed %v of a drag down, want none", got)
+	}
+	if got := connection.OnPostScroll(geometry.OffsetZero, geometry.NewOffset(0, 50), drag); got.Y() != 50 {
+		t.Errorf("post scroll consumed %v, want what the content left", got)
+	}
+	// Flings of the content do not move the sheet.
+	if got := connection.OnPostScroll(geometry.OffsetZero, geometry.NewOffset(0, 50), nestedscroll.NestedScrollSourceSideEffect); got != geometry.OffsetZero {
+		t.Errorf("post scroll consumed %v of a fling, want none", got)
+	}
+
+	// A fling up from between the values settles the sheet expanded.
+	if got := connection.OnPreFling(geometry.NewOffset(0, -2000)); got.Y() != -2000 {
+		t.Errorf("pre fling consumed %v, want the whole fling", got)
+	}
+	if state.TargetValue() != Expanded {
+		t.Errorf("target = %v after a fling up, want Expanded", state.TargetValue())
+	}
+}
+
+func TestRememberStandardBottomSheetState(t *testing.T) {
+	store := store.NewPersistentState(map[string]state.MutableValue{})
+	var sheets []*SheetState
+	var following []*int
+	for range 2 {
+		c := compose.NewComposer(store)
+		sheets = append(sheets, RememberStandardBottomSheetState(c))
+		key := fmt.Sprintf("following-%v", c.GenerateID())
+		following = append(following, c.State(key, func() any { return new(int) }).Get().(*int))
+	}
+	if sheets[0] != sheets[1] {
+		t.Fatalf("RememberStandardBottomSheetState returned a new state on recomposition")
+	}
+	if following[0] != following[1] {
+		t.Errorf("RememberStandardBottomSheetState shifted the IDs generated after it")
+	}
+}
@@ -0,0 +1,70 @@
+package bottomsheet
+
+import (
+	"github.com/zodimo/go-compose/compose"
+	"github.com/zodimo/go-compose/compose/foundation/layout/box"
+	"github.com/zodimo/go-compose/compose/material3"
+	"github.com/zodimo/go-compose/compose/ui/graphics"
+	"github.com/zodimo/go-compose/compose/ui/graphics/shape"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+	"github.com/zodimo/go-compose/modifiers/background"
+	"github.com/zodimo/go-compose/modifiers/padding"
+	"github.com/zodimo/go-compose/modifiers/size"
+)
+
+// BottomSheetDefaults holds the default values of the bottom sheets.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/SheetDefaults.kt
+var BottomSheetDefaults = bottomSheetDefaults{}
+
+type bottomSheetDefaults struct{}
+
+// SheetPeekHeight is the height of a partially expanded standard sheet.
+func (bottomSheetDefaults) SheetPeekHeight() unit.Dp {
+	return 56
+}
+
+// SheetMaxWidth is the widest a sheet gets, centered on wider layouts.
+func (bottomSheetDefaults) SheetMaxWidth() unit.Dp {
+	return 640
+}
+
+// ExpandedShape is the shape of a sheet: only its top corners are rounded.
+func (bottomSheetDefaults) ExpandedShape() shape.Shape {
+	return &shape.RoundedCornerShape{TopStart: 28, TopEnd: 28}
+}
+
+// Elevation is the tonal elevation of a standard sheet.
+func (bottomSheetDefaults) Elevation() unit.Dp {
+	return 1
+}
+
+// PositionalThreshold is how far a sheet must be dragged towards the next
+// value to settle there when released without a fling.
+func (bottomSheetDefaults) PositionalThreshold() unit.Dp {
+	return 56
+}
+
+// VelocityThreshold is the release velocity, per second, above which a sheet
+// settles at the next value in the fling direction.
+func (bottomSheetDefaults) VelocityThreshold() unit.Dp {
+	return 125
+}
+
+// DragHandle is the handle shown above the content of a sheet.
+func (bottomSheetDefaults) DragHandle() Composable {
+	return func(c Composer) Composer {
+		color := graphics.SetOpacity(material3.Theme(c).ColorScheme().OnSurfaceVariant, 0.4)
+		return box.Box(
+			box.Box(
+				compose.Id(),
+				box.WithModifier(
+					size.Width(32).
+						Then(size.Height(4)).
+						Then(background.Background(color, background.WithShape(&shape.RoundedCornerShape{Radius: 2}))),
+				),
+			),
+			box.WithModifier(padding.Padding(0, 22, 0, 22)),
+		)(c)
+	}
+}
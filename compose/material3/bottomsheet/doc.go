@@ -1,5 +1,7 @@
 /*
-Package bottomsheet contains Material 3 Bottom Sheet components.
+Package bottomsheet contains Material 3 Bottom Sheet components: the
+ModalBottomSheet, and the standard sheet of a BottomSheetScaffold. Both are
+driven by a SheetState.
 
 Reference: [Bottom Sheets](https://m3.material.io/components/bottom-sheets/overview)
 Specs: [Bottom Sheets Specs](https://m3.material.io/components/bottom-sheets/specs)
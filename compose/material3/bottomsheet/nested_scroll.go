@@ -0,0 +1,56 @@
+package bottomsheet
+
+import (
+	"math"
+
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+	"github.com/zodimo/go-compose/compose/ui/input/nestedscroll"
+)
+
+// sheetNestedScrollConnection lets the scrollable content of a sheet drag
+// the sheet: a drag up expands the sheet before scrolling the content, and
+// what the content cannot scroll of a drag down collapses the sheet.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/SheetDefaults.kt
+type sheetNestedScrollConnection struct {
+	state *SheetState
+}
+
+var _ nestedscroll.NestedScrollConnection = (*sheetNestedScrollConnection)(nil)
+
+func (n *sheetNestedScrollConnection) OnPreScroll(available geometry.Offset, source nestedscroll.NestedScrollSource) geometry.Offset {
+	if delta := available.Y(); delta < 0 && source == nestedscroll.NestedScrollSourceUserInput {
+		return geometry.NewOffset(0, n.state.anchoredDraggableState.DispatchRawDelta(delta))
+	}
+	return geometry.OffsetZero
+}
+
+func (n *sheetNestedScrollConnection) OnPostScroll(consumed, available geometry.Offset, source nestedscroll.NestedScrollSource) geometry.Offset {
+	if source == nestedscroll.NestedScrollSourceUserInput {
+		return geometry.NewOffset(0, n.state.anchoredDraggableState.DispatchRawDelta(available.Y()))
+	}
+	return geometry.OffsetZero
+}
+
+func (n *sheetNestedScrollConnection) OnPreFling(available geometry.Offset) geometry.Offset {
+	draggable := n.state.anchoredDraggableState
+	offset := draggable.Offset()
+	if math.IsNaN(float64(offset)) {
+		return geometry.OffsetZero
+	}
+	// A fling up from a sheet that is not expanded yet expands it, instead
+	// of scrolling the content.
+	if velocity := available.Y(); velocity < 0 && offset > draggable.Anchors().MinPosition() {
+		draggable.Settle(velocity)
+		return available
+	}
+	return geometry.OffsetZero
+}
+
+func (n *sheetNestedScrollConnection) OnPostFling(consumed, available geometry.Offset) geometry.Offset {
+	if math.IsNaN(float64(n.state.anchoredDraggableState.Offset())) {
+		return geometry.OffsetZero
+	}
+	n.state.anchoredDraggableState.Settle(available.Y())
+	return available
+}
@@ -1,7 +1,10 @@
 package bottomsheet
 
 import (
+	"github.com/zodimo/go-compose/compose/ui"
 	"github.com/zodimo/go-compose/compose/ui/graphics"
+	"github.com/zodimo/go-compose/compose/ui/graphics/shape"
+	"github.com/zodimo/go-compose/compose/ui/unit"
 	"github.com/zodimo/go-compose/pkg/api"
 
 	"git.sr.ht/~schnwalter/gio-mw/token"
@@ -64,3 +67,165 @@ func WithScrimColor(col graphics.Color) ModalBottomSheetOption {
 }
 
 // Additional options for Shape, DragHandle, etc.
+
+type SheetStateOptions struct {
+	InitialValue SheetValue
+	// SkipPartiallyExpanded makes a sheet taller than its peek height go
+	// from hidden straight to expanded.
+	SkipPartiallyExpanded bool
+	// SkipHiddenState keeps the sheet shown: it can only be expanded or
+	// partially expanded.
+	SkipHiddenState bool
+	// ConfirmValueChange can veto the sheet settling at a new value after a
+	// drag.
+	ConfirmValueChange func(value SheetValue) bool
+}
+
+type SheetStateOption func(*SheetStateOptions)
+
+func WithInitialValue(value SheetValue) SheetStateOption {
+	return func(o *SheetStateOptions) {
+		o.InitialValue = value
+	}
+}
+
+func WithSkipPartiallyExpanded(skip bool) SheetStateOption {
+	return func(o *SheetStateOptions) {
+		o.SkipPartiallyExpanded = skip
+	}
+}
+
+func WithSkipHiddenState(skip bool) SheetStateOption {
+	return func(o *SheetStateOptions) {
+		o.SkipHiddenState = skip
+	}
+}
+
+func WithConfirmValueChange(confirm func(value SheetValue) bool) SheetStateOption {
+	return func(o *SheetStateOptions) {
+		o.ConfirmValueChange = confirm
+	}
+}
+
+func DefaultSheetStateOptions() SheetStateOptions {
+	return SheetStateOptions{
+		InitialValue:       Hidden,
+		ConfirmValueChange: func(SheetValue) bool { return true },
+	}
+}
+
+type BottomSheetScaffoldOptions struct {
+	Modifier ui.Modifier
+	// SheetState is the state of the sheet, remembered with
+	// RememberStandardBottomSheetState when not set.
+	SheetState *SheetState
+	// SheetPeekHeight is the height of the partially expanded sheet.
+	SheetPeekHeight unit.Dp
+	// SheetMaxWidth caps the width of the sheet, centered on wider layouts.
+	SheetMaxWidth       unit.Dp
+	SheetShape          shape.Shape
+	SheetContainerColor graphics.Color // Will use default if not set
+	SheetContentColor   graphics.Color // Will use default if not set
+	// SheetDragHandle is shown above the sheet content, nil for none.
+	SheetDragHandle   Composable
+	SheetSwipeEnabled bool
+	TopBar            Composable
+	SnackbarHost      Composable
+	ContainerColor    graphics.Color // Will use default if not set
+	ContentColor      graphics.Color // Will use default if not set
+}
+
+type BottomSheetScaffoldOption func(*BottomSheetScaffoldOptions)
+
+func WithScaffoldModifier(m ui.Modifier) BottomSheetScaffoldOption {
+	return func(o *BottomSheetScaffoldOptions) {
+		o.Modifier = m
+	}
+}
+
+func WithScaffoldSheetState(state *SheetState) BottomSheetScaffoldOption {
+	return func(o *BottomSheetScaffoldOptions) {
+		o.SheetState = state
+	}
+}
+
+func WithSheetPeekHeight(height unit.Dp) BottomSheetScaffoldOption {
+	return func(o *BottomSheetScaffoldOptions) {
+		o.SheetPeekHeight = height
+	}
+}
+
+func WithSheetMaxWidth(width unit.Dp) BottomSheetScaffoldOption {
+	return func(o *BottomSheetScaffoldOptions) {
+		o.SheetMaxWidth = width
+	}
+}
+
+func WithSheetShape(s shape.Shape) BottomSheetScaffoldOption {
+	return func(o *BottomSheetScaffoldOptions) {
+		o.SheetShape = s
+	}
+}
+
+func WithSheetContainerColor(col graphics.Color) BottomSheetScaffoldOption {
+	return func(o *BottomSheetScaffoldOptions) {
+		o.SheetContainerColor = col
+	}
+}
+
+func WithSheetContentColor(col graphics.Color) BottomSheetScaffoldOption {
+	return func(o *BottomSheetScaffoldOptions) {
+		o.SheetContentColor = col
+	}
+}
+
+func WithSheetDragHandle(dragHandle Composable) BottomSheetScaffoldOption {
+	return func(o *BottomSheetScaffoldOptions) {
+		o.SheetDragHandle = dragHandle
+	}
+}
+
+func WithSheetSwipeEnabled(enabled bool) BottomSheetScaffoldOption {
+	return func(o *BottomSheetScaffoldOptions) {
+		o.SheetSwipeEnabled = enabled
+	}
+}
+
+func WithScaffoldTopBar(topBar Composable) BottomSheetScaffoldOption {
+	return func(o *BottomSheetScaffoldOptions) {
+		o.TopBar = topBar
+	}
+}
+
+func WithScaffoldSnackbarHost(snackbarHost Composable) BottomSheetScaffoldOption {
+	return func(o *BottomSheetScaffoldOptions) {
+		o.SnackbarHost = snackbarHost
+	}
+}
+
+func WithScaffoldContainerColor(col graphics.Color) BottomSheetScaffoldOption {
+	return func(o *BottomSheetScaffoldOptions) {
+		o.ContainerColor = col
+	}
+}
+
+func WithScaffoldContentColor(col graphics.Color) BottomSheetScaffoldOption {
+	return func(o *BottomSheetScaffoldOptions) {
+		o.ContentColor = col
+	}
+}
+
+func DefaultBottomSheetScaffoldOptions() BottomSheetScaffoldOptions {
+	return BottomSheetScaffoldOptions{
+		Modifier:            ui.EmptyModifier,
+		SheetPeekHeight:     BottomSheetDefaults.SheetPeekHeight(),
+		SheetMaxWidth:       BottomSheetDefaults.SheetMaxWidth(),
+		SheetShape:          BottomSheetDefaults.ExpandedShape(),
+		SheetContainerColor: graphics.ColorUnspecified,
+		SheetContentColor:   graphics.ColorUnspecified,
+		SheetDragHandle:     BottomSheetDefaults.DragHandle(),
+		SheetSwipeEnabled:   true,
+		ContainerColor:      graphics.ColorUnspecified,
+		ContentColor:        graphics.ColorUnspecified,
+	}
+}
@@ -1,32 +1,212 @@
 package bottomsheet
 
 import (
+	"fmt"
+	"math"
 	"time"
 
+	"github.com/zodimo/go-compose/compose/foundation/gestures"
 	"github.com/zodimo/go-compose/internal/animation"
 )
 
+// SheetValue is the anchor a bottom sheet rests at.
+type SheetValue int
+
+const (
+	// Hidden means the sheet is off screen.
+	Hidden SheetValue = iota
+	// Expanded means the sheet is shown at its full height.
+	Expanded
+	// PartiallyExpanded means the sheet shows its peek height.
+	PartiallyExpanded
+)
+
+func (v SheetValue) String() string {
+	switch v {
+	case Hidden:
+		return "Hidden"
+	case Expanded:
+		return "Expanded"
+	case PartiallyExpanded:
+		return "PartiallyExpanded"
+	default:
+		return fmt.Sprintf("SheetValue(%d)", int(v))
+	}
+}
+
+// SheetState is the state of a ModalBottomSheet or of the sheet of a
+// BottomSheetScaffold.
+//
+// Show, Expand, PartialExpand and Hide only start the animation to the new
+// value and return, so they can be called from click handlers as well as
+// from effects.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/SheetState.kt
 type SheetState struct {
 	visibleAnim *animation.VisibilityAnimation
+
+	skipPartiallyExpanded bool
+	skipHiddenState       bool
+
+	anchoredDraggableState *gestures.AnchoredDraggableState[SheetValue]
+}
+
+// NewSheetState creates a SheetState, hidden unless set otherwise with
+// WithInitialValue. Use RememberStandardBottomSheetState to keep the state
+// of a BottomSheetScaffold across recompositions.
+func NewSheetState(options ...SheetStateOption) *SheetState {
+	opts := resolveSheetStateOptions(DefaultSheetStateOptions(), options)
+	s := newSheetState(opts)
+	s.anchoredDraggableState = gestures.NewAnchoredDraggableState(opts.InitialValue, s.anchoredDraggableOptions(opts)...)
+	return s
+}
+
+// RememberStandardBottomSheetState returns the SheetState of a
+// BottomSheetScaffold that survives recompositions. It starts partially
+// expanded and cannot be hidden, unless set otherwise with WithInitialValue
+// and WithSkipHiddenState. The options are only read the first time.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/BottomSheetScaffold.kt
+func RememberStandardBottomSheetState(c Composer, options ...SheetStateOption) *SheetState {
+	key := fmt.Sprintf("standardBottomSheetState-%v", c.GenerateID())
+	defaults := DefaultSheetStateOptions()
+	defaults.InitialValue = PartiallyExpanded
+	defaults.SkipHiddenState = true
+	opts := resolveSheetStateOptions(defaults, options)
+	// The anchored draggable state is remembered on every composition, not only
+	// when the sheet state is created, so it does not shift the IDs generated
+	// after it. Its options bind to s, which is only kept the first time.
+	s := newSheetState(opts)
+	s.anchoredDraggableState = gestures.RememberAnchoredDraggableState(c, opts.InitialValue, s.anchoredDraggableOptions(opts)...)
+	return c.State(key, func() any {
+		return s
+	}).Get().(*SheetState)
 }
 
-func NewSheetState() *SheetState {
+func resolveSheetStateOptions(opts SheetStateOptions, options []SheetStateOption) SheetStateOptions {
+	for _, option := range options {
+		if option == nil {
+			continue
+		}
+		option(&opts)
+	}
+	if opts.SkipPartiallyExpanded && opts.InitialValue == PartiallyExpanded {
+		panic("bottomsheet: the initial value cannot be PartiallyExpanded when skipPartiallyExpanded is set")
+	}
+	if opts.SkipHiddenState && opts.InitialValue == Hidden {
+		panic("bottomsheet: the initial value cannot be Hidden when skipHiddenState is set")
+	}
+	return opts
+}
+
+func newSheetState(opts SheetStateOptions) *SheetState {
+	visibleAnim := &animation.VisibilityAnimation{
+		Duration: time.Millisecond * 300,
+		State:    animation.Invisible,
+	}
+	if opts.InitialValue != Hidden {
+		visibleAnim.State = animation.Visible
+	}
 	return &SheetState{
-		visibleAnim: &animation.VisibilityAnimation{
-			Duration: time.Millisecond * 300,
-			State:    animation.Invisible,
-		},
+		visibleAnim:           visibleAnim,
+		skipPartiallyExpanded: opts.SkipPartiallyExpanded,
+		skipHiddenState:       opts.SkipHiddenState,
 	}
 }
 
+func (s *SheetState) anchoredDraggableOptions(opts SheetStateOptions) []gestures.AnchoredDraggableStateOption {
+	threshold := BottomSheetDefaults.PositionalThreshold()
+	return []gestures.AnchoredDraggableStateOption{
+		gestures.WithPositionalThreshold(func(float32) float32 {
+			return s.anchoredDraggableState.Density().DpToPx(threshold)
+		}),
+		gestures.WithVelocityThreshold(BottomSheetDefaults.VelocityThreshold()),
+		gestures.WithConfirmValueChange(opts.ConfirmValueChange),
+	}
+}
+
+// CurrentValue returns the value the sheet rests at, or last rested at while
+// being dragged or animating.
+func (s *SheetState) CurrentValue() SheetValue {
+	return s.anchoredDraggableState.CurrentValue()
+}
+
+// TargetValue returns the value the sheet is animating to, or would settle
+// at if released now.
+func (s *SheetState) TargetValue() SheetValue {
+	return s.anchoredDraggableState.TargetValue()
+}
+
+// HasExpandedState reports whether the sheet can be expanded: a sheet no
+// taller than its peek height only partially expands.
+func (s *SheetState) HasExpandedState() bool {
+	return s.anchoredDraggableState.Anchors().HasAnchorFor(Expanded)
+}
+
+// HasPartiallyExpandedState reports whether the sheet can be partially
+// expanded.
+func (s *SheetState) HasPartiallyExpandedState() bool {
+	return s.anchoredDraggableState.Anchors().HasAnchorFor(PartiallyExpanded)
+}
+
+// RequireOffset returns the distance, in pixels, from the top of the layout
+// to the top of the sheet, panicking when the sheet was not laid out yet.
+func (s *SheetState) RequireOffset() float32 {
+	return s.anchoredDraggableState.RequireOffset()
+}
+
+// IsAnimationRunning reports whether the sheet is settling at a value.
+func (s *SheetState) IsAnimationRunning() bool {
+	return s.anchoredDraggableState.IsAnimationRunning()
+}
+
+// Show shows the sheet, partially expanded when it can be.
 func (s *SheetState) Show() {
 	s.visibleAnim.Appear(time.Now())
+	if !s.skipPartiallyExpanded && s.anchorsUnknownOrHave(PartiallyExpanded) {
+		s.anchoredDraggableState.AnimateTo(PartiallyExpanded)
+		return
+	}
+	s.anchoredDraggableState.AnimateTo(Expanded)
+}
+
+// Expand animates the sheet to its full height.
+func (s *SheetState) Expand() {
+	s.anchoredDraggableState.AnimateTo(Expanded)
+}
+
+// PartialExpand animates the sheet to its peek height. It does nothing when
+// the state skips the partially expanded value.
+func (s *SheetState) PartialExpand() {
+	if s.skipPartiallyExpanded {
+		return
+	}
+	s.anchoredDraggableState.AnimateTo(PartiallyExpanded)
 }
 
+// Hide hides the sheet. A standard sheet state skips the hidden value
+// unless created with WithSkipHiddenState(false): Hide then leaves it shown.
 func (s *SheetState) Hide() {
+	if s.skipHiddenState {
+		return
+	}
 	s.visibleAnim.Disappear(time.Now())
+	s.anchoredDraggableState.AnimateTo(Hidden)
+}
+
+// SnapTo moves the sheet to value without animation.
+func (s *SheetState) SnapTo(value SheetValue) {
+	s.anchoredDraggableState.SnapTo(value)
 }
 
+// IsVisible reports whether the sheet is shown, or animating.
 func (s *SheetState) IsVisible() bool {
-	return s.visibleAnim.Visible()
+	return s.visibleAnim.Visible() || s.anchoredDraggableState.CurrentValue() != Hidden
+}
+
+// anchorsUnknownOrHave reports whether value has an anchor, assuming it does
+// until the sheet is laid out.
+func (s *SheetState) anchorsUnknownOrHave(value SheetValue) bool {
+	anchors := s.anchoredDraggableState.Anchors()
+	return anchors == nil || math.IsNaN(float64(s.anchoredDraggableState.Offset())) || anchors.HasAnchorFor(value)
 }
@@ -0,0 +1,120 @@
+package carousel
+
+import (
+	"math"
+)
+
+// mediumItemFlexPercentage is how much medium items may grow or shrink to
+// bring large items closer to their target size.
+const mediumItemFlexPercentage = 0.1
+
+// arrangement is a number of large, medium and small items, and their sizes,
+// that fill a carousel.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/carousel/Arrangement.kt
+type arrangement struct {
+	priority    int
+	smallSize   float32
+	smallCount  int
+	mediumSize  float32
+	mediumCount int
+	largeSize   float32
+	largeCount  int
+	cost        float32
+}
+
+func (a arrangement) itemCount() int {
+	return a.largeCount + a.mediumCount + a.smallCount
+}
+
+// arrangementTargets are the sizes an arrangement tries to give its items.
+type arrangementTargets struct {
+	availableSpace, itemSpacing           float32
+	smallSize, minSmallSize, maxSmallSize float32
+	mediumSize, largeSize                 float32
+}
+
+// findLowestCostArrangement returns the arrangement of the given counts
+// whose large items are closest to their target size, favoring the counts
+// that come first, and false when there is none.
+func findLowestCostArrangement(t arrangementTargets, smallCounts, mediumCounts, largeCounts []int) (arrangement, bool) {
+	var lowest arrangement
+	found := false
+	priority := 1
+	for _, largeCount := range largeCounts {
+		for _, mediumCount := range mediumCounts {
+			for _, smallCount := range smallCounts {
+				a := fitArrangement(t, priority, smallCount, mediumCount, largeCount)
+				if !found || a.cost < lowest.cost {
+					lowest, found = a, true
+					if lowest.cost == 0 {
+						return lowest, true
+					}
+				}
+				priority++
+			}
+		}
+	}
+	return lowest, found
+}
+
+// fitArrangement sizes smallCount, mediumCount and largeCount items to fill
+// the available space: small items first flex within their size range, then
+// large items take the rest, medium items being between small and large
+// ones.
+func fitArrangement(t arrangementTargets, priority, smallCount, mediumCount, largeCount int) arrangement {
+	a := arrangement{
+		priority:    priority,
+		smallSize:   min(max(t.smallSize, t.minSmallSize), t.maxSmallSize),
+		smallCount:  smallCount,
+		mediumSize:  t.mediumSize,
+		mediumCount: mediumCount,
+		largeSize:   t.largeSize,
+		largeCount:  largeCount,
+	}
+	space := t.availableSpace - float32(max(a.itemCount()-1, 0))*t.itemSpacing
+
+	delta := space - (a.largeSize*float32(largeCount) + a.mediumSize*float32(mediumCount) + a.smallSize*float32(smallCount))
+	if smallCount > 0 && delta > 0 {
+		a.smallSize += min(delta/float32(smallCount), t.maxSmallSize-a.smallSize)
+	} else if smallCount > 0 && delta < 0 {
+		a.smallSize += max(delta/float32(smallCount), t.minSmallSize-a.smallSize)
+	}
+	if smallCount == 0 {
+		a.smallSize = 0
+	}
+	a.largeSize = (space - (float32(smallCount)+float32(mediumCount)/2)*a.smallSize) /
+		(float32(largeCount) + float32(mediumCount)/2)
+	a.mediumSize = (a.largeSize + a.smallSize) / 2
+
+	if mediumCount > 0 && a.largeSize != t.largeSize {
+		targetAdjustment := (t.largeSize - a.largeSize) * float32(largeCount)
+		availableMediumFlex := mediumItemFlexPercentage * a.mediumSize * float32(mediumCount)
+		distribute := min(float32(math.Abs(float64(targetAdjustment))), availableMediumFlex)
+		if targetAdjustment > 0 {
+			a.mediumSize -= distribute / float32(mediumCount)
+			a.largeSize += distribute / float32(largeCount)
+		} else {
+			a.mediumSize += distribute / float32(mediumCount)
+			a.largeSize -= distribute / float32(largeCount)
+		}
+	}
+
+	a.cost = math.MaxFloat32
+	if a.isValid() {
+		a.cost = float32(math.Abs(float64(t.largeSize-a.largeSize))) * float32(priority)
+	}
+	return a
+}
+
+// isValid reports whether large items are larger than medium ones, which
+// are larger than small ones.
+func (a arrangement) isValid() bool {
+	if a.largeCount > 0 && a.smallCount > 0 && a.mediumCount > 0 {
+		return a.largeSize > a.mediumSize && a.mediumSize > a.smallSize
+	}
+	if a.largeCount > 0 && a.smallCount > 0 {
+		return a.largeSize > a.smallSize
+	}
+	return true
+}
@@ -0,0 +1,190 @@
+package carousel
+
+import (
+	"github.com/zodimo/go-compose/compose/foundation/layout/box"
+	"github.com/zodimo/go-compose/compose/foundation/pager"
+	"github.com/zodimo/go-compose/compose/ui/graphics/shape"
+	"github.com/zodimo/go-compose/compose/ui/platform"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+)
+
+// HorizontalMultiBrowseCarousel is a Material 3 carousel showing large
+// items as close to preferredItemWidth as fit, followed by a medium and a
+// small item that items shrink into as they scroll out at the end, and out
+// of at the start. The first and last items are large at the ends of the
+// carousel, which snaps to the large items.
+//
+// content returns item, with a scope telling how the item is shown.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/carousel/Carousel.kt
+func HorizontalMultiBrowseCarousel(
+	state *CarouselState,
+	preferredItemWidth unit.Dp,
+	content func(scope CarouselItemScope, item int) Composable,
+	options ...CarouselOption,
+) Composable {
+	return carousel(state, func(density unit.Density, availableSpace, itemSpacing float32, opts CarouselOptions) keylineList {
+		return multiBrowseKeylineList(
+			availableSpace,
+			density.DpToPx(preferredItemWidth),
+			itemSpacing,
+			state.ItemCount(),
+			density.DpToPx(opts.MinSmallItemWidth),
+			density.DpToPx(opts.MaxSmallItemWidth),
+			density.DpToPx(CarouselDefaults.AnchorSize()),
+		)
+	}, content, options)
+}
+
+// HorizontalUncontainedCarousel is a Material 3 carousel showing items of
+// itemWidth, the last one in view cut by the end of the carousel and
+// shrinking as it scrolls out.
+//
+// It takes the arguments and options of HorizontalMultiBrowseCarousel.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/carousel/Carousel.kt
+func HorizontalUncontainedCarousel(
+	state *CarouselState,
+	itemWidth unit.Dp,
+	content func(scope CarouselItemScope, item int) Composable,
+	options ...CarouselOption,
+) Composable {
+	return carousel(state, func(density unit.Density, availableSpace, itemSpacing float32, opts CarouselOptions) keylineList {
+		return uncontainedKeylineList(
+			availableSpace,
+			density.DpToPx(itemWidth),
+			itemSpacing,
+			density.DpToPx(CarouselDefaults.MinSmallItemSize()),
+			density.DpToPx(CarouselDefaults.AnchorSize()),
+		)
+	}, content, options)
+}
+
+// HorizontalCenteredHeroCarousel is a Material 3 carousel showing a large
+// item centered between small items, that items shrink into as they scroll
+// out on either side. The large item takes the room the small items leave,
+// up to WithMaxItemWidth. At the ends of the carousel, the first and last
+// items are large at its edges.
+//
+// It takes the arguments and options of HorizontalMultiBrowseCarousel.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/carousel/Carousel.kt
+func HorizontalCenteredHeroCarousel(
+	state *CarouselState,
+	content func(scope CarouselItemScope, item int) Composable,
+	options ...CarouselOption,
+) Composable {
+	return carousel(state, func(density unit.Density, availableSpace, itemSpacing float32, opts CarouselOptions) keylineList {
+		maxItemSize := availableSpace
+		if opts.MaxItemWidth.IsSpecified() {
+			maxItemSize = density.DpToPx(opts.MaxItemWidth)
+		}
+		return heroKeylineList(
+			availableSpace,
+			maxItemSize,
+			itemSpacing,
+			state.ItemCount(),
+			density.DpToPx(opts.MinSmallItemWidth),
+			density.DpToPx(opts.MaxSmallItemWidth),
+			density.DpToPx(CarouselDefaults.AnchorSize()),
+		)
+	}, content, options)
+}
+
+// keylinesFunc returns the default keylines of a carousel of availableSpace
+// pixels, whose items are itemSpacing pixels apart.
+type keylinesFunc func(density unit.Density, availableSpace, itemSpacing float32, opts CarouselOptions) keylineList
+
+// carousel lays the items out with a HorizontalPager, whose pages are as
+// large as the focal items, and masks and moves each item to its keyline.
+func carousel(state *CarouselState, keylines keylinesFunc, content func(scope CarouselItemScope, item int) Composable, options []CarouselOption) Composable {
+	opts := DefaultCarouselOptions()
+	for _, option := range options {
+		if option == nil {
+			continue
+		}
+		option(&opts)
+	}
+	return func(c Composer) Composer {
+		rtl := platform.LocalLayoutDirection.Current(c) == unit.LayoutDirectionRtl
+		pageSize := &carouselPageSize{keylines: keylines, opts: opts}
+		return pager.HorizontalPager(
+			state.pagerState,
+			func(item int) Composable {
+				return carouselItem(state, pageSize, item, rtl, content)
+			},
+			pager.WithModifier(opts.Modifier),
+			pager.WithPageSize(pageSize),
+			pager.WithPageSpacing(opts.ItemSpacing),
+			pager.WithSnapPosition(keylineSnapPosition{pageSize}),
+			pager.WithBeyondViewportPageCount(beyondViewportItemCount),
+			pager.WithUserScrollEnabled(opts.UserScrollEnabled),
+		)(c)
+	}
+}
+
+// carouselItem composes content for item, masked and moved to where the
+// keylines place it at the scroll position of state.
+func carouselItem(state *CarouselState, pageSize *carouselPageSize, item int, rtl bool, content func(scope CarouselItemScope, item int) Composable) Composable {
+	return func(c Composer) Composer {
+		s := pageSize.strategy
+		if s == nil || !s.isValid() {
+			return box.Box(content(&carouselItemScope{rtl: rtl}, item))(c)
+		}
+		snapOffset := round(s.snapOffset())
+		scrollOffset := state.scrollOffset(pageSize.itemSize, pageSize.itemSpacing, pageSize.availableSpace, snapOffset)
+		maxScroll := maxScrollOffset(state.ItemCount(), pageSize.itemSize, pageSize.itemSpacing, pageSize.availableSpace)
+		info, translation := s.itemLayout(item, pageSize.itemSize, pageSize.itemSpacing, state.itemCrossAxisSize, scrollOffset, float32(maxScroll))
+
+		return box.Box(
+			content(&carouselItemScope{info: info, rtl: rtl}, item),
+			box.WithModifier(maskModifier(maskData{
+				itemSize:    pageSize.itemSize,
+				maskStart:   info.MaskRect.Left,
+				maskEnd:     info.MaskRect.Right,
+				shape:       shape.ShapeRectangle,
+				translation: translation,
+				rtl:         rtl,
+				onLayout: func(crossAxisSize int) {
+					state.itemCrossAxisSize = crossAxisSize
+				},
+			})),
+		)(c)
+	}
+}
+
+// carouselPageSize sizes the pages of the pager of a carousel as its focal
+// items, keeping the strategy of the last measurement for the items to be
+// placed with.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/carousel/Carousel.kt
+type carouselPageSize struct {
+	keylines keylinesFunc
+	opts     CarouselOptions
+
+	strategy                              *strategy
+	itemSize, itemSpacing, availableSpace int
+}
+
+func (p *carouselPageSize) CalculateMainAxisPageSize(density unit.Density, availableSpace, pageSpacing int) int {
+	space, spacing := float32(availableSpace), float32(pageSpacing)
+	p.strategy = newStrategy(p.keylines(density, space, spacing, p.opts), space, spacing)
+	p.itemSize, p.itemSpacing, p.availableSpace = availableSpace, pageSpacing, availableSpace
+	if p.strategy.isValid() {
+		p.itemSize = round(p.strategy.itemMainAxisSize)
+	}
+	return p.itemSize
+}
+
+// keylineSnapPosition settles the items of a carousel at the start of its
+// first focal keyline.
+type keylineSnapPosition struct {
+	pageSize *carouselPageSize
+}
+
+func (p keylineSnapPosition) Position(layoutSize, itemSize, itemIndex, itemCount int) int {
+	if p.pageSize.strategy == nil {
+		return 0
+	}
+	return round(p.pageSize.strategy.snapOffset())
+}
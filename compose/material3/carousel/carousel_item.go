@@ -0,0 +1,54 @@
+package carousel
+
+import (
+	"github.com/zodimo/go-compose/compose/ui"
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+	"github.com/zodimo/go-compose/compose/ui/graphics/shape"
+)
+
+// CarouselItemInfo tells how an item of a carousel is shown, in pixels.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/carousel/CarouselItemInfo.kt
+type CarouselItemInfo struct {
+	// Size is the size of the part of the item shown, along the carousel.
+	Size float32
+	// MinSize and MaxSize are the sizes of the smallest and the largest,
+	// focal, items of the carousel.
+	MinSize float32
+	MaxSize float32
+	// MaskRect is the part of the item shown, from its top start corner. Its
+	// height is that of the item in the last layout.
+	MaskRect geometry.Rect
+}
+
+// CarouselItemScope is the scope of the content of an item of a carousel.
+// The content is measured as large as the focal items, and masked and moved
+// as the item shrinks towards the edges of the carousel; it usually fills
+// the item.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/carousel/CarouselItemScope.kt
+type CarouselItemScope interface {
+	// CarouselItemInfo returns how the item is shown.
+	CarouselItemInfo() CarouselItemInfo
+	// MaskClip clips content filling the item to shape, following the part
+	// of the item shown.
+	MaskClip(s shape.Shape) ui.Modifier
+}
+
+type carouselItemScope struct {
+	info CarouselItemInfo
+	rtl  bool
+}
+
+func (s *carouselItemScope) CarouselItemInfo() CarouselItemInfo {
+	return s.info
+}
+
+func (s *carouselItemScope) MaskClip(sh shape.Shape) ui.Modifier {
+	return maskModifier(maskData{
+		maskStart: s.info.MaskRect.Left,
+		maskEnd:   s.info.MaskRect.Right,
+		shape:     sh,
+		rtl:       s.rtl,
+	})
+}
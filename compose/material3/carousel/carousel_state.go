@@ -0,0 +1,90 @@
+package carousel
+
+import (
+	"fmt"
+
+	"github.com/zodimo/go-compose/compose/foundation/pager"
+)
+
+// CarouselState holds the scroll position of a carousel. It scrolls like a
+// pager, each item being a page, and settles with an item at its snap
+// position.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/carousel/CarouselState.kt
+type CarouselState struct {
+	pagerState *pager.PagerState
+	// itemCrossAxisSize is the size of the items across the carousel in the
+	// last layout.
+	itemCrossAxisSize int
+}
+
+// NewCarouselState creates a state showing currentItem, of the items of a
+// carousel of itemCount items.
+func NewCarouselState(currentItem int, itemCount func() int) *CarouselState {
+	if itemCount == nil {
+		panic("NewCarouselState: itemCount cannot be nil")
+	}
+	return &CarouselState{pagerState: pager.NewPagerState(currentItem, itemCount)}
+}
+
+// RememberCarouselState returns a CarouselState that survives
+// recompositions. itemCount is updated on every composition.
+func RememberCarouselState(c Composer, initialItem int, itemCount func() int) *CarouselState {
+	if itemCount == nil {
+		panic("RememberCarouselState: itemCount cannot be nil")
+	}
+	pagerState := pager.RememberPagerState(c, initialItem, itemCount)
+	key := fmt.Sprintf("carouselState-%v", c.GenerateID())
+	return c.State(key, func() any {
+		return &CarouselState{pagerState: pagerState}
+	}).Get().(*CarouselState)
+}
+
+// ItemCount returns the number of items.
+func (s *CarouselState) ItemCount() int {
+	return s.pagerState.PageCount()
+}
+
+// CurrentItem returns the item closest to the snap position of the
+// carousel.
+func (s *CarouselState) CurrentItem() int {
+	return s.pagerState.CurrentPage()
+}
+
+// IsScrollInProgress reports whether the carousel is dragged or settling.
+func (s *CarouselState) IsScrollInProgress() bool {
+	return s.pagerState.IsScrollInProgress()
+}
+
+// ScrollToItem shows item without animation.
+func (s *CarouselState) ScrollToItem(item int) {
+	s.pagerState.ScrollToPage(item)
+}
+
+// AnimateScrollToItem scrolls to item with the animation the carousel
+// settles with.
+func (s *CarouselState) AnimateScrollToItem(item int) {
+	s.pagerState.AnimateScrollToPage(item)
+}
+
+// DispatchRawDelta scrolls the carousel by delta pixels towards the last
+// item, without settling, and returns the delta that was consumed.
+func (s *CarouselState) DispatchRawDelta(delta float32) float32 {
+	return s.pagerState.DispatchRawDelta(delta)
+}
+
+// scrollOffset returns the scroll position, in pixels, of a carousel whose
+// items of itemSize are itemSpacing apart in a carousel of availableSpace,
+// and settle at snapOffset.
+func (s *CarouselState) scrollOffset(itemSize, itemSpacing, availableSpace, snapOffset int) float32 {
+	stride := itemSize + itemSpacing
+	item := s.CurrentItem()
+	position := max(min(item*stride-snapOffset, maxScrollOffset(s.ItemCount(), itemSize, itemSpacing, availableSpace)), 0)
+	return float32(position) + s.pagerState.CurrentPageOffsetFraction()*float32(stride)
+}
+
+// maxScrollOffset returns how far itemCount items of itemSize, itemSpacing
+// apart, scroll in a carousel of availableSpace.
+func maxScrollOffset(itemCount, itemSize, itemSpacing, availableSpace int) int {
+	return max(itemCount*(itemSize+itemSpacing)-itemSpacing-availableSpace, 0)
+}
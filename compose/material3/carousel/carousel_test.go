@@ -0,0 +1,163 @@
+package carousel
+
+import (
+	"image"
+	"math"
+	"testing"
+
+	"github.com/zodimo/go-compose/compose"
+	"github.com/zodimo/go-compose/compose/foundation/layout/box"
+	"github.com/zodimo/go-compose/compose/ui/graphics/shape"
+	"github.com/zodimo/go-compose/modifiers/size"
+	"github.com/zodimo/go-compose/runtime"
+	"github.com/zodimo/go-compose/state"
+	"github.com/zodimo/go-compose/store"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+	gioUnit "gioui.org/unit"
+)
+
+func near(a, b float32) bool {
+	return math.Abs(float64(a-b)) < 0.01
+}
+
+// shownSpace returns the space the items of l that are not anchors take,
+// with the spacing between them.
+func shownSpace(l keylineList, itemSpacing float32) float32 {
+	var space float32
+	for _, k := range l.keylines[l.firstNonAnchorIndex : l.lastNonAnchorIndex+1] {
+		space += k.size - k.cutoff + itemSpacing
+	}
+	return space - itemSpacing
+}
+
+func TestMultiBrowseKeylineList(t *testing.T) {
+	l := multiBrowseKeylineList(400, 186, 8, 10, 40, 56, 10)
+	var sizes []float32
+	for _, k := range l.keylines {
+		sizes = append(sizes, k.size)
+	}
+	if len(sizes) != 5 || !l.keylines[0].isAnchor || !l.keylines[4].isAnchor {
+		t.Fatalf("keyline sizes = %v, want an anchor, a large, a medium and a small item, and an anchor", sizes)
+	}
+	if !(sizes[1] > sizes[2] && sizes[2] > sizes[3]) || sizes[3] < 40 || sizes[3] > 56 {
+		t.Errorf("item sizes = %v, want decreasing sizes, the small one in [40, 56]", sizes[1:4])
+	}
+	if got := shownSpace(l, 8); !near(got, 400) {
+		t.Errorf("items take %v pixels, want 400", got)
+	}
+	if first := l.firstFocal(); !near(first.offset-first.size/2, 0) {
+		t.Errorf("first focal item starts at %v, want 0", first.offset-first.size/2)
+	}
+
+	// With fewer items than the arrangement holds, the small item goes.
+	if got := multiBrowseKeylineList(400, 186, 8, 2, 40, 56, 10); len(got.keylines) != 4 {
+		t.Errorf("2 items: %d keylines, want 4", len(got.keylines))
+	}
+	if got := multiBrowseKeylineList(0, 186, 8, 10, 40, 56, 10); !got.isEmpty() {
+		t.Error("keylines of an empty carousel are not empty")
+	}
+}
+
+func TestUncontainedKeylineList(t *testing.T) {
+	l := uncontainedKeylineList(400, 150, 8, 40, 10)
+	if len(l.keylines) != 5 || l.lastFocalIndex != 2 {
+		t.Fatalf("%d keylines, last focal %d, want 5 and 2", len(l.keylines), l.lastFocalIndex)
+	}
+	medium := l.keylines[3]
+	if medium.cutoff <= 0 || medium.size >= 150 {
+		t.Errorf("medium item of %v cut by %v, want a smaller item cut by the end", medium.size, medium.cutoff)
+	}
+	if got := shownSpace(l, 8); !near(got, 400) {
+		t.Errorf("items take %v pixels, want 400", got)
+	}
+}
+
+func TestHeroKeylineList(t *testing.T) {
+	l := heroKeylineList(400, 400, 8, 10, 40, 56, 10)
+	if l.firstFocalIndex != l.lastFocalIndex || !near(l.firstFocal().offset, 200) {
+		t.Fatalf("focal keylines %d to %d at %v, want one centered", l.firstFocalIndex, l.lastFocalIndex, l.firstFocal().offset)
+	}
+	if before, after := l.keylines[l.firstFocalIndex-1], l.keylines[l.firstFocalIndex+1]; before.size != after.size || before.isAnchor {
+		t.Errorf("items around the hero are %v and %v, want the same small items", before.size, after.size)
+	}
+	if got := shownSpace(l, 8); !near(got, 400) {
+		t.Errorf("items take %v pixels, want 400", got)
+	}
+	// Two items are both large.
+	if got := heroKeylineList(400, 400, 8, 2, 40, 56, 10); len(got.keylines) != 3 || got.firstFocal().size != 400 {
+		t.Errorf("2 items: %d keylines, want a single large one", len(got.keylines))
+	}
+}
+
+// shown returns where item is shown in a carousel laid out by s, scrolled
+// by scroll out of maxScroll.
+func shown(s *strategy, item int, scroll, maxScroll float32) (start, end float32) {
+	itemSize := round(s.itemMainAxisSize)
+	spacing := round(s.itemSpacing)
+	info, translation := s.itemLayout(item, itemSize, spacing, 100, scroll, maxScroll)
+	position := float32(item*(itemSize+spacing)) - scroll + translation
+	return position + info.MaskRect.Left, position + info.MaskRect.Right
+}
+
+func TestStrategy_Ends(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		keylines keylineList
+	}{
+		{"multi-browse", multiBrowseKeylineList(400, 186, 8, 10, 40, 56, 10)},
+		{"uncontained", uncontainedKeylineList(400, 150, 8, 40, 10)},
+		{"hero", heroKeylineList(400, 400, 8, 10, 40, 56, 10)},
+	} {
+		s := newStrategy(tt.keylines, 400, 8)
+		itemSize := round(s.itemMainAxisSize)
+		maxScroll := float32(maxScrollOffset(10, itemSize, 8, 400))
+
+		// The first item is focal at the start, and the last one at the end.
+		if start, end := shown(s, 0, 0, maxScroll); math.Abs(float64(start)) > 1 || math.Abs(float64(end-start-s.itemMainAxisSize)) > 1 {
+			t.Errorf("%s: first item shown from %v to %v, want a focal item at 0", tt.name, start, end)
+		}
+		if start, end := shown(s, 9, maxScroll, maxScroll); math.Abs(float64(end-400)) > 1 || math.Abs(float64(end-start-s.itemMainAxisSize)) > 1 {
+			t.Errorf("%s: last item shown from %v to %v, want a focal item ending at 400", tt.name, start, end)
+		}
+
+		// In the middle, items are itemSpacing apart.
+		scroll := maxScroll / 2
+		for item := 1; item < 9; item++ {
+			_, prevEnd := shown(s, item-1, scroll, maxScroll)
+			start, end := shown(s, item, scroll, maxScroll)
+			if end > start && start < 400 && prevEnd > 0 && math.Abs(float64(start-prevEnd-8)) > 1 {
+				t.Errorf("%s: item %d starts at %v, %v after item %d, want 8", tt.name, item, start, start-prevEnd, item-1)
+			}
+		}
+	}
+}
+
+func TestHorizontalMultiBrowseCarousel(t *testing.T) {
+	s := NewCarouselState(0, func() int { return 10 })
+	sizes := map[int]float32{}
+	content := func(scope CarouselItemScope, item int) Composable {
+		sizes[item] = scope.CarouselItemInfo().Size
+		return box.Box(compose.Id(), box.WithModifier(size.FillMax().Then(scope.MaskClip(shape.ShapeRectangle))))
+	}
+	c := compose.NewComposer(store.NewPersistentState(map[string]state.MutableValue{}))
+	node := HorizontalMultiBrowseCarousel(s, 186, content, WithItemSpacing(8), WithModifier(size.FillMax()))(c).Build()
+	gtx := layout.Context{
+		Ops:         new(op.Ops),
+		Constraints: layout.Exact(image.Pt(400, 200)),
+		Metric:      gioUnit.Metric{PxPerDp: 1, PxPerSp: 1},
+	}
+	runtime.NewRuntime().Run(gtx, node).Add(gtx.Ops)
+
+	// The large, medium and small items, and two beyond them.
+	if len(sizes) != 5 {
+		t.Fatalf("composed items %v, want 5", sizes)
+	}
+	if !(sizes[0] > sizes[1] && sizes[1] > sizes[2] && sizes[2] > sizes[3]) {
+		t.Errorf("item sizes = %v, want decreasing sizes", sizes)
+	}
+	if s.itemCrossAxisSize != 200 {
+		t.Errorf("items are %d pixels high, want 200", s.itemCrossAxisSize)
+	}
+}
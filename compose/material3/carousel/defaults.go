@@ -0,0 +1,32 @@
+package carousel
+
+import (
+	"github.com/zodimo/go-compose/compose/ui/unit"
+)
+
+// CarouselDefaults holds the default values of the carousels.
+var CarouselDefaults = carouselDefaults{}
+
+type carouselDefaults struct{}
+
+// MinSmallItemSize is the smallest the small items of a carousel get, along
+// the carousel.
+func (carouselDefaults) MinSmallItemSize() unit.Dp {
+	return 40
+}
+
+// MaxSmallItemSize is the largest the small items of a carousel get, along
+// the carousel.
+func (carouselDefaults) MaxSmallItemSize() unit.Dp {
+	return 56
+}
+
+// AnchorSize is the size items shrink to as they leave the carousel.
+func (carouselDefaults) AnchorSize() unit.Dp {
+	return 10
+}
+
+// beyondViewportItemCount is the number of items composed on each side of
+// those the pager of a carousel has in view: its medium and small items are
+// in view while the pager places them further along.
+const beyondViewportItemCount = 2
@@ -0,0 +1,29 @@
+/*
+Package carousel contains Material 3 carousels, which show a scrollable list
+of items that shrink, masked, as they scroll towards the edges, and snap to
+their large items:
+
+  - HorizontalMultiBrowseCarousel shows large, medium and small items, for
+    browsing many items at once.
+  - HorizontalUncontainedCarousel shows items of a fixed size, the last one
+    cut by the end of the carousel.
+  - HorizontalCenteredHeroCarousel shows a large item centered between small
+    ones, for a single item in focus.
+
+Items are sized by keylines, following the Material carousel strategies. The
+content of an item is measured as large as the focal items; MaskClip clips
+it to the part shown, which keeps it still as the item shrinks:
+
+	state := carousel.RememberCarouselState(c, 0, func() int { return len(photos) })
+	carousel.HorizontalMultiBrowseCarousel(state, 186, func(scope carousel.CarouselItemScope, item int) carousel.Composable {
+		return image.Image(photos[item], image.WithModifier(
+			size.FillMax().Then(scope.MaskClip(material3.ShapeExtraLarge)),
+		))
+	}, carousel.WithItemSpacing(8), carousel.WithModifier(size.FillMaxWidth().Then(size.Height(221))))
+
+Carousels are pagers: the CarouselState scrolls them like a pager.PagerState.
+
+Reference: [Carousel](https://m3.material.io/components/carousel/overview)
+Specs: [Carousel Specs](https://m3.material.io/components/carousel/specs)
+*/
+package carousel
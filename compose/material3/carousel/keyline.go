@@ -0,0 +1,291 @@
+package carousel
+
+// keyline is the size and position of an item at a point of a carousel.
+// Items are sized and placed by interpolating between the keylines their
+// position falls between.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/carousel/KeylineList.kt
+type keyline struct {
+	// size is the size of an item at the keyline.
+	size float32
+	// offset is the center of an item at the keyline, from the start of the
+	// carousel.
+	offset float32
+	// unadjustedOffset is the center of the item at the keyline when all
+	// items are as large as the focal items.
+	unadjustedOffset float32
+	// isFocal, isAnchor and isPivot tell whether the keyline is one of the
+	// largest ones, one outside the carousel the items shrink towards, or
+	// the one the keylines were laid out from.
+	isFocal, isAnchor, isPivot bool
+	// cutoff is how much of an item at the keyline is outside the carousel.
+	cutoff float32
+}
+
+// keylineList is the list of the keylines of a carousel, from its start to
+// its end.
+type keylineList struct {
+	keylines []keyline
+
+	pivotIndex                              int
+	firstFocalIndex, lastFocalIndex         int
+	firstNonAnchorIndex, lastNonAnchorIndex int
+}
+
+func newKeylineList(keylines []keyline) keylineList {
+	l := keylineList{
+		keylines:            keylines,
+		firstFocalIndex:     -1,
+		lastFocalIndex:      -1,
+		firstNonAnchorIndex: -1,
+		lastNonAnchorIndex:  -1,
+	}
+	for i, k := range keylines {
+		if k.isPivot {
+			l.pivotIndex = i
+		}
+		if k.isFocal {
+			if l.firstFocalIndex < 0 {
+				l.firstFocalIndex = i
+			}
+			l.lastFocalIndex = i
+		}
+		if !k.isAnchor {
+			if l.firstNonAnchorIndex < 0 {
+				l.firstNonAnchorIndex = i
+			}
+			l.lastNonAnchorIndex = i
+		}
+	}
+	return l
+}
+
+func (l keylineList) isEmpty() bool {
+	return len(l.keylines) == 0
+}
+
+func (l keylineList) first() keyline {
+	return l.keylines[0]
+}
+
+func (l keylineList) last() keyline {
+	return l.keylines[len(l.keylines)-1]
+}
+
+func (l keylineList) pivot() keyline {
+	return l.keylines[l.pivotIndex]
+}
+
+func (l keylineList) firstFocal() keyline {
+	return l.keylines[l.firstFocalIndex]
+}
+
+func (l keylineList) lastFocal() keyline {
+	return l.keylines[l.lastFocalIndex]
+}
+
+// isFirstFocalItemAtStartOfContainer reports whether the first focal item
+// is the first item and starts within the carousel.
+func (l keylineList) isFirstFocalItemAtStartOfContainer() bool {
+	first := l.firstFocal()
+	return first.offset-first.size/2 >= 0 && l.firstFocalIndex == l.firstNonAnchorIndex
+}
+
+// isLastFocalItemAtEndOfContainer reports whether the last focal item is the
+// last item and ends within a carousel of carouselMainAxisSize.
+func (l keylineList) isLastFocalItemAtEndOfContainer(carouselMainAxisSize float32) bool {
+	last := l.lastFocal()
+	return last.offset+last.size/2 <= carouselMainAxisSize && l.lastFocalIndex == l.lastNonAnchorIndex
+}
+
+// firstIndexAfterFocalRangeWithSize returns the first keyline after the
+// focal ones of size, or the last keyline.
+func (l keylineList) firstIndexAfterFocalRangeWithSize(size float32) int {
+	for i := l.lastFocalIndex; i < len(l.keylines); i++ {
+		if l.keylines[i].size == size {
+			return i
+		}
+	}
+	return len(l.keylines) - 1
+}
+
+// lastIndexBeforeFocalRangeWithSize returns the last keyline before the
+// focal ones of size, or the first keyline.
+func (l keylineList) lastIndexBeforeFocalRangeWithSize(size float32) int {
+	for i := l.firstFocalIndex - 1; i >= 0; i-- {
+		if l.keylines[i].size == size {
+			return i
+		}
+	}
+	return 0
+}
+
+// keylineBefore returns the last keyline whose unadjusted offset is at most
+// unadjustedOffset, or the first keyline.
+func (l keylineList) keylineBefore(unadjustedOffset float32) keyline {
+	for i := len(l.keylines) - 1; i >= 0; i-- {
+		if l.keylines[i].unadjustedOffset <= unadjustedOffset {
+			return l.keylines[i]
+		}
+	}
+	return l.first()
+}
+
+// keylineAfter returns the first keyline whose unadjusted offset is at least
+// unadjustedOffset, or the last keyline.
+func (l keylineList) keylineAfter(unadjustedOffset float32) keyline {
+	for _, k := range l.keylines {
+		if k.unadjustedOffset >= unadjustedOffset {
+			return k
+		}
+	}
+	return l.last()
+}
+
+// keylineSize is a keyline before it is laid out.
+type keylineSize struct {
+	size     float32
+	isAnchor bool
+}
+
+// carouselAlignment is where the focal keylines of a carousel are.
+type carouselAlignment int
+
+const (
+	carouselAlignmentStart carouselAlignment = iota
+	carouselAlignmentCenter
+)
+
+// keylineListOf lays out sizes in a carousel of carouselMainAxisSize, the
+// focal ones, the largest, aligned by alignment.
+func keylineListOf(carouselMainAxisSize, itemSpacing float32, alignment carouselAlignment, sizes []keylineSize) keylineList {
+	firstFocal, lastFocal := focalRange(sizes)
+	if firstFocal < 0 {
+		return keylineList{}
+	}
+	focalSize := sizes[firstFocal].size
+	pivotOffset := focalSize / 2
+	if alignment == carouselAlignmentCenter {
+		count := float32(lastFocal - firstFocal + 1)
+		focalRangeSize := count*focalSize + (count-1)*itemSpacing
+		pivotOffset = carouselMainAxisSize/2 - focalRangeSize/2 + focalSize/2
+	}
+	return keylineListWithPivot(carouselMainAxisSize, itemSpacing, firstFocal, pivotOffset, sizes)
+}
+
+// keylineListWithPivot lays out sizes in a carousel of carouselMainAxisSize,
+// with the center of the keyline at pivotIndex at pivotOffset.
+func keylineListWithPivot(carouselMainAxisSize, itemSpacing float32, pivotIndex int, pivotOffset float32, sizes []keylineSize) keylineList {
+	firstFocal, lastFocal := focalRange(sizes)
+	if firstFocal < 0 {
+		return keylineList{}
+	}
+	focalSize := sizes[firstFocal].size
+	cutoff := func(size, offset float32) float32 {
+		switch {
+		case offset-size/2 < 0:
+			return size/2 - offset
+		case offset+size/2 > carouselMainAxisSize:
+			return offset + size/2 - carouselMainAxisSize
+		}
+		return 0
+	}
+	keylines := make([]keyline, len(sizes))
+	at := func(i int, offset, unadjustedOffset float32) {
+		k := sizes[i]
+		keylines[i] = keyline{
+			size:             k.size,
+			offset:           offset,
+			unadjustedOffset: unadjustedOffset,
+			isFocal:          i >= firstFocal && i <= lastFocal,
+			isAnchor:         k.isAnchor,
+			isPivot:          i == pivotIndex,
+		}
+		if !k.isAnchor {
+			keylines[i].cutoff = max(cutoff(k.size, offset), 0)
+		}
+	}
+	pivot := sizes[pivotIndex]
+	at(pivotIndex, pivotOffset, pivotOffset)
+
+	// Items are laid out edge to edge, itemSpacing apart, while their
+	// unadjusted offsets are those of focal items.
+	edge := pivotOffset - pivot.size/2 - itemSpacing
+	unadjusted := pivotOffset - focalSize/2 - itemSpacing
+	for i := pivotIndex - 1; i >= 0; i-- {
+		at(i, edge-sizes[i].size/2, unadjusted-focalSize/2)
+		edge -= sizes[i].size + itemSpacing
+		unadjusted -= focalSize + itemSpacing
+	}
+	edge = pivotOffset + pivot.size/2 + itemSpacing
+	unadjusted = pivotOffset + focalSize/2 + itemSpacing
+	for i := pivotIndex + 1; i < len(sizes); i++ {
+		at(i, edge+sizes[i].size/2, unadjusted+focalSize/2)
+		edge += sizes[i].size + itemSpacing
+		unadjusted += focalSize + itemSpacing
+	}
+	return newKeylineList(keylines)
+}
+
+// focalRange returns the first and last of the largest sizes, which must be
+// next to each other, or -1 when there are none.
+func focalRange(sizes []keylineSize) (first, last int) {
+	first, last = -1, -1
+	var focalSize float32
+	for i, k := range sizes {
+		switch {
+		case k.size > focalSize:
+			first, last, focalSize = i, i, k.size
+		case k.size == focalSize && last == i-1:
+			last = i
+		}
+	}
+	return first, last
+}
+
+// sizes returns the sizes of the keylines of l.
+func (l keylineList) sizes() []keylineSize {
+	sizes := make([]keylineSize, len(l.keylines))
+	for i, k := range l.keylines {
+		sizes[i] = keylineSize{size: k.size, isAnchor: k.isAnchor}
+	}
+	return sizes
+}
+
+// lerpKeylineList interpolates each keyline of from and to, which must be
+// as many, by fraction.
+func lerpKeylineList(from, to keylineList, fraction float32) keylineList {
+	keylines := make([]keyline, len(from.keylines))
+	for i := range keylines {
+		keylines[i] = lerpKeyline(from.keylines[i], to.keylines[i], fraction)
+	}
+	return newKeylineList(keylines)
+}
+
+func lerpKeyline(from, to keyline, fraction float32) keyline {
+	k := to
+	if fraction < 0.5 {
+		k = from
+	}
+	k.size = lerp(from.size, to.size, fraction)
+	k.offset = lerp(from.offset, to.offset, fraction)
+	k.unadjustedOffset = lerp(from.unadjustedOffset, to.unadjustedOffset, fraction)
+	k.cutoff = lerp(from.cutoff, to.cutoff, fraction)
+	return k
+}
+
+func lerp(start, stop, fraction float32) float32 {
+	return start + (stop-start)*fraction
+}
+
+// lerpRange maps value in [inputMin, inputMax] to [outputMin, outputMax],
+// clamping values outside the input range.
+func lerpRange(outputMin, outputMax, inputMin, inputMax, value float32) float32 {
+	if value <= inputMin {
+		return outputMin
+	}
+	if value >= inputMax {
+		return outputMax
+	}
+	return lerp(outputMin, outputMax, (value-inputMin)/(inputMax-inputMin))
+}
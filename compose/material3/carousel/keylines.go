@@ -0,0 +1,158 @@
+package carousel
+
+import (
+	"math"
+	"slices"
+)
+
+// mediumLargeItemPercentageThreshold is the largest an uncontained carousel
+// makes its medium item, as a fraction of its large items.
+const mediumLargeItemPercentageThreshold = 0.85
+
+// multiBrowseKeylineList returns the keylines of a carousel of
+// carouselMainAxisSize showing large items as close to preferredItemSize as
+// possible, followed by a medium and a small item, between minSmallItemSize
+// and maxSmallItemSize, that items shrink into at the end.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/carousel/Keylines.kt
+func multiBrowseKeylineList(carouselMainAxisSize, preferredItemSize, itemSpacing float32, itemCount int, minSmallItemSize, maxSmallItemSize, anchorSize float32) keylineList {
+	if carouselMainAxisSize <= 0 || preferredItemSize <= 0 {
+		return keylineList{}
+	}
+	smallCounts := []int{1}
+	mediumCounts := []int{1, 0}
+
+	targetLargeSize := min(preferredItemSize, carouselMainAxisSize)
+	// A small item is ideally a third of a large item, and a medium item
+	// halfway between them.
+	targetSmallSize := min(max(targetLargeSize/3, minSmallItemSize), maxSmallItemSize)
+	targetMediumSize := (targetLargeSize + targetSmallSize) / 2
+	if carouselMainAxisSize < minSmallItemSize*2 {
+		smallCounts = []int{0}
+	}
+
+	// The carousel holds at least as many large items as fit next to the
+	// largest medium and small items.
+	minAvailableLargeSpace := carouselMainAxisSize - targetMediumSize*float32(slices.Max(mediumCounts)) - maxSmallItemSize*float32(slices.Max(smallCounts))
+	minLargeCount := max(1, int(math.Floor(float64(minAvailableLargeSpace/targetLargeSize))))
+	maxLargeCount := int(math.Ceil(float64(carouselMainAxisSize / targetLargeSize)))
+	largeCounts := make([]int, max(maxLargeCount-minLargeCount+1, 1))
+	for i := range largeCounts {
+		largeCounts[i] = max(maxLargeCount-i, 1)
+	}
+
+	targets := arrangementTargets{
+		availableSpace: carouselMainAxisSize,
+		itemSpacing:    itemSpacing,
+		smallSize:      targetSmallSize,
+		minSmallSize:   minSmallItemSize,
+		maxSmallSize:   maxSmallItemSize,
+		mediumSize:     targetMediumSize,
+		largeSize:      targetLargeSize,
+	}
+	a, ok := findLowestCostArrangement(targets, smallCounts, mediumCounts, largeCounts)
+	if ok && a.itemCount() > itemCount {
+		// With fewer items than the arrangement holds, small items go first,
+		// then medium ones, so that the items there are stay large.
+		smallCount, mediumCount := a.smallCount, a.mediumCount
+		for surplus := a.itemCount() - itemCount; surplus > 0; surplus-- {
+			if smallCount > 0 {
+				smallCount--
+			} else if mediumCount > 1 {
+				mediumCount--
+			}
+		}
+		a, ok = findLowestCostArrangement(targets, []int{smallCount}, []int{mediumCount}, []int{a.largeCount})
+	}
+	if !ok {
+		return keylineList{}
+	}
+
+	sizes := []keylineSize{{size: anchorSize, isAnchor: true}}
+	sizes = appendSizes(sizes, a.largeSize, a.largeCount)
+	sizes = appendSizes(sizes, a.mediumSize, a.mediumCount)
+	sizes = appendSizes(sizes, a.smallSize, a.smallCount)
+	sizes = append(sizes, keylineSize{size: anchorSize, isAnchor: true})
+	return keylineListOf(carouselMainAxisSize, itemSpacing, carouselAlignmentStart, sizes)
+}
+
+// uncontainedKeylineList returns the keylines of a carousel of
+// carouselMainAxisSize showing as many items of itemSize as fit, followed by
+// a medium item cut by the end of the carousel, at least minMediumItemSize.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/carousel/Keylines.kt
+func uncontainedKeylineList(carouselMainAxisSize, itemSize, itemSpacing, minMediumItemSize, anchorSize float32) keylineList {
+	if carouselMainAxisSize <= 0 || itemSize <= 0 {
+		return keylineList{}
+	}
+	largeSize := min(itemSize, carouselMainAxisSize)
+	largeCount := max(1, int(math.Floor(float64((carouselMainAxisSize+itemSpacing)/(largeSize+itemSpacing)))))
+	remainingSpace := carouselMainAxisSize - float32(largeCount)*(largeSize+itemSpacing)
+
+	// The medium item is large enough to show its content when it is cut,
+	// and small enough to tell from the large items.
+	mediumSize := max(remainingSpace*1.5, minMediumItemSize)
+	if threshold := largeSize * mediumLargeItemPercentageThreshold; mediumSize > threshold {
+		mediumSize = max(threshold, remainingSpace*1.2)
+	}
+	mediumSize = min(mediumSize, largeSize)
+
+	sizes := []keylineSize{{size: anchorSize, isAnchor: true}}
+	sizes = appendSizes(sizes, largeSize, largeCount)
+	sizes = append(sizes,
+		keylineSize{size: mediumSize},
+		keylineSize{size: anchorSize, isAnchor: true},
+	)
+	return keylineListOf(carouselMainAxisSize, itemSpacing, carouselAlignmentStart, sizes)
+}
+
+// heroKeylineList returns the keylines of a carousel of carouselMainAxisSize
+// showing a large item, at most maxItemSize, centered between small items,
+// between minSmallItemSize and maxSmallItemSize, that items shrink into at
+// both ends.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/carousel/Keylines.kt
+func heroKeylineList(carouselMainAxisSize, maxItemSize, itemSpacing float32, itemCount int, minSmallItemSize, maxSmallItemSize, anchorSize float32) keylineList {
+	if carouselMainAxisSize <= 0 || maxItemSize <= 0 {
+		return keylineList{}
+	}
+	// Small and medium items come in pairs, one on each side of the large
+	// item.
+	smallCounts := []int{2}
+	mediumCounts := []int{0, 2}
+	if carouselMainAxisSize < minSmallItemSize*4 || itemCount < 3 {
+		smallCounts, mediumCounts = []int{0}, []int{0}
+	}
+
+	targetLargeSize := min(maxItemSize, carouselMainAxisSize)
+	targetSmallSize := min(max(targetLargeSize/3, minSmallItemSize), maxSmallItemSize)
+	targets := arrangementTargets{
+		availableSpace: carouselMainAxisSize,
+		itemSpacing:    itemSpacing,
+		smallSize:      targetSmallSize,
+		minSmallSize:   minSmallItemSize,
+		maxSmallSize:   maxSmallItemSize,
+		mediumSize:     (targetLargeSize + targetSmallSize) / 2,
+		largeSize:      targetLargeSize,
+	}
+	a, ok := findLowestCostArrangement(targets, smallCounts, mediumCounts, []int{1})
+	if !ok {
+		return keylineList{}
+	}
+
+	sizes := []keylineSize{{size: anchorSize, isAnchor: true}}
+	sizes = appendSizes(sizes, a.smallSize, a.smallCount/2)
+	sizes = appendSizes(sizes, a.mediumSize, a.mediumCount/2)
+	sizes = appendSizes(sizes, a.largeSize, a.largeCount)
+	sizes = appendSizes(sizes, a.mediumSize, a.mediumCount/2)
+	sizes = appendSizes(sizes, a.smallSize, a.smallCount/2)
+	sizes = append(sizes, keylineSize{size: anchorSize, isAnchor: true})
+	return keylineListOf(carouselMainAxisSize, itemSpacing, carouselAlignmentCenter, sizes)
+}
+
+func appendSizes(sizes []keylineSize, size float32, count int) []keylineSize {
+	for range count {
+		sizes = append(sizes, keylineSize{size: size})
+	}
+	return sizes
+}
@@ -0,0 +1,124 @@
+package carousel
+
+import (
+	"image"
+	"math"
+
+	"github.com/zodimo/go-compose/compose/ui"
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+	"github.com/zodimo/go-compose/compose/ui/graphics/shape"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+	node "github.com/zodimo/go-compose/internal/Node"
+	"github.com/zodimo/go-compose/internal/layoutnode"
+	"github.com/zodimo/go-compose/internal/modifier"
+
+	"gioui.org/op"
+)
+
+// maskData is how an item of a carousel, or its content, is masked.
+type maskData struct {
+	// itemSize, when not zero, is the size the content is measured at along
+	// the carousel.
+	itemSize int
+	// maskStart and maskEnd are the part of the content along the carousel
+	// that is shown, in pixels from its start, clipped by shape.
+	maskStart, maskEnd float32
+	shape              shape.Shape
+	// translation moves the content along the carousel.
+	translation float32
+	rtl         bool
+	// onLayout is called with the size of the content across the carousel.
+	onLayout func(crossAxisSize int)
+}
+
+// maskModifier measures, masks and moves content as data says, at layout
+// time.
+func maskModifier(data maskData) ui.Modifier {
+	return modifier.NewInspectableModifier(
+		modifier.NewModifier(&maskElement{data: data}),
+		modifier.NewInspectorInfo("carouselMask", map[string]any{
+			"maskStart":   data.maskStart,
+			"maskEnd":     data.maskEnd,
+			"translation": data.translation,
+		}),
+	)
+}
+
+type maskElement struct {
+	data maskData
+}
+
+func (e *maskElement) Create() node.Node {
+	return newMaskNode(e.data)
+}
+
+func (e *maskElement) Update(n node.Node) {
+	n.(*maskNode).data = e.data
+}
+
+// Equals is always false, as the mask follows the scroll position.
+func (e *maskElement) Equals(other modifier.Element) bool {
+	return false
+}
+
+type maskNode struct {
+	node.ChainNode
+	data maskData
+}
+
+func newMaskNode(data maskData) *maskNode {
+	n := &maskNode{data: data}
+	n.ChainNode = node.NewChainNode(
+		node.NewNodeID(),
+		node.NodeKindLayout,
+		node.LayoutPhase,
+		func(t node.TreeNode) {
+			t.(layoutnode.LayoutModifierNode).AttachLayoutModifier(func(widget layoutnode.LayoutWidget) layoutnode.LayoutWidget {
+				return layoutnode.NewLayoutWidget(func(gtx layoutnode.LayoutContext) layoutnode.LayoutDimensions {
+					return n.layout(gtx, widget)
+				})
+			})
+		},
+	)
+	return n
+}
+
+func (n *maskNode) layout(gtx layoutnode.LayoutContext, widget layoutnode.LayoutWidget) layoutnode.LayoutDimensions {
+	d := n.data
+	if d.itemSize > 0 {
+		gtx.Constraints.Min.X, gtx.Constraints.Max.X = d.itemSize, d.itemSize
+	}
+	macro := op.Record(gtx.Ops)
+	dims := widget.Layout(gtx)
+	call := macro.Stop()
+	if d.onLayout != nil {
+		d.onLayout(dims.Size.Y)
+	}
+
+	start, end, translation := round(d.maskStart), round(d.maskEnd), round(d.translation)
+	if d.rtl {
+		start, end, translation = dims.Size.X-end, dims.Size.X-start, -translation
+	}
+	direction := unit.LayoutDirectionLtr
+	if d.rtl {
+		direction = unit.LayoutDirectionRtl
+	}
+	moved := op.Offset(image.Pt(translation+start, 0)).Push(gtx.Ops)
+	clipped := shape.CreateOutline(d.shape, image.Pt(max(end-start, 0), dims.Size.Y), gtx.Metric, direction).Push(gtx.Ops)
+	back := op.Offset(image.Pt(-start, 0)).Push(gtx.Ops)
+	call.Add(gtx.Ops)
+	back.Pop()
+	clipped.Pop()
+	moved.Pop()
+	return dims
+}
+
+func round(v float32) int {
+	return int(math.Round(float64(v)))
+}
+
+// maskRect returns the rectangle from start to end along the carousel, and
+// crossAxisSize across it.
+func maskRect(start, end, crossAxisSize float32) geometry.Rect {
+	return geometry.NewRect(start, 0, end, crossAxisSize)
+}
@@ -0,0 +1,70 @@
+package carousel
+
+import (
+	"github.com/zodimo/go-compose/compose/ui"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+)
+
+type CarouselOptions struct {
+	Modifier    ui.Modifier
+	ItemSpacing unit.Dp
+	// UserScrollEnabled lets the user swipe the carousel. The CarouselState
+	// scrolls it either way.
+	UserScrollEnabled bool
+	// MinSmallItemWidth and MaxSmallItemWidth bound the small items of
+	// HorizontalMultiBrowseCarousel and HorizontalCenteredHeroCarousel.
+	MinSmallItemWidth unit.Dp
+	MaxSmallItemWidth unit.Dp
+	// MaxItemWidth is the largest the large item of a
+	// HorizontalCenteredHeroCarousel gets. Unspecified, it takes all the
+	// room the small items leave.
+	MaxItemWidth unit.Dp
+}
+
+type CarouselOption func(*CarouselOptions)
+
+func DefaultCarouselOptions() CarouselOptions {
+	return CarouselOptions{
+		Modifier:          ui.EmptyModifier,
+		UserScrollEnabled: true,
+		MinSmallItemWidth: CarouselDefaults.MinSmallItemSize(),
+		MaxSmallItemWidth: CarouselDefaults.MaxSmallItemSize(),
+		MaxItemWidth:      unit.DpUnspecified,
+	}
+}
+
+func WithModifier(m ui.Modifier) CarouselOption {
+	return func(o *CarouselOptions) {
+		o.Modifier = m
+	}
+}
+
+func WithItemSpacing(spacing unit.Dp) CarouselOption {
+	return func(o *CarouselOptions) {
+		o.ItemSpacing = spacing
+	}
+}
+
+func WithUserScrollEnabled(enabled bool) CarouselOption {
+	return func(o *CarouselOptions) {
+		o.UserScrollEnabled = enabled
+	}
+}
+
+func WithMinSmallItemWidth(width unit.Dp) CarouselOption {
+	return func(o *CarouselOptions) {
+		o.MinSmallItemWidth = width
+	}
+}
+
+func WithMaxSmallItemWidth(width unit.Dp) CarouselOption {
+	return func(o *CarouselOptions) {
+		o.MaxSmallItemWidth = width
+	}
+}
+
+func WithMaxItemWidth(width unit.Dp) CarouselOption {
+	return func(o *CarouselOptions) {
+		o.MaxItemWidth = width
+	}
+}
@@ -0,0 +1,251 @@
+package carousel
+
+import (
+	"math"
+	"slices"
+)
+
+// strategy sizes and places the items of a carousel as it scrolls. Its
+// default keylines apply in the middle of the carousel; near its ends, the
+// keylines shift step by step so that the first and last items end up
+// focal.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/carousel/Strategy.kt
+type strategy struct {
+	defaultKeylines   keylineList
+	startKeylineSteps []keylineList
+	endKeylineSteps   []keylineList
+	// startShiftDistance and endShiftDistance are the scroll distances over
+	// which the keylines shift from the start and end steps to the default
+	// ones, and startShiftPoints and endShiftPoints the fractions of them at
+	// which each step applies.
+	startShiftDistance, endShiftDistance float32
+	startShiftPoints, endShiftPoints     []float32
+
+	availableSpace   float32
+	itemSpacing      float32
+	itemMainAxisSize float32
+}
+
+func newStrategy(defaultKeylines keylineList, availableSpace, itemSpacing float32) *strategy {
+	s := &strategy{
+		defaultKeylines: defaultKeylines,
+		availableSpace:  availableSpace,
+		itemSpacing:     itemSpacing,
+	}
+	if defaultKeylines.isEmpty() {
+		return s
+	}
+	s.itemMainAxisSize = defaultKeylines.firstFocal().size
+	s.startKeylineSteps = startKeylineSteps(defaultKeylines, availableSpace, itemSpacing)
+	s.endKeylineSteps = endKeylineSteps(defaultKeylines, availableSpace, itemSpacing)
+	s.startShiftDistance = max(slicesLast(s.startKeylineSteps).first().unadjustedOffset-s.startKeylineSteps[0].first().unadjustedOffset, 0)
+	s.endShiftDistance = max(s.endKeylineSteps[0].last().unadjustedOffset-slicesLast(s.endKeylineSteps).last().unadjustedOffset, 0)
+	s.startShiftPoints = stepInterpolationPoints(s.startShiftDistance, s.startKeylineSteps, true)
+	s.endShiftPoints = stepInterpolationPoints(s.endShiftDistance, s.endKeylineSteps, false)
+	return s
+}
+
+// isValid reports whether the strategy has keylines to place items with.
+func (s *strategy) isValid() bool {
+	return !s.defaultKeylines.isEmpty() && s.availableSpace > 0 && s.itemMainAxisSize > 0
+}
+
+// snapOffset returns where items settle, in pixels from the start of the
+// carousel: at the start of the first focal keyline.
+func (s *strategy) snapOffset() float32 {
+	if !s.isValid() {
+		return 0
+	}
+	return s.defaultKeylines.firstFocal().unadjustedOffset - s.itemMainAxisSize/2
+}
+
+// keylinesForScrollOffset returns the keylines of the carousel scrolled by
+// scrollOffset out of maxScrollOffset. roundToNearestStep returns the step
+// closest to the scroll offset, rather than interpolating between steps.
+func (s *strategy) keylinesForScrollOffset(scrollOffset, maxScrollOffset float32, roundToNearestStep bool) keylineList {
+	scrollOffset = max(scrollOffset, 0)
+	startShiftOffset := s.startShiftDistance
+	endShiftOffset := max(maxScrollOffset-s.endShiftDistance, 0)
+	if scrollOffset >= startShiftOffset && scrollOffset <= endShiftOffset {
+		return s.defaultKeylines
+	}
+
+	// At the start, the keylines go from the last step to the default ones;
+	// at the end, from the default ones to the last step.
+	interpolation := lerpRange(1, 0, 0, startShiftOffset, scrollOffset)
+	points, steps := s.startShiftPoints, s.startKeylineSteps
+	if scrollOffset > endShiftOffset {
+		interpolation = lerpRange(0, 1, endShiftOffset, maxScrollOffset, scrollOffset)
+		points, steps = s.endShiftPoints, s.endKeylineSteps
+	}
+	from, to, fraction := shiftPointRange(len(steps), points, interpolation)
+	if roundToNearestStep {
+		if fraction < 0.5 {
+			return steps[from]
+		}
+		return steps[to]
+	}
+	return lerpKeylineList(steps[from], steps[to], fraction)
+}
+
+// startKeylineSteps returns the keylines shifting, one item at a time, the
+// items before the focal ones after them, so that the first item is focal
+// at the start of the carousel.
+func startKeylineSteps(defaultKeylines keylineList, carouselMainAxisSize, itemSpacing float32) []keylineList {
+	steps := []keylineList{defaultKeylines}
+	if defaultKeylines.isFirstFocalItemAtStartOfContainer() {
+		return steps
+	}
+	startIndex := defaultKeylines.firstNonAnchorIndex
+	numberOfSteps := defaultKeylines.firstFocalIndex - startIndex
+	if numberOfSteps <= 0 {
+		if cutoff := defaultKeylines.firstFocal().cutoff; cutoff > 0 {
+			steps = append(steps, shiftedKeylineList(defaultKeylines, carouselMainAxisSize, itemSpacing, cutoff))
+		}
+		return steps
+	}
+	for i := range numberOfSteps {
+		prev := slicesLast(steps)
+		originalItemIndex := startIndex + i
+		dstIndex := len(defaultKeylines.keylines) - 1
+		if originalItemIndex > 0 {
+			neighborBeforeSize := defaultKeylines.keylines[originalItemIndex-1].size
+			dstIndex = prev.firstIndexAfterFocalRangeWithSize(neighborBeforeSize) - 1
+		}
+		steps = append(steps, movedKeylineList(prev, defaultKeylines.firstNonAnchorIndex, dstIndex, carouselMainAxisSize, itemSpacing))
+	}
+	return steps
+}
+
+// endKeylineSteps returns the keylines shifting, one item at a time, the
+// items after the focal ones before them, so that the last item is focal at
+// the end of the carousel.
+func endKeylineSteps(defaultKeylines keylineList, carouselMainAxisSize, itemSpacing float32) []keylineList {
+	steps := []keylineList{defaultKeylines}
+	if defaultKeylines.isLastFocalItemAtEndOfContainer(carouselMainAxisSize) {
+		return steps
+	}
+	endIndex := defaultKeylines.lastNonAnchorIndex
+	numberOfSteps := endIndex - defaultKeylines.lastFocalIndex
+	if numberOfSteps <= 0 {
+		if cutoff := defaultKeylines.lastFocal().cutoff; cutoff > 0 {
+			steps = append(steps, shiftedKeylineList(defaultKeylines, carouselMainAxisSize, itemSpacing, -cutoff))
+		}
+		return steps
+	}
+	for i := range numberOfSteps {
+		prev := slicesLast(steps)
+		originalItemIndex := endIndex - i
+		dstIndex := 0
+		if originalItemIndex < len(defaultKeylines.keylines)-1 {
+			neighborAfterSize := defaultKeylines.keylines[originalItemIndex+1].size
+			dstIndex = prev.lastIndexBeforeFocalRangeWithSize(neighborAfterSize) + 1
+		}
+		steps = append(steps, movedKeylineList(prev, defaultKeylines.lastNonAnchorIndex, dstIndex, carouselMainAxisSize, itemSpacing))
+	}
+	return steps
+}
+
+// movedKeylineList returns the keylines of from with the one at srcIndex
+// moved to dstIndex, the focal keylines shifting by the space it took.
+func movedKeylineList(from keylineList, srcIndex, dstIndex int, carouselMainAxisSize, itemSpacing float32) keylineList {
+	// The pivot shifts towards the end when a keyline moves before it, and
+	// towards the start otherwise.
+	pivotDir := -1
+	if srcIndex > dstIndex {
+		pivotDir = 1
+	}
+	moved := from.keylines[srcIndex]
+	pivotDelta := (moved.size - moved.cutoff + itemSpacing) * float32(pivotDir)
+
+	sizes := from.sizes()
+	size := sizes[srcIndex]
+	sizes = slices.Delete(sizes, srcIndex, srcIndex+1)
+	sizes = slices.Insert(sizes, dstIndex, size)
+	return keylineListWithPivot(carouselMainAxisSize, itemSpacing, from.pivotIndex+pivotDir, from.pivot().offset+pivotDelta, sizes)
+}
+
+// shiftedKeylineList returns the keylines of from moved by delta.
+func shiftedKeylineList(from keylineList, carouselMainAxisSize, itemSpacing, delta float32) keylineList {
+	return keylineListWithPivot(carouselMainAxisSize, itemSpacing, from.pivotIndex, from.pivot().offset+delta, from.sizes())
+}
+
+// stepInterpolationPoints returns the fraction of totalShiftDistance at
+// which each of steps applies.
+func stepInterpolationPoints(totalShiftDistance float32, steps []keylineList, isShiftingLeft bool) []float32 {
+	points := []float32{0}
+	if totalShiftDistance == 0 || len(steps) == 0 {
+		return points
+	}
+	for i := 1; i < len(steps); i++ {
+		prev, curr := steps[i-1], steps[i]
+		distanceShifted := prev.last().unadjustedOffset - curr.last().unadjustedOffset
+		if isShiftingLeft {
+			distanceShifted = curr.first().unadjustedOffset - prev.first().unadjustedOffset
+		}
+		point := float32(1)
+		if i < len(steps)-1 {
+			point = points[i-1] + distanceShifted/totalShiftDistance
+		}
+		points = append(points, point)
+	}
+	return points
+}
+
+// shiftPointRange returns the steps interpolation falls between, and the
+// fraction of the way from one to the other.
+func shiftPointRange(stepsCount int, points []float32, interpolation float32) (from, to int, fraction float32) {
+	lower := points[0]
+	for i := 1; i < stepsCount && i < len(points); i++ {
+		upper := points[i]
+		if interpolation <= upper {
+			return i - 1, i, lerpRange(0, 1, lower, upper, interpolation)
+		}
+		lower = upper
+	}
+	return 0, 0, 0
+}
+
+// itemLayout returns where item index is drawn in the carousel scrolled by
+// scrollOffset out of maxScrollOffset: its info, and how far it is moved
+// from where the pager places it.
+func (s *strategy) itemLayout(index int, itemSize, itemSpacing int, crossAxisSize int, scrollOffset, maxScrollOffset float32) (CarouselItemInfo, float32) {
+	keylines := s.keylinesForScrollOffset(scrollOffset, maxScrollOffset, false)
+	roundedKeylines := s.keylinesForScrollOffset(scrollOffset, maxScrollOffset, true)
+
+	// The center of the item as placed by the pager, all items being as
+	// large as the focal ones.
+	unadjustedCenter := float32(index*(itemSize+itemSpacing)) + float32(itemSize)/2 - scrollOffset
+	before := keylines.keylineBefore(unadjustedCenter)
+	after := keylines.keylineAfter(unadjustedCenter)
+	var progress float32
+	if after.unadjustedOffset != before.unadjustedOffset {
+		progress = (unadjustedCenter - before.unadjustedOffset) / (after.unadjustedOffset - before.unadjustedOffset)
+	}
+	k := lerpKeyline(before, after, progress)
+
+	minSize := float32(math.Inf(1))
+	for _, rk := range roundedKeylines.keylines {
+		minSize = min(minSize, rk.size)
+	}
+	center := float32(itemSize) / 2
+	info := CarouselItemInfo{
+		Size:     k.size,
+		MinSize:  minSize,
+		MaxSize:  roundedKeylines.firstFocal().size,
+		MaskRect: maskRect(center-k.size/2, center+k.size/2, float32(crossAxisSize)),
+	}
+
+	// The masked items are moved next to each other. Beyond the first or
+	// last keyline, they keep going at the scroll speed.
+	translation := k.offset - unadjustedCenter
+	if before == after {
+		translation = k.offset - k.unadjustedOffset
+	}
+	return info, translation
+}
+
+func slicesLast[T any](s []T) T {
+	return s[len(s)-1]
+}
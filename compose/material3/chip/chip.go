@@ -2,6 +2,7 @@ package chip
 
 import (
 	"fmt"
+	"github.com/zodimo/go-compose/compose/foundation/layout/arrangement"
 
 	"github.com/zodimo/go-compose/compose/foundation/layout/box"
 	"github.com/zodimo/go-compose/compose/foundation/layout/row"
@@ -107,7 +108,7 @@ func Chip(onClick func(), label string, options ...ChipOption) api.Composable {
 				),
 				row.WithModifier(rowModifier),
 				row.WithAlignment(row.Middle),
-				row.WithSpacing(row.SpaceSides),
+				row.WithHorizontalArrangement(arrangement.Center),
 			)(c)
 		}
 
@@ -117,6 +118,7 @@ func Chip(onClick func(), label string, options ...ChipOption) api.Composable {
 			surface.WithColor(opts.Color),
 			surface.WithBorder(opts.BorderWidth, opts.BorderColor),
 			surface.WithShadowElevation(opts.Elevation),
+			surface.WithFocusIndicator(true),
 		}
 
 		// Prepare Surface Modifier with Clickable
@@ -0,0 +1,8 @@
+package datepicker
+
+import (
+	"github.com/zodimo/go-compose/pkg/api"
+)
+
+type Composable = api.Composable
+type Composer = api.Composer
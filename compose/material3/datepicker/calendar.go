@@ -0,0 +1,275 @@
+package datepicker
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gioui.org/io/system"
+)
+
+// today returns the current date, replaced in tests.
+var today = func() time.Time {
+	return dateOf(time.Now())
+}
+
+// dateOf returns the date of t at midnight UTC, the form dates are kept in,
+// or the zero time for the zero time.
+func dateOf(t time.Time) time.Time {
+	if t.IsZero() {
+		return time.Time{}
+	}
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// monthOf returns the first day of the month of t.
+func monthOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// daysInMonth returns the number of days of the month of t.
+func daysInMonth(t time.Time) int {
+	return time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// daysFromStartOfWeekToFirstOfMonth returns the number of days of the first
+// week of the month of t that belong to the previous month, in weeks starting
+// on firstDayOfWeek.
+func daysFromStartOfWeekToFirstOfMonth(t time.Time, firstDayOfWeek time.Weekday) int {
+	return (int(monthOf(t).Weekday()) - int(firstDayOfWeek) + 7) % 7
+}
+
+// calendarLocale holds the names and formats of dates in a locale.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/internal/CalendarModel.kt
+type calendarLocale struct {
+	firstDayOfWeek time.Weekday
+	monthNames     [12]string
+	shortMonths    [12]string
+	// weekdays are the narrow names of the days, from Sunday.
+	weekdays [7]string
+	// dateOrder is the order of the day, month and year fields of dates, as
+	// a permutation of "DMY", and separator the character between them.
+	dateOrder string
+	separator string
+	// dayDot is set in locales writing a dot after the day, as in "17. Aug".
+	dayDot bool
+}
+
+// Month, day and date format names of the supported languages; others fall
+// back to English.
+var calendarLanguages = map[string]calendarLocale{
+	"en": {
+		monthNames:  [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+		shortMonths: [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		weekdays:    [7]string{"S", "M", "T", "W", "T", "F", "S"},
+		dateOrder:   "DMY",
+		separator:   "/",
+	},
+	"de": {
+		monthNames:  [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+		shortMonths: [12]string{"Jan.", "Feb.", "März", "Apr.", "Mai", "Juni", "Juli", "Aug.", "Sept.", "Okt.", "Nov.", "Dez."},
+		weekdays:    [7]string{"S", "M", "D", "M", "D", "F", "S"},
+		dateOrder:   "DMY",
+		separator:   ".",
+		dayDot:      true,
+	},
+	"fr": {
+		monthNames:  [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+		shortMonths: [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+		weekdays:    [7]string{"D", "L", "M", "M", "J", "V", "S"},
+		dateOrder:   "DMY",
+		separator:   "/",
+	},
+	"es": {
+		monthNames:  [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+		shortMonths: [12]string{"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sept", "oct", "nov", "dic"},
+		weekdays:    [7]string{"D", "L", "M", "X", "J", "V", "S"},
+		dateOrder:   "DMY",
+		separator:   "/",
+	},
+	"it": {
+		monthNames:  [12]string{"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno", "luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre"},
+		shortMonths: [12]string{"gen", "feb", "mar", "apr", "mag", "giu", "lug", "ago", "set", "ott", "nov", "dic"},
+		weekdays:    [7]string{"D", "L", "M", "M", "G", "V", "S"},
+		dateOrder:   "DMY",
+		separator:   "/",
+	},
+	"pt": {
+		monthNames:  [12]string{"janeiro", "fevereiro", "março", "abril", "maio", "junho", "julho", "agosto", "setembro", "outubro", "novembro", "dezembro"},
+		shortMonths: [12]string{"jan.", "fev.", "mar.", "abr.", "mai.", "jun.", "jul.", "ago.", "set.", "out.", "nov.", "dez."},
+		weekdays:    [7]string{"D", "S", "T", "Q", "Q", "S", "S"},
+		dateOrder:   "DMY",
+		separator:   "/",
+	},
+	"nl": {
+		monthNames:  [12]string{"januari", "februari", "maart", "april", "mei", "juni", "juli", "augustus", "september", "oktober", "november", "december"},
+		shortMonths: [12]string{"jan", "feb", "mrt", "apr", "mei", "jun", "jul", "aug", "sep", "okt", "nov", "dec"},
+		weekdays:    [7]string{"Z", "M", "D", "W", "D", "V", "Z"},
+		dateOrder:   "DMY",
+		separator:   "-",
+	},
+}
+
+// Regions whose weeks do not start on Monday, after CLDR.
+var (
+	sundayFirstRegions = map[string]bool{
+		"US": true, "CA": true, "MX": true, "BR": true, "JP": true, "KR": true, "TW": true,
+		"HK": true, "IL": true, "PH": true, "IN": true, "ZA": true, "SA": true,
+	}
+	saturdayFirstRegions = map[string]bool{
+		"AF": true, "BH": true, "DJ": true, "DZ": true, "EG": true, "IQ": true, "IR": true,
+		"JO": true, "KW": true, "LY": true, "OM": true, "QA": true, "SD": true, "SY": true,
+	}
+	// defaultRegions are the regions assumed for language tags without one.
+	defaultRegions = map[string]string{"en": "US", "ja": "JP", "ko": "KR", "he": "IL", "pt": "BR", "ar": "EG"}
+	// monthFirstRegions write dates month first.
+	monthFirstRegions = map[string]bool{"US": true, "PH": true, "FM": true, "MH": true, "PW": true}
+)
+
+// calendarLocaleOf returns the calendar names and formats of locale, whose
+// Language is a BCP 47 tag such as "en-US".
+func calendarLocaleOf(locale system.Locale) calendarLocale {
+	language, region := parseLanguageTag(locale.Language)
+	if region == "" {
+		region = defaultRegions[language]
+	}
+	l, ok := calendarLanguages[language]
+	if !ok {
+		l = calendarLanguages["en"]
+	}
+	switch {
+	case sundayFirstRegions[region]:
+		l.firstDayOfWeek = time.Sunday
+	case saturdayFirstRegions[region]:
+		l.firstDayOfWeek = time.Saturday
+	default:
+		l.firstDayOfWeek = time.Monday
+	}
+	if language == "en" && monthFirstRegions[region] {
+		l.dateOrder = "MDY"
+	}
+	return l
+}
+
+// parseLanguageTag returns the lower case language and upper case region of
+// a BCP 47 tag, skipping its script.
+func parseLanguageTag(tag string) (language, region string) {
+	parts := strings.FieldsFunc(tag, func(r rune) bool { return r == '-' || r == '_' })
+	if len(parts) == 0 {
+		return "", ""
+	}
+	language = strings.ToLower(parts[0])
+	for _, part := range parts[1:] {
+		if len(part) == 2 || len(part) == 3 && part[0] >= '0' && part[0] <= '9' {
+			return language, strings.ToUpper(part)
+		}
+	}
+	return language, ""
+}
+
+// weekdayNames returns the narrow names of the days of the week, from the
+// first day of the week.
+func (l calendarLocale) weekdayNames() []string {
+	names := make([]string, 7)
+	for i := range names {
+		names[i] = l.weekdays[(int(l.firstDayOfWeek)+i)%7]
+	}
+	return names
+}
+
+// formatMonthYear formats the month of t, as in "August 2024".
+func (l calendarLocale) formatMonthYear(t time.Time) string {
+	return fmt.Sprintf("%s %d", l.monthNames[t.Month()-1], t.Year())
+}
+
+// formatDate formats the date t for reading, as in "Aug 17, 2024".
+func (l calendarLocale) formatDate(t time.Time) string {
+	month := l.shortMonths[t.Month()-1]
+	switch {
+	case l.dateOrder == "MDY":
+		return fmt.Sprintf("%s %d, %d", month, t.Day(), t.Year())
+	case l.dayDot:
+		return fmt.Sprintf("%d. %s %d", t.Day(), month, t.Year())
+	default:
+		return fmt.Sprintf("%d %s %d", t.Day(), month, t.Year())
+	}
+}
+
+// inputPattern returns the pattern of dates typed in, as in "MM/DD/YYYY".
+func (l calendarLocale) inputPattern() string {
+	fields := make([]string, 3)
+	for i, field := range l.dateOrder {
+		switch field {
+		case 'D':
+			fields[i] = "DD"
+		case 'M':
+			fields[i] = "MM"
+		case 'Y':
+			fields[i] = "YYYY"
+		}
+	}
+	return strings.Join(fields, l.separator)
+}
+
+// formatInput formats the date t as typed in, as in "08/17/2024".
+func (l calendarLocale) formatInput(t time.Time) string {
+	fields := make([]string, 3)
+	for i, field := range l.dateOrder {
+		switch field {
+		case 'D':
+			fields[i] = fmt.Sprintf("%02d", t.Day())
+		case 'M':
+			fields[i] = fmt.Sprintf("%02d", int(t.Month()))
+		case 'Y':
+			fields[i] = fmt.Sprintf("%04d", t.Year())
+		}
+	}
+	return strings.Join(fields, l.separator)
+}
+
+// inputText formats date as typed in, or returns "" for the zero time.
+func (l calendarLocale) inputText(date time.Time) string {
+	if date.IsZero() {
+		return ""
+	}
+	return l.formatInput(date)
+}
+
+// parseInput parses a date typed in the input pattern, and reports whether
+// it is a valid date.
+func (l calendarLocale) parseInput(s string) (time.Time, bool) {
+	fields := strings.Split(strings.TrimSpace(s), l.separator)
+	if len(fields) != 3 {
+		return time.Time{}, false
+	}
+	var year, month, day int
+	for i, field := range l.dateOrder {
+		digits := 2
+		if field == 'Y' {
+			digits = 4
+		}
+		if len(fields[i]) != digits {
+			return time.Time{}, false
+		}
+		n, err := strconv.Atoi(fields[i])
+		if err != nil || n < 0 {
+			return time.Time{}, false
+		}
+		switch field {
+		case 'D':
+			day = n
+		case 'M':
+			month = n
+		case 'Y':
+			year = n
+		}
+	}
+	t := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if t.Year() != year || int(t.Month()) != month || t.Day() != day {
+		return time.Time{}, false
+	}
+	return t, true
+}
@@ -0,0 +1,109 @@
+package datepicker
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"gioui.org/io/system"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestCalendarLocale(t *testing.T) {
+	tests := []struct {
+		language       string
+		firstDayOfWeek time.Weekday
+		weekdays       []string
+		monthYear      string
+		formatted      string
+		pattern        string
+	}{
+		{"en-US", time.Sunday, []string{"S", "M", "T", "W", "T", "F", "S"}, "August 2024", "Aug 17, 2024", "MM/DD/YYYY"},
+		{"en", time.Sunday, []string{"S", "M", "T", "W", "T", "F", "S"}, "August 2024", "Aug 17, 2024", "MM/DD/YYYY"},
+		{"en-GB", time.Monday, []string{"M", "T", "W", "T", "F", "S", "S"}, "August 2024", "17 Aug 2024", "DD/MM/YYYY"},
+		{"de-DE", time.Monday, []string{"M", "D", "M", "D", "F", "S", "S"}, "August 2024", "17. Aug. 2024", "DD.MM.YYYY"},
+		{"fr_CA", time.Sunday, []string{"D", "L", "M", "M", "J", "V", "S"}, "août 2024", "17 août 2024", "DD/MM/YYYY"},
+		{"ar-EG", time.Saturday, []string{"S", "S", "M", "T", "W", "T", "F"}, "August 2024", "17 Aug 2024", "DD/MM/YYYY"},
+		{"zh-Hant-TW", time.Sunday, []string{"S", "M", "T", "W", "T", "F", "S"}, "August 2024", "17 Aug 2024", "DD/MM/YYYY"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.language, func(t *testing.T) {
+			l := calendarLocaleOf(system.Locale{Language: tt.language})
+			if l.firstDayOfWeek != tt.firstDayOfWeek {
+				t.Errorf("firstDayOfWeek = %v, want %v", l.firstDayOfWeek, tt.firstDayOfWeek)
+			}
+			if got := l.weekdayNames(); !slices.Equal(got, tt.weekdays) {
+				t.Errorf("weekdayNames = %v, want %v", got, tt.weekdays)
+			}
+			if got := l.formatMonthYear(date(2024, time.August, 1)); got != tt.monthYear {
+				t.Errorf("formatMonthYear = %q, want %q", got, tt.monthYear)
+			}
+			if got := l.formatDate(date(2024, time.August, 17)); got != tt.formatted {
+				t.Errorf("formatDate = %q, want %q", got, tt.formatted)
+			}
+			if got := l.inputPattern(); got != tt.pattern {
+				t.Errorf("inputPattern = %q, want %q", got, tt.pattern)
+			}
+		})
+	}
+}
+
+func TestParseInput(t *testing.T) {
+	us := calendarLocaleOf(system.Locale{Language: "en-US"})
+	de := calendarLocaleOf(system.Locale{Language: "de-DE"})
+	tests := []struct {
+		name   string
+		locale calendarLocale
+		input  string
+		want   time.Time
+		ok     bool
+	}{
+		{"month first", us, "08/17/2024", date(2024, time.August, 17), true},
+		{"day first", de, "17.08.2024", date(2024, time.August, 17), true},
+		{"spaces", us, " 02/29/2024 ", date(2024, time.February, 29), true},
+		{"not a day", us, "02/30/2024", time.Time{}, false},
+		{"short year", us, "08/17/24", time.Time{}, false},
+		{"wrong separator", de, "17/08/2024", time.Time{}, false},
+		{"not a number", us, "08/1x/2024", time.Time{}, false},
+		{"empty", us, "", time.Time{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.locale.parseInput(tt.input)
+			if ok != tt.ok || !got.Equal(tt.want) {
+				t.Errorf("parseInput(%q) = %v, %v, want %v, %v", tt.input, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+	if got := us.formatInput(date(2024, time.August, 7)); got != "08/07/2024" {
+		t.Errorf("formatInput = %q, want 08/07/2024", got)
+	}
+	if got := us.inputText(time.Time{}); got != "" {
+		t.Errorf("inputText(zero) = %q, want none", got)
+	}
+}
+
+func TestDaysFromStartOfWeekToFirstOfMonth(t *testing.T) {
+	// August 1, 2024 is a Thursday.
+	august := date(2024, time.August, 1)
+	tests := []struct {
+		firstDayOfWeek time.Weekday
+		want           int
+	}{
+		{time.Sunday, 4},
+		{time.Monday, 3},
+		{time.Saturday, 5},
+		{time.Thursday, 0},
+	}
+	for _, tt := range tests {
+		if got := daysFromStartOfWeekToFirstOfMonth(august, tt.firstDayOfWeek); got != tt.want {
+			t.Errorf("weeks from %v: got %d, want %d", tt.firstDayOfWeek, got, tt.want)
+		}
+	}
+	if got := daysInMonth(date(2024, time.February, 10)); got != 29 {
+		t.Errorf("daysInMonth(February 2024) = %d, want 29", got)
+	}
+}
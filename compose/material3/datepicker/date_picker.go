@@ -0,0 +1,436 @@
+package datepicker
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/zodimo/go-compose/compose"
+	"github.com/zodimo/go-compose/compose/foundation/layout/box"
+	"github.com/zodimo/go-compose/compose/foundation/layout/column"
+	"github.com/zodimo/go-compose/compose/foundation/layout/row"
+	"github.com/zodimo/go-compose/compose/foundation/lazy"
+	"github.com/zodimo/go-compose/compose/foundation/pager"
+	ftext "github.com/zodimo/go-compose/compose/foundation/text"
+	"github.com/zodimo/go-compose/compose/material3/divider"
+	"github.com/zodimo/go-compose/compose/material3/icon"
+	"github.com/zodimo/go-compose/compose/material3/iconbutton"
+	"github.com/zodimo/go-compose/compose/material3/surface"
+	"github.com/zodimo/go-compose/compose/material3/text"
+	"github.com/zodimo/go-compose/compose/material3/textfield"
+	"github.com/zodimo/go-compose/compose/ui"
+	"github.com/zodimo/go-compose/compose/ui/graphics/shape"
+	"github.com/zodimo/go-compose/compose/ui/platform"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+	"github.com/zodimo/go-compose/modifiers/background"
+	"github.com/zodimo/go-compose/modifiers/clickable"
+	"github.com/zodimo/go-compose/modifiers/padding"
+	"github.com/zodimo/go-compose/modifiers/size"
+	"github.com/zodimo/go-compose/modifiers/weight"
+	"github.com/zodimo/go-compose/state"
+
+	mdicons "golang.org/x/exp/shiny/materialdesign/icons"
+)
+
+const (
+	// dayCellSize is the size of the cells of the days and of the weekdays.
+	dayCellSize = 48
+	// dayContainerSize is the size of the circle behind a selected day.
+	dayContainerSize = 40
+	// weeksInMonth is the number of weeks a month page always shows, so that
+	// all the pages have the same height.
+	weeksInMonth = 6
+	// yearPickerHeight is the height of the year picker, which takes the
+	// place of the weekdays and the month pages.
+	yearPickerHeight = dayCellSize * (weeksInMonth + 1)
+)
+
+// DatePicker lets the user pick a date from a calendar, or type it in. The
+// calendar pages through the months of the year range of state, and its month
+// header opens a year picker.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/DatePicker.kt
+func DatePicker(state *DatePickerState, options ...DatePickerOption) Composable {
+	return func(c Composer) Composer {
+		opts := DefaultDatePickerOptions(c)
+		for _, option := range options {
+			if option == nil {
+				continue
+			}
+			option(&opts)
+		}
+
+		title := opts.Title
+		if title == nil {
+			title = datePickerTitle(state.DisplayMode(), "Select date", "Enter date", opts.Colors)
+		}
+		headline := opts.Headline
+		if headline == nil {
+			placeholder := "Selected date"
+			if state.DisplayMode() == DisplayModeInput {
+				placeholder = "Entered date"
+			}
+			headline = datePickerHeadline(dateText(state.calendar, state.SelectedDate(), placeholder), opts.Colors)
+		}
+
+		var content Composable
+		if state.DisplayMode() == DisplayModeInput {
+			content = dateInputField(&state.baseDatePickerState, "Date", state.SelectedDate(), func(date time.Time) time.Time {
+				state.SetSelectedDate(date)
+				return state.SelectedDate()
+			}, nil, ui.EmptyModifier)
+			content = box.Box(content, box.WithModifier(padding.Padding(24, 16, 24, 0)))
+		} else {
+			content = calendar(&state.baseDatePickerState, daySelection{
+				isSelected: func(date time.Time) bool { return date.Equal(state.SelectedDate()) },
+				inRange:    func(time.Time) bool { return false },
+				onClick:    state.SetSelectedDate,
+			}, opts.Colors)
+		}
+
+		return datePickerLayout(&state.baseDatePickerState, opts, title, headline, content)(c)
+	}
+}
+
+// datePickerLayout lays out the header of a date picker, with its title,
+// headline and mode toggle, above its content.
+func datePickerLayout(s *baseDatePickerState, opts DatePickerOptions, title, headline, content Composable) Composable {
+	modeToggle := compose.Id()
+	if opts.ShowModeToggle {
+		modeToggle = displayModeToggle(s, opts.Colors)
+	}
+	return column.Column(
+		compose.Sequence(
+			column.Column(
+				compose.Sequence(
+					title,
+					row.Row(
+						compose.Sequence(
+							box.Box(headline, box.WithModifier(weight.Weight(1))),
+							modeToggle,
+						),
+						row.WithAlignment(row.Middle),
+						row.WithModifier(size.FillMaxWidth()),
+					),
+				),
+				column.WithModifier(size.FillMaxWidth().Then(padding.Padding(24, 16, 12, 12))),
+			),
+			divider.Divider(divider.WithColor(opts.Colors.DividerColor)),
+			content,
+		),
+		column.WithModifier(opts.Modifier.Then(size.Width(int(DatePickerDefaults.Width()))).Then(background.Background(opts.Colors.ContainerColor))),
+	)
+}
+
+// datePickerTitle returns the default title of a date picker in mode.
+func datePickerTitle(mode DisplayMode, pickerTitle, inputTitle string, colors DatePickerColors) Composable {
+	title := pickerTitle
+	if mode == DisplayModeInput {
+		title = inputTitle
+	}
+	return text.LabelLarge(title, ftext.WithColor(colors.TitleContentColor))
+}
+
+// datePickerHeadline returns the default headline of a date picker.
+func datePickerHeadline(headline string, colors DatePickerColors) Composable {
+	return text.HeadlineLarge(headline, ftext.WithColor(colors.HeadlineContentColor), ftext.WithMaxLines(1))
+}
+
+// dateText formats date for a headline, or returns placeholder when no date
+// is selected.
+func dateText(calendar calendarLocale, date time.Time, placeholder string) string {
+	if date.IsZero() {
+		return placeholder
+	}
+	return calendar.formatDate(date)
+}
+
+// displayModeToggle switches a date picker between its calendar and its
+// text input.
+func displayModeToggle(s *baseDatePickerState, colors DatePickerColors) Composable {
+	if s.DisplayMode() == DisplayModePicker {
+		return iconbutton.Standard(func() { s.SetDisplayMode(DisplayModeInput) }, mdicons.EditorModeEdit, "Switch to text input mode")
+	}
+	return iconbutton.Standard(func() { s.SetDisplayMode(DisplayModePicker) }, mdicons.ActionDateRange, "Switch to calendar input mode")
+}
+
+// daySelection is how a calendar shows and changes the selected dates.
+type daySelection struct {
+	isSelected func(date time.Time) bool
+	inRange    func(date time.Time) bool
+	onClick    func(date time.Time)
+}
+
+// calendar shows the months of s, one page each, or the year picker when the
+// user opens it from the month header.
+func calendar(s *baseDatePickerState, selection daySelection, colors DatePickerColors) Composable {
+	return func(c Composer) Composer {
+		yearPickerVisible := state.MustState(c, fmt.Sprintf("datePickerYearPicker-%v", c.GenerateID()), func() bool { return false })
+
+		var body Composable
+		if yearPickerVisible.Get() {
+			body = yearPicker(s, colors, func(year int) {
+				month := s.DisplayedMonth()
+				s.SetDisplayedMonth(time.Date(year, month.Month(), 1, 0, 0, 0, 0, time.UTC))
+				yearPickerVisible.Set(false)
+			})
+		} else {
+			body = column.Column(
+				compose.Sequence(
+					weekdays(s.calendar, colors),
+					pager.HorizontalPager(s.months, func(page int) pager.Composable {
+						return month(s, s.yearRange.monthAt(page), selection, colors)
+					}, pager.WithModifier(size.Size(dayCellSize*7, dayCellSize*weeksInMonth))),
+				),
+				column.WithModifier(padding.Horizontal(12, 12)),
+			)
+		}
+
+		return column.Column(
+			compose.Sequence(
+				monthHeader(s, yearPickerVisible, colors),
+				body,
+			),
+		)(c)
+	}
+}
+
+// monthHeader shows the displayed month, which opens the year picker, and
+// the buttons to page through the months.
+func monthHeader(s *baseDatePickerState, yearPickerVisible state.MutableValueTyped[bool], colors DatePickerColors) Composable {
+	return func(c Composer) Composer {
+		navigation := compose.Id()
+		if !yearPickerVisible.Get() {
+			previous, next := mdicons.NavigationChevronLeft, mdicons.NavigationChevronRight
+			if platform.LocalLayoutDirection.Current(c) == unit.LayoutDirectionRtl {
+				previous, next = next, previous
+			}
+			target := s.months.TargetPage()
+			previousButton, nextButton := compose.Id(), compose.Id()
+			if target > 0 {
+				previousButton = iconbutton.Standard(func() { s.months.AnimateScrollToPage(target - 1) }, previous, "Change to previous month")
+			}
+			if target < s.months.PageCount()-1 {
+				nextButton = iconbutton.Standard(func() { s.months.AnimateScrollToPage(target + 1) }, next, "Change to next month")
+			}
+			navigation = row.Row(compose.Sequence(previousButton, nextButton))
+		}
+
+		return row.Row(
+			compose.Sequence(
+				box.Box(
+					row.Row(
+						compose.Sequence(
+							text.TitleSmall(s.calendar.formatMonthYear(s.DisplayedMonth()), ftext.WithColor(colors.NavigationContentColor)),
+							icon.Icon(icon.IconBytes(mdicons.NavigationArrowDropDown), icon.WithColor(colors.NavigationContentColor)),
+						),
+						row.WithAlignment(row.Middle),
+						row.WithModifier(
+							clickable.OnClick(func() { yearPickerVisible.Set(!yearPickerVisible.Get()) }).
+								Then(padding.Padding(8, 8, 8, 8)),
+						),
+					),
+					box.WithModifier(weight.Weight(1)),
+				),
+				navigation,
+			),
+			row.WithAlignment(row.Middle),
+			row.WithModifier(size.FillMaxWidth().Then(size.Height(56)).Then(padding.Padding(16, 0, 12, 0))),
+		)(c)
+	}
+}
+
+// weekdays shows the narrow names of the days of the week, from the first
+// day of the week of calendar.
+func weekdays(calendar calendarLocale, colors DatePickerColors) Composable {
+	var cells []Composable
+	for _, name := range calendar.weekdayNames() {
+		cells = append(cells, box.Box(
+			text.BodyLarge(name, ftext.WithColor(colors.WeekdayContentColor)),
+			box.WithAlignment(box.Center),
+			box.WithModifier(size.Size(dayCellSize, dayCellSize)),
+		))
+	}
+	return row.Row(compose.Sequence(cells...))
+}
+
+// month shows the days of the month starting at first, in weeks from the
+// first day of the week of the locale of s.
+func month(s *baseDatePickerState, first time.Time, selection daySelection, colors DatePickerColors) Composable {
+	return func(c Composer) Composer {
+		now := dateOf(today())
+		offset := daysFromStartOfWeekToFirstOfMonth(first, s.calendar.firstDayOfWeek)
+		days := daysInMonth(first)
+		weeks := make([]Composable, weeksInMonth)
+		for week := range weeks {
+			cells := make([]Composable, 7)
+			for weekday := range cells {
+				day := week*7 + weekday - offset + 1
+				if day < 1 || day > days {
+					cells[weekday] = box.Box(compose.Id(), box.WithModifier(size.Size(dayCellSize, dayCellSize)))
+					continue
+				}
+				date := first.AddDate(0, 0, day-1)
+				cells[weekday] = dayCell(
+					day,
+					selection.isSelected(date),
+					selection.inRange(date),
+					date.Equal(now),
+					s.isSelectable(date),
+					func() { selection.onClick(date) },
+					colors,
+				)
+			}
+			weeks[week] = row.Row(compose.Sequence(cells...))
+		}
+		return column.Column(compose.Sequence(weeks...))(c)
+	}
+}
+
+// dayCell shows a day of a month, in a circle when selected or today, and on
+// a band when in a selected range.
+func dayCell(day int, selected, inRange, today, enabled bool, onClick func(), colors DatePickerColors) Composable {
+	content, container := colors.dayColors(selected, inRange, today, enabled)
+	surfaceOptions := []surface.SurfaceOption{
+		surface.WithShape(shape.CircleShape),
+		surface.WithColor(container),
+		surface.WithContentColor(content),
+		surface.WithAlignment(box.Center),
+	}
+	dayModifier := size.Size(dayContainerSize, dayContainerSize)
+	if enabled {
+		dayModifier = dayModifier.Then(clickable.OnClick(onClick))
+	}
+	surfaceOptions = append(surfaceOptions, surface.WithModifier(dayModifier))
+	if today && !selected {
+		surfaceOptions = append(surfaceOptions, surface.WithBorder(1, colors.TodayDateBorderColor))
+	}
+
+	cellModifier := size.Size(dayCellSize, dayCellSize)
+	if inRange {
+		cellModifier = cellModifier.Then(padding.Vertical(4, 4)).Then(background.Background(colors.DayInSelectionContainerColor))
+	}
+	return box.Box(
+		surface.Surface(text.BodyLarge(strconv.Itoa(day), ftext.WithColor(content)), surfaceOptions...),
+		box.WithAlignment(box.Center),
+		box.WithModifier(cellModifier),
+	)
+}
+
+// yearPicker shows the years of s in a grid, starting around the displayed
+// one, and calls onYearSelected with the year the user picks.
+func yearPicker(s *baseDatePickerState, colors DatePickerColors, onYearSelected func(year int)) Composable {
+	return func(c Composer) Composer {
+		displayedYear := s.DisplayedMonth().Year()
+		currentYear := today().Year()
+		gridState := c.State(fmt.Sprintf("datePickerYearGrid-%v", c.GenerateID()), func() any {
+			gridState := lazy.NewLazyGridState()
+			// Show the row of the displayed year, below the row above it.
+			gridState.List.Position.First = max((displayedYear-s.yearRange.Start)/3-1, 0)
+			return gridState
+		}).Get().(*lazy.LazyGridState)
+
+		years := s.yearRange.End - s.yearRange.Start + 1
+		return lazy.LazyVerticalGrid(lazy.Fixed(3), func(scope lazy.LazyGridScope) {
+			scope.Items(years, func(index int) any { return s.yearRange.Start + index }, func(index int) Composable {
+				year := s.yearRange.Start + index
+				return yearCell(year, year == displayedYear, year == currentYear, s.selectableDates.IsSelectableYear(year), func() { onYearSelected(year) }, colors)
+			})
+		},
+			lazy.WithGridState(gridState),
+			lazy.WithGridModifier(size.Height(yearPickerHeight).Then(size.FillMaxWidth()).Then(padding.Horizontal(12, 12))),
+		)(c)
+	}
+}
+
+// yearCell shows a year of the year picker.
+func yearCell(year int, selected, current, enabled bool, onClick func(), colors DatePickerColors) Composable {
+	content, container := colors.yearColors(selected, enabled)
+	yearModifier := size.Size(72, 36)
+	if enabled {
+		yearModifier = yearModifier.Then(clickable.OnClick(onClick))
+	}
+	surfaceOptions := []surface.SurfaceOption{
+		surface.WithShape(shape.CircleShape),
+		surface.WithColor(container),
+		surface.WithContentColor(content),
+		surface.WithAlignment(box.Center),
+		surface.WithModifier(yearModifier),
+	}
+	if current && !selected {
+		surfaceOptions = append(surfaceOptions, surface.WithBorder(1, colors.TodayDateBorderColor))
+	}
+	return box.Box(
+		surface.Surface(text.BodyLarge(strconv.Itoa(year), ftext.WithColor(content)), surfaceOptions...),
+		box.WithAlignment(box.Center),
+		box.WithModifier(size.FillMaxWidth().Then(size.Height(52))),
+	)
+}
+
+// dateInput is the text typed in a date field, and the date it was parsed
+// to, which tells when the date changed elsewhere.
+type dateInput struct {
+	text string
+	date time.Time
+}
+
+// dateInputField lets the user type date in. onDateChange gets the date
+// typed, or the zero time when the text is not a date that can be picked,
+// and returns the date the field then shows. validate returns an error for
+// dates that are not valid for other reasons, or "" for valid ones.
+func dateInputField(s *baseDatePickerState, label string, date time.Time, onDateChange func(time.Time) time.Time, validate func(time.Time) string, modifier ui.Modifier) Composable {
+	return func(c Composer) Composer {
+		input := state.MustState(c, fmt.Sprintf("dateInputField-%v", c.GenerateID()), func() dateInput {
+			return dateInput{text: s.calendar.inputText(date), date: date}
+		})
+		current := input.Get()
+		if !current.date.Equal(date) {
+			// The date was changed elsewhere, from the calendar or another
+			// field.
+			current = dateInput{text: s.calendar.inputText(date), date: date}
+			input.Set(current)
+		}
+
+		errorText := s.inputError(current.text, validate)
+		supportingText := s.calendar.inputPattern()
+		if errorText != "" {
+			supportingText = errorText
+		}
+		return textfield.Outlined(
+			current.text,
+			func(value string) {
+				parsed, ok := s.calendar.parseInput(value)
+				if !ok || !s.isSelectable(parsed) {
+					parsed = time.Time{}
+				}
+				input.Set(dateInput{text: value, date: onDateChange(parsed)})
+			},
+			textfield.WithLabel(label),
+			textfield.WithPlaceholder(s.calendar.inputPattern()),
+			textfield.WithSupportingText(supportingText),
+			textfield.WithError(errorText != ""),
+			textfield.WithSingleLine(true),
+			textfield.WithModifier(modifier),
+		)(c)
+	}
+}
+
+// inputError returns why text is not a date the user can pick, or "" when it
+// is one or is empty.
+func (s *baseDatePickerState) inputError(text string, validate func(time.Time) string) string {
+	if text == "" {
+		return ""
+	}
+	date, ok := s.calendar.parseInput(text)
+	switch {
+	case !ok:
+		return fmt.Sprintf("Date does not match expected pattern: %s", s.calendar.inputPattern())
+	case !s.yearRange.Contains(date.Year()):
+		return fmt.Sprintf("Date out of expected year range %d - %d", s.yearRange.Start, s.yearRange.End)
+	case !s.isSelectable(date):
+		return fmt.Sprintf("Date not allowed: %s", s.calendar.formatDate(date))
+	case validate != nil:
+		return validate(date)
+	}
+	return ""
+}
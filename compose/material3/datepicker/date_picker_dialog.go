@@ -0,0 +1,58 @@
+package datepicker
+
+import (
+	"github.com/zodimo/go-compose/compose"
+	"github.com/zodimo/go-compose/compose/foundation/layout/box"
+	"github.com/zodimo/go-compose/compose/foundation/layout/column"
+	"github.com/zodimo/go-compose/compose/material3/dialog"
+	"github.com/zodimo/go-compose/modifiers/size"
+)
+
+// DatePickerDialog shows content, a DatePicker or a DateRangePicker, in a
+// dialog with a confirm button and an optional dismiss button below it:
+//
+//	c.When(showPicker.Get(),
+//		datepicker.DatePickerDialog(
+//			onDismiss,
+//			button.Text(onConfirm, "OK"),
+//			datepicker.DatePicker(state),
+//			datepicker.WithDismissButton(button.Text(onDismiss, "Cancel")),
+//		),
+//	)
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/DatePickerDialog.kt
+func DatePickerDialog(
+	onDismissRequest func(),
+	confirmButton Composable,
+	content Composable,
+	options ...DatePickerDialogOption,
+) Composable {
+	opts := DefaultDatePickerDialogOptions()
+	for _, option := range options {
+		if option == nil {
+			continue
+		}
+		option(&opts)
+	}
+
+	var buttons []Composable
+	if opts.DismissButton != nil {
+		buttons = append(buttons, opts.DismissButton)
+	}
+	if confirmButton != nil {
+		buttons = append(buttons, confirmButton)
+	}
+
+	items := []Composable{content}
+	if len(buttons) > 0 {
+		items = append(items, box.Box(
+			dialog.DialogButtonRow(buttons...),
+			box.WithAlignment(box.E),
+			box.WithModifier(size.FillMaxWidth()),
+		))
+	}
+	return dialog.BasicAlertDialog(
+		onDismissRequest,
+		column.Column(compose.Sequence(items...), column.WithModifier(opts.Modifier)),
+	)
+}
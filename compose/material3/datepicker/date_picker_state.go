@@ -0,0 +1,394 @@
+package datepicker
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/zodimo/go-compose/compose/foundation/pager"
+	"github.com/zodimo/go-compose/compose/ui/platform"
+	"github.com/zodimo/go-compose/state"
+
+	"gioui.org/io/system"
+)
+
+// DisplayMode is how a date picker lets the user pick dates.
+type DisplayMode int
+
+const (
+	// DisplayModePicker shows a calendar to pick dates from.
+	DisplayModePicker DisplayMode = iota
+	// DisplayModeInput shows text fields to type dates in.
+	DisplayModeInput
+)
+
+func (m DisplayMode) String() string {
+	switch m {
+	case DisplayModePicker:
+		return "Picker"
+	case DisplayModeInput:
+		return "Input"
+	default:
+		return fmt.Sprintf("DisplayMode(%d)", int(m))
+	}
+}
+
+// YearRange is the range of years, both included, a date picker shows.
+type YearRange struct {
+	Start, End int
+}
+
+// Contains reports whether year is in the range.
+func (r YearRange) Contains(year int) bool {
+	return year >= r.Start && year <= r.End
+}
+
+// monthCount returns the number of months of the range.
+func (r YearRange) monthCount() int {
+	return max(r.End-r.Start+1, 0) * 12
+}
+
+// monthIndex returns the index of the month of t in the range.
+func (r YearRange) monthIndex(t time.Time) int {
+	return (t.Year()-r.Start)*12 + int(t.Month()) - 1
+}
+
+// monthAt returns the first day of the month at index in the range.
+func (r YearRange) monthAt(index int) time.Time {
+	return time.Date(r.Start, time.Month(index+1), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// SelectableDates restricts the dates the user can pick.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/DatePicker.kt
+type SelectableDates interface {
+	// IsSelectableDate reports whether date, at midnight UTC, can be picked.
+	IsSelectableDate(date time.Time) bool
+	// IsSelectableYear reports whether year is shown in the year picker.
+	IsSelectableYear(year int) bool
+}
+
+// SelectableDatesFunc makes a function a SelectableDates, in which all
+// years are selectable.
+type SelectableDatesFunc func(date time.Time) bool
+
+func (f SelectableDatesFunc) IsSelectableDate(date time.Time) bool {
+	return f(date)
+}
+
+func (f SelectableDatesFunc) IsSelectableYear(year int) bool {
+	return true
+}
+
+// AllDates lets the user pick any date.
+var AllDates SelectableDates = SelectableDatesFunc(func(time.Time) bool { return true })
+
+// defaultLocale is the locale of states created outside of a composition,
+// the default of platform.LocalLocale.
+var defaultLocale = system.Locale{Language: "en-US", Direction: system.LTR}
+
+type DatePickerStateOptions struct {
+	// InitialSelectedDate is the date selected at first, zero for none. The
+	// end date of a range is InitialSelectedEndDate.
+	InitialSelectedDate    time.Time
+	InitialSelectedEndDate time.Time
+	// InitialDisplayedMonth is the month shown at first. When zero, it is
+	// the month of InitialSelectedDate, or the current month.
+	InitialDisplayedMonth time.Time
+	YearRange             YearRange
+	InitialDisplayMode    DisplayMode
+	SelectableDates       SelectableDates
+	// Locale names months and days and picks the first day of the week.
+	Locale system.Locale
+}
+
+type DatePickerStateOption func(*DatePickerStateOptions)
+
+func DefaultDatePickerStateOptions() DatePickerStateOptions {
+	return DatePickerStateOptions{
+		YearRange:          DatePickerDefaults.YearRange(),
+		InitialDisplayMode: DisplayModePicker,
+		SelectableDates:    AllDates,
+		Locale:             defaultLocale,
+	}
+}
+
+func WithInitialSelectedDate(date time.Time) DatePickerStateOption {
+	return func(o *DatePickerStateOptions) {
+		o.InitialSelectedDate = date
+	}
+}
+
+// WithInitialSelectedDateRange selects the range from start to end at
+// first, in a DateRangePickerState.
+func WithInitialSelectedDateRange(start, end time.Time) DatePickerStateOption {
+	return func(o *DatePickerStateOptions) {
+		o.InitialSelectedDate = start
+		o.InitialSelectedEndDate = end
+	}
+}
+
+func WithInitialDisplayedMonth(month time.Time) DatePickerStateOption {
+	return func(o *DatePickerStateOptions) {
+		o.InitialDisplayedMonth = month
+	}
+}
+
+func WithYearRange(start, end int) DatePickerStateOption {
+	return func(o *DatePickerStateOptions) {
+		o.YearRange = YearRange{Start: start, End: end}
+	}
+}
+
+func WithInitialDisplayMode(mode DisplayMode) DatePickerStateOption {
+	return func(o *DatePickerStateOptions) {
+		o.InitialDisplayMode = mode
+	}
+}
+
+func WithSelectableDates(selectableDates SelectableDates) DatePickerStateOption {
+	return func(o *DatePickerStateOptions) {
+		o.SelectableDates = selectableDates
+	}
+}
+
+func WithLocale(locale system.Locale) DatePickerStateOption {
+	return func(o *DatePickerStateOptions) {
+		o.Locale = locale
+	}
+}
+
+func datePickerStateOptions(options []DatePickerStateOption, locale system.Locale) DatePickerStateOptions {
+	opts := DefaultDatePickerStateOptions()
+	opts.Locale = locale
+	for _, option := range options {
+		if option == nil {
+			continue
+		}
+		option(&opts)
+	}
+	if opts.SelectableDates == nil {
+		opts.SelectableDates = AllDates
+	}
+	opts.InitialSelectedDate = dateOf(opts.InitialSelectedDate)
+	opts.InitialSelectedEndDate = dateOf(opts.InitialSelectedEndDate)
+	if opts.InitialDisplayedMonth.IsZero() {
+		opts.InitialDisplayedMonth = opts.InitialSelectedDate
+	}
+	if opts.InitialDisplayedMonth.IsZero() {
+		opts.InitialDisplayedMonth = today()
+	}
+	return opts
+}
+
+// baseDatePickerState holds what the states of the date pickers share: the
+// month displayed, the display mode and the dates that can be picked.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/internal/BaseDatePickerStateImpl.kt
+type baseDatePickerState struct {
+	// months pages through the months of yearRange, its current page being
+	// the month displayed.
+	months          *pager.PagerState
+	displayMode     state.MutableValueTyped[DisplayMode]
+	yearRange       YearRange
+	selectableDates SelectableDates
+	locale          system.Locale
+	calendar        calendarLocale
+}
+
+func newBaseDatePickerState(opts DatePickerStateOptions, months func(initialPage int, pageCount func() int) *pager.PagerState, displayMode state.MutableValueTyped[DisplayMode]) baseDatePickerState {
+	if opts.YearRange.End < opts.YearRange.Start {
+		panic(fmt.Sprintf("DatePickerState: the year range %d-%d is empty", opts.YearRange.Start, opts.YearRange.End))
+	}
+	yearRange := opts.YearRange
+	initialMonth := monthOf(opts.InitialDisplayedMonth)
+	if !yearRange.Contains(initialMonth.Year()) {
+		initialMonth = yearRange.monthAt(0)
+	}
+	return baseDatePickerState{
+		months:          months(yearRange.monthIndex(initialMonth), yearRange.monthCount),
+		displayMode:     displayMode,
+		yearRange:       yearRange,
+		selectableDates: opts.SelectableDates,
+		locale:          opts.Locale,
+		calendar:        calendarLocaleOf(opts.Locale),
+	}
+}
+
+// DisplayedMonth returns the first day of the month displayed.
+func (s *baseDatePickerState) DisplayedMonth() time.Time {
+	return s.yearRange.monthAt(s.months.CurrentPage())
+}
+
+// SetDisplayedMonth displays the month of t, when in the year range.
+func (s *baseDatePickerState) SetDisplayedMonth(t time.Time) {
+	if !s.yearRange.Contains(t.Year()) {
+		return
+	}
+	s.months.ScrollToPage(s.yearRange.monthIndex(t))
+}
+
+// DisplayMode returns how the user picks dates.
+func (s *baseDatePickerState) DisplayMode() DisplayMode {
+	return s.displayMode.Get()
+}
+
+func (s *baseDatePickerState) SetDisplayMode(mode DisplayMode) {
+	s.displayMode.Set(mode)
+}
+
+// YearRange returns the years the picker shows.
+func (s *baseDatePickerState) YearRange() YearRange {
+	return s.yearRange
+}
+
+// SelectableDates returns the dates the user can pick.
+func (s *baseDatePickerState) SelectableDates() SelectableDates {
+	return s.selectableDates
+}
+
+// Locale returns the locale the picker names months and days in.
+func (s *baseDatePickerState) Locale() system.Locale {
+	return s.locale
+}
+
+// isSelectable reports whether date can be picked.
+func (s *baseDatePickerState) isSelectable(date time.Time) bool {
+	return s.yearRange.Contains(date.Year()) &&
+		s.selectableDates.IsSelectableYear(date.Year()) &&
+		s.selectableDates.IsSelectableDate(date)
+}
+
+// DatePickerState holds the date selected in a DatePicker and the month it
+// displays.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/DatePicker.kt
+type DatePickerState struct {
+	baseDatePickerState
+	selectedDate state.MutableValueTyped[time.Time]
+}
+
+// NewDatePickerState creates a DatePickerState. Use RememberDatePickerState
+// to keep it across recompositions.
+func NewDatePickerState(options ...DatePickerStateOption) *DatePickerState {
+	opts := datePickerStateOptions(options, defaultLocale)
+	return &DatePickerState{
+		baseDatePickerState: newBaseDatePickerState(opts, pager.NewPagerState, state.MutableStateOf(opts.InitialDisplayMode)),
+		selectedDate:        state.MutableStateOf(opts.InitialSelectedDate),
+	}
+}
+
+// RememberDatePickerState returns a DatePickerState that survives
+// recompositions, in the locale of platform.LocalLocale unless WithLocale
+// sets one. Changes to it schedule a new frame.
+func RememberDatePickerState(c Composer, options ...DatePickerStateOption) *DatePickerState {
+	opts := datePickerStateOptions(options, platform.LocalLocale.Current(c))
+	key := fmt.Sprintf("datePickerState-%v", c.GenerateID())
+	base := rememberBaseDatePickerState(c, key, opts)
+	selectedDate := state.MustState(c, key+"/selectedDate", func() time.Time { return opts.InitialSelectedDate })
+	return c.State(key, func() any {
+		return &DatePickerState{baseDatePickerState: base, selectedDate: selectedDate}
+	}).Get().(*DatePickerState)
+}
+
+func rememberBaseDatePickerState(c Composer, key string, opts DatePickerStateOptions) baseDatePickerState {
+	displayMode := state.MustState(c, key+"/displayMode", func() DisplayMode { return opts.InitialDisplayMode })
+	return newBaseDatePickerState(opts, func(initialPage int, pageCount func() int) *pager.PagerState {
+		return pager.RememberPagerState(c, initialPage, pageCount)
+	}, displayMode)
+}
+
+// SelectedDate returns the selected date at midnight UTC, or the zero time
+// when none is.
+func (s *DatePickerState) SelectedDate() time.Time {
+	return s.selectedDate.Get()
+}
+
+// SetSelectedDate selects the date of t, or none for the zero time. Dates
+// that cannot be picked are ignored.
+func (s *DatePickerState) SetSelectedDate(t time.Time) {
+	date := dateOf(t)
+	if !date.IsZero() && !s.isSelectable(date) {
+		return
+	}
+	s.selectedDate.Set(date)
+}
+
+// DateRangePickerState holds the range of dates selected in a
+// DateRangePicker and the month it displays.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/DateRangePicker.kt
+type DateRangePickerState struct {
+	baseDatePickerState
+	selectedStartDate state.MutableValueTyped[time.Time]
+	selectedEndDate   state.MutableValueTyped[time.Time]
+}
+
+// NewDateRangePickerState creates a DateRangePickerState. Use
+// RememberDateRangePickerState to keep it across recompositions.
+func NewDateRangePickerState(options ...DatePickerStateOption) *DateRangePickerState {
+	opts := datePickerStateOptions(options, defaultLocale)
+	s := &DateRangePickerState{
+		baseDatePickerState: newBaseDatePickerState(opts, pager.NewPagerState, state.MutableStateOf(opts.InitialDisplayMode)),
+		selectedStartDate:   state.MutableStateOf(time.Time{}),
+		selectedEndDate:     state.MutableStateOf(time.Time{}),
+	}
+	s.SetSelection(opts.InitialSelectedDate, opts.InitialSelectedEndDate)
+	return s
+}
+
+// RememberDateRangePickerState returns a DateRangePickerState that survives
+// recompositions, in the locale of platform.LocalLocale unless WithLocale
+// sets one. Changes to it schedule a new frame.
+func RememberDateRangePickerState(c Composer, options ...DatePickerStateOption) *DateRangePickerState {
+	opts := datePickerStateOptions(options, platform.LocalLocale.Current(c))
+	key := fmt.Sprintf("dateRangePickerState-%v", c.GenerateID())
+	base := rememberBaseDatePickerState(c, key, opts)
+	start := state.MustState(c, key+"/selectedStartDate", func() time.Time { return time.Time{} })
+	end := state.MustState(c, key+"/selectedEndDate", func() time.Time { return time.Time{} })
+	return c.State(key, func() any {
+		s := &DateRangePickerState{baseDatePickerState: base, selectedStartDate: start, selectedEndDate: end}
+		s.SetSelection(opts.InitialSelectedDate, opts.InitialSelectedEndDate)
+		return s
+	}).Get().(*DateRangePickerState)
+}
+
+// SelectedStartDate returns the first date of the selected range at
+// midnight UTC, or the zero time when none is selected.
+func (s *DateRangePickerState) SelectedStartDate() time.Time {
+	return s.selectedStartDate.Get()
+}
+
+// SelectedEndDate returns the last date of the selected range at midnight
+// UTC, or the zero time while only its start is selected.
+func (s *DateRangePickerState) SelectedEndDate() time.Time {
+	return s.selectedEndDate.Get()
+}
+
+// SetSelection selects the range from the date of start to the date of end.
+// A zero end selects only the start, and a zero start clears the selection.
+// Ranges ending before they start, or with dates that cannot be picked, are
+// ignored.
+func (s *DateRangePickerState) SetSelection(start, end time.Time) {
+	start, end = dateOf(start), dateOf(end)
+	if start.IsZero() {
+		end = time.Time{}
+	}
+	if !start.IsZero() && !s.isSelectable(start) ||
+		!end.IsZero() && (!s.isSelectable(end) || end.Before(start)) {
+		return
+	}
+	s.selectedStartDate.Set(start)
+	s.selectedEndDate.Set(end)
+}
+
+// selectDate extends the selection with a date the user picked: it ends the
+// range when only its start is selected and date is not before it, or
+// starts a new range otherwise.
+func (s *DateRangePickerState) selectDate(date time.Time) {
+	start, end := s.SelectedStartDate(), s.SelectedEndDate()
+	if !start.IsZero() && end.IsZero() && !date.Before(start) {
+		s.SetSelection(start, date)
+		return
+	}
+	s.SetSelection(date, time.Time{})
+}
@@ -0,0 +1,107 @@
+package datepicker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/zodimo/go-compose/compose"
+	"github.com/zodimo/go-compose/compose/ui/platform"
+	"github.com/zodimo/go-compose/state"
+	"github.com/zodimo/go-compose/store"
+
+	"gioui.org/io/system"
+)
+
+func TestDatePickerState(t *testing.T) {
+	s := NewDatePickerState(
+		WithInitialSelectedDate(time.Date(2024, time.August, 17, 15, 4, 0, 0, time.FixedZone("CEST", 2*3600))),
+		WithYearRange(2000, 2030),
+		WithSelectableDates(SelectableDatesFunc(func(date time.Time) bool {
+			return date.Weekday() != time.Sunday
+		})),
+	)
+	if got, want := s.SelectedDate(), date(2024, time.August, 17); !got.Equal(want) || got.Location() != time.UTC {
+		t.Errorf("SelectedDate = %v, want %v", got, want)
+	}
+	if got, want := s.DisplayedMonth(), date(2024, time.August, 1); !got.Equal(want) {
+		t.Errorf("DisplayedMonth = %v, want %v", got, want)
+	}
+
+	// August 18, 2024 is a Sunday.
+	s.SetSelectedDate(date(2024, time.August, 18))
+	if got, want := s.SelectedDate(), date(2024, time.August, 17); !got.Equal(want) {
+		t.Errorf("SelectedDate after unselectable date = %v, want %v", got, want)
+	}
+	s.SetSelectedDate(date(2031, time.January, 2))
+	if got, want := s.SelectedDate(), date(2024, time.August, 17); !got.Equal(want) {
+		t.Errorf("SelectedDate after date out of range = %v, want %v", got, want)
+	}
+	s.SetSelectedDate(time.Time{})
+	if !s.SelectedDate().IsZero() {
+		t.Errorf("SelectedDate after clearing = %v, want none", s.SelectedDate())
+	}
+
+	s.SetDisplayedMonth(date(2030, time.December, 25))
+	if got, want := s.DisplayedMonth(), date(2030, time.December, 1); !got.Equal(want) {
+		t.Errorf("DisplayedMonth = %v, want %v", got, want)
+	}
+	s.SetDisplayedMonth(date(1999, time.December, 25))
+	if got, want := s.DisplayedMonth(), date(2030, time.December, 1); !got.Equal(want) {
+		t.Errorf("DisplayedMonth after month out of range = %v, want %v", got, want)
+	}
+	if got := s.months.PageCount(); got != 31*12 {
+		t.Errorf("month count = %d, want %d", got, 31*12)
+	}
+
+	// Months out of the range start at the first month of the range.
+	s = NewDatePickerState(WithInitialDisplayedMonth(date(1850, time.May, 1)))
+	if got, want := s.DisplayedMonth(), date(1900, time.January, 1); !got.Equal(want) {
+		t.Errorf("DisplayedMonth out of range = %v, want %v", got, want)
+	}
+}
+
+func TestDateRangePickerState(t *testing.T) {
+	s := NewDateRangePickerState(WithInitialSelectedDateRange(date(2024, time.August, 10), date(2024, time.August, 5)))
+	if !s.SelectedStartDate().IsZero() || !s.SelectedEndDate().IsZero() {
+		t.Errorf("range ending before its start = %v - %v, want none", s.SelectedStartDate(), s.SelectedEndDate())
+	}
+
+	steps := []struct {
+		click      time.Time
+		start, end time.Time
+	}{
+		{date(2024, time.August, 10), date(2024, time.August, 10), time.Time{}},
+		// A date before the start starts the range again.
+		{date(2024, time.August, 5), date(2024, time.August, 5), time.Time{}},
+		{date(2024, time.August, 12), date(2024, time.August, 5), date(2024, time.August, 12)},
+		// The range is complete, the next date starts a new one.
+		{date(2024, time.August, 20), date(2024, time.August, 20), time.Time{}},
+		// A range can start and end on the same day.
+		{date(2024, time.August, 20), date(2024, time.August, 20), date(2024, time.August, 20)},
+	}
+	for i, step := range steps {
+		s.selectDate(step.click)
+		if !s.SelectedStartDate().Equal(step.start) || !s.SelectedEndDate().Equal(step.end) {
+			t.Errorf("step %d: range = %v - %v, want %v - %v", i, s.SelectedStartDate(), s.SelectedEndDate(), step.start, step.end)
+		}
+	}
+}
+
+func TestRememberDatePickerState(t *testing.T) {
+	store := store.NewPersistentState(map[string]state.MutableValue{})
+	var states []*DatePickerState
+	for range 2 {
+		c := compose.NewComposer(store)
+		compose.CompositionLocalProvider1(platform.LocalLocale, system.Locale{Language: "de-DE"}, func(c Composer) Composer {
+			s := RememberDatePickerState(c, WithInitialSelectedDate(date(2024, time.August, 17)))
+			states = append(states, s)
+			return DatePicker(s)(c)
+		})(c).Build()
+	}
+	if states[0] != states[1] {
+		t.Fatalf("RememberDatePickerState returned a new state on recomposition")
+	}
+	if got := states[0].calendar.firstDayOfWeek; got != time.Monday {
+		t.Errorf("first day of the week of LocalLocale = %v, want Monday", got)
+	}
+}
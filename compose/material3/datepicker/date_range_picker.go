@@ -0,0 +1,92 @@
+package datepicker
+
+import (
+	"time"
+
+	"github.com/zodimo/go-compose/compose"
+	"github.com/zodimo/go-compose/compose/foundation/layout/arrangement"
+	"github.com/zodimo/go-compose/compose/foundation/layout/box"
+	"github.com/zodimo/go-compose/compose/foundation/layout/row"
+	"github.com/zodimo/go-compose/modifiers/padding"
+	"github.com/zodimo/go-compose/modifiers/size"
+	"github.com/zodimo/go-compose/modifiers/weight"
+)
+
+// DateRangePicker lets the user pick a range of dates from a calendar, or
+// type its start and end dates in. In the calendar, the first click starts
+// the range and the next one ends it.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/DateRangePicker.kt
+func DateRangePicker(state *DateRangePickerState, options ...DatePickerOption) Composable {
+	return func(c Composer) Composer {
+		opts := DefaultDatePickerOptions(c)
+		for _, option := range options {
+			if option == nil {
+				continue
+			}
+			option(&opts)
+		}
+
+		title := opts.Title
+		if title == nil {
+			title = datePickerTitle(state.DisplayMode(), "Select dates", "Enter dates", opts.Colors)
+		}
+		headline := opts.Headline
+		if headline == nil {
+			start := dateText(state.calendar, state.SelectedStartDate(), "Start date")
+			end := dateText(state.calendar, state.SelectedEndDate(), "End date")
+			headline = datePickerHeadline(start+" – "+end, opts.Colors)
+		}
+
+		var content Composable
+		if state.DisplayMode() == DisplayModeInput {
+			content = dateRangeInput(state)
+		} else {
+			content = calendar(&state.baseDatePickerState, daySelection{
+				isSelected: func(date time.Time) bool {
+					return date.Equal(state.SelectedStartDate()) || date.Equal(state.SelectedEndDate())
+				},
+				inRange: func(date time.Time) bool {
+					start, end := state.SelectedStartDate(), state.SelectedEndDate()
+					return !end.IsZero() && date.After(start) && date.Before(end)
+				},
+				onClick: state.selectDate,
+			}, opts.Colors)
+		}
+
+		return datePickerLayout(&state.baseDatePickerState, opts, title, headline, content)(c)
+	}
+}
+
+// dateRangeInput lets the user type the start and end dates of a range in.
+func dateRangeInput(state *DateRangePickerState) Composable {
+	start := dateInputField(&state.baseDatePickerState, "Start date", state.SelectedStartDate(), func(date time.Time) time.Time {
+		end := state.SelectedEndDate()
+		if date.IsZero() || end.Before(date) {
+			end = time.Time{}
+		}
+		state.SetSelection(date, end)
+		return state.SelectedStartDate()
+	}, nil, weight.Weight(1))
+	end := dateInputField(&state.baseDatePickerState, "End date", state.SelectedEndDate(), func(date time.Time) time.Time {
+		if state.SelectedStartDate().IsZero() || date.Before(state.SelectedStartDate()) {
+			date = time.Time{}
+		}
+		state.SetSelection(state.SelectedStartDate(), date)
+		return state.SelectedEndDate()
+	}, func(date time.Time) string {
+		if date.Before(state.SelectedStartDate()) {
+			return "Invalid date range input"
+		}
+		return ""
+	}, weight.Weight(1))
+
+	return box.Box(
+		row.Row(
+			compose.Sequence(start, end),
+			row.WithHorizontalArrangement(arrangement.SpacedBy(8, arrangement.AlignmentStart)),
+			row.WithModifier(size.FillMaxWidth()),
+		),
+		box.WithModifier(padding.Padding(24, 16, 24, 0)),
+	)
+}
@@ -0,0 +1,100 @@
+package datepicker
+
+import (
+	"github.com/zodimo/go-compose/compose/material3"
+	"github.com/zodimo/go-compose/compose/ui/graphics"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+)
+
+// DatePickerColors represents the colors of the date pickers.
+type DatePickerColors struct {
+	ContainerColor               graphics.Color
+	TitleContentColor            graphics.Color
+	HeadlineContentColor         graphics.Color
+	WeekdayContentColor          graphics.Color
+	NavigationContentColor       graphics.Color
+	DayContentColor              graphics.Color
+	DisabledDayContentColor      graphics.Color
+	SelectedDayContentColor      graphics.Color
+	SelectedDayContainerColor    graphics.Color
+	TodayContentColor            graphics.Color
+	TodayDateBorderColor         graphics.Color
+	DayInSelectionRangeColor     graphics.Color
+	DayInSelectionContainerColor graphics.Color
+	YearContentColor             graphics.Color
+	DisabledYearContentColor     graphics.Color
+	SelectedYearContentColor     graphics.Color
+	SelectedYearContainerColor   graphics.Color
+	DividerColor                 graphics.Color
+}
+
+// dayColors returns the content and container colors of a day.
+func (c DatePickerColors) dayColors(selected, inRange, today, enabled bool) (content, container graphics.Color) {
+	container = graphics.ColorTransparent
+	switch {
+	case selected:
+		content, container = c.SelectedDayContentColor, c.SelectedDayContainerColor
+	case !enabled:
+		content = c.DisabledDayContentColor
+	case inRange:
+		content = c.DayInSelectionRangeColor
+	case today:
+		content = c.TodayContentColor
+	default:
+		content = c.DayContentColor
+	}
+	return content, container
+}
+
+// yearColors returns the content and container colors of a year.
+func (c DatePickerColors) yearColors(selected, enabled bool) (content, container graphics.Color) {
+	switch {
+	case selected:
+		return c.SelectedYearContentColor, c.SelectedYearContainerColor
+	case !enabled:
+		return c.DisabledYearContentColor, graphics.ColorTransparent
+	default:
+		return c.YearContentColor, graphics.ColorTransparent
+	}
+}
+
+// DatePickerDefaults holds the default values of the date pickers.
+var DatePickerDefaults = datePickerDefaults{}
+
+type datePickerDefaults struct{}
+
+// Colors returns the default DatePickerColors, from the theme.
+func (d datePickerDefaults) Colors(c Composer) DatePickerColors {
+	scheme := material3.Theme(c).ColorScheme()
+	disabled := scheme.OnSurface.SetOpacity(0.38)
+	return DatePickerColors{
+		ContainerColor:               scheme.SurfaceContainerHigh,
+		TitleContentColor:            scheme.OnSurfaceVariant,
+		HeadlineContentColor:         scheme.OnSurfaceVariant,
+		WeekdayContentColor:          scheme.OnSurface,
+		NavigationContentColor:       scheme.OnSurfaceVariant,
+		DayContentColor:              scheme.OnSurface,
+		DisabledDayContentColor:      disabled,
+		SelectedDayContentColor:      scheme.OnPrimary,
+		SelectedDayContainerColor:    scheme.Primary,
+		TodayContentColor:            scheme.Primary,
+		TodayDateBorderColor:         scheme.Primary,
+		DayInSelectionRangeColor:     scheme.OnSecondaryContainer,
+		DayInSelectionContainerColor: scheme.SecondaryContainer,
+		YearContentColor:             scheme.OnSurfaceVariant,
+		DisabledYearContentColor:     disabled,
+		SelectedYearContentColor:     scheme.OnPrimary,
+		SelectedYearContainerColor:   scheme.Primary,
+		DividerColor:                 scheme.OutlineVariant,
+	}
+}
+
+// YearRange returns the years the date pickers show by default.
+func (d datePickerDefaults) YearRange() YearRange {
+	return YearRange{Start: 1900, End: 2100}
+}
+
+// Width returns the width of the date pickers.
+func (d datePickerDefaults) Width() unit.Dp {
+	return 360
+}
@@ -0,0 +1,21 @@
+/*
+Package datepicker contains Material 3 date pickers: DatePicker, to pick a
+date, and DateRangePicker, to pick a range of dates, from a calendar or as text
+typed in. DatePickerDialog shows them in a dialog:
+
+	state := datepicker.RememberDatePickerState(c,
+		datepicker.WithYearRange(2000, 2030),
+		datepicker.WithSelectableDates(datepicker.SelectableDatesFunc(func(date time.Time) bool {
+			return date.Weekday() != time.Sunday
+		})),
+	)
+	datepicker.DatePickerDialog(onDismiss, button.Text(onConfirm, "OK"), datepicker.DatePicker(state))
+
+Dates are days at midnight UTC. Month and weekday names, the first day of the
+week and the pattern of dates typed in follow the locale of
+platform.LocalLocale.
+
+Reference: [Date pickers](https://m3.material.io/components/date-pickers/overview)
+Specs: [Date pickers Specs](https://m3.material.io/components/date-pickers/specs)
+*/
+package datepicker
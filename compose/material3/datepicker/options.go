@@ -0,0 +1,82 @@
+package datepicker
+
+import (
+	"github.com/zodimo/go-compose/compose/ui"
+)
+
+type DatePickerOptions struct {
+	Modifier ui.Modifier
+	// Title replaces the title above the headline, "Select date" by default.
+	Title Composable
+	// Headline replaces the headline, the selected date by default.
+	Headline Composable
+	// ShowModeToggle shows the button that switches between the calendar
+	// and the text input.
+	ShowModeToggle bool
+	Colors         DatePickerColors
+}
+
+type DatePickerOption func(*DatePickerOptions)
+
+func DefaultDatePickerOptions(c Composer) DatePickerOptions {
+	return DatePickerOptions{
+		Modifier:       ui.EmptyModifier,
+		ShowModeToggle: true,
+		Colors:         DatePickerDefaults.Colors(c),
+	}
+}
+
+func WithModifier(m ui.Modifier) DatePickerOption {
+	return func(o *DatePickerOptions) {
+		o.Modifier = m
+	}
+}
+
+func WithTitle(title Composable) DatePickerOption {
+	return func(o *DatePickerOptions) {
+		o.Title = title
+	}
+}
+
+func WithHeadline(headline Composable) DatePickerOption {
+	return func(o *DatePickerOptions) {
+		o.Headline = headline
+	}
+}
+
+func WithShowModeToggle(show bool) DatePickerOption {
+	return func(o *DatePickerOptions) {
+		o.ShowModeToggle = show
+	}
+}
+
+func WithColors(colors DatePickerColors) DatePickerOption {
+	return func(o *DatePickerOptions) {
+		o.Colors = colors
+	}
+}
+
+type DatePickerDialogOptions struct {
+	Modifier      ui.Modifier
+	DismissButton Composable
+}
+
+type DatePickerDialogOption func(*DatePickerDialogOptions)
+
+func DefaultDatePickerDialogOptions() DatePickerDialogOptions {
+	return DatePickerDialogOptions{
+		Modifier: ui.EmptyModifier,
+	}
+}
+
+func WithDialogModifier(m ui.Modifier) DatePickerDialogOption {
+	return func(o *DatePickerDialogOptions) {
+		o.Modifier = m
+	}
+}
+
+func WithDismissButton(dismissButton Composable) DatePickerDialogOption {
+	return func(o *DatePickerDialogOptions) {
+		o.DismissButton = dismissButton
+	}
+}
@@ -2,6 +2,7 @@ package dialog
 
 import (
 	"github.com/zodimo/go-compose/compose"
+	"github.com/zodimo/go-compose/compose/foundation/layout/arrangement"
 	"github.com/zodimo/go-compose/compose/foundation/layout/box"
 	"github.com/zodimo/go-compose/compose/foundation/layout/column"
 	"github.com/zodimo/go-compose/compose/foundation/layout/overlay"
@@ -118,7 +119,7 @@ func DialogButtonRow(buttons ...api.Composable) api.Composable {
 
 	return row.Row(
 		compose.Sequence(rowItems...),
-		row.WithSpacing(row.SpaceStart), // Push to end by leaving space at start
+		row.WithHorizontalArrangement(arrangement.End),
 	)
 }
 
@@ -0,0 +1,149 @@
+package floatingactionbutton
+
+import (
+	"image"
+	"math"
+	"time"
+
+	"github.com/zodimo/go-compose/compose/ui"
+	node "github.com/zodimo/go-compose/internal/Node"
+	"github.com/zodimo/go-compose/internal/animation"
+	"github.com/zodimo/go-compose/internal/layoutnode"
+	"github.com/zodimo/go-compose/internal/modifier"
+
+	"gioui.org/op"
+	"gioui.org/op/clip"
+)
+
+// progressAnimation animates a progress between 0 and 1 toward a target,
+// taking duration to cover the whole range. When the target changes
+// mid-animation, it turns back from the progress reached.
+type progressAnimation struct {
+	duration time.Duration
+	target   float32
+	anim     animation.FloatAnimation
+}
+
+func newProgressAnimation(target float32, duration time.Duration) *progressAnimation {
+	return &progressAnimation{
+		duration: duration,
+		target:   target,
+		anim:     animation.FloatAnimation{From: target, To: target},
+	}
+}
+
+// animateTo starts animating toward target at now, unless it is the target
+// already.
+func (a *progressAnimation) animateTo(target float32, now time.Time) {
+	if target == a.target {
+		return
+	}
+	from := a.valueAt(now)
+	a.target = target
+	a.anim = animation.FloatAnimation{
+		From:     from,
+		To:       target,
+		Duration: time.Duration(float32(a.duration) * float32(math.Abs(float64(target-from)))),
+		Started:  now,
+	}
+}
+
+// valueAt returns the progress at now.
+func (a *progressAnimation) valueAt(now time.Time) float32 {
+	value, _ := a.anim.ValueAt(now)
+	return value
+}
+
+// progress returns the progress at gtx.Now, requesting a frame while the
+// animation runs.
+func (a *progressAnimation) progress(gtx layoutnode.LayoutContext) float32 {
+	value, done := a.anim.ValueAt(gtx.Now)
+	if !done {
+		gtx.Execute(op.InvalidateCmd{})
+	}
+	return value
+}
+
+// visible reports whether the progress is above 0, or animating toward a
+// target above 0, at now.
+func (a *progressAnimation) visible(now time.Time) bool {
+	return a.target > 0 || a.valueAt(now) > 0
+}
+
+// progressLayout lays out widget given the progress of an animation.
+type progressLayout func(gtx layoutnode.LayoutContext, progress float32, widget layoutnode.LayoutWidget) layoutnode.LayoutDimensions
+
+// animatedLayoutModifier lays its content out with layout, at the progress
+// anim has reached, requesting frames for as long as anim is animating.
+func animatedLayoutModifier(name string, anim *progressAnimation, layout progressLayout) ui.Modifier {
+	return modifier.NewInspectableModifier(
+		modifier.NewModifier(&animatedLayoutElement{anim: anim, layout: layout}),
+		modifier.NewInspectorInfo(name, map[string]any{
+			"anim": anim,
+		}),
+	)
+}
+
+type animatedLayoutElement struct {
+	anim   *progressAnimation
+	layout progressLayout
+}
+
+func (e *animatedLayoutElement) Create() node.Node {
+	return newAnimatedLayoutNode(e)
+}
+
+func (e *animatedLayoutElement) Update(n node.Node) {
+	n.(*animatedLayoutNode).element = e
+}
+
+// Equals is always false, as layout closes over the composition.
+func (e *animatedLayoutElement) Equals(other modifier.Element) bool {
+	return false
+}
+
+type animatedLayoutNode struct {
+	node.ChainNode
+	element *animatedLayoutElement
+}
+
+func newAnimatedLayoutNode(element *animatedLayoutElement) *animatedLayoutNode {
+	n := &animatedLayoutNode{element: element}
+	n.ChainNode = node.NewChainNode(
+		node.NewNodeID(),
+		node.NodeKindLayout,
+		node.LayoutPhase,
+		func(t node.TreeNode) {
+			t.(layoutnode.LayoutModifierNode).AttachLayoutModifier(func(widget layoutnode.LayoutWidget) layoutnode.LayoutWidget {
+				return layoutnode.NewLayoutWidget(func(gtx layoutnode.LayoutContext) layoutnode.LayoutDimensions {
+					e := n.element
+					return e.layout(gtx, e.anim.progress(gtx), widget)
+				})
+			})
+		},
+	)
+	return n
+}
+
+// revealLayout lays widget out, then shows size of it, the part at offset
+// within it, as a layout of size.
+func revealLayout(gtx layoutnode.LayoutContext, widget layoutnode.LayoutWidget, reveal func(content image.Point) (size, offset image.Point)) layoutnode.LayoutDimensions {
+	macro := op.Record(gtx.Ops)
+	dims := widget.Layout(gtx)
+	call := macro.Stop()
+
+	size, offset := reveal(dims.Size)
+	if size.X <= 0 || size.Y <= 0 {
+		return layoutnode.LayoutDimensions{}
+	}
+	clipped := clip.Rect{Max: size}.Push(gtx.Ops)
+	moved := op.Offset(offset.Mul(-1)).Push(gtx.Ops)
+	call.Add(gtx.Ops)
+	moved.Pop()
+	clipped.Pop()
+	return layoutnode.LayoutDimensions{Size: size}
+}
+
+func lerpInt(start, stop int, fraction float32) int {
+	return start + int(math.Round(float64(float32(stop-start)*fraction)))
+}
@@ -0,0 +1,111 @@
+package floatingactionbutton
+
+import (
+	"time"
+
+	"github.com/zodimo/go-compose/compose/material3"
+	"github.com/zodimo/go-compose/compose/ui/graphics/shape"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+)
+
+// FloatingActionButtonDefaults holds the default values of the floating
+// action buttons.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/FloatingActionButton.kt
+var FloatingActionButtonDefaults = floatingActionButtonDefaults{}
+
+type floatingActionButtonDefaults struct{}
+
+// SmallShape is the shape of a SmallFloatingActionButton.
+func (floatingActionButtonDefaults) SmallShape() shape.Shape {
+	return &shape.RoundedCornerShape{Radius: 12}
+}
+
+// LargeShape is the shape of a LargeFloatingActionButton.
+func (floatingActionButtonDefaults) LargeShape() shape.Shape {
+	return &shape.RoundedCornerShape{Radius: 28}
+}
+
+// ExtendedFabMinWidth is the minimum width of an expanded
+// ExtendedFloatingActionButton.
+func (floatingActionButtonDefaults) ExtendedFabMinWidth() unit.Dp {
+	return 80
+}
+
+// ExtendedFabCollapsedSize is the size of a collapsed
+// ExtendedFloatingActionButton, that of a FloatingActionButton.
+func (floatingActionButtonDefaults) ExtendedFabCollapsedSize() unit.Dp {
+	return 56
+}
+
+// ExtendedFabStartPadding and ExtendedFabEndPadding pad the icon and the
+// text of an ExtendedFloatingActionButton, ExtendedFabIconSpacing apart.
+func (floatingActionButtonDefaults) ExtendedFabStartPadding() unit.Dp {
+	return 16
+}
+
+func (floatingActionButtonDefaults) ExtendedFabEndPadding() unit.Dp {
+	return 20
+}
+
+func (floatingActionButtonDefaults) ExtendedFabIconSpacing() unit.Dp {
+	return 12
+}
+
+// ExtendedFabDuration is how long an ExtendedFloatingActionButton takes to
+// expand or collapse.
+func (floatingActionButtonDefaults) ExtendedFabDuration() time.Duration {
+	return material3.DefaultMotionTokens.DurationMedium2
+}
+
+// ToggleShapeRadius and ToggleCheckedShapeRadius are the corner radii of a
+// ToggleFloatingActionButton, unchecked and checked.
+func (floatingActionButtonDefaults) ToggleShapeRadius() unit.Dp {
+	return 16
+}
+
+func (floatingActionButtonDefaults) ToggleCheckedShapeRadius() unit.Dp {
+	return 28
+}
+
+// ToggleDuration is how long a ToggleFloatingActionButton takes to be
+// checked or unchecked.
+func (floatingActionButtonDefaults) ToggleDuration() time.Duration {
+	return material3.DefaultMotionTokens.DurationMedium1
+}
+
+// MenuItemHeight is the height of a FloatingActionButtonMenuItem, whose
+// icon and text are MenuItemIconSpacing apart and padded by
+// MenuItemHorizontalPadding.
+func (floatingActionButtonDefaults) MenuItemHeight() unit.Dp {
+	return 56
+}
+
+func (floatingActionButtonDefaults) MenuItemHorizontalPadding() unit.Dp {
+	return 24
+}
+
+func (floatingActionButtonDefaults) MenuItemIconSpacing() unit.Dp {
+	return 8
+}
+
+// MenuItemSpacing is the space between the items of a
+// FloatingActionButtonMenu, and MenuButtonSpacing between them and its
+// button.
+func (floatingActionButtonDefaults) MenuItemSpacing() unit.Dp {
+	return 4
+}
+
+func (floatingActionButtonDefaults) MenuButtonSpacing() unit.Dp {
+	return 8
+}
+
+// MenuItemDuration is how long an item of a FloatingActionButtonMenu takes
+// to show or hide, MenuItemStagger after the one before it.
+func (floatingActionButtonDefaults) MenuItemDuration() time.Duration {
+	return material3.DefaultMotionTokens.DurationMedium1
+}
+
+func (floatingActionButtonDefaults) MenuItemStagger() time.Duration {
+	return material3.DefaultMotionTokens.DurationShort1
+}
@@ -0,0 +1,115 @@
+package floatingactionbutton
+
+import (
+	"fmt"
+	"image"
+	"time"
+
+	"github.com/zodimo/go-compose/compose"
+	"github.com/zodimo/go-compose/compose/foundation/layout/row"
+	"github.com/zodimo/go-compose/compose/foundation/layout/spacer"
+	"github.com/zodimo/go-compose/compose/material3"
+	"github.com/zodimo/go-compose/compose/ui/platform"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+	"github.com/zodimo/go-compose/internal/layoutnode"
+	"github.com/zodimo/go-compose/modifiers/clickable"
+	"github.com/zodimo/go-compose/modifiers/padding"
+	"github.com/zodimo/go-compose/modifiers/size"
+	"github.com/zodimo/go-compose/pkg/api"
+)
+
+// ExtendedFloatingActionButton is a Material 3 Extended Floating Action
+// Button, showing text after icon. It collapses to a FloatingActionButton
+// showing icon alone when expanded is false, and animates between the two,
+// such as when the content under it scrolls:
+//
+//	expanded := listState.FirstVisibleItemIndex() == 0
+//	floatingactionbutton.ExtendedFloatingActionButton(onClick, text.Text("Compose"), icon.Icon(icons.ContentCreate), expanded)
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/FloatingActionButton.kt
+func ExtendedFloatingActionButton(
+	onClick func(),
+	text api.Composable,
+	icon api.Composable,
+	expanded bool,
+	options ...FloatingActionButtonOption,
+) api.Composable {
+	return func(c api.Composer) api.Composer {
+		opts := DefaultFloatingActionButtonOptions(c)
+		for _, option := range options {
+			if option == nil {
+				continue
+			}
+			option(&opts)
+		}
+
+		fabClickable := rememberClickable(c, "extended_fab_clickable")
+		elevation := pressedElevation(fabClickable, opts.Elevation)
+		anim := rememberProgressAnimation(c, "extended_fab_anim", expanded, FloatingActionButtonDefaults.ExtendedFabDuration())
+		rtl := platform.LocalLayoutDirection.Current(c) == unit.LayoutDirectionRtl
+
+		fabModifier := opts.Modifier.Then(
+			clickable.OnClick(onClick, clickable.WithClickable(fabClickable)),
+		).Then(
+			size.Height(int(FloatingActionButtonDefaults.ExtendedFabCollapsedSize())),
+		)
+
+		return SurfaceWithThemeDefaults(
+			fabClickable,
+			elevation,
+			opts,
+			fabModifier,
+			row.Row(
+				compose.Sequence(
+					icon,
+					spacer.Spacer(int(FloatingActionButtonDefaults.ExtendedFabIconSpacing()), 0),
+					text,
+				),
+				row.WithAlignment(row.Middle),
+				row.WithModifier(
+					animatedLayoutModifier("extendedFloatingActionButton", anim, func(gtx layoutnode.LayoutContext, progress float32, widget layoutnode.LayoutWidget) layoutnode.LayoutDimensions {
+						return extendedLayout(gtx, progress, rtl, widget)
+					}).Then(padding.Padding(
+						int(FloatingActionButtonDefaults.ExtendedFabStartPadding()), 0,
+						int(FloatingActionButtonDefaults.ExtendedFabEndPadding()), 0,
+					)).Then(size.FillMaxHeight()),
+				),
+			),
+		)(c)
+	}
+}
+
+// extendedLayout shows as much of the extended content of an
+// ExtendedFloatingActionButton as it is expanded, by progress, from its start,
+// where the icon is: all of it when expanded, the size of a
+// FloatingActionButton when collapsed.
+func extendedLayout(gtx layoutnode.LayoutContext, progress float32, rtl bool, widget layoutnode.LayoutWidget) layoutnode.LayoutDimensions {
+	collapsed := gtx.Dp(unit.DpToGioUnit(FloatingActionButtonDefaults.ExtendedFabCollapsedSize()))
+	minWidth := gtx.Dp(unit.DpToGioUnit(FloatingActionButtonDefaults.ExtendedFabMinWidth()))
+	gtx.Constraints.Min.X = 0
+	progress = material3.EasingEmphasized.Transform(progress)
+	return revealLayout(gtx, widget, func(content image.Point) (image.Point, image.Point) {
+		width := lerpInt(collapsed, max(content.X, minWidth), progress)
+		if rtl {
+			return image.Pt(width, content.Y), image.Pt(content.X-width, 0)
+		}
+		return image.Pt(width, content.Y), image.Point{}
+	})
+}
+
+// rememberProgressAnimation returns an animation of duration that keeps
+// across recompositions, animating toward 1 while on is true and toward 0
+// otherwise. It starts out settled at on.
+func rememberProgressAnimation(c api.Composer, name string, on bool, duration time.Duration) *progressAnimation {
+	var target float32
+	if on {
+		target = 1
+	}
+	statePath := fmt.Sprintf("%d/%s/%s", c.GenerateID(), c.GetPath(), name)
+	anim := c.State(statePath, func() any {
+		return newProgressAnimation(target, duration)
+	}).Get().(*progressAnimation)
+	anim.duration = duration
+	anim.animateTo(target, time.Now())
+	return anim
+}
@@ -32,20 +32,8 @@ func FloatingActionButton(
 			option(&opts)
 		}
 
-		// Managing state for interaction (Pressed/Hovered)
-		key := c.GenerateID()
-		path := c.GetPath()
-		statePath := fmt.Sprintf("%d/%s/fab_clickable", key, path)
-		clickableState := c.State(statePath, func() any { return &widget.Clickable{} })
-		fabClickable := clickableState.Get().(*widget.Clickable)
-
-		// Determine Elevation based on state
-		elevation := opts.Elevation
-		if fabClickable.Pressed() {
-			elevation = token.ElevationLevel3
-		} else if fabClickable.Hovered() {
-			elevation = token.ElevationLevel4
-		}
+		fabClickable := rememberClickable(c, "fab_clickable")
+		elevation := pressedElevation(fabClickable, opts.Elevation)
 
 		// Construct modifier chain
 		fabModifier := opts.Modifier.Then(
@@ -68,6 +56,54 @@ func FloatingActionButton(
 	}
 }
 
+// SmallFloatingActionButton is a 40dp FloatingActionButton, for a secondary
+// action or to sit alongside other buttons.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/FloatingActionButton.kt
+func SmallFloatingActionButton(
+	onClick func(),
+	content api.Composable,
+	options ...FloatingActionButtonOption,
+) api.Composable {
+	return FloatingActionButton(onClick, content, append([]FloatingActionButtonOption{
+		WithSize(FabSizeSmall),
+		WithShape(FloatingActionButtonDefaults.SmallShape()),
+	}, options...)...)
+}
+
+// LargeFloatingActionButton is a 96dp FloatingActionButton, for the primary
+// action of a screen with room for it.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/FloatingActionButton.kt
+func LargeFloatingActionButton(
+	onClick func(),
+	content api.Composable,
+	options ...FloatingActionButtonOption,
+) api.Composable {
+	return FloatingActionButton(onClick, content, append([]FloatingActionButtonOption{
+		WithSize(FabSizeLarge),
+		WithShape(FloatingActionButtonDefaults.LargeShape()),
+	}, options...)...)
+}
+
+// rememberClickable returns the clickable of a button, that keeps its
+// interactions (Pressed/Hovered) across recompositions.
+func rememberClickable(c api.Composer, name string) *widget.Clickable {
+	statePath := fmt.Sprintf("%d/%s/%s", c.GenerateID(), c.GetPath(), name)
+	return c.State(statePath, func() any { return &widget.Clickable{} }).Get().(*widget.Clickable)
+}
+
+// pressedElevation returns the elevation of a button of elevation, raised
+// as it is pressed or hovered.
+func pressedElevation(fabClickable *widget.Clickable, elevation token.ElevationLevel) token.ElevationLevel {
+	if fabClickable.Pressed() {
+		return token.ElevationLevel3
+	} else if fabClickable.Hovered() {
+		return token.ElevationLevel4
+	}
+	return elevation
+}
+
 // SurfaceWithThemeDefaults wraps Surface.
 func SurfaceWithThemeDefaults(
 	fabClickable *widget.Clickable,
@@ -84,6 +120,7 @@ func SurfaceWithThemeDefaults(
 			surface.WithColor(opts.ContainerColor),
 			surface.WithContentColor(opts.ContentColor),
 			surface.WithModifier(fabModifier),
+			surface.WithFocusIndicator(true),
 		}
 
 		return surface.Surface(
@@ -0,0 +1,156 @@
+package floatingactionbutton
+
+import (
+	"image"
+	"time"
+
+	"github.com/zodimo/go-compose/compose"
+	"github.com/zodimo/go-compose/compose/foundation/layout/box"
+	"github.com/zodimo/go-compose/compose/foundation/layout/column"
+	"github.com/zodimo/go-compose/compose/foundation/layout/row"
+	"github.com/zodimo/go-compose/compose/foundation/layout/spacer"
+	"github.com/zodimo/go-compose/compose/material3"
+	"github.com/zodimo/go-compose/compose/material3/surface"
+	"github.com/zodimo/go-compose/compose/ui/graphics/shape"
+	"github.com/zodimo/go-compose/compose/ui/platform"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+	"github.com/zodimo/go-compose/internal/layoutnode"
+	"github.com/zodimo/go-compose/modifiers/clickable"
+	"github.com/zodimo/go-compose/modifiers/padding"
+	"github.com/zodimo/go-compose/modifiers/size"
+	"github.com/zodimo/go-compose/pkg/api"
+)
+
+// FloatingActionButtonMenu is a Material 3 expressive menu of the items
+// above button, typically a ToggleFloatingActionButton checked when
+// expanded. As it expands, the items show one after the other, from the
+// closest to button, and hide in the reverse order as it collapses.
+//
+// The items, typically FloatingActionButtonMenuItems, are aligned with
+// button on the side of the Scaffold the menu is the floating action button
+// of, as set by scaffold.WithFloatingActionButtonPosition, or as set by
+// WithMenuHorizontalAlignment.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/FloatingActionButtonMenu.kt
+func FloatingActionButtonMenu(
+	expanded bool,
+	button api.Composable,
+	items []api.Composable,
+	options ...FloatingActionButtonMenuOption,
+) api.Composable {
+	return func(c api.Composer) api.Composer {
+		opts := DefaultFloatingActionButtonMenuOptions(c)
+		for _, option := range options {
+			if option == nil {
+				continue
+			}
+			option(&opts)
+		}
+
+		itemDuration := FloatingActionButtonDefaults.MenuItemDuration()
+		stagger := FloatingActionButtonDefaults.MenuItemStagger()
+		duration := itemDuration + time.Duration(max(len(items)-1, 0))*stagger
+		anim := rememberProgressAnimation(c, "fab_menu_anim", expanded, duration)
+		rtl := platform.LocalLayoutDirection.Current(c) == unit.LayoutDirectionRtl
+
+		menuItems := make([]api.Composable, len(items))
+		for i, item := range items {
+			// The items show from the one closest to button.
+			order := len(items) - 1 - i
+			itemModifier := animatedLayoutModifier("floatingActionButtonMenuItem", anim, func(gtx layoutnode.LayoutContext, progress float32, widget layoutnode.LayoutWidget) layoutnode.LayoutDimensions {
+				itemProgress := (progress*float32(duration) - float32(time.Duration(order)*stagger)) / float32(itemDuration)
+				return menuItemLayout(gtx, min(max(itemProgress, 0), 1), opts.HorizontalAlignment, rtl, widget)
+			})
+			if i < len(items)-1 {
+				itemModifier = itemModifier.Then(padding.Padding(0, 0, 0, int(FloatingActionButtonDefaults.MenuItemSpacing())))
+			}
+			menuItems[i] = box.Box(item, box.WithModifier(itemModifier))
+		}
+
+		return column.Column(
+			compose.Sequence(
+				c.When(
+					len(items) > 0 && anim.visible(time.Now()),
+					column.Column(
+						compose.Sequence(menuItems...),
+						column.WithAlignment(opts.HorizontalAlignment),
+						column.WithModifier(padding.Padding(0, 0, 0, int(FloatingActionButtonDefaults.MenuButtonSpacing()))),
+					),
+				),
+				button,
+			),
+			column.WithAlignment(opts.HorizontalAlignment),
+			column.WithModifier(opts.Modifier),
+		)(c)
+	}
+}
+
+// menuItemLayout shows as much of an item of a FloatingActionButtonMenu as
+// it is shown, by progress, growing out of the button below it from the side
+// the items are aligned on.
+func menuItemLayout(gtx layoutnode.LayoutContext, progress float32, alignment column.Alignment, rtl bool, widget layoutnode.LayoutWidget) layoutnode.LayoutDimensions {
+	if progress <= 0 {
+		return layoutnode.LayoutDimensions{}
+	}
+	progress = material3.EasingEmphasizedDecelerate.Transform(progress)
+	start := alignment == column.Start
+	if rtl {
+		start = alignment == column.End
+	}
+	return revealLayout(gtx, widget, func(content image.Point) (image.Point, image.Point) {
+		size := image.Pt(lerpInt(0, content.X, progress), lerpInt(0, content.Y, progress))
+		offset := image.Pt(content.X-size.X, content.Y-size.Y)
+		switch {
+		case alignment == column.Middle:
+			offset.X /= 2
+		case start:
+			offset.X = 0
+		}
+		return size, offset
+	})
+}
+
+// FloatingActionButtonMenuItem is an item of a FloatingActionButtonMenu,
+// showing text after icon.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/FloatingActionButtonMenu.kt
+func FloatingActionButtonMenuItem(
+	onClick func(),
+	text api.Composable,
+	icon api.Composable,
+	options ...FloatingActionButtonMenuItemOption,
+) api.Composable {
+	return func(c api.Composer) api.Composer {
+		opts := DefaultFloatingActionButtonMenuItemOptions(c)
+		for _, option := range options {
+			if option == nil {
+				continue
+			}
+			option(&opts)
+		}
+
+		itemClickable := rememberClickable(c, "fab_menu_item_clickable")
+		horizontalPadding := int(FloatingActionButtonDefaults.MenuItemHorizontalPadding())
+
+		return surface.Surface(
+			row.Row(
+				compose.Sequence(
+					icon,
+					spacer.Spacer(int(FloatingActionButtonDefaults.MenuItemIconSpacing()), 0),
+					text,
+				),
+				row.WithAlignment(row.Middle),
+				row.WithModifier(padding.Padding(horizontalPadding, 0, horizontalPadding, 0).Then(size.FillMaxHeight())),
+			),
+			surface.WithShape(shape.ShapeCircle),
+			surface.WithColor(opts.ContainerColor),
+			surface.WithContentColor(opts.ContentColor),
+			surface.WithModifier(opts.Modifier.Then(
+				clickable.OnClick(onClick, clickable.WithClickable(itemClickable)),
+			).Then(
+				size.Height(int(FloatingActionButtonDefaults.MenuItemHeight())),
+			)),
+			surface.WithFocusIndicator(true),
+		)(c)
+	}
+}
@@ -0,0 +1,93 @@
+package floatingactionbutton
+
+import (
+	"image"
+	"testing"
+	"time"
+
+	"github.com/zodimo/go-compose/compose/foundation/layout/column"
+	"github.com/zodimo/go-compose/internal/layoutnode"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+	gioUnit "gioui.org/unit"
+)
+
+func testContext() layoutnode.LayoutContext {
+	return layout.Context{
+		Ops:         new(op.Ops),
+		Constraints: layout.Constraints{Max: image.Pt(400, 400)},
+		Metric:      gioUnit.Metric{PxPerDp: 1, PxPerSp: 1},
+	}
+}
+
+func sizedWidget(width, height int) layoutnode.LayoutWidget {
+	return layoutnode.NewLayoutWidget(func(gtx layoutnode.LayoutContext) layoutnode.LayoutDimensions {
+		return layoutnode.LayoutDimensions{Size: image.Pt(width, height)}
+	})
+}
+
+func TestExtendedLayout(t *testing.T) {
+	tests := []struct {
+		name         string
+		contentWidth int
+		progress     float32
+		want         int
+	}{
+		{"collapsed", 200, 0, 56},
+		{"expanded", 200, 1, 200},
+		{"expanded to min width", 60, 1, 80},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dims := extendedLayout(testContext(), tt.progress, false, sizedWidget(tt.contentWidth, 56))
+			if got := dims.Size; got != image.Pt(tt.want, 56) {
+				t.Errorf("size = %v, want %v", got, image.Pt(tt.want, 56))
+			}
+		})
+	}
+
+	halfway := extendedLayout(testContext(), 0.5, false, sizedWidget(200, 56)).Size.X
+	if halfway <= 56 || halfway >= 200 {
+		t.Errorf("halfway width = %d, want between 56 and 200", halfway)
+	}
+}
+
+func TestMenuItemLayout(t *testing.T) {
+	if dims := menuItemLayout(testContext(), 0, column.End, false, sizedWidget(120, 56)); dims.Size != (image.Point{}) {
+		t.Errorf("hidden item size = %v, want none", dims.Size)
+	}
+	if dims := menuItemLayout(testContext(), 1, column.End, false, sizedWidget(120, 56)); dims.Size != image.Pt(120, 56) {
+		t.Errorf("shown item size = %v, want %v", dims.Size, image.Pt(120, 56))
+	}
+	dims := menuItemLayout(testContext(), 0.2, column.Start, false, sizedWidget(120, 56))
+	if dims.Size.X <= 0 || dims.Size.X >= 120 || dims.Size.Y <= 0 || dims.Size.Y >= 56 {
+		t.Errorf("showing item size = %v, want within %v", dims.Size, image.Pt(120, 56))
+	}
+}
+
+func TestProgressAnimation_Reverse(t *testing.T) {
+	start := time.Now()
+	at := func(d time.Duration) time.Time { return start.Add(d) }
+	anim := newProgressAnimation(0, 100*time.Millisecond)
+
+	// Expanding, then collapsing halfway through.
+	anim.animateTo(1, start)
+	if got := anim.valueAt(at(50 * time.Millisecond)); got != 0.5 {
+		t.Fatalf("progress halfway = %v, want 0.5", got)
+	}
+	anim.animateTo(1, at(50*time.Millisecond))
+	anim.animateTo(0, at(50*time.Millisecond))
+	if got := anim.valueAt(at(50 * time.Millisecond)); got != 0.5 {
+		t.Errorf("progress once reversed = %v, want 0.5, where it turned back", got)
+	}
+	if got := anim.valueAt(at(75 * time.Millisecond)); got != 0.25 {
+		t.Errorf("progress while collapsing = %v, want 0.25", got)
+	}
+	if got := anim.valueAt(at(100 * time.Millisecond)); got != 0 {
+		t.Errorf("progress once collapsed = %v, want 0, in the time left", got)
+	}
+	if anim.visible(at(100 * time.Millisecond)) {
+		t.Error("a collapsed animation should not be visible")
+	}
+}
@@ -1,7 +1,9 @@
 package floatingactionbutton
 
 import (
+	"github.com/zodimo/go-compose/compose/foundation/layout/column"
 	"github.com/zodimo/go-compose/compose/material3"
+	"github.com/zodimo/go-compose/compose/material3/scaffold"
 	"github.com/zodimo/go-compose/compose/ui"
 	"github.com/zodimo/go-compose/compose/ui/graphics"
 	"github.com/zodimo/go-compose/compose/ui/graphics/shape"
@@ -34,6 +36,10 @@ type FloatingActionButtonOptions struct {
 	Modifier       ui.Modifier
 	Shape          shape.Shape
 	Size           FabSize
+	// CheckedContainerColor and CheckedContentColor are the colors of a
+	// checked ToggleFloatingActionButton.
+	CheckedContainerColor graphics.Color
+	CheckedContentColor   graphics.Color
 }
 
 type FloatingActionButtonOption func(*FloatingActionButtonOptions)
@@ -50,6 +56,9 @@ func DefaultFloatingActionButtonOptions(c api.Composer) FloatingActionButtonOpti
 		Modifier:       modifier.EmptyModifier,
 		Shape:          shape.ShapeCircle,
 		Size:           FabSizeMedium,
+
+		CheckedContainerColor: theme.ColorScheme().Primary,
+		CheckedContentColor:   theme.ColorScheme().OnPrimary,
 	}
 }
 
@@ -89,3 +98,93 @@ func WithSize(size FabSize) FloatingActionButtonOption {
 		o.Size = size
 	}
 }
+
+// WithCheckedContainerColor sets the container color of a checked
+// ToggleFloatingActionButton.
+func WithCheckedContainerColor(col graphics.Color) FloatingActionButtonOption {
+	return func(o *FloatingActionButtonOptions) {
+		o.CheckedContainerColor = col
+	}
+}
+
+// WithCheckedContentColor sets the content color of a checked
+// ToggleFloatingActionButton.
+func WithCheckedContentColor(col graphics.Color) FloatingActionButtonOption {
+	return func(o *FloatingActionButtonOptions) {
+		o.CheckedContentColor = col
+	}
+}
+
+type FloatingActionButtonMenuOptions struct {
+	Modifier ui.Modifier
+	// HorizontalAlignment aligns the items with the button.
+	HorizontalAlignment column.Alignment
+}
+
+type FloatingActionButtonMenuOption func(*FloatingActionButtonMenuOptions)
+
+// DefaultFloatingActionButtonMenuOptions returns the default options of a
+// FloatingActionButtonMenu, whose items are aligned on the side of the
+// Scaffold it is the floating action button of.
+func DefaultFloatingActionButtonMenuOptions(c api.Composer) FloatingActionButtonMenuOptions {
+	alignment := column.End
+	switch scaffold.LocalFabPosition.Current(c) {
+	case scaffold.FabPositionCenter:
+		alignment = column.Middle
+	case scaffold.FabPositionStart:
+		alignment = column.Start
+	}
+	return FloatingActionButtonMenuOptions{
+		Modifier:            modifier.EmptyModifier,
+		HorizontalAlignment: alignment,
+	}
+}
+
+func WithMenuModifier(m ui.Modifier) FloatingActionButtonMenuOption {
+	return func(o *FloatingActionButtonMenuOptions) {
+		o.Modifier = m
+	}
+}
+
+// WithMenuHorizontalAlignment aligns the items of the menu with its button
+// at its start, end or middle.
+func WithMenuHorizontalAlignment(alignment column.Alignment) FloatingActionButtonMenuOption {
+	return func(o *FloatingActionButtonMenuOptions) {
+		o.HorizontalAlignment = alignment
+	}
+}
+
+type FloatingActionButtonMenuItemOptions struct {
+	Modifier       ui.Modifier
+	ContainerColor graphics.Color
+	ContentColor   graphics.Color
+}
+
+type FloatingActionButtonMenuItemOption func(*FloatingActionButtonMenuItemOptions)
+
+func DefaultFloatingActionButtonMenuItemOptions(c api.Composer) FloatingActionButtonMenuItemOptions {
+	theme := material3.Theme(c)
+	return FloatingActionButtonMenuItemOptions{
+		Modifier:       modifier.EmptyModifier,
+		ContainerColor: theme.ColorScheme().PrimaryContainer,
+		ContentColor:   theme.ColorScheme().OnPrimaryContainer,
+	}
+}
+
+func WithMenuItemModifier(m ui.Modifier) FloatingActionButtonMenuItemOption {
+	return func(o *FloatingActionButtonMenuItemOptions) {
+		o.Modifier = m
+	}
+}
+
+func WithMenuItemContainerColor(col graphics.Color) FloatingActionButtonMenuItemOption {
+	return func(o *FloatingActionButtonMenuItemOptions) {
+		o.ContainerColor = col
+	}
+}
+
+func WithMenuItemContentColor(col graphics.Color) FloatingActionButtonMenuItemOption {
+	return func(o *FloatingActionButtonMenuItemOptions) {
+		o.ContentColor = col
+	}
+}
@@ -0,0 +1,90 @@
+package floatingactionbutton
+
+import (
+	"time"
+
+	"github.com/zodimo/go-compose/compose/foundation/layout/box"
+	"github.com/zodimo/go-compose/compose/material3"
+	"github.com/zodimo/go-compose/compose/ui/graphics"
+	"github.com/zodimo/go-compose/compose/ui/graphics/shape"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+	"github.com/zodimo/go-compose/internal/layoutnode"
+	"github.com/zodimo/go-compose/modifiers/clickable"
+	"github.com/zodimo/go-compose/modifiers/size"
+	"github.com/zodimo/go-compose/pkg/api"
+
+	"gioui.org/layout"
+)
+
+// ToggleFloatingActionButton is a Material 3 expressive floating action
+// button that is checked or not, typically opening and closing a
+// FloatingActionButtonMenu. As it is checked, it morphs from a rounded
+// square in the container colors into a circle in the checked colors.
+//
+// content returns the content of the button at checkedProgress, from 0
+// unchecked to 1 checked, such as an icon turning from "add" to "close".
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/FloatingActionButtonMenu.kt
+func ToggleFloatingActionButton(
+	checked bool,
+	onCheckedChange func(checked bool),
+	content func(checkedProgress float32) api.Composable,
+	options ...FloatingActionButtonOption,
+) api.Composable {
+	return func(c api.Composer) api.Composer {
+		opts := DefaultFloatingActionButtonOptions(c)
+		for _, option := range options {
+			if option == nil {
+				continue
+			}
+			option(&opts)
+		}
+
+		fabClickable := rememberClickable(c, "toggle_fab_clickable")
+		elevation := pressedElevation(fabClickable, opts.Elevation)
+		anim := rememberProgressAnimation(c, "toggle_fab_anim", checked, FloatingActionButtonDefaults.ToggleDuration())
+		progress := material3.EasingEmphasized.Transform(anim.valueAt(time.Now()))
+
+		opts.ContainerColor = graphics.Lerp(opts.ContainerColor, opts.CheckedContainerColor, progress)
+		opts.ContentColor = graphics.Lerp(opts.ContentColor, opts.CheckedContentColor, progress)
+		opts.Shape = &shape.RoundedCornerShape{
+			Radius: unit.Dp(lerp(
+				float32(FloatingActionButtonDefaults.ToggleShapeRadius()),
+				float32(FloatingActionButtonDefaults.ToggleCheckedShapeRadius()),
+				progress,
+			)),
+		}
+
+		// The button is composed at the progress of the animation, that its
+		// layout keeps requesting frames for.
+		fabModifier := opts.Modifier.Then(
+			animatedLayoutModifier("toggleFloatingActionButton", anim, func(gtx layoutnode.LayoutContext, _ float32, widget layoutnode.LayoutWidget) layoutnode.LayoutDimensions {
+				return widget.Layout(gtx)
+			}),
+		).Then(
+			clickable.OnClick(func() {
+				if onCheckedChange != nil {
+					onCheckedChange(!checked)
+				}
+			}, clickable.WithClickable(fabClickable)),
+		).Then(
+			GetSizeModifier(opts.Size),
+		)
+
+		return SurfaceWithThemeDefaults(
+			fabClickable,
+			elevation,
+			opts,
+			fabModifier,
+			box.Box(
+				content(progress),
+				box.WithAlignment(layout.Center),
+				box.WithModifier(size.FillMax()),
+			),
+		)(c)
+	}
+}
+
+func lerp(start, stop, fraction float32) float32 {
+	return start + (stop-start)*fraction
+}
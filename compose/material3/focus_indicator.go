@@ -0,0 +1,16 @@
+package material3
+
+import (
+	"github.com/zodimo/go-compose/compose/ui"
+	"github.com/zodimo/go-compose/compose/ui/graphics/shape"
+	"github.com/zodimo/go-compose/modifiers/focus"
+)
+
+// FocusIndicator returns the Material 3 focus indicator for an element of
+// shape s: an outline in the secondary color, drawn around the element while
+// the clickable or focus target inside it is focused.
+//
+// https://m3.material.io/foundations/interaction/states/state-layers#focus
+func FocusIndicator(c Composer, s shape.Shape) ui.Modifier {
+	return focus.Indicator(Theme(c).ColorScheme().Secondary, focus.WithIndicatorShape(s))
+}
@@ -0,0 +1,8 @@
+/*
+Package materialshapes contains the Material 3 Expressive shape library: a set
+of rounded polygons (cookies, clovers, bursts, ...) that can be used as a
+Shape anywhere one is accepted and morphed into each other.
+
+Reference: [Shape](https://m3.material.io/styles/shape/overview)
+*/
+package materialshapes
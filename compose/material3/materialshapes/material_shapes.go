@@ -0,0 +1,331 @@
+package materialshapes
+
+import (
+	"math"
+
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+	"github.com/zodimo/go-compose/compose/ui/graphics/polygon"
+	"github.com/zodimo/go-compose/compose/ui/graphics/shape"
+)
+
+var (
+	cornerRound15  = polygon.CornerRounding{Radius: 0.15}
+	cornerRound20  = polygon.CornerRounding{Radius: 0.2}
+	cornerRound30  = polygon.CornerRounding{Radius: 0.3}
+	cornerRound50  = polygon.CornerRounding{Radius: 0.5}
+	cornerRound100 = polygon.CornerRounding{Radius: 1}
+)
+
+// The Material shapes, normalized to the unit square, built from the
+// vertices and roundings of Compose.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/MaterialShapes.kt
+var (
+	Circle = polygon.Circle(10, 1).Normalized()
+	Square = polygon.Rectangle(1, 1, cornerRound30, nil).Normalized()
+
+	Slanted = customPolygon([]pointNRound{
+		{0.926, 0.970, polygon.CornerRounding{Radius: 0.189, Smoothing: 0.811}},
+		{-0.021, 0.967, polygon.CornerRounding{Radius: 0.187, Smoothing: 0.057}},
+	}, 2, false).Normalized()
+
+	Arch = regularPolygon(4, []polygon.CornerRounding{cornerRound100, cornerRound100, cornerRound20, cornerRound20}).
+		Rotated(-135).Normalized()
+
+	Fan = customPolygon([]pointNRound{
+		{1.004, 1.000, polygon.CornerRounding{Radius: 0.148, Smoothing: 0.417}},
+		{0.000, 1.000, polygon.CornerRounding{Radius: 0.151}},
+		{0.000, -0.003, polygon.CornerRounding{Radius: 0.148}},
+		{0.978, 0.020, polygon.CornerRounding{Radius: 0.803}},
+	}, 1, false).Normalized()
+
+	Arrow = customPolygon([]pointNRound{
+		{0.500, 0.892, polygon.CornerRounding{Radius: 0.313}},
+		{-0.216, 1.050, polygon.CornerRounding{Radius: 0.207}},
+		{0.499, -0.160, polygon.CornerRounding{Radius: 0.215, Smoothing: 1}},
+		{1.225, 1.060, polygon.CornerRounding{Radius: 0.211}},
+	}, 1, false).Normalized()
+
+	SemiCircle = polygon.Rectangle(1.6, 1, polygon.Unrounded, []polygon.CornerRounding{cornerRound20, cornerRound20, cornerRound100, cornerRound100}).Normalized()
+
+	Oval = polygon.Circle(8, 1).Scaled(1, 0.64).Rotated(-45).Normalized()
+
+	Pill = customPolygon([]pointNRound{
+		// Start from the right.
+		{0.961, 0.039, polygon.CornerRounding{Radius: 0.426}},
+		{1.001, 0.428, polygon.Unrounded},
+		{1.000, 0.609, polygon.CornerRounding{Radius: 1}},
+	}, 2, true).Normalized()
+
+	Triangle = polygon.Polygon(3, 1, cornerRound20).Rotated(-90).Normalized()
+
+	Diamond = customPolygon([]pointNRound{
+		{0.500, 1.096, polygon.CornerRounding{Radius: 0.151, Smoothing: 0.524}},
+		{0.040, 0.500, polygon.CornerRounding{Radius: 0.159}},
+	}, 2, false).Normalized()
+
+	ClamShell = customPolygon([]pointNRound{
+		{0.171, 0.841, polygon.CornerRounding{Radius: 0.159}},
+		{-0.020, 0.500, polygon.CornerRounding{Radius: 0.140}},
+		{0.170, 0.159, polygon.CornerRounding{Radius: 0.159}},
+	}, 2, false).Normalized()
+
+	Pentagon = customPolygon([]pointNRound{
+		{0.500, -0.009, polygon.CornerRounding{Radius: 0.172}},
+		{1.030, 0.365, polygon.CornerRounding{Radius: 0.164}},
+		{0.828, 0.970, polygon.CornerRounding{Radius: 0.169}},
+	}, 1, true).Normalized()
+
+	Gem = customPolygon([]pointNRound{
+		{0.499, 1.023, polygon.CornerRounding{Radius: 0.241, Smoothing: 0.778}},
+		{-0.005, 0.792, polygon.CornerRounding{Radius: 0.208}},
+		{0.073, 0.258, polygon.CornerRounding{Radius: 0.228}},
+		{0.433, -0.000, polygon.CornerRounding{Radius: 0.491}},
+	}, 1, true).Normalized()
+
+	Sunny = polygon.Star(8, 1, 0.8, cornerRound15, cornerRound15).Normalized()
+
+	VerySunny = customPolygon([]pointNRound{
+		{0.500, 1.080, polygon.CornerRounding{Radius: 0.085}},
+		{0.358, 0.843, polygon.CornerRounding{Radius: 0.085}},
+	}, 8, false).Normalized()
+
+	Cookie4 = customPolygon([]pointNRound{
+		{1.237, 1.236, polygon.CornerRounding{Radius: 0.258}},
+		{0.500, 0.918, polygon.CornerRounding{Radius: 0.233}},
+	}, 4, false).Normalized()
+
+	Cookie6 = customPolygon([]pointNRound{
+		{0.723, 0.884, polygon.CornerRounding{Radius: 0.394}},
+		{0.500, 1.099, polygon.CornerRounding{Radius: 0.398}},
+	}, 6, false).Normalized()
+
+	Cookie7  = polygon.Star(7, 1, 0.75, cornerRound50, cornerRound50).Rotated(-90).Normalized()
+	Cookie9  = polygon.Star(9, 1, 0.8, cornerRound50, cornerRound50).Rotated(-90).Normalized()
+	Cookie12 = polygon.Star(12, 1, 0.8, cornerRound50, cornerRound50).Rotated(-90).Normalized()
+
+	Ghostish = customPolygon([]pointNRound{
+		{0.500, 0, polygon.CornerRounding{Radius: 1}},
+		{1, 0, polygon.CornerRounding{Radius: 1}},
+		{1, 1.140, polygon.CornerRounding{Radius: 0.254, Smoothing: 0.106}},
+		{0.575, 0.906, polygon.CornerRounding{Radius: 0.253}},
+	}, 1, true).Normalized()
+
+	Clover4 = customPolygon([]pointNRound{
+		{0.500, 0.074, polygon.Unrounded},
+		{0.725, -0.099, polygon.CornerRounding{Radius: 0.476}},
+	}, 4, true).Normalized()
+
+	Clover8 = customPolygon([]pointNRound{
+		{0.500, 0.036, polygon.Unrounded},
+		{0.758, -0.101, polygon.CornerRounding{Radius: 0.209}},
+	}, 8, false).Normalized()
+
+	Burst = customPolygon([]pointNRound{
+		{0.500, -0.006, polygon.CornerRounding{Radius: 0.006}},
+		{0.592, 0.158, polygon.CornerRounding{Radius: 0.006}},
+	}, 12, false).Normalized()
+
+	SoftBurst = customPolygon([]pointNRound{
+		{0.193, 0.277, polygon.CornerRounding{Radius: 0.053}},
+		{0.176, 0.055, polygon.CornerRounding{Radius: 0.053}},
+	}, 10, false).Normalized()
+
+	Boom = customPolygon([]pointNRound{
+		{0.457, 0.296, polygon.CornerRounding{Radius: 0.007}},
+		{0.500, -0.051, polygon.CornerRounding{Radius: 0.007}},
+	}, 15, false).Normalized()
+
+	SoftBoom = customPolygon([]pointNRound{
+		{0.733, 0.454, polygon.Unrounded},
+		{0.839, 0.437, polygon.CornerRounding{Radius: 0.532}},
+		{0.949, 0.449, polygon.CornerRounding{Radius: 0.439, Smoothing: 1}},
+		{0.998, 0.478, polygon.CornerRounding{Radius: 0.174}},
+		// Mirrored from here.
+		{0.952, 0.521, polygon.CornerRounding{Radius: 0.439, Smoothing: 1}},
+		{0.838, 0.540, polygon.CornerRounding{Radius: 0.532}},
+		{0.733, 0.546, polygon.Unrounded},
+	}, 16, false).Normalized()
+
+	Flower = customPolygon([]pointNRound{
+		{0.370, 0.187, polygon.Unrounded},
+		{0.416, 0.049, polygon.CornerRounding{Radius: 0.381}},
+		{0.479, 0.001, polygon.CornerRounding{Radius: 0.095}},
+		// Mirrored from here.
+		{0.521, 0.001, polygon.CornerRounding{Radius: 0.095}},
+		{0.584, 0.049, polygon.CornerRounding{Radius: 0.381}},
+		{0.630, 0.187, polygon.Unrounded},
+	}, 8, false).Normalized()
+
+	Puffy = customPolygon([]pointNRound{
+		{0.500, 0.053, polygon.Unrounded},
+		{0.545, -0.040, polygon.CornerRounding{Radius: 0.405}},
+		{0.670, -0.035, polygon.CornerRounding{Radius: 0.426}},
+		{0.717, 0.066, polygon.CornerRounding{Radius: 0.574}},
+		{0.722, 0.128, polygon.Unrounded},
+		{0.777, 0.002, polygon.CornerRounding{Radius: 0.360}},
+		{0.914, 0.149, polygon.CornerRounding{Radius: 0.660}},
+		{0.926, 0.289, polygon.CornerRounding{Radius: 0.660}},
+		{0.881, 0.346, polygon.Unrounded},
+		{0.940, 0.344, polygon.CornerRounding{Radius: 0.126}},
+		{1.003, 0.437, polygon.CornerRounding{Radius: 0.255}},
+	}, 2, true).Scaled(1, 0.742).Normalized()
+
+	PuffyDiamond = customPolygon([]pointNRound{
+		{0.870, 0.130, polygon.CornerRounding{Radius: 0.146}},
+		{0.818, 0.357, polygon.Unrounded},
+		{1.000, 0.332, polygon.CornerRounding{Radius: 0.853}},
+	}, 4, true).Normalized()
+
+	PixelCircle = customPolygon([]pointNRound{
+		{0.500, 0.000, polygon.Unrounded},
+		{0.704, 0.000, polygon.Unrounded},
+		{0.704, 0.065, polygon.Unrounded},
+		{0.843, 0.065, polygon.Unrounded},
+		{0.843, 0.148, polygon.Unrounded},
+		{0.926, 0.148, polygon.Unrounded},
+		{0.926, 0.296, polygon.Unrounded},
+		{1.000, 0.296, polygon.Unrounded},
+	}, 2, true).Normalized()
+
+	PixelTriangle = customPolygon([]pointNRound{
+		// Center of the bottom.
+		{0.110, 0.500, polygon.Unrounded},
+		{0.113, 0.000, polygon.Unrounded},
+		{0.287, 0.000, polygon.Unrounded},
+		{0.287, 0.087, polygon.Unrounded},
+		{0.421, 0.087, polygon.Unrounded},
+		{0.421, 0.170, polygon.Unrounded},
+		{0.560, 0.170, polygon.Unrounded},
+		{0.560, 0.265, polygon.Unrounded},
+		{0.674, 0.265, polygon.Unrounded},
+		{0.675, 0.344, polygon.Unrounded},
+		{0.789, 0.344, polygon.Unrounded},
+		{0.789, 0.439, polygon.Unrounded},
+		{0.888, 0.439, polygon.Unrounded},
+	}, 1, true).Normalized()
+
+	Bun = customPolygon([]pointNRound{
+		// Center of the right side.
+		{0.796, 0.500, polygon.Unrounded},
+		{0.853, 0.518, cornerRound100},
+		{0.992, 0.631, cornerRound100},
+		{0.968, 1.000, cornerRound100},
+	}, 2, true).Normalized()
+
+	Heart = customPolygon([]pointNRound{
+		{0.500, 0.268, polygon.CornerRounding{Radius: 0.016}},
+		{0.792, -0.066, polygon.CornerRounding{Radius: 0.958}},
+		{1.064, 0.276, polygon.CornerRounding{Radius: 1}},
+		{0.501, 0.946, polygon.CornerRounding{Radius: 0.129}},
+	}, 1, true).Normalized()
+)
+
+// All returns every Material shape, in the order they appear in the M3 shape library.
+func All() []*polygon.RoundedPolygon {
+	return []*polygon.RoundedPolygon{
+		Circle, Square, Slanted, Arch, Fan, Arrow, SemiCircle, Oval, Pill,
+		Triangle, Diamond, ClamShell, Pentagon, Gem, Sunny, VerySunny, Cookie4,
+		Cookie6, Cookie7, Cookie9, Cookie12, Ghostish, Clover4, Clover8, Burst,
+		SoftBurst, Boom, SoftBoom, Flower, Puffy, PuffyDiamond, PixelCircle,
+		PixelTriangle, Bun, Heart,
+	}
+}
+
+// ToShape returns a Shape drawing p stretched to the layout size.
+func ToShape(p *polygon.RoundedPolygon) shape.Shape {
+	return shape.RoundedPolygonShape(p)
+}
+
+// Morph creates a morph between two Material shapes. Use MorphShape to draw it
+// at a given progress, typically driven by an animation.
+func Morph(start, end *polygon.RoundedPolygon) *polygon.Morph {
+	return polygon.NewMorph(start, end)
+}
+
+// MorphShape returns a Shape drawing m at progress, in [0, 1].
+func MorphShape(m *polygon.Morph, progress float32) shape.Shape {
+	return shape.MorphShape(m, progress)
+}
+
+// pointNRound is a vertex of a Material shape, in the unit square, and the
+// rounding of its corner.
+type pointNRound struct {
+	x, y     float32
+	rounding polygon.CornerRounding
+}
+
+// shapeCenter is the center the vertices of the Material shapes repeat
+// around.
+var shapeCenter = geometry.NewOffset(0.5, 0.5)
+
+// customPolygon creates a polygon repeating points reps times around the
+// center of the unit square. When mirroring, every other repetition is
+// mirrored, running through points backwards.
+func customPolygon(points []pointNRound, reps int, mirroring bool) *polygon.RoundedPolygon {
+	repeated := repeatPoints(points, reps, mirroring)
+	vertices := make([]geometry.Offset, len(repeated))
+	roundings := make([]polygon.CornerRounding, len(repeated))
+	for i, p := range repeated {
+		vertices[i] = geometry.NewOffset(p.x, p.y)
+		roundings[i] = p.rounding
+	}
+	return polygon.NewRoundedPolygon(vertices, polygon.Unrounded, roundings, shapeCenter)
+}
+
+func repeatPoints(points []pointNRound, reps int, mirroring bool) []pointNRound {
+	cx, cy := float64(shapeCenter.X()), float64(shapeCenter.Y())
+	if !mirroring {
+		out := make([]pointNRound, 0, len(points)*reps)
+		for r := 0; r < reps; r++ {
+			sin, cos := math.Sincos(2 * math.Pi * float64(r) / float64(reps))
+			for _, p := range points {
+				x, y := float64(p.x)-cx, float64(p.y)-cy
+				out = append(out, pointNRound{float32(cx + x*cos - y*sin), float32(cy + x*sin + y*cos), p.rounding})
+			}
+		}
+		return out
+	}
+
+	angles := make([]float64, len(points))
+	distances := make([]float64, len(points))
+	for i, p := range points {
+		x, y := float64(p.x)-cx, float64(p.y)-cy
+		angles[i] = math.Atan2(y, x)
+		distances[i] = math.Hypot(x, y)
+	}
+	actualReps := reps * 2
+	sectionAngle := 2 * math.Pi / float64(actualReps)
+	out := make([]pointNRound, 0, len(points)*actualReps)
+	for r := 0; r < actualReps; r++ {
+		for index := range points {
+			i := index
+			if r%2 == 1 {
+				i = len(points) - 1 - index
+			}
+			// The first point of a section is on the mirror line it shares
+			// with the section before, which adds it.
+			if i == 0 && r%2 == 1 {
+				continue
+			}
+			a := sectionAngle*float64(r) + angles[i]
+			if r%2 == 1 {
+				a = sectionAngle*float64(r) + sectionAngle - angles[i] + 2*angles[0]
+			}
+			sin, cos := math.Sincos(a)
+			out = append(out, pointNRound{float32(cx + cos*distances[i]), float32(cy + sin*distances[i]), points[i].rounding})
+		}
+	}
+	return out
+}
+
+// regularPolygon creates a regular polygon of numVertices on the unit
+// circle, the first at angle 0, with the rounding of each vertex.
+func regularPolygon(numVertices int, roundings []polygon.CornerRounding) *polygon.RoundedPolygon {
+	vertices := make([]geometry.Offset, numVertices)
+	for i := range vertices {
+		sin, cos := math.Sincos(2 * math.Pi * float64(i) / float64(numVertices))
+		vertices[i] = geometry.NewOffset(float32(cos), float32(sin))
+	}
+	return polygon.NewRoundedPolygon(vertices, polygon.Unrounded, roundings, geometry.OffsetZero)
+}
@@ -0,0 +1,60 @@
+package materialshapes
+
+import (
+	"math"
+	"testing"
+
+	"github.com/zodimo/go-compose/compose/ui/graphics/polygon"
+)
+
+func TestAll_NormalizedAndClosed(t *testing.T) {
+	for i, p := range All() {
+		n := len(p.Cubics)
+		if n == 0 {
+			t.Fatalf("shape %d has no cubics", i)
+		}
+		for j := 0; j < n; j++ {
+			if !p.Cubics[j].Anchor1.Equal(p.Cubics[(j+1)%n].Anchor0) {
+				t.Errorf("shape %d: cubic %d is not connected", i, j)
+			}
+		}
+		b := p.Bounds()
+		if math.IsNaN(float64(b.Width())) || math.IsNaN(float64(b.Height())) {
+			t.Errorf("shape %d has points that are not numbers", i)
+			continue
+		}
+		if b.Left < -1e-3 || b.Top < -1e-3 || b.Right > 1+1e-3 || b.Bottom > 1+1e-3 {
+			t.Errorf("shape %d is not normalized: %s", i, b)
+		}
+		if side := math.Max(float64(b.Width()), float64(b.Height())); math.Abs(side-1) > 1e-3 {
+			t.Errorf("shape %d should fill the unit square on one axis: %s", i, b)
+		}
+	}
+}
+
+func TestRepeatPoints(t *testing.T) {
+	points := []pointNRound{{0.5, 0, polygon.Unrounded}, {0.75, 0, cornerRound20}}
+	repeated := repeatPoints(points, 4, false)
+	if len(repeated) != 8 {
+		t.Fatalf("got %d points, want 8", len(repeated))
+	}
+	if p := repeated[2]; math.Abs(float64(p.x-1)) > 1e-6 || math.Abs(float64(p.y-0.5)) > 1e-6 {
+		t.Errorf("the second repetition should turn the first point a quarter, got %v, %v", p.x, p.y)
+	}
+
+	mirrored := repeatPoints(points, 2, true)
+	// Each mirrored section runs backwards, without the point on the mirror
+	// line it shares with the next section.
+	if len(mirrored) != 6 {
+		t.Fatalf("got %d mirrored points, want 6", len(mirrored))
+	}
+	if mirrored[1].rounding != cornerRound20 || mirrored[2].rounding != cornerRound20 || mirrored[3].rounding != polygon.Unrounded {
+		t.Errorf("a mirrored section should run through the points backwards")
+	}
+	// Two mirrored repetitions of (0.75, 0) are symmetric across both axes.
+	for i, want := range map[int][2]float32{2: {0.75, 1}, 3: {0.5, 1}, 4: {0.25, 1}, 5: {0.25, 0}} {
+		if p := mirrored[i]; math.Abs(float64(p.x-want[0])) > 1e-6 || math.Abs(float64(p.y-want[1])) > 1e-6 {
+			t.Errorf("mirrored point %d = %v, %v, want %v", i, p.x, p.y, want)
+		}
+	}
+}
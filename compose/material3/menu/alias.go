@@ -3,3 +3,4 @@ package menu
 import "github.com/zodimo/go-compose/pkg/api"
 
 type Composer = api.Composer
+type Composable = api.Composable
@@ -2,9 +2,12 @@ package menu
 
 import (
 	"github.com/zodimo/go-compose/compose/material3"
+	"github.com/zodimo/go-compose/compose/material3/icon"
 	"github.com/zodimo/go-compose/compose/ui/graphics"
 	"github.com/zodimo/go-compose/compose/ui/graphics/shape"
 	"github.com/zodimo/go-compose/compose/ui/unit"
+
+	mdicons "golang.org/x/exp/shiny/materialdesign/icons"
 )
 
 // MenuDefaults contains default values used for DropdownMenu and DropdownMenuItem.
@@ -87,3 +90,24 @@ type ContentPadding struct {
 	End    unit.Dp
 	Bottom unit.Dp
 }
+
+// ExposedDropdownMenuMaxHeight is the default height above which an
+// ExposedDropdownMenu scrolls, showing five and a half items to hint that
+// there are more.
+const ExposedDropdownMenuMaxHeight unit.Dp = 5.5*MenuListItemContainerHeight + 2*DropdownMenuVerticalPadding
+
+// ExposedDropdownMenuDefaults contains default values used for
+// ExposedDropdownMenuBox.
+var ExposedDropdownMenuDefaults = exposedDropdownMenuDefaults{}
+
+type exposedDropdownMenuDefaults struct{}
+
+// TrailingIcon is the arrow at the end of the anchor of an
+// ExposedDropdownMenuBox, pointing up when the menu is expanded.
+func (exposedDropdownMenuDefaults) TrailingIcon(expanded bool) Composable {
+	arrow := mdicons.NavigationArrowDropDown
+	if expanded {
+		arrow = mdicons.NavigationArrowDropUp
+	}
+	return icon.Icon(icon.IconBytes(arrow))
+}
@@ -1,5 +1,7 @@
 /*
-Package menu contains Material 3 Menu components.
+Package menu contains Material 3 Menu components: DropdownMenu, and
+ExposedDropdownMenuBox anchoring a menu to a select or autocomplete text
+field.
 
 Reference: [Menus](https://m3.material.io/components/menus/overview)
 Specs: [Menus Specs](https://m3.material.io/components/menus/specs)
@@ -0,0 +1,394 @@
+package menu
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/zodimo/go-compose/compose"
+	"github.com/zodimo/go-compose/compose/foundation/layout/box"
+	"github.com/zodimo/go-compose/compose/foundation/lazy"
+	"github.com/zodimo/go-compose/compose/material3"
+	"github.com/zodimo/go-compose/compose/material3/surface"
+	"github.com/zodimo/go-compose/compose/ui"
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+	"github.com/zodimo/go-compose/compose/ui/graphics"
+	uilayout "github.com/zodimo/go-compose/compose/ui/layout"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+	"github.com/zodimo/go-compose/compose/ui/window"
+	"github.com/zodimo/go-compose/modifiers/background"
+	"github.com/zodimo/go-compose/modifiers/focus"
+	"github.com/zodimo/go-compose/modifiers/padding"
+	"github.com/zodimo/go-compose/modifiers/pointer"
+	"github.com/zodimo/go-compose/modifiers/size"
+	"github.com/zodimo/go-compose/state"
+
+	"gioui.org/io/key"
+	"gioui.org/layout"
+)
+
+// MenuAnchorType is the kind of anchor a MenuAnchor modifier is applied to.
+type MenuAnchorType int
+
+const (
+	// MenuAnchorTypePrimaryNotEditable is a read-only text field picking one
+	// of the options of the menu: a press on it opens or closes the menu.
+	MenuAnchorTypePrimaryNotEditable MenuAnchorType = iota
+	// MenuAnchorTypePrimaryEditable is an editable text field whose text
+	// filters the options of the menu: a press on it opens the menu, and
+	// leaves it open to place the caret.
+	MenuAnchorTypePrimaryEditable
+)
+
+// ExposedDropdownMenuBoxScope anchors an ExposedDropdownMenu to a text
+// field of an ExposedDropdownMenuBox.
+type ExposedDropdownMenuBoxScope interface {
+	// MenuAnchor marks the text field the menu is anchored to, below it and
+	// as wide as it. Once it is focused, Down opens the menu, Up and Down
+	// move through its items, Enter clicks the highlighted item and Escape
+	// closes it.
+	MenuAnchor(anchorType MenuAnchorType, enabled bool) ui.Modifier
+
+	// ExposedDropdownMenu is the menu of the box, showing items when
+	// expanded. It scrolls when the items are taller than its maximum
+	// height.
+	ExposedDropdownMenu(expanded bool, onDismissRequest func(), items []Composable, options ...ExposedDropdownMenuOption) Composable
+
+	// ExposedDropdownMenuItem is an item of the menu, taking the options of
+	// DropdownMenuItem. Items are selectable with the keyboard in the order
+	// they are created.
+	ExposedDropdownMenuItem(text string, onClick func(), options ...DropdownMenuItemOption) Composable
+}
+
+// ExposedDropdownMenuBox is a text field showing a menu of options below
+// it: content applies scope.MenuAnchor to an outlined or filled text field
+// and adds scope.ExposedDropdownMenu after it.
+//
+// expanded is whether the menu is shown.
+// onExpandedChange is called when the anchor asks to open or close the
+// menu.
+//
+// A select field is a read-only text field anchored with
+// MenuAnchorTypePrimaryNotEditable. An autocomplete field is an editable
+// text field anchored with MenuAnchorTypePrimaryEditable, that expands the
+// menu as the user types and shows the options FilterOptions keeps.
+//
+//	menu.ExposedDropdownMenuBox(expanded, setExpanded, func(scope menu.ExposedDropdownMenuBoxScope) api.Composable {
+//		items := make([]api.Composable, len(options))
+//		for i, option := range options {
+//			items[i] = scope.ExposedDropdownMenuItem(option, func() {
+//				setText(option)
+//				setExpanded(false)
+//			})
+//		}
+//		return compose.Sequence(
+//			textfield.Outlined(text, setText,
+//				textfield.WithReadOnly(true),
+//				textfield.WithTrailingIcon(menu.ExposedDropdownMenuDefaults.TrailingIcon(expanded)),
+//				textfield.WithModifier(scope.MenuAnchor(menu.MenuAnchorTypePrimaryNotEditable, true)),
+//			),
+//			scope.ExposedDropdownMenu(expanded, func() { setExpanded(false) }, items),
+//		)
+//	})
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/ExposedDropdownMenu.kt
+func ExposedDropdownMenuBox(
+	expanded bool,
+	onExpandedChange func(bool),
+	content func(scope ExposedDropdownMenuBoxScope) Composable,
+	options ...ExposedDropdownMenuBoxOption,
+) Composable {
+	return func(c Composer) Composer {
+		opts := DefaultExposedDropdownMenuBoxOptions()
+		for _, option := range options {
+			if option == nil {
+				continue
+			}
+			option(&opts)
+		}
+
+		b := c.State(fmt.Sprintf("exposedDropdownMenuBox-%v", c.GenerateID()), func() any {
+			return &exposedDropdownMenuBox{
+				anchor:      state.MutableStateOf(menuAnchorLayout{}),
+				highlighted: state.MutableStateOf(-1),
+				list:        lazy.NewLazyListState(),
+				requester:   focus.NewFocusRequester(),
+			}
+		}).Get().(*exposedDropdownMenuBox)
+		b.expanded = expanded
+		b.onExpandedChange = onExpandedChange
+
+		return box.Box(
+			func(c Composer) Composer {
+				previous := b.items
+				b.items = nil
+				content(b)(c)
+				// The highlight is lost when the menu closes or its items
+				// change, e.g. as an autocomplete field filters them.
+				if !expanded || !slices.EqualFunc(previous, b.items, func(p, i exposedDropdownMenuItem) bool {
+					return p.text == i.text
+				}) {
+					b.highlight(-1)
+				}
+				return c
+			},
+			box.WithModifier(
+				uilayout.OnGloballyPositioned(func(coordinates uilayout.LayoutCoordinates) {
+					b.setLayout(func(l *menuAnchorLayout) {
+						l.box = coordinates.PositionInWindow()
+					})
+				}).Then(opts.Modifier),
+			),
+		)(c)
+	}
+}
+
+// menuAnchorLayout is where the anchor of an ExposedDropdownMenuBox is, to
+// place its menu.
+type menuAnchorLayout struct {
+	// anchor is the bounds of the anchor in the window, in pixels.
+	anchor geometry.Rect
+	// box is the position of the box in the window, in pixels.
+	box      geometry.Offset
+	pxPerDp  float32
+	anchored bool
+}
+
+// menu returns the offset of the menu from the box, below the anchor, and
+// its width, the width of the anchor.
+func (l menuAnchorLayout) menu() (x, y, width unit.Dp) {
+	if !l.anchored || l.pxPerDp <= 0 {
+		return 0, 0, 0
+	}
+	x = unit.Dp((l.anchor.Left - l.box.X()) / l.pxPerDp)
+	y = unit.Dp((l.anchor.Bottom - l.box.Y()) / l.pxPerDp)
+	width = unit.Dp((l.anchor.Right - l.anchor.Left) / l.pxPerDp)
+	return x, y, width
+}
+
+type exposedDropdownMenuItem struct {
+	text    string
+	onClick func()
+	enabled bool
+}
+
+// exposedDropdownMenuBox is the remembered state of an
+// ExposedDropdownMenuBox, and its scope. The fields other than the states,
+// the list and the requester are updated by every composition.
+type exposedDropdownMenuBox struct {
+	expanded         bool
+	onExpandedChange func(bool)
+	// items are the items created by the last composition, in order.
+	items []exposedDropdownMenuItem
+
+	anchor state.MutableValueTyped[menuAnchorLayout]
+	// highlighted is the item selected with the keyboard, or -1.
+	highlighted state.MutableValueTyped[int]
+	list        *lazy.LazyListState
+	// requester is attached to the anchor, to find whether it has the focus.
+	requester *focus.FocusRequester
+}
+
+var _ ExposedDropdownMenuBoxScope = (*exposedDropdownMenuBox)(nil)
+
+func (b *exposedDropdownMenuBox) setLayout(update func(l *menuAnchorLayout)) {
+	l := b.anchor.Get()
+	update(&l)
+	if l != b.anchor.Get() {
+		b.anchor.Set(l)
+	}
+}
+
+func (b *exposedDropdownMenuBox) highlight(index int) {
+	if b.highlighted.Get() != index {
+		b.highlighted.Set(index)
+	}
+}
+
+func (b *exposedDropdownMenuBox) MenuAnchor(anchorType MenuAnchorType, enabled bool) ui.Modifier {
+	m := uilayout.OnGloballyPositioned(func(coordinates uilayout.LayoutCoordinates) {
+		b.setLayout(func(l *menuAnchorLayout) {
+			l.anchor = coordinates.BoundsInWindow()
+			l.anchored = true
+		})
+	}).Then(newMenuAnchorModifier(b, enabled))
+	if !enabled {
+		return m
+	}
+	return m.Then(focus.Requester(b.requester)).Then(pointer.PointerInput(func(scope pointer.PointerInputScope) {
+		scope.OnPointerEvent(func(event *pointer.PointerEvent) {
+			// The text field handles the press as well, to take the focus
+			// or place the caret.
+			if !event.ChangedPointer().ChangedToUp() {
+				return
+			}
+			if anchorType == MenuAnchorTypePrimaryEditable {
+				if !b.expanded {
+					b.onExpandedChange(true)
+				}
+				return
+			}
+			b.onExpandedChange(!b.expanded)
+		})
+	}, anchorType))
+}
+
+func (b *exposedDropdownMenuBox) ExposedDropdownMenu(expanded bool, onDismissRequest func(), items []Composable, options ...ExposedDropdownMenuOption) Composable {
+	return func(c Composer) Composer {
+		if !expanded || len(items) == 0 {
+			return c
+		}
+
+		opts := DefaultExposedDropdownMenuOptions()
+		for _, option := range options {
+			if option == nil {
+				continue
+			}
+			option(&opts)
+		}
+
+		x, y, width := b.anchor.Get().menu()
+		widthModifier := size.WrapContentWidth()
+		if width > 0 {
+			widthModifier = size.Width(int(width))
+		}
+
+		return window.Popup(
+			surface.Surface(
+				lazy.LazyColumn(
+					func(scope lazy.LazyListScope) {
+						scope.Items(len(items), nil, func(index int) compose.Composable {
+							return items[index]
+						})
+					},
+					lazy.WithState(b.list),
+					lazy.WithModifier(
+						size.MaxHeight(int(opts.MaxHeight)).
+							Then(padding.Vertical(
+								int(DropdownMenuVerticalPadding),
+								int(DropdownMenuVerticalPadding),
+							)),
+					),
+				),
+				surface.WithShape(MenuDefaults.Shape()),
+				surface.WithColor(MenuDefaults.ContainerColor(c)),
+				surface.WithShadowElevation(ShadowElevation),
+				surface.WithModifier(widthModifier.Then(opts.Modifier)),
+			),
+			window.WithOffset(x, y),
+			window.WithOnDismissRequest(onDismissRequest),
+		)(c)
+	}
+}
+
+func (b *exposedDropdownMenuBox) ExposedDropdownMenuItem(text string, onClick func(), options ...DropdownMenuItemOption) Composable {
+	opts := DefaultDropdownMenuItemOptions()
+	for _, option := range options {
+		if option == nil {
+			continue
+		}
+		option(&opts)
+	}
+	index := len(b.items)
+	b.items = append(b.items, exposedDropdownMenuItem{text: text, onClick: onClick, enabled: opts.Enabled})
+
+	return func(c Composer) Composer {
+		modifier := size.FillMaxWidth()
+		if b.highlighted.Get() == index {
+			modifier = modifier.Then(background.Background(
+				graphics.SetOpacity(material3.Theme(c).ColorScheme().OnSurface, 0.1),
+			))
+		}
+		return box.Box(
+			DropdownMenuItem(text, onClick, append(slices.Clip(options), WithMenuItemModifier(size.FillMaxWidth().Then(opts.Modifier)))...),
+			box.WithModifier(modifier),
+		)(c)
+	}
+}
+
+// move highlights the next enabled item in direction, 1 or -1, scrolling
+// the menu to show it. With no item highlighted, Down highlights the first
+// enabled item and Up the last.
+func (b *exposedDropdownMenuBox) move(direction int) {
+	n := len(b.items)
+	i := b.highlighted.Get()
+	if i < 0 {
+		i = -1
+		if direction < 0 {
+			i = n
+		}
+	}
+	for i += direction; i >= 0 && i < n; i += direction {
+		if b.items[i].enabled {
+			b.highlight(i)
+			scrollToVisible(&b.list.List.Position, i)
+			return
+		}
+	}
+}
+
+// onKey handles a key pressed while the anchor has the focus, and reports
+// whether it handled it.
+func (b *exposedDropdownMenuBox) onKey(name key.Name) bool {
+	if !b.expanded {
+		if name == key.NameDownArrow {
+			b.onExpandedChange(true)
+			return true
+		}
+		return false
+	}
+	switch name {
+	case key.NameDownArrow:
+		b.move(1)
+	case key.NameUpArrow:
+		b.move(-1)
+	case key.NameReturn, key.NameEnter:
+		i := b.highlighted.Get()
+		if i < 0 || i >= len(b.items) || !b.items[i].enabled {
+			return false
+		}
+		b.items[i].onClick()
+	case key.NameEscape:
+		b.onExpandedChange(false)
+	default:
+		return false
+	}
+	return true
+}
+
+// scrollToVisible scrolls a list at position the least to show the whole
+// item at index.
+func scrollToVisible(position *layout.Position, index int) {
+	lastVisible := position.First + position.Count - 1
+	if position.OffsetLast != 0 {
+		// The last item is partly visible.
+		lastVisible--
+	}
+	switch {
+	case index < position.First || (index == position.First && position.Offset > 0):
+		position.First = index
+		position.Offset = 0
+		position.BeforeEnd = true
+	case position.Count > 0 && index > lastVisible:
+		position.First += index - lastVisible
+		position.Offset = 0
+		position.BeforeEnd = true
+	}
+}
+
+// FilterOptions returns the options containing query, ignoring case, in
+// order: the options an autocomplete field shows for its text. All the
+// options are kept for an empty query.
+func FilterOptions(options []string, query string) []string {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" {
+		return options
+	}
+	var filtered []string
+	for _, option := range options {
+		if strings.Contains(strings.ToLower(option), query) {
+			filtered = append(filtered, option)
+		}
+	}
+	return filtered
+}
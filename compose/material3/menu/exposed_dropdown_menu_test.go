@@ -0,0 +1,194 @@
+package menu
+
+import (
+	"image"
+	"slices"
+	"testing"
+
+	"github.com/zodimo/go-compose/compose"
+	"github.com/zodimo/go-compose/compose/foundation/lazy"
+	"github.com/zodimo/go-compose/compose/material3/textfield"
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+	"github.com/zodimo/go-compose/modifiers/focus"
+	"github.com/zodimo/go-compose/runtime"
+	"github.com/zodimo/go-compose/state"
+	"github.com/zodimo/go-compose/store"
+	"github.com/zodimo/go-compose/theme"
+
+	"gioui.org/io/input"
+	"gioui.org/io/key"
+	"gioui.org/layout"
+	"gioui.org/op"
+	gioUnit "gioui.org/unit"
+)
+
+func TestFilterOptions(t *testing.T) {
+	options := []string{"Apple", "Apricot", "Banana", "Pineapple"}
+	if got := FilterOptions(options, " "); !slices.Equal(got, options) {
+		t.Errorf("FilterOptions(blank) = %v, want all the options", got)
+	}
+	if got, want := FilterOptions(options, "APP"), []string{"Apple", "Pineapple"}; !slices.Equal(got, want) {
+		t.Errorf("FilterOptions(APP) = %v, want %v", got, want)
+	}
+	if got := FilterOptions(options, "cherry"); len(got) != 0 {
+		t.Errorf("FilterOptions(cherry) = %v, want none", got)
+	}
+}
+
+func newBox(expanded bool, texts ...string) (*exposedDropdownMenuBox, *[]string) {
+	events := &[]string{}
+	b := &exposedDropdownMenuBox{
+		expanded: expanded,
+		onExpandedChange: func(expanded bool) {
+			if expanded {
+				*events = append(*events, "expand")
+			} else {
+				*events = append(*events, "collapse")
+			}
+		},
+		anchor:      state.MutableStateOf(menuAnchorLayout{}),
+		highlighted: state.MutableStateOf(-1),
+		list:        lazy.NewLazyListState(),
+	}
+	for _, text := range texts {
+		b.ExposedDropdownMenuItem(text, func() { *events = append(*events, text) }, WithEnabled(text != "disabled"))
+	}
+	return b, events
+}
+
+func TestExposedDropdownMenuBox_Keys(t *testing.T) {
+	b, events := newBox(false, "a", "disabled", "c")
+
+	if !b.onKey(key.NameDownArrow) || !slices.Equal(*events, []string{"expand"}) {
+		t.Fatalf("Down on a collapsed menu: %v, want expand", *events)
+	}
+	if b.onKey(key.NameUpArrow) {
+		t.Error("Up on a collapsed menu was handled")
+	}
+
+	b.expanded = true
+	if b.onKey(key.NameReturn) {
+		t.Error("Enter without a highlighted item was handled")
+	}
+	b.onKey(key.NameDownArrow)
+	if got := b.highlighted.Get(); got != 0 {
+		t.Errorf("Down highlighted %d, want 0", got)
+	}
+	// The disabled item is skipped.
+	b.onKey(key.NameDownArrow)
+	if got := b.highlighted.Get(); got != 2 {
+		t.Errorf("Down highlighted %d, want 2", got)
+	}
+	// The highlight stops at the last item.
+	b.onKey(key.NameDownArrow)
+	if got := b.highlighted.Get(); got != 2 {
+		t.Errorf("Down past the end highlighted %d, want 2", got)
+	}
+	b.onKey(key.NameUpArrow)
+	if got := b.highlighted.Get(); got != 0 {
+		t.Errorf("Up highlighted %d, want 0", got)
+	}
+	b.onKey(key.NameReturn)
+	b.onKey(key.NameEscape)
+	if want := []string{"expand", "a", "collapse"}; !slices.Equal(*events, want) {
+		t.Errorf("events = %v, want %v", *events, want)
+	}
+
+	b, _ = newBox(true, "a", "b", "c")
+	b.onKey(key.NameUpArrow)
+	if got := b.highlighted.Get(); got != 2 {
+		t.Errorf("Up without a highlight highlighted %d, want the last item", got)
+	}
+}
+
+func TestMenuAnchor_FocusedKeys(t *testing.T) {
+	s := store.NewPersistentState(map[string]state.MutableValue{})
+	var router input.Router
+	requester := focus.NewFocusRequester()
+	expanded := false
+	var selected []string
+	frame := func(keys ...key.Name) {
+		for _, name := range keys {
+			router.Queue(key.Event{Name: name, State: key.Press})
+		}
+		node := ExposedDropdownMenuBox(expanded, func(e bool) { expanded = e }, func(scope ExposedDropdownMenuBoxScope) Composable {
+			var items []Composable
+			for _, text := range []string{"a", "b", "c"} {
+				items = append(items, scope.ExposedDropdownMenuItem(text, func() { selected = append(selected, text) }))
+			}
+			return compose.Sequence(
+				textfield.Outlined("", func(string) {},
+					textfield.WithReadOnly(true),
+					textfield.WithModifier(focus.Requester(requester).Then(scope.MenuAnchor(MenuAnchorTypePrimaryNotEditable, true))),
+				),
+				scope.ExposedDropdownMenu(expanded, func() { expanded = false }, items),
+			)
+		})(compose.NewComposer(s)).Build()
+		gtx := layout.Context{
+			Ops:         new(op.Ops),
+			Source:      router.Source(),
+			Constraints: layout.Constraints{Max: image.Pt(400, 400)},
+			Metric:      gioUnit.Metric{PxPerDp: 1, PxPerSp: 1},
+		}
+		runtime.NewRuntime().Run(theme.GetThemeManager().Material3ThemeInit(gtx), node).Add(gtx.Ops)
+		router.Frame(gtx.Ops)
+	}
+	frame()
+	// Keys are left to the text field until the anchor has the focus.
+	frame(key.NameDownArrow)
+	if expanded {
+		t.Fatal("Down expanded the menu of an anchor without the focus")
+	}
+	requester.RequestFocus()
+	frame()
+	frame()
+	frame(key.NameDownArrow)
+	if !expanded {
+		t.Fatal("Down should expand the menu of the focused anchor")
+	}
+	// The router routes key events with the filters of the last frame, so
+	// keys are sent a frame apart, as typed.
+	for _, name := range []key.Name{key.NameDownArrow, key.NameDownArrow, key.NameDownArrow, key.NameUpArrow} {
+		frame(name)
+	}
+	frame(key.NameReturn)
+	if !slices.Equal(selected, []string{"b"}) {
+		t.Errorf("Enter selected %v, want the highlighted item b", selected)
+	}
+	frame(key.NameEscape)
+	if expanded {
+		t.Error("Escape should collapse the menu")
+	}
+}
+
+func TestScrollToVisible(t *testing.T) {
+	// Items 3 to 7 are shown, the last one partly.
+	pos := layout.Position{First: 3, Count: 5, OffsetLast: -10}
+	scrollToVisible(&pos, 5)
+	if pos.First != 3 {
+		t.Errorf("scrolled to the visible item 5: First = %d, want 3", pos.First)
+	}
+	scrollToVisible(&pos, 7)
+	if pos.First != 4 || pos.Offset != 0 {
+		t.Errorf("scrolled to the partly visible item 7: First = %d, Offset = %d, want 4, 0", pos.First, pos.Offset)
+	}
+	scrollToVisible(&pos, 1)
+	if pos.First != 1 {
+		t.Errorf("scrolled up to item 1: First = %d, want 1", pos.First)
+	}
+}
+
+func TestMenuAnchorLayout_Menu(t *testing.T) {
+	l := menuAnchorLayout{
+		anchor:   geometry.Rect{Left: 20, Top: 40, Right: 220, Bottom: 152},
+		box:      geometry.NewOffset(10, 20),
+		pxPerDp:  2,
+		anchored: true,
+	}
+	if x, y, width := l.menu(); x != 5 || y != 66 || width != 100 {
+		t.Errorf("menu() = %v, %v, %v, want 5, 66, 100", x, y, width)
+	}
+	if _, _, width := (menuAnchorLayout{}).menu(); width != 0 {
+		t.Errorf("menu() before layout has width %v, want 0", width)
+	}
+}
@@ -0,0 +1,105 @@
+package menu
+
+import (
+	"github.com/zodimo/go-compose/compose/ui"
+	node "github.com/zodimo/go-compose/internal/Node"
+	"github.com/zodimo/go-compose/internal/layoutnode"
+	"github.com/zodimo/go-compose/internal/modifier"
+
+	"gioui.org/io/event"
+	"gioui.org/io/key"
+	"gioui.org/layout"
+)
+
+// newMenuAnchorModifier handles the keys of the menu of b while the anchor
+// has the focus. It asks for them before the text field lays out, so that
+// the editor of the text field does not take Up, Down and Enter.
+func newMenuAnchorModifier(b *exposedDropdownMenuBox, enabled bool) ui.Modifier {
+	return modifier.NewInspectableModifier(
+		modifier.NewModifier(&MenuAnchorElement{box: b, enabled: enabled}),
+		modifier.NewInspectorInfo("menuAnchor", map[string]any{
+			"enabled": enabled,
+		}),
+	)
+}
+
+type MenuAnchorElement struct {
+	box     *exposedDropdownMenuBox
+	enabled bool
+}
+
+func (e *MenuAnchorElement) Create() node.Node {
+	return NewMenuAnchorNode(e.box, e.enabled)
+}
+
+func (e *MenuAnchorElement) Update(n node.Node) {
+	an := n.(*MenuAnchorNode)
+	an.box = e.box
+	an.enabled = e.enabled
+}
+
+func (e *MenuAnchorElement) Equals(other modifier.Element) bool {
+	o, ok := other.(*MenuAnchorElement)
+	return ok && o.box == e.box && o.enabled == e.enabled
+}
+
+type MenuAnchorNode struct {
+	node.ChainNode
+	box     *exposedDropdownMenuBox
+	enabled bool
+}
+
+func NewMenuAnchorNode(b *exposedDropdownMenuBox, enabled bool) *MenuAnchorNode {
+	n := &MenuAnchorNode{
+		box:     b,
+		enabled: enabled,
+	}
+	n.ChainNode = node.NewChainNode(
+		node.NewNodeID(),
+		node.NodeKindLayout,
+		node.LayoutPhase,
+		func(t node.TreeNode) {
+			no := t.(layoutnode.LayoutModifierNode)
+			no.AttachLayoutModifier(func(widget layoutnode.LayoutWidget) layoutnode.LayoutWidget {
+				return layoutnode.NewLayoutWidget(func(gtx layoutnode.LayoutContext) layoutnode.LayoutDimensions {
+					return n.layout(gtx, widget.Layout)
+				})
+			})
+		},
+	)
+	return n
+}
+
+func (n *MenuAnchorNode) layout(gtx layout.Context, widget layout.Widget) layout.Dimensions {
+	b := n.box
+	b.setLayout(func(l *menuAnchorLayout) {
+		l.pxPerDp = gtx.Metric.PxPerDp
+	})
+	if n.enabled && b.requester.Focused(gtx) {
+		// Key events go to the first filter asking for them in the frame,
+		// whatever the focus.
+		filters := []event.Filter{key.Filter{Name: key.NameDownArrow}}
+		if b.expanded {
+			filters = append(filters,
+				key.Filter{Name: key.NameUpArrow},
+				key.Filter{Name: key.NameEscape},
+			)
+			if b.highlighted.Get() >= 0 {
+				filters = append(filters,
+					key.Filter{Name: key.NameReturn},
+					key.Filter{Name: key.NameEnter},
+				)
+			}
+		}
+		for {
+			e, ok := gtx.Event(filters...)
+			if !ok {
+				break
+			}
+			if e, ok := e.(key.Event); ok && e.State == key.Press {
+				b.onKey(e.Name)
+			}
+		}
+	}
+	return widget(gtx)
+}
@@ -4,8 +4,10 @@ import (
 	"github.com/zodimo/go-compose/compose/foundation/layout/box"
 	"github.com/zodimo/go-compose/compose/foundation/layout/row"
 	fText "github.com/zodimo/go-compose/compose/foundation/text"
+	"github.com/zodimo/go-compose/compose/material3"
 	"github.com/zodimo/go-compose/compose/material3/text"
 	"github.com/zodimo/go-compose/compose/ui"
+	"github.com/zodimo/go-compose/compose/ui/graphics/shape"
 	uiText "github.com/zodimo/go-compose/compose/ui/text"
 	"github.com/zodimo/go-compose/modifiers/clickable"
 	"github.com/zodimo/go-compose/modifiers/padding"
@@ -59,6 +61,22 @@ func DropdownMenuItem(
 							),
 						)(c)
 
+						// Shortcut - M3 spec: trailing supporting text, Label Large
+						if opts.Shortcut.Key != "" {
+							box.Box(
+								text.LabelLarge(
+									opts.Shortcut.String(),
+									fText.WithTextStyleOptions(
+										uiText.WithColor(colors.TrailingIconColorFor(opts.Enabled)),
+									),
+								),
+								box.WithModifier(padding.Padding(
+									int(DropdownMenuItemHorizontalPadding), padding.NotSet,
+									padding.NotSet, padding.NotSet,
+								)),
+							)(c)
+						}
+
 						// Trailing Icon
 						if opts.TrailingIcon != nil {
 							trailingIconMod := ui.Modifier(padding.Padding(
@@ -79,6 +97,7 @@ func DropdownMenuItem(
 				size.WrapContentWidth().
 					Then(size.MinWidth(int(DropdownMenuItemDefaultMinWidth))).
 					Then(size.Height(int(MenuListItemContainerHeight))).
+					Then(material3.FocusIndicator(c, shape.ShapeRectangle)).
 					Then(clickable.OnClick(onClick)).
 					Then(padding.Horizontal(
 						int(DropdownMenuItemHorizontalPadding),
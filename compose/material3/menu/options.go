@@ -2,6 +2,7 @@ package menu
 
 import (
 	"github.com/zodimo/go-compose/internal/modifier"
+	"github.com/zodimo/go-compose/modifiers/key"
 	"github.com/zodimo/go-compose/pkg/api"
 
 	"github.com/zodimo/go-compose/compose/ui"
@@ -43,7 +44,9 @@ type DropdownMenuItemOptions struct {
 	Modifier     ui.Modifier
 	LeadingIcon  api.Composable
 	TrailingIcon api.Composable
-	Enabled      bool
+	// Shortcut is shown as accelerator text at the end of the item.
+	Shortcut key.KeyboardShortcut
+	Enabled  bool
 }
 
 func DefaultDropdownMenuItemOptions() DropdownMenuItemOptions {
@@ -75,8 +78,65 @@ func WithTrailingIcon(icon api.Composable) DropdownMenuItemOption {
 	}
 }
 
+// WithShortcut shows the accelerator text of shortcut at the end of the item.
+// It does not handle the shortcut: register it with key.Shortcuts.
+func WithShortcut(shortcut key.KeyboardShortcut) DropdownMenuItemOption {
+	return func(opts *DropdownMenuItemOptions) {
+		opts.Shortcut = shortcut
+	}
+}
+
 func WithEnabled(enabled bool) DropdownMenuItemOption {
 	return func(opts *DropdownMenuItemOptions) {
 		opts.Enabled = enabled
 	}
 }
+
+// ExposedDropdownMenuBoxOptions
+
+type ExposedDropdownMenuBoxOptions struct {
+	Modifier ui.Modifier
+}
+
+func DefaultExposedDropdownMenuBoxOptions() ExposedDropdownMenuBoxOptions {
+	return ExposedDropdownMenuBoxOptions{
+		Modifier: modifier.EmptyModifier,
+	}
+}
+
+type ExposedDropdownMenuBoxOption func(*ExposedDropdownMenuBoxOptions)
+
+func WithBoxModifier(m ui.Modifier) ExposedDropdownMenuBoxOption {
+	return func(opts *ExposedDropdownMenuBoxOptions) {
+		opts.Modifier = m
+	}
+}
+
+// ExposedDropdownMenuOptions
+
+type ExposedDropdownMenuOptions struct {
+	Modifier ui.Modifier
+	// MaxHeight is the height above which the menu scrolls.
+	MaxHeight unit.Dp
+}
+
+func DefaultExposedDropdownMenuOptions() ExposedDropdownMenuOptions {
+	return ExposedDropdownMenuOptions{
+		Modifier:  modifier.EmptyModifier,
+		MaxHeight: ExposedDropdownMenuMaxHeight,
+	}
+}
+
+type ExposedDropdownMenuOption func(*ExposedDropdownMenuOptions)
+
+func WithExposedDropdownMenuModifier(m ui.Modifier) ExposedDropdownMenuOption {
+	return func(opts *ExposedDropdownMenuOptions) {
+		opts.Modifier = m
+	}
+}
+
+func WithMaxHeight(maxHeight unit.Dp) ExposedDropdownMenuOption {
+	return func(opts *ExposedDropdownMenuOptions) {
+		opts.MaxHeight = maxHeight
+	}
+}
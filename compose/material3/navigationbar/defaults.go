@@ -1,6 +1,7 @@
 package navigationbar
 
 import (
+	"github.com/zodimo/go-compose/compose/foundation/layout/windowinsets"
 	"github.com/zodimo/go-compose/compose/material3"
 	"github.com/zodimo/go-compose/compose/ui"
 	"github.com/zodimo/go-compose/compose/ui/graphics"
@@ -42,6 +43,12 @@ func (d navigationBarDefaults) Height() unit.Dp {
 	return unit.Dp(80)
 }
 
+// WindowInsets returns the default insets of a NavigationBar: the sides and
+// bottom of the system bars.
+func (d navigationBarDefaults) WindowInsets() windowinsets.WindowInsets {
+	return windowinsets.Only(windowinsets.SystemBars, windowinsets.SidesHorizontal|windowinsets.SidesBottom)
+}
+
 func DefaultNavigationBarItemOptions() NavigationBarItemOptions {
 	return NavigationBarItemOptions{
 		Modifier: ui.EmptyModifier,
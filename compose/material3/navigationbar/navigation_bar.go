@@ -2,6 +2,7 @@ package navigationbar
 
 import (
 	"github.com/zodimo/go-compose/compose/foundation/layout/row"
+	"github.com/zodimo/go-compose/compose/foundation/layout/windowinsets"
 	"github.com/zodimo/go-compose/compose/material3/surface"
 	"github.com/zodimo/go-compose/modifiers/size"
 )
@@ -11,6 +12,8 @@ import (
 // Material 3 Specs:
 // - Height: 80dp
 // - Layout: Items are equally distributed.
+//
+// The container extends behind the window insets, which pad the items.
 func NavigationBar(
 	content Composable,
 	options ...NavigationBarOption,
@@ -31,14 +34,17 @@ func NavigationBar(
 					}
 					return c
 				},
-				row.WithModifier(size.FillMax()),
+				row.WithModifier(
+					windowinsets.WindowInsetsPadding(opts.WindowInsets).
+						Then(size.FillMaxWidth()).
+						Then(size.Height(int(opts.Height))),
+				),
 				// Items utilize weight to distribute space evenly
 				row.WithAlignment(row.Middle), // Vertically centered
 			),
 			surface.WithModifier(
 				opts.Modifier.
-					Then(size.FillMaxWidth()).
-					Then(size.Height(int(opts.Height))),
+					Then(size.FillMaxWidth()),
 			),
 			surface.WithColor(opts.ContainerColor),
 			surface.WithContentColor(opts.ContentColor),
@@ -106,6 +106,7 @@ func NavigationBarItem(
 				opts.Modifier.
 					Then(weight.Weight(1)).     // Fill share of the Row
 					Then(size.FillMaxHeight()). // Fill height of the Row
+					Then(material3.FocusIndicator(c, shape.ShapeRectangle)).
 					Then(clickable.OnClick(func() {
 						if onClick != nil {
 							onClick()
@@ -1,6 +1,7 @@
 package navigationbar
 
 import (
+	"github.com/zodimo/go-compose/compose/foundation/layout/windowinsets"
 	"github.com/zodimo/go-compose/compose/ui"
 	"github.com/zodimo/go-compose/compose/ui/graphics"
 	"github.com/zodimo/go-compose/compose/ui/unit"
@@ -13,6 +14,7 @@ type NavigationBarOptions struct {
 	ContentColor   graphics.Color
 	TonalElevation unit.Dp
 	Height         unit.Dp
+	WindowInsets   windowinsets.WindowInsets
 }
 
 // NavigationBarOption is a function that configures a NavigationBar.
@@ -26,6 +28,7 @@ func DefaultNavigationBarOptions(c Composer) NavigationBarOptions {
 		ContentColor:   NavigationBarDefaults.Colors(c).ContentColor,
 		TonalElevation: NavigationBarDefaults.ContainerElevation(),
 		Height:         NavigationBarDefaults.Height(),
+		WindowInsets:   NavigationBarDefaults.WindowInsets(),
 	}
 }
 
@@ -58,3 +61,9 @@ func WithHeight(h unit.Dp) NavigationBarOption {
 		o.Height = h
 	}
 }
+
+func WithWindowInsets(insets windowinsets.WindowInsets) NavigationBarOption {
+	return func(o *NavigationBarOptions) {
+		o.WindowInsets = insets
+	}
+}
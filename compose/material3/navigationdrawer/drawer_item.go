@@ -85,6 +85,7 @@ func NavigationDrawerItem(
 			},
 			surface.WithColor(containerColor),
 			surface.WithShape(&shape.RoundedCornerShape{Radius: unit.Dp(28)}), // Stadium shape (height 56 / 2)
+			surface.WithFocusIndicator(true),
 			surface.WithModifier(
 				m.
 					Then(size.FillMaxWidth()).
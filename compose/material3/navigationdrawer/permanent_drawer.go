@@ -20,37 +20,45 @@ func PermanentNavigationDrawer(
 	content Composable,
 	modifier ui.Modifier,
 ) Composable {
+	return row.Row(
+		func(c Composer) Composer {
+			// 1. Drawer Sheet
+			PermanentDrawerSheet(drawerContent, modifier)(c)
+
+			// 2. Main Content
+			box.Box(
+				content,
+				box.WithModifier(size.FillMax()),
+			)(c)
+
+			return c
+		},
+		row.WithModifier(size.FillMax()),
+	)
+}
+
+// PermanentDrawerSheet is the sheet of a PermanentNavigationDrawer, for
+// layouts that place the drawer themselves.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/NavigationDrawer.kt
+func PermanentDrawerSheet(drawerContent Composable, modifier ui.Modifier) Composable {
 	return func(c Composer) Composer {
 		theme := material3.Theme(c)
 		drawerContainerColor := theme.ColorScheme().SurfaceContainerLow
 
-		return row.Row(
-			func(c Composer) Composer {
-				// 1. Drawer Sheet
-				surface.Surface(
-					drawerContent,
-					surface.WithColor(drawerContainerColor),
-					// Standard drawer doesn't usually have rounded corners on the edge touching the content
-					// unless it's a specific variant, but M3 defaults often show 0 radius or small radius.
-					// We'll stick to a standard square edge or small radius if needed.
-					// M3: "Permanent navigation drawers are co-planar with the content."
-					surface.WithShape(&shape.RoundedCornerShape{Radius: unit.Dp(0)}),
-					surface.WithModifier(
-						modifier.
-							Then(size.Width(360)).
-							Then(size.FillMaxHeight()),
-					),
-				)(c)
-
-				// 2. Main Content
-				box.Box(
-					content,
-					box.WithModifier(size.FillMax()),
-				)(c)
-
-				return c
-			},
-			row.WithModifier(size.FillMax()),
+		return surface.Surface(
+			drawerContent,
+			surface.WithColor(drawerContainerColor),
+			// Standard drawer doesn't usually have rounded corners on the edge touching the content
+			// unless it's a specific variant, but M3 defaults often show 0 radius or small radius.
+			// We'll stick to a standard square edge or small radius if needed.
+			// M3: "Permanent navigation drawers are co-planar with the content."
+			surface.WithShape(&shape.RoundedCornerShape{Radius: unit.Dp(0)}),
+			surface.WithModifier(
+				modifier.
+					Then(size.Width(360)).
+					Then(size.FillMaxHeight()),
+			),
 		)(c)
 	}
 }
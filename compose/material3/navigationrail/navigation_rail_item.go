@@ -81,6 +81,7 @@ func NavigationRailItem(
 			column.WithModifier(
 				modifier.
 					Then(size.FillMaxWidth()).
+					Then(material3.FocusIndicator(c, shape.ShapeRectangle)).
 					Then(clickable.OnClick(func() {
 						if onClick != nil {
 							onClick()
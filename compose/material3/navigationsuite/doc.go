@@ -0,0 +1,17 @@
+/*
+Package navigationsuite contains NavigationSuiteScaffold, which shows its
+destinations in the navigation component that suits the window: a
+navigation bar on narrow windows, a navigation rail on medium ones and a
+permanent navigation drawer on large ones.
+
+	navigationsuite.NavigationSuiteScaffold(
+		[]navigationsuite.NavigationSuiteItem{
+			{Selected: tab == 0, OnClick: func() { setTab(0) }, Icon: homeIcon, Label: text.Text("Home")},
+			{Selected: tab == 1, OnClick: func() { setTab(1) }, Icon: settingsIcon, Label: text.Text("Settings")},
+		},
+		content,
+	)
+
+Reference: https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3-adaptive-navigation-suite/src/commonMain/kotlin/androidx/compose/material3/adaptive/navigationsuite/NavigationSuiteScaffold.kt
+*/
+package navigationsuite
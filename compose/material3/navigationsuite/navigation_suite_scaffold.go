@@ -0,0 +1,187 @@
+package navigationsuite
+
+import (
+	"fmt"
+
+	"github.com/zodimo/go-compose/compose"
+	"github.com/zodimo/go-compose/compose/foundation/layout/box"
+	"github.com/zodimo/go-compose/compose/foundation/layout/column"
+	"github.com/zodimo/go-compose/compose/material3/navigationbar"
+	"github.com/zodimo/go-compose/compose/material3/navigationdrawer"
+	"github.com/zodimo/go-compose/compose/material3/navigationrail"
+	"github.com/zodimo/go-compose/compose/material3/windowsizeclass"
+	"github.com/zodimo/go-compose/compose/ui"
+	uilayout "github.com/zodimo/go-compose/compose/ui/layout"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+	"github.com/zodimo/go-compose/modifiers/padding"
+	"github.com/zodimo/go-compose/modifiers/size"
+)
+
+// NavigationSuiteType is the navigation component of a NavigationSuiteScaffold.
+type NavigationSuiteType int
+
+const (
+	// NavigationSuiteTypeNavigationBar shows the destinations in a
+	// navigation bar below the content.
+	NavigationSuiteTypeNavigationBar NavigationSuiteType = iota
+	// NavigationSuiteTypeNavigationRail shows the destinations in a
+	// navigation rail at the start of the content.
+	NavigationSuiteTypeNavigationRail
+	// NavigationSuiteTypeNavigationDrawer shows the destinations in a
+	// permanent navigation drawer at the start of the content.
+	NavigationSuiteTypeNavigationDrawer
+	// NavigationSuiteTypeNone shows the content only.
+	NavigationSuiteTypeNone
+)
+
+func (t NavigationSuiteType) String() string {
+	switch t {
+	case NavigationSuiteTypeNavigationBar:
+		return "NavigationBar"
+	case NavigationSuiteTypeNavigationRail:
+		return "NavigationRail"
+	case NavigationSuiteTypeNavigationDrawer:
+		return "NavigationDrawer"
+	case NavigationSuiteTypeNone:
+		return "None"
+	default:
+		return fmt.Sprintf("NavigationSuiteType(%d)", int(t))
+	}
+}
+
+// NavigationSuiteItem is a destination of a NavigationSuiteScaffold.
+type NavigationSuiteItem struct {
+	Selected bool
+	OnClick  func()
+	Icon     Composable
+	Label    Composable
+	Modifier ui.Modifier
+}
+
+// NavigationSuiteScaffoldDefaults holds the default values of
+// NavigationSuiteScaffold.
+var NavigationSuiteScaffoldDefaults = navigationSuiteScaffoldDefaults{}
+
+type navigationSuiteScaffoldDefaults struct{}
+
+// CalculateFromSizeClass returns the navigation bar for compact windows,
+// and for windows of compact height, where a rail would leave little room
+// to its destinations; the navigation rail for medium and expanded widths;
+// and the permanent navigation drawer for large and extra large ones.
+func (navigationSuiteScaffoldDefaults) CalculateFromSizeClass(sizeClass windowsizeclass.WindowSizeClass) NavigationSuiteType {
+	switch {
+	case sizeClass.WidthSizeClass == windowsizeclass.WindowWidthSizeClassCompact,
+		sizeClass.HeightSizeClass == windowsizeclass.WindowHeightSizeClassCompact:
+		return NavigationSuiteTypeNavigationBar
+	case sizeClass.WidthSizeClass >= windowsizeclass.WindowWidthSizeClassLarge:
+		return NavigationSuiteTypeNavigationDrawer
+	default:
+		return NavigationSuiteTypeNavigationRail
+	}
+}
+
+// NavigationSuiteScaffold shows content with the navigation component that
+// suits the window for items, switching between them as the window is
+// resized.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3-adaptive-navigation-suite/src/commonMain/kotlin/androidx/compose/material3/adaptive/navigationsuite/NavigationSuiteScaffold.kt
+func NavigationSuiteScaffold(items []NavigationSuiteItem, content Composable, options ...NavigationSuiteScaffoldOption) Composable {
+	opts := DefaultNavigationSuiteScaffoldOptions()
+	for _, option := range options {
+		if option == nil {
+			continue
+		}
+		option(&opts)
+	}
+	return windowsizeclass.CalculateWindowSizeClass(func(sizeClass windowsizeclass.WindowSizeClass) windowsizeclass.Composable {
+		return NavigationSuiteScaffoldLayout(opts.LayoutType(sizeClass), items, content)
+	}, box.WithModifier(opts.Modifier.Then(size.FillMax())))
+}
+
+// NavigationSuiteScaffoldLayout shows content with the navigation component
+// of layoutType for items. The content is composed ahead of the navigation
+// component, at the same place for every layout type, so that its state is
+// kept when the window is resized to another layout type.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3-adaptive-navigation-suite/src/commonMain/kotlin/androidx/compose/material3/adaptive/navigationsuite/NavigationSuiteScaffold.kt
+func NavigationSuiteScaffoldLayout(layoutType NavigationSuiteType, items []NavigationSuiteItem, content Composable) Composable {
+	slots := []Composable{box.Box(content, box.WithModifier(size.FillMax()))}
+	if navigation := navigationSuite(layoutType, items); navigation != nil {
+		slots = append(slots, navigation)
+	}
+	return uilayout.Layout(
+		compose.Sequence(slots...),
+		uilayout.MeasurePolicyFunc(func(scope uilayout.MeasureScope, measurables []uilayout.Measurable, constraints unit.Constraints) uilayout.MeasureResult {
+			width, height := constraints.MaxWidth(), constraints.MaxHeight()
+			if len(measurables) < 2 {
+				body := measurables[0].Measure(unit.Fixed(width, height))
+				return scope.Layout(width, height, func() {
+					body.PlaceRelative(0, 0)
+				})
+			}
+			navigation := measurables[1].Measure(unit.NewConstraints(0, width, 0, height))
+			if layoutType == NavigationSuiteTypeNavigationBar {
+				body := measurables[0].Measure(unit.Fixed(width, max(height-navigation.Height(), 0)))
+				return scope.Layout(width, height, func() {
+					body.PlaceRelative(0, 0)
+					navigation.PlaceRelative(0, height-navigation.Height())
+				})
+			}
+			body := measurables[0].Measure(unit.Fixed(max(width-navigation.Width(), 0), height))
+			return scope.Layout(width, height, func() {
+				navigation.PlaceRelative(0, 0)
+				body.PlaceRelative(navigation.Width(), 0)
+			})
+		}),
+		uilayout.WithModifier(size.FillMax()),
+	)
+}
+
+// navigationSuite returns the navigation component of layoutType for items,
+// or nil for NavigationSuiteTypeNone.
+func navigationSuite(layoutType NavigationSuiteType, items []NavigationSuiteItem) Composable {
+	switch layoutType {
+	case NavigationSuiteTypeNavigationBar:
+		return navigationbar.NavigationBar(suiteItems(items, navigationBarItem))
+	case NavigationSuiteTypeNavigationRail:
+		return navigationrail.NavigationRail(ui.EmptyModifier, nil, suiteItems(items, navigationRailItem))
+	case NavigationSuiteTypeNavigationDrawer:
+		return navigationdrawer.PermanentDrawerSheet(
+			column.Column(
+				suiteItems(items, navigationDrawerItem),
+				column.WithModifier(padding.All(12)),
+			),
+			ui.EmptyModifier,
+		)
+	default:
+		return nil
+	}
+}
+
+func suiteItems(items []NavigationSuiteItem, item func(NavigationSuiteItem) Composable) Composable {
+	composables := make([]Composable, len(items))
+	for i, it := range items {
+		composables[i] = item(it)
+	}
+	return compose.Sequence(composables...)
+}
+
+func navigationBarItem(item NavigationSuiteItem) Composable {
+	return navigationbar.NavigationBarItem(item.Selected, item.OnClick, item.Icon, item.Label,
+		navigationbar.ItemWithModifier(modifierOf(item)))
+}
+
+func navigationRailItem(item NavigationSuiteItem) Composable {
+	return navigationrail.NavigationRailItem(item.Selected, item.OnClick, item.Icon, item.Label, modifierOf(item))
+}
+
+func navigationDrawerItem(item NavigationSuiteItem) Composable {
+	return navigationdrawer.NavigationDrawerItem(item.Selected, item.OnClick, item.Icon, item.Label, modifierOf(item))
+}
+
+func modifierOf(item NavigationSuiteItem) ui.Modifier {
+	if item.Modifier == nil {
+		return ui.EmptyModifier
+	}
+	return item.Modifier
+}
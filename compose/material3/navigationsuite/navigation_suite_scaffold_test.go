@@ -0,0 +1,102 @@
+package navigationsuite
+
+import (
+	"fmt"
+	"image"
+	"testing"
+
+	"github.com/zodimo/go-compose/compose"
+	"github.com/zodimo/go-compose/compose/material3/windowsizeclass"
+	"github.com/zodimo/go-compose/runtime"
+	"github.com/zodimo/go-compose/state"
+	"github.com/zodimo/go-compose/store"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/unit"
+)
+
+func TestCalculateFromSizeClass(t *testing.T) {
+	tests := []struct {
+		width  windowsizeclass.WindowWidthSizeClass
+		height windowsizeclass.WindowHeightSizeClass
+		want   NavigationSuiteType
+	}{
+		{windowsizeclass.WindowWidthSizeClassCompact, windowsizeclass.WindowHeightSizeClassMedium, NavigationSuiteTypeNavigationBar},
+		{windowsizeclass.WindowWidthSizeClassMedium, windowsizeclass.WindowHeightSizeClassMedium, NavigationSuiteTypeNavigationRail},
+		{windowsizeclass.WindowWidthSizeClassExpanded, windowsizeclass.WindowHeightSizeClassCompact, NavigationSuiteTypeNavigationBar},
+		{windowsizeclass.WindowWidthSizeClassExpanded, windowsizeclass.WindowHeightSizeClassExpanded, NavigationSuiteTypeNavigationRail},
+		{windowsizeclass.WindowWidthSizeClassLarge, windowsizeclass.WindowHeightSizeClassMedium, NavigationSuiteTypeNavigationDrawer},
+		{windowsizeclass.WindowWidthSizeClassExtraLarge, windowsizeclass.WindowHeightSizeClassExpanded, NavigationSuiteTypeNavigationDrawer},
+	}
+	for _, tt := range tests {
+		sizeClass := windowsizeclass.WindowSizeClass{WidthSizeClass: tt.width, HeightSizeClass: tt.height}
+		if got := NavigationSuiteScaffoldDefaults.CalculateFromSizeClass(sizeClass); got != tt.want {
+			t.Errorf("CalculateFromSizeClass(%v) = %v, want %v", sizeClass, got, tt.want)
+		}
+	}
+}
+
+func TestNavigationSuiteScaffold(t *testing.T) {
+	empty := func(c Composer) Composer { return c }
+	items := []NavigationSuiteItem{
+		{Selected: true, OnClick: func() {}, Icon: empty, Label: empty},
+		{OnClick: func() {}, Icon: empty, Label: empty},
+	}
+	for _, tt := range []struct {
+		width int
+		want  NavigationSuiteType
+	}{
+		{400, NavigationSuiteTypeNavigationBar},
+		{700, NavigationSuiteTypeNavigationRail},
+		{1300, NavigationSuiteTypeNavigationDrawer},
+	} {
+		var got NavigationSuiteType
+		layoutType := WithLayoutTypeFunc(func(sizeClass windowsizeclass.WindowSizeClass) NavigationSuiteType {
+			got = NavigationSuiteScaffoldDefaults.CalculateFromSizeClass(sizeClass)
+			return got
+		})
+		c := compose.NewComposer(store.NewPersistentState(map[string]state.MutableValue{}))
+		node := NavigationSuiteScaffold(items, empty, layoutType)(c).Build()
+		gtx := layout.Context{
+			Ops:         new(op.Ops),
+			Constraints: layout.Exact(image.Pt(tt.width, 800)),
+			Metric:      unit.Metric{PxPerDp: 1, PxPerSp: 1},
+		}
+		runtime.NewRuntime().Run(gtx, node).Add(gtx.Ops)
+		if got != tt.want {
+			t.Errorf("width %d: layout type = %v, want %v", tt.width, got, tt.want)
+		}
+	}
+}
+
+func TestNavigationSuiteScaffoldLayout_KeepsContentState(t *testing.T) {
+	empty := func(c Composer) Composer { return c }
+	items := []NavigationSuiteItem{{Selected: true, OnClick: func() {}, Icon: empty, Label: empty}}
+	store := store.NewPersistentState(map[string]state.MutableValue{})
+	var states []*int
+	content := func(c Composer) Composer {
+		key := fmt.Sprintf("content-%v", c.GenerateID())
+		states = append(states, c.State(key, func() any { return new(int) }).Get().(*int))
+		return c
+	}
+	for _, layoutType := range []NavigationSuiteType{
+		NavigationSuiteTypeNavigationBar,
+		NavigationSuiteTypeNavigationRail,
+		NavigationSuiteTypeNavigationDrawer,
+		NavigationSuiteTypeNone,
+		NavigationSuiteTypeNavigationBar,
+	} {
+		c := compose.NewComposer(store)
+		node := NavigationSuiteScaffoldLayout(layoutType, items, content)(c).Build()
+		gtx := layout.Context{
+			Ops:         new(op.Ops),
+			Constraints: layout.Exact(image.Pt(800, 600)),
+			Metric:      unit.Metric{PxPerDp: 1, PxPerSp: 1},
+		}
+		runtime.NewRuntime().Run(gtx, node).Add(gtx.Ops)
+		if states[len(states)-1] != states[0] {
+			t.Errorf("the content lost its state when switching to %v", layoutType)
+		}
+	}
+}
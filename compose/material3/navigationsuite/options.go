@@ -0,0 +1,44 @@
+package navigationsuite
+
+import (
+	"github.com/zodimo/go-compose/compose/material3/windowsizeclass"
+	"github.com/zodimo/go-compose/compose/ui"
+)
+
+type NavigationSuiteScaffoldOptions struct {
+	Modifier ui.Modifier
+	// LayoutType returns the navigation component for a window of
+	// sizeClass.
+	LayoutType func(sizeClass windowsizeclass.WindowSizeClass) NavigationSuiteType
+}
+
+type NavigationSuiteScaffoldOption func(*NavigationSuiteScaffoldOptions)
+
+func DefaultNavigationSuiteScaffoldOptions() NavigationSuiteScaffoldOptions {
+	return NavigationSuiteScaffoldOptions{
+		Modifier:   ui.EmptyModifier,
+		LayoutType: NavigationSuiteScaffoldDefaults.CalculateFromSizeClass,
+	}
+}
+
+func WithModifier(m ui.Modifier) NavigationSuiteScaffoldOption {
+	return func(o *NavigationSuiteScaffoldOptions) {
+		o.Modifier = m
+	}
+}
+
+// WithLayoutType shows the destinations in the navigation component of
+// layoutType, whatever the window.
+func WithLayoutType(layoutType NavigationSuiteType) NavigationSuiteScaffoldOption {
+	return WithLayoutTypeFunc(func(windowsizeclass.WindowSizeClass) NavigationSuiteType {
+		return layoutType
+	})
+}
+
+// WithLayoutTypeFunc chooses the navigation component of each window size
+// class with layoutType.
+func WithLayoutTypeFunc(layoutType func(sizeClass windowsizeclass.WindowSizeClass) NavigationSuiteType) NavigationSuiteScaffoldOption {
+	return func(o *NavigationSuiteScaffoldOptions) {
+		o.LayoutType = layoutType
+	}
+}
@@ -2,6 +2,7 @@ package button
 
 import (
 	"fmt"
+	"github.com/zodimo/go-compose/compose/foundation/layout/arrangement"
 
 	"github.com/zodimo/go-compose/compose/foundation"
 	foundationLayout "github.com/zodimo/go-compose/compose/foundation/layout"
@@ -174,8 +175,8 @@ func OutlinedButton(onClick func(), content Composable, options ...ButtonOption)
 								int(contentPadding.Bottom),
 							)),
 					),
-					row.WithAlignment(row.Middle),    // Center Vertically
-					row.WithSpacing(row.SpaceAround), // SpaceAround? No, "Around" doesn't exist? Check Step 683: SpaceAround
+					row.WithAlignment(row.Middle), // Center Vertically
+					row.WithHorizontalArrangement(arrangement.SpaceAround),
 				)(c)
 			},
 
@@ -0,0 +1,18 @@
+/*
+Package panescaffold contains ListDetailPaneScaffold and SupportingPaneScaffold,
+which show up to three panes side by side as the window allows, and only the
+pane navigated to on narrow windows.
+
+	navigator := panescaffold.RememberListDetailPaneScaffoldNavigator(c)
+	panescaffold.ListDetailPaneScaffold(
+		navigator,
+		MailList(func(id string) {
+			setSelected(id)
+			navigator.NavigateTo(panescaffold.ListDetailPaneScaffoldRoleDetail)
+		}),
+		MailDetail(selected),
+	)(c)
+
+Reference: https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/adaptive/adaptive-layout/src/commonMain/kotlin/androidx/compose/material3/adaptive/layout/ThreePaneScaffold.kt
+*/
+package panescaffold
@@ -0,0 +1,117 @@
+package panescaffold
+
+import (
+	"fmt"
+
+	"github.com/zodimo/go-compose/state"
+)
+
+// ThreePaneScaffoldRole is the role of a pane of a three pane scaffold, in
+// decreasing priority: when the window has room for fewer panes than the
+// scaffold has, the pane navigated to is shown first, then the others by
+// priority.
+type ThreePaneScaffoldRole int
+
+const (
+	ThreePaneScaffoldRolePrimary ThreePaneScaffoldRole = iota
+	ThreePaneScaffoldRoleSecondary
+	ThreePaneScaffoldRoleTertiary
+)
+
+func (r ThreePaneScaffoldRole) String() string {
+	switch r {
+	case ThreePaneScaffoldRolePrimary:
+		return "Primary"
+	case ThreePaneScaffoldRoleSecondary:
+		return "Secondary"
+	case ThreePaneScaffoldRoleTertiary:
+		return "Tertiary"
+	default:
+		return fmt.Sprintf("ThreePaneScaffoldRole(%d)", int(r))
+	}
+}
+
+// The roles of the panes of a ListDetailPaneScaffold.
+const (
+	ListDetailPaneScaffoldRoleList   = ThreePaneScaffoldRoleSecondary
+	ListDetailPaneScaffoldRoleDetail = ThreePaneScaffoldRolePrimary
+	ListDetailPaneScaffoldRoleExtra  = ThreePaneScaffoldRoleTertiary
+)
+
+// The roles of the panes of a SupportingPaneScaffold.
+const (
+	SupportingPaneScaffoldRoleMain       = ThreePaneScaffoldRolePrimary
+	SupportingPaneScaffoldRoleSupporting = ThreePaneScaffoldRoleSecondary
+	SupportingPaneScaffoldRoleExtra      = ThreePaneScaffoldRoleTertiary
+)
+
+// ThreePaneScaffoldNavigator keeps the panes navigated to by a three pane
+// scaffold, the last one being its destination.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/adaptive/adaptive-navigation/src/commonMain/kotlin/androidx/compose/material3/adaptive/navigation/ThreePaneScaffoldNavigator.kt
+type ThreePaneScaffoldNavigator struct {
+	backStack state.MutableValueTyped[[]ThreePaneScaffoldRole]
+}
+
+// NewThreePaneScaffoldNavigator creates a navigator whose back stack is kept
+// in backStack. Use the Remember functions to keep it across recompositions.
+func NewThreePaneScaffoldNavigator(backStack state.MutableValueTyped[[]ThreePaneScaffoldRole]) *ThreePaneScaffoldNavigator {
+	return &ThreePaneScaffoldNavigator{backStack: backStack}
+}
+
+// RememberListDetailPaneScaffoldNavigator returns a navigator starting at the
+// list pane, that survives recompositions.
+func RememberListDetailPaneScaffoldNavigator(c Composer) *ThreePaneScaffoldNavigator {
+	return rememberNavigator(c, "listDetailPaneScaffoldNavigator", ListDetailPaneScaffoldRoleList)
+}
+
+// RememberSupportingPaneScaffoldNavigator returns a navigator starting at the
+// main pane, that survives recompositions.
+func RememberSupportingPaneScaffoldNavigator(c Composer) *ThreePaneScaffoldNavigator {
+	return rememberNavigator(c, "supportingPaneScaffoldNavigator", SupportingPaneScaffoldRoleMain)
+}
+
+func rememberNavigator(c Composer, name string, initialDestination ThreePaneScaffoldRole) *ThreePaneScaffoldNavigator {
+	key := fmt.Sprintf("%s-%v", name, c.GenerateID())
+	backStack := state.MustState(c, key, func() []ThreePaneScaffoldRole {
+		return []ThreePaneScaffoldRole{initialDestination}
+	})
+	return NewThreePaneScaffoldNavigator(backStack)
+}
+
+// CurrentDestination returns the pane navigated to last.
+func (n *ThreePaneScaffoldNavigator) CurrentDestination() ThreePaneScaffoldRole {
+	stack := n.backStack.Get()
+	if len(stack) == 0 {
+		return ThreePaneScaffoldRolePrimary
+	}
+	return stack[len(stack)-1]
+}
+
+// NavigateTo navigates to the pane of role, unless it is the destination
+// already.
+func (n *ThreePaneScaffoldNavigator) NavigateTo(role ThreePaneScaffoldRole) {
+	if n.CurrentDestination() == role && len(n.backStack.Get()) > 0 {
+		return
+	}
+	n.backStack.Update(func(stack []ThreePaneScaffoldRole) []ThreePaneScaffoldRole {
+		return append(append([]ThreePaneScaffoldRole(nil), stack...), role)
+	})
+}
+
+// CanNavigateBack reports whether there is a pane to navigate back to.
+func (n *ThreePaneScaffoldNavigator) CanNavigateBack() bool {
+	return len(n.backStack.Get()) > 1
+}
+
+// NavigateBack navigates back to the previous destination, and reports
+// whether there was one.
+func (n *ThreePaneScaffoldNavigator) NavigateBack() bool {
+	if !n.CanNavigateBack() {
+		return false
+	}
+	n.backStack.Update(func(stack []ThreePaneScaffoldRole) []ThreePaneScaffoldRole {
+		return stack[:len(stack)-1]
+	})
+	return true
+}
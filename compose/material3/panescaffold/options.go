@@ -0,0 +1,44 @@
+package panescaffold
+
+import (
+	"github.com/zodimo/go-compose/compose/material3/windowsizeclass"
+	"github.com/zodimo/go-compose/compose/ui"
+)
+
+type PaneScaffoldOptions struct {
+	Modifier ui.Modifier
+	// ExtraPane is the tertiary pane, nil for none.
+	ExtraPane Composable
+	// Directive returns how panes are laid out in a window of sizeClass.
+	Directive func(sizeClass windowsizeclass.WindowSizeClass) PaneScaffoldDirective
+}
+
+type PaneScaffoldOption func(*PaneScaffoldOptions)
+
+func DefaultPaneScaffoldOptions() PaneScaffoldOptions {
+	return PaneScaffoldOptions{
+		Modifier:  ui.EmptyModifier,
+		Directive: PaneScaffoldDefaults.CalculateDirective,
+	}
+}
+
+func WithModifier(m ui.Modifier) PaneScaffoldOption {
+	return func(o *PaneScaffoldOptions) {
+		o.Modifier = m
+	}
+}
+
+// WithExtraPane adds the tertiary pane, shown beside the others on the
+// widest windows, or when navigated to.
+func WithExtraPane(extraPane Composable) PaneScaffoldOption {
+	return func(o *PaneScaffoldOptions) {
+		o.ExtraPane = extraPane
+	}
+}
+
+// WithDirective lays out the panes in each window size class by directive.
+func WithDirective(directive func(sizeClass windowsizeclass.WindowSizeClass) PaneScaffoldDirective) PaneScaffoldOption {
+	return func(o *PaneScaffoldOptions) {
+		o.Directive = directive
+	}
+}
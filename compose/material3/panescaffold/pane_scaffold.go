@@ -0,0 +1,123 @@
+package panescaffold
+
+import (
+	"github.com/zodimo/go-compose/compose"
+	"github.com/zodimo/go-compose/compose/foundation/layout/arrangement"
+	"github.com/zodimo/go-compose/compose/foundation/layout/box"
+	"github.com/zodimo/go-compose/compose/foundation/layout/row"
+	"github.com/zodimo/go-compose/compose/material3/windowsizeclass"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+	"github.com/zodimo/go-compose/modifiers/size"
+	"github.com/zodimo/go-compose/modifiers/weight"
+)
+
+// PaneScaffoldDirective is how a pane scaffold lays out its panes.
+type PaneScaffoldDirective struct {
+	// MaxHorizontalPartitions is the number of panes shown side by side.
+	MaxHorizontalPartitions int
+	// HorizontalPartitionSpacerSize is the space between panes.
+	HorizontalPartitionSpacerSize unit.Dp
+	// PreferredPaneWidth is the width of the panes beside the primary one,
+	// which takes the rest.
+	PreferredPaneWidth unit.Dp
+}
+
+// PaneScaffoldDefaults holds the default values of the pane scaffolds.
+var PaneScaffoldDefaults = paneScaffoldDefaults{}
+
+type paneScaffoldDefaults struct{}
+
+// CalculateDirective returns one pane for compact and medium widths, two
+// for expanded ones, and three for large and extra large ones.
+func (paneScaffoldDefaults) CalculateDirective(sizeClass windowsizeclass.WindowSizeClass) PaneScaffoldDirective {
+	partitions := 1
+	switch {
+	case sizeClass.WidthSizeClass >= windowsizeclass.WindowWidthSizeClassLarge:
+		partitions = 3
+	case sizeClass.WidthSizeClass == windowsizeclass.WindowWidthSizeClassExpanded:
+		partitions = 2
+	}
+	return PaneScaffoldDirective{
+		MaxHorizontalPartitions:       partitions,
+		HorizontalPartitionSpacerSize: 24,
+		PreferredPaneWidth:            360,
+	}
+}
+
+// ListDetailPaneScaffold shows a list and the detail of its selected item
+// side by side on wide windows, and the pane navigator navigated to last on
+// narrow ones. The list is at the start, the detail after it.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/adaptive/adaptive-layout/src/commonMain/kotlin/androidx/compose/material3/adaptive/layout/ListDetailPaneScaffold.kt
+func ListDetailPaneScaffold(navigator *ThreePaneScaffoldNavigator, listPane, detailPane Composable, options ...PaneScaffoldOption) Composable {
+	return threePaneScaffold(navigator, detailPane, listPane, []ThreePaneScaffoldRole{
+		ListDetailPaneScaffoldRoleList,
+		ListDetailPaneScaffoldRoleDetail,
+		ListDetailPaneScaffoldRoleExtra,
+	}, options)
+}
+
+// SupportingPaneScaffold shows main content and content supporting it side
+// by side on wide windows, and the pane navigator navigated to last on
+// narrow ones. The main pane is at the start, the supporting one after it.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/adaptive/adaptive-layout/src/commonMain/kotlin/androidx/compose/material3/adaptive/layout/SupportingPaneScaffold.kt
+func SupportingPaneScaffold(navigator *ThreePaneScaffoldNavigator, mainPane, supportingPane Composable, options ...PaneScaffoldOption) Composable {
+	return threePaneScaffold(navigator, mainPane, supportingPane, []ThreePaneScaffoldRole{
+		SupportingPaneScaffoldRoleMain,
+		SupportingPaneScaffoldRoleSupporting,
+		SupportingPaneScaffoldRoleExtra,
+	}, options)
+}
+
+// threePaneScaffold lays out the shown panes in order, the primary one
+// filling the width the others leave.
+func threePaneScaffold(navigator *ThreePaneScaffoldNavigator, primary, secondary Composable, order []ThreePaneScaffoldRole, options []PaneScaffoldOption) Composable {
+	opts := DefaultPaneScaffoldOptions()
+	for _, option := range options {
+		if option == nil {
+			continue
+		}
+		option(&opts)
+	}
+	panes := map[ThreePaneScaffoldRole]Composable{
+		ThreePaneScaffoldRolePrimary:   primary,
+		ThreePaneScaffoldRoleSecondary: secondary,
+	}
+	if opts.ExtraPane != nil {
+		panes[ThreePaneScaffoldRoleTertiary] = opts.ExtraPane
+	}
+	return windowsizeclass.CalculateWindowSizeClass(func(sizeClass windowsizeclass.WindowSizeClass) windowsizeclass.Composable {
+		directive := opts.Directive(sizeClass)
+		shown := shownPanes(navigator.CurrentDestination(), directive.MaxHorizontalPartitions, len(panes))
+		var composables []Composable
+		for _, role := range order {
+			if !shown[role] {
+				continue
+			}
+			modifier := size.FillMaxHeight().Then(size.Width(int(directive.PreferredPaneWidth)))
+			if role == ThreePaneScaffoldRolePrimary || len(shown) == 1 {
+				modifier = weight.Weight(1).Then(size.FillMaxHeight())
+			}
+			composables = append(composables, box.Box(panes[role], box.WithModifier(modifier)))
+		}
+		return row.Row(
+			compose.Sequence(composables...),
+			row.WithHorizontalArrangement(arrangement.SpacedBy(directive.HorizontalPartitionSpacerSize, arrangement.AlignmentStart)),
+			row.WithModifier(size.FillMax()),
+		)
+	}, box.WithModifier(opts.Modifier.Then(size.FillMax())))
+}
+
+// shownPanes returns the panes shown out of count, in a window with room for
+// maxPanes: the destination, then the others by priority.
+func shownPanes(destination ThreePaneScaffoldRole, maxPanes, count int) map[ThreePaneScaffoldRole]bool {
+	shown := map[ThreePaneScaffoldRole]bool{}
+	if int(destination) < count {
+		shown[destination] = true
+	}
+	for role := ThreePaneScaffoldRolePrimary; int(role) < count && len(shown) < max(maxPanes, 1); role++ {
+		shown[role] = true
+	}
+	return shown
+}
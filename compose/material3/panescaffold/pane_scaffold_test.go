@@ -0,0 +1,113 @@
+package panescaffold
+
+import (
+	"image"
+	"testing"
+
+	"github.com/zodimo/go-compose/compose"
+	"github.com/zodimo/go-compose/compose/foundation/layout/box"
+	uilayout "github.com/zodimo/go-compose/compose/ui/layout"
+	"github.com/zodimo/go-compose/modifiers/size"
+	"github.com/zodimo/go-compose/runtime"
+	"github.com/zodimo/go-compose/state"
+	"github.com/zodimo/go-compose/store"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/unit"
+)
+
+func TestShownPanes(t *testing.T) {
+	tests := []struct {
+		destination ThreePaneScaffoldRole
+		maxPanes    int
+		count       int
+		want        []ThreePaneScaffoldRole
+	}{
+		{ThreePaneScaffoldRoleSecondary, 1, 2, []ThreePaneScaffoldRole{ThreePaneScaffoldRoleSecondary}},
+		{ThreePaneScaffoldRolePrimary, 1, 2, []ThreePaneScaffoldRole{ThreePaneScaffoldRolePrimary}},
+		{ThreePaneScaffoldRoleSecondary, 2, 2, []ThreePaneScaffoldRole{ThreePaneScaffoldRolePrimary, ThreePaneScaffoldRoleSecondary}},
+		{ThreePaneScaffoldRoleSecondary, 3, 2, []ThreePaneScaffoldRole{ThreePaneScaffoldRolePrimary, ThreePaneScaffoldRoleSecondary}},
+		{ThreePaneScaffoldRoleTertiary, 2, 3, []ThreePaneScaffoldRole{ThreePaneScaffoldRolePrimary, ThreePaneScaffoldRoleTertiary}},
+		{ThreePaneScaffoldRoleSecondary, 3, 3, []ThreePaneScaffoldRole{ThreePaneScaffoldRolePrimary, ThreePaneScaffoldRoleSecondary, ThreePaneScaffoldRoleTertiary}},
+		// Without an extra pane, navigating to it shows the primary one.
+		{ThreePaneScaffoldRoleTertiary, 1, 2, []ThreePaneScaffoldRole{ThreePaneScaffoldRolePrimary}},
+	}
+	for _, tt := range tests {
+		got := shownPanes(tt.destination, tt.maxPanes, tt.count)
+		if len(got) != len(tt.want) {
+			t.Errorf("shownPanes(%v, %d, %d) = %v, want %v", tt.destination, tt.maxPanes, tt.count, got, tt.want)
+			continue
+		}
+		for _, role := range tt.want {
+			if !got[role] {
+				t.Errorf("shownPanes(%v, %d, %d) = %v, want %v", tt.destination, tt.maxPanes, tt.count, got, tt.want)
+			}
+		}
+	}
+}
+
+func TestThreePaneScaffoldNavigator(t *testing.T) {
+	c := compose.NewComposer(store.NewPersistentState(map[string]state.MutableValue{}))
+	navigator := RememberListDetailPaneScaffoldNavigator(c)
+	if got := navigator.CurrentDestination(); got != ListDetailPaneScaffoldRoleList {
+		t.Fatalf("initial destination = %v, want %v", got, ListDetailPaneScaffoldRoleList)
+	}
+	if navigator.CanNavigateBack() {
+		t.Errorf("CanNavigateBack() at the initial destination = true, want false")
+	}
+	navigator.NavigateTo(ListDetailPaneScaffoldRoleDetail)
+	navigator.NavigateTo(ListDetailPaneScaffoldRoleDetail)
+	if got := navigator.CurrentDestination(); got != ListDetailPaneScaffoldRoleDetail {
+		t.Errorf("destination = %v, want %v", got, ListDetailPaneScaffoldRoleDetail)
+	}
+	if !navigator.NavigateBack() {
+		t.Fatalf("NavigateBack() = false, want true")
+	}
+	if got := navigator.CurrentDestination(); got != ListDetailPaneScaffoldRoleList {
+		t.Errorf("destination after back = %v, want %v", got, ListDetailPaneScaffoldRoleList)
+	}
+	if navigator.NavigateBack() {
+		t.Errorf("NavigateBack() at the initial destination = true, want false")
+	}
+}
+
+func TestListDetailPaneScaffold(t *testing.T) {
+	for _, tt := range []struct {
+		width      int
+		list       image.Rectangle
+		detail     image.Rectangle
+		withDetail bool
+	}{
+		{width: 400, list: image.Rect(0, 0, 400, 600)},
+		{width: 400, detail: image.Rect(0, 0, 400, 600), withDetail: true},
+		{width: 1000, list: image.Rect(0, 0, 360, 600), detail: image.Rect(384, 0, 1000, 600)},
+	} {
+		var list, detail image.Rectangle
+		pane := func(r *image.Rectangle) Composable {
+			bounds := uilayout.OnGloballyPositioned(func(coordinates uilayout.LayoutCoordinates) {
+				b := coordinates.BoundsInWindow()
+				*r = image.Rect(int(b.Left), int(b.Top), int(b.Right), int(b.Bottom))
+			})
+			return box.Box(func(c Composer) Composer { return c }, box.WithModifier(size.FillMax().Then(bounds)))
+		}
+		c := compose.NewComposer(store.NewPersistentState(map[string]state.MutableValue{}))
+		navigator := RememberListDetailPaneScaffoldNavigator(c)
+		if tt.withDetail {
+			navigator.NavigateTo(ListDetailPaneScaffoldRoleDetail)
+		}
+		node := ListDetailPaneScaffold(navigator, pane(&list), pane(&detail))(c).Build()
+		gtx := layout.Context{
+			Ops:         new(op.Ops),
+			Constraints: layout.Exact(image.Pt(tt.width, 600)),
+			Metric:      unit.Metric{PxPerDp: 1, PxPerSp: 1},
+		}
+		runtime.NewRuntime().Run(gtx, node).Add(gtx.Ops)
+		if list != tt.list {
+			t.Errorf("width %d: list bounds = %v, want %v", tt.width, list, tt.list)
+		}
+		if detail != tt.detail {
+			t.Errorf("width %d: detail bounds = %v, want %v", tt.width, detail, tt.detail)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package pulltorefresh
+
+import (
+	"time"
+
+	"github.com/zodimo/go-compose/compose/material3"
+	"github.com/zodimo/go-compose/compose/ui/graphics"
+	"github.com/zodimo/go-compose/compose/ui/graphics/shape"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+)
+
+// PullToRefreshDefaults holds the default values of the pull to refresh
+// components.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/pulltorefresh/PullToRefresh.kt
+var PullToRefreshDefaults = pullToRefreshDefaults{}
+
+type pullToRefreshDefaults struct{}
+
+// PositionalThreshold is how far the indicator must be pulled to refresh,
+// and where it rests while refreshing.
+func (pullToRefreshDefaults) PositionalThreshold() unit.Dp {
+	return 80
+}
+
+// IndicatorSize is the size of the container of the indicator.
+func (pullToRefreshDefaults) IndicatorSize() unit.Dp {
+	return 40
+}
+
+// Shape is the shape of the container of the indicator.
+func (pullToRefreshDefaults) Shape() shape.Shape {
+	return shape.CircleShape
+}
+
+// Elevation is the shadow elevation of the container of the indicator.
+func (pullToRefreshDefaults) Elevation() unit.Dp {
+	return 3
+}
+
+// ContainerColor is the color of the container of the indicator.
+func (pullToRefreshDefaults) ContainerColor(c Composer) graphics.Color {
+	return material3.Theme(c).ColorScheme().SurfaceContainerHigh
+}
+
+// IndicatorColor is the color of the arrow of the indicator.
+func (pullToRefreshDefaults) IndicatorColor(c Composer) graphics.Color {
+	return material3.Theme(c).ColorScheme().OnSurfaceVariant
+}
+
+// AnimationDuration is how long the indicator takes to move to the
+// threshold or back out of view.
+func (pullToRefreshDefaults) AnimationDuration() time.Duration {
+	return material3.DefaultMotionTokens.DurationMedium2
+}
@@ -0,0 +1,9 @@
+/*
+Package pulltorefresh contains the Material 3 pull to refresh components: the
+PullToRefreshBox, which refreshes its scrollable content when pulled down
+from its top, and the indicator of PullToRefreshDefaults. Both are driven by
+a PullToRefreshState.
+
+Reference: [Pull to Refresh](https://m3.material.io/components/progress-indicators/overview)
+*/
+package pulltorefresh
@@ -0,0 +1,289 @@
+package pulltorefresh
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+	"time"
+
+	"github.com/zodimo/go-compose/compose/foundation/layout/box"
+	"github.com/zodimo/go-compose/compose/material3/progress"
+	"github.com/zodimo/go-compose/compose/material3/surface"
+	"github.com/zodimo/go-compose/compose/ui"
+	"github.com/zodimo/go-compose/compose/ui/graphics"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+	node "github.com/zodimo/go-compose/internal/Node"
+	"github.com/zodimo/go-compose/internal/animation"
+	"github.com/zodimo/go-compose/internal/layoutnode"
+	"github.com/zodimo/go-compose/internal/modifier"
+	"github.com/zodimo/go-compose/modifiers/alpha"
+	"github.com/zodimo/go-compose/modifiers/size"
+
+	"gioui.org/f32"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+)
+
+const (
+	// maxProgressArc is the part of the circle the arc covers at the
+	// threshold.
+	maxProgressArc = 0.8
+	// minAlpha and maxAlpha are the alpha of the arrow before and past the
+	// threshold.
+	minAlpha = 0.3
+	maxAlpha = 1
+	// thresholdAlphaDuration is how long the arrow takes to change its
+	// alpha as the pull crosses the threshold.
+	thresholdAlphaDuration = 300 * time.Millisecond
+	// crossfadeDuration is how long the arrow takes to turn into the
+	// LoadingIndicator once refreshing.
+	crossfadeDuration = 100 * time.Millisecond
+)
+
+// Sizes of the arrow, in dp.
+const (
+	spinnerSize = 16
+	strokeWidth = 2.5
+	arcRadius   = 5.5
+	arrowWidth  = 10
+	arrowHeight = 5
+)
+
+// Indicator returns the pull to refresh indicator of state: a circular
+// container, following the pull from above the top of its layout, showing
+// an arrow that grows with the pull and brightens past the threshold. Once
+// refreshing, the arrow turns into a LoadingIndicator, the indicator
+// resting at the threshold.
+func (pullToRefreshDefaults) Indicator(state *PullToRefreshState, isRefreshing bool, options ...IndicatorOption) Composable {
+	return func(c Composer) Composer {
+		opts := DefaultIndicatorOptions()
+		for _, option := range options {
+			if option == nil {
+				continue
+			}
+			option(&opts)
+		}
+		containerColor := opts.ContainerColor.TakeOrElse(PullToRefreshDefaults.ContainerColor(c))
+		indicatorColor := opts.Color.TakeOrElse(PullToRefreshDefaults.IndicatorColor(c))
+
+		key := fmt.Sprintf("pullToRefreshIndicator-%v", c.GenerateID())
+		thresholdAnim := c.State(key+"/threshold", func() any {
+			return &animation.VisibilityAnimation{Duration: thresholdAlphaDuration, State: animation.Invisible}
+		}).Get().(*animation.VisibilityAnimation)
+		refreshingAnim := c.State(key+"/refreshing", func() any {
+			return &animation.VisibilityAnimation{Duration: crossfadeDuration, State: animation.Invisible}
+		}).Get().(*animation.VisibilityAnimation)
+
+		now := time.Now()
+		distanceFraction := state.DistanceFraction()
+		if distanceFraction >= 1 {
+			thresholdAnim.Appear(now)
+		} else {
+			thresholdAnim.Disappear(now)
+		}
+		if isRefreshing {
+			refreshingAnim.Appear(now)
+		} else {
+			refreshingAnim.Disappear(now)
+		}
+		arrowAlpha := minAlpha + (maxAlpha-minAlpha)*thresholdAnim.RevealedAt(now)
+		refreshing := refreshingAnim.RevealedAt(now)
+
+		return surface.Surface(
+			c.Sequence(
+				c.When(refreshing < 1, circularArrow(distanceFraction, indicatorColor, arrowAlpha*(1-refreshing))),
+				c.When(refreshing > 0, progress.LoadingIndicator(progress.WithModifier(
+					size.Size(spinnerSize+strokeWidth*2, spinnerSize+strokeWidth*2).Then(alpha.Alpha(refreshing)),
+				))),
+			),
+			surface.WithShape(PullToRefreshDefaults.Shape()),
+			surface.WithColor(containerColor),
+			surface.WithShadowElevation(PullToRefreshDefaults.Elevation()),
+			surface.WithAlignment(box.Center),
+			surface.WithModifier(
+				indicatorLayoutModifier(state, isRefreshing, opts.Threshold, thresholdAnim, refreshingAnim).
+					Then(opts.Modifier).
+					Then(size.Size(int(PullToRefreshDefaults.IndicatorSize()), int(PullToRefreshDefaults.IndicatorSize()))),
+			),
+		)(c)
+	}
+}
+
+// circularArrow draws the arc and arrow head of the indicator at the
+// distance fraction progress.
+func circularArrow(progress float32, col graphics.Color, arrowAlpha float32) Composable {
+	return func(c Composer) Composer {
+		c.StartBlock("PullToRefreshArrow")
+		c.SetWidgetConstructor(layoutnode.NewLayoutNodeWidgetConstructor(func(node layoutnode.LayoutNode) layoutnode.GioLayoutWidget {
+			return func(gtx layoutnode.LayoutContext) layoutnode.LayoutDimensions {
+				return drawCircularArrow(gtx, newArrowValues(progress), graphics.ColorToNRGBA(col), arrowAlpha)
+			}
+		}))
+		return c.EndBlock()
+	}
+}
+
+// arrowValues is the arc of the arrow, in degrees clockwise from three
+// o'clock, and the scale of its head.
+type arrowValues struct {
+	startAngle float32
+	endAngle   float32
+	scale      float32
+}
+
+// newArrowValues returns the arrow at the distance fraction progress: it
+// grows from 40% of the threshold, and turns further past it.
+func newArrowValues(progress float32) arrowValues {
+	adjusted := max(min(progress, 1)-0.4, 0) * 5 / 3
+	linearTension := min(max(float32(math.Abs(float64(progress)))-1, 0), 2)
+	tension := linearTension - linearTension*linearTension/4
+	rotation := (-0.25 + 0.4*adjusted + tension) * 0.5
+	return arrowValues{
+		startAngle: rotation * 360,
+		endAngle:   (rotation + adjusted*maxProgressArc) * 360,
+		scale:      min(adjusted, 1),
+	}
+}
+
+func drawCircularArrow(gtx layoutnode.LayoutContext, values arrowValues, col color.NRGBA, arrowAlpha float32) layoutnode.LayoutDimensions {
+	sz := gtx.Dp(spinnerSize)
+	dims := layoutnode.LayoutDimensions{Size: image.Pt(sz, sz)}
+	if values.endAngle == values.startAngle {
+		return dims
+	}
+	col.A = uint8(float32(col.A) * arrowAlpha)
+	px := func(dp float32) float32 { return dp * gtx.Metric.PxPerDp }
+	stroke := px(strokeWidth)
+	radius := px(arcRadius) + stroke/2
+	center := f32.Pt(float32(sz)/2, float32(sz)/2)
+
+	paint.FillShape(gtx.Ops, col, clip.Stroke{
+		Path:  arcPath(gtx.Ops, center, radius, values.startAngle, values.endAngle),
+		Width: stroke,
+	}.Op())
+
+	// The head points clockwise at the end of the arc.
+	width, height := px(arrowWidth)*values.scale, px(arrowHeight)*values.scale
+	rotation := f32.Affine2D{}.Rotate(center, values.endAngle*math.Pi/180)
+	defer op.Affine(rotation).Push(gtx.Ops).Pop()
+	var head clip.Path
+	head.Begin(gtx.Ops)
+	head.MoveTo(f32.Pt(center.X+radius-width/2, center.Y-stroke))
+	head.LineTo(f32.Pt(center.X+radius+width/2, center.Y-stroke))
+	head.LineTo(f32.Pt(center.X+radius, center.Y-stroke+height))
+	head.Close()
+	paint.FillShape(gtx.Ops, col, clip.Outline{Path: head.End()}.Op())
+	return dims
+}
+
+// arcPath returns the arc of radius around center from startAngle to
+// endAngle, in degrees clockwise from three o'clock.
+func arcPath(ops *op.Ops, center f32.Point, radius, startAngle, endAngle float32) clip.PathSpec {
+	const degreesPerSegment = 6
+	segments := max(int(math.Abs(float64(endAngle-startAngle))/degreesPerSegment), 1)
+	var p clip.Path
+	p.Begin(ops)
+	for i := 0; i <= segments; i++ {
+		angle := float64(startAngle+(endAngle-startAngle)*float32(i)/float32(segments)) * math.Pi / 180
+		sin, cos := math.Sincos(angle)
+		pt := center.Add(f32.Pt(radius*float32(cos), radius*float32(sin)))
+		if i == 0 {
+			p.MoveTo(pt)
+		} else {
+			p.LineTo(pt)
+		}
+	}
+	return p.End()
+}
+
+// indicatorLayoutModifier places the indicator above the top of its layout
+// by its height, moved down by the distance fraction of state times
+// threshold, and clips it to its layout. It hides the indicator at rest,
+// and requests frames while the alpha of the arrow or the crossfade to the
+// LoadingIndicator animate.
+func indicatorLayoutModifier(state *PullToRefreshState, isRefreshing bool, threshold unit.Dp, anims ...*animation.VisibilityAnimation) ui.Modifier {
+	return modifier.NewInspectableModifier(
+		modifier.NewModifier(&indicatorLayoutElement{state: state, isRefreshing: isRefreshing, threshold: threshold, anims: anims}),
+		modifier.NewInspectorInfo("pullToRefreshIndicator", map[string]any{
+			"state":        state,
+			"isRefreshing": isRefreshing,
+			"threshold":    threshold,
+		}),
+	)
+}
+
+type indicatorLayoutElement struct {
+	state        *PullToRefreshState
+	isRefreshing bool
+	threshold    unit.Dp
+	anims        []*animation.VisibilityAnimation
+}
+
+func (e *indicatorLayoutElement) Create() node.Node {
+	return newIndicatorLayoutNode(e)
+}
+
+func (e *indicatorLayoutElement) Update(n node.Node) {
+	n.(*indicatorLayoutNode).element = e
+}
+
+func (e *indicatorLayoutElement) Equals(other modifier.Element) bool {
+	o, ok := other.(*indicatorLayoutElement)
+	if !ok || o.state != e.state || o.isRefreshing != e.isRefreshing || o.threshold != e.threshold || len(o.anims) != len(e.anims) {
+		return false
+	}
+	for i := range e.anims {
+		if o.anims[i] != e.anims[i] {
+			return false
+		}
+	}
+	return true
+}
+
+type indicatorLayoutNode struct {
+	node.ChainNode
+	element *indicatorLayoutElement
+}
+
+func newIndicatorLayoutNode(element *indicatorLayoutElement) *indicatorLayoutNode {
+	n := &indicatorLayoutNode{element: element}
+	n.ChainNode = node.NewChainNode(
+		node.NewNodeID(),
+		node.NodeKindLayout,
+		node.LayoutPhase,
+		func(t node.TreeNode) {
+			t.(layoutnode.LayoutModifierNode).AttachLayoutModifier(func(widget layoutnode.LayoutWidget) layoutnode.LayoutWidget {
+				return layoutnode.NewLayoutWidget(func(gtx layoutnode.LayoutContext) layoutnode.LayoutDimensions {
+					e := n.element
+					for _, anim := range e.anims {
+						anim.Revealed(gtx)
+					}
+					threshold := gtx.Dp(unit.DpToGioUnit(e.threshold))
+					return layoutIndicator(gtx, e.state.DistanceFraction(), e.isRefreshing, threshold, widget)
+				})
+			})
+		},
+	)
+	return n
+}
+
+// layoutIndicator lays widget out at distanceFraction times threshold from
+// the top of the layout, its bottom edge there, and clips it to the layout.
+// The indicator takes its own size.
+func layoutIndicator(gtx layoutnode.LayoutContext, distanceFraction float32, isRefreshing bool, threshold int, widget layoutnode.LayoutWidget) layoutnode.LayoutDimensions {
+	macro := op.Record(gtx.Ops)
+	dims := widget.Layout(gtx)
+	call := macro.Stop()
+	if distanceFraction == 0 && !isRefreshing {
+		return dims
+	}
+
+	y := int(math.Round(float64(distanceFraction*float32(threshold)))) - dims.Size.Y
+	// The clip leaves room around the indicator for its shadow.
+	defer clip.Rect{Min: image.Pt(-dims.Size.X, 0), Max: image.Pt(2*dims.Size.X, y+2*dims.Size.Y)}.Push(gtx.Ops).Pop()
+	defer op.Offset(image.Pt(0, y)).Push(gtx.Ops).Pop()
+	call.Add(gtx.Ops)
+	return dims
+}
@@ -0,0 +1,129 @@
+package pulltorefresh
+
+import (
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+	"github.com/zodimo/go-compose/compose/ui/input/nestedscroll"
+)
+
+// dragMultiplier slows the indicator down relative to the pull.
+const dragMultiplier = 0.5
+
+// pullToRefreshConnection pulls the indicator with what the content cannot
+// scroll of a scroll towards its start, pushes it back before the content
+// scrolls towards its end, and refreshes when a pull past the threshold is
+// released.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/pulltorefresh/PullToRefresh.kt
+type pullToRefreshConnection struct {
+	state        *PullToRefreshState
+	isRefreshing bool
+	onRefresh    func()
+	enabled      bool
+
+	// thresholdPx is the refresh threshold, set as the box is laid out.
+	thresholdPx float32
+	// distancePulled is how far the content was pulled since the last
+	// release.
+	distancePulled float32
+}
+
+var _ nestedscroll.NestedScrollConnection = (*pullToRefreshConnection)(nil)
+
+// update sets the values of the latest composition, moving the indicator
+// to the threshold, or out of view, when a refresh starts or ends.
+func (n *pullToRefreshConnection) update(state *PullToRefreshState, isRefreshing bool, onRefresh func(), enabled bool) {
+	switch {
+	case n.state != state:
+		if isRefreshing {
+			state.SnapTo(1)
+		} else {
+			state.SnapTo(0)
+		}
+	case n.isRefreshing != isRefreshing:
+		if isRefreshing {
+			state.AnimateToThreshold()
+		} else {
+			state.AnimateToHidden()
+		}
+	}
+	n.state, n.isRefreshing, n.onRefresh, n.enabled = state, isRefreshing, onRefresh, enabled
+}
+
+func (n *pullToRefreshConnection) OnPreScroll(available geometry.Offset, source nestedscroll.NestedScrollSource) geometry.Offset {
+	if !n.enabled || n.state.IsAnimating() {
+		return geometry.OffsetZero
+	}
+	// Pushing back a pulled indicator comes before scrolling the content.
+	if source == nestedscroll.NestedScrollSourceUserInput && available.Y() < 0 {
+		return geometry.NewOffset(0, n.consume(available.Y()))
+	}
+	return geometry.OffsetZero
+}
+
+func (n *pullToRefreshConnection) OnPostScroll(consumed, available geometry.Offset, source nestedscroll.NestedScrollSource) geometry.Offset {
+	if !n.enabled || n.state.IsAnimating() {
+		return geometry.OffsetZero
+	}
+	if source == nestedscroll.NestedScrollSourceUserInput && available.Y() > 0 {
+		return geometry.NewOffset(0, n.consume(available.Y()))
+	}
+	return geometry.OffsetZero
+}
+
+func (n *pullToRefreshConnection) OnPreFling(available geometry.Offset) geometry.Offset {
+	if !n.enabled {
+		return geometry.OffsetZero
+	}
+	return geometry.NewOffset(0, n.release(available.Y()))
+}
+
+func (n *pullToRefreshConnection) OnPostFling(consumed, available geometry.Offset) geometry.Offset {
+	return geometry.OffsetZero
+}
+
+// consume pulls the indicator by delta, not above its hidden position, and
+// returns the part of delta it took.
+func (n *pullToRefreshConnection) consume(delta float32) float32 {
+	if n.isRefreshing || n.thresholdPx <= 0 {
+		return 0
+	}
+	pulled := max(n.distancePulled+delta, 0)
+	consumed := pulled - n.distancePulled
+	n.distancePulled = pulled
+	n.state.SnapTo(n.verticalOffset() / n.thresholdPx)
+	return consumed
+}
+
+// release ends a pull, refreshing when it went past the threshold, and
+// returns the part of the fling velocity it took.
+func (n *pullToRefreshConnection) release(velocity float32) float32 {
+	if n.isRefreshing {
+		return 0
+	}
+	if n.distancePulled*dragMultiplier > n.thresholdPx {
+		if n.onRefresh != nil {
+			n.onRefresh()
+		}
+	} else {
+		n.state.AnimateToHidden()
+	}
+	consumed := velocity
+	if n.distancePulled == 0 || velocity < 0 {
+		consumed = 0
+	}
+	n.distancePulled = 0
+	return consumed
+}
+
+// verticalOffset returns the offset of the indicator for the distance
+// pulled: half of it up to the threshold, and past the threshold an offset
+// growing ever slower, to at most one and a half times the threshold.
+func (n *pullToRefreshConnection) verticalOffset() float32 {
+	adjusted := n.distancePulled * dragMultiplier
+	if adjusted <= n.thresholdPx {
+		return adjusted
+	}
+	linearTension := min(adjusted/n.thresholdPx-1, 2)
+	tension := linearTension - linearTension*linearTension/4
+	return n.thresholdPx + n.thresholdPx*tension
+}
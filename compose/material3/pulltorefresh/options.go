@@ -0,0 +1,115 @@
+package pulltorefresh
+
+import (
+	"github.com/zodimo/go-compose/compose/foundation/layout/box"
+	"github.com/zodimo/go-compose/compose/ui"
+	"github.com/zodimo/go-compose/compose/ui/graphics"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+)
+
+type PullToRefreshBoxOptions struct {
+	Modifier ui.Modifier
+	// State is the state of the pull, remembered with
+	// RememberPullToRefreshState when not set.
+	State            *PullToRefreshState
+	ContentAlignment box.Direction
+	// Indicator replaces PullToRefreshDefaults.Indicator. It is laid out at
+	// the top center of the box, over the content.
+	Indicator Composable
+	// Threshold is how far the indicator must be pulled to refresh.
+	Threshold unit.Dp
+	// Enabled lets the content be pulled.
+	Enabled bool
+}
+
+type PullToRefreshBoxOption func(*PullToRefreshBoxOptions)
+
+func DefaultPullToRefreshBoxOptions() PullToRefreshBoxOptions {
+	return PullToRefreshBoxOptions{
+		Modifier:         ui.EmptyModifier,
+		ContentAlignment: box.NW,
+		Threshold:        PullToRefreshDefaults.PositionalThreshold(),
+		Enabled:          true,
+	}
+}
+
+func WithModifier(m ui.Modifier) PullToRefreshBoxOption {
+	return func(o *PullToRefreshBoxOptions) {
+		o.Modifier = m
+	}
+}
+
+func WithState(state *PullToRefreshState) PullToRefreshBoxOption {
+	return func(o *PullToRefreshBoxOptions) {
+		o.State = state
+	}
+}
+
+func WithContentAlignment(alignment box.Direction) PullToRefreshBoxOption {
+	return func(o *PullToRefreshBoxOptions) {
+		o.ContentAlignment = alignment
+	}
+}
+
+func WithIndicator(indicator Composable) PullToRefreshBoxOption {
+	return func(o *PullToRefreshBoxOptions) {
+		o.Indicator = indicator
+	}
+}
+
+func WithThreshold(threshold unit.Dp) PullToRefreshBoxOption {
+	return func(o *PullToRefreshBoxOptions) {
+		o.Threshold = threshold
+	}
+}
+
+func WithEnabled(enabled bool) PullToRefreshBoxOption {
+	return func(o *PullToRefreshBoxOptions) {
+		o.Enabled = enabled
+	}
+}
+
+type IndicatorOptions struct {
+	Modifier       ui.Modifier
+	ContainerColor graphics.Color
+	// Color is the color of the arrow shown while pulling.
+	Color graphics.Color
+	// Threshold is the distance the indicator moves down to at a distance
+	// fraction of 1.
+	Threshold unit.Dp
+}
+
+type IndicatorOption func(*IndicatorOptions)
+
+func DefaultIndicatorOptions() IndicatorOptions {
+	return IndicatorOptions{
+		Modifier:       ui.EmptyModifier,
+		ContainerColor: graphics.ColorUnspecified,
+		Color:          graphics.ColorUnspecified,
+		Threshold:      PullToRefreshDefaults.PositionalThreshold(),
+	}
+}
+
+func WithIndicatorModifier(m ui.Modifier) IndicatorOption {
+	return func(o *IndicatorOptions) {
+		o.Modifier = m
+	}
+}
+
+func WithIndicatorContainerColor(col graphics.Color) IndicatorOption {
+	return func(o *IndicatorOptions) {
+		o.ContainerColor = col
+	}
+}
+
+func WithIndicatorColor(col graphics.Color) IndicatorOption {
+	return func(o *IndicatorOptions) {
+		o.Color = col
+	}
+}
+
+func WithIndicatorThreshold(threshold unit.Dp) IndicatorOption {
+	return func(o *IndicatorOptions) {
+		o.Threshold = threshold
+	}
+}
@@ -0,0 +1,125 @@
+package pulltorefresh
+
+import (
+	"fmt"
+
+	"github.com/zodimo/go-compose/compose/foundation/layout/box"
+	"github.com/zodimo/go-compose/compose/ui"
+	"github.com/zodimo/go-compose/compose/ui/input/nestedscroll"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+	node "github.com/zodimo/go-compose/internal/Node"
+	"github.com/zodimo/go-compose/internal/layoutnode"
+	"github.com/zodimo/go-compose/internal/modifier"
+	"github.com/zodimo/go-compose/modifiers/size"
+
+	"gioui.org/op"
+)
+
+// PullToRefreshBox lays content out with a pull to refresh indicator over
+// its top. Pulling the scrollable content, such as a LazyColumn, down from
+// its top edge, by dragging or with the mouse wheel, pulls the indicator;
+// released past the threshold, it calls onRefresh. The indicator then rests
+// at the threshold for as long as isRefreshing is set, which onRefresh is
+// expected to set until the refresh is done.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/pulltorefresh/PullToRefresh.kt
+func PullToRefreshBox(isRefreshing bool, onRefresh func(), content Composable, options ...PullToRefreshBoxOption) Composable {
+	return func(c Composer) Composer {
+		opts := DefaultPullToRefreshBoxOptions()
+		for _, option := range options {
+			if option == nil {
+				continue
+			}
+			option(&opts)
+		}
+
+		key := fmt.Sprintf("pullToRefreshBox-%v", c.GenerateID())
+		state := opts.State
+		if state == nil {
+			state = RememberPullToRefreshState(c)
+		}
+		connection := c.State(key+"/nestedScroll", func() any {
+			return &pullToRefreshConnection{}
+		}).Get().(*pullToRefreshConnection)
+		connection.update(state, isRefreshing, onRefresh, opts.Enabled)
+
+		indicator := opts.Indicator
+		if indicator == nil {
+			indicator = PullToRefreshDefaults.Indicator(state, isRefreshing, WithIndicatorThreshold(opts.Threshold))
+		}
+
+		return box.Box(
+			c.Sequence(
+				content,
+				box.Box(
+					indicator,
+					box.WithAlignment(box.N),
+					box.WithModifier(size.FillMaxWidth()),
+				),
+			),
+			box.WithAlignment(opts.ContentAlignment),
+			box.WithModifier(
+				opts.Modifier.
+					Then(pullToRefreshModifier(connection, opts.Threshold)).
+					Then(nestedscroll.NestedScroll(connection, nil)),
+			),
+		)(c)
+	}
+}
+
+// pullToRefreshModifier sets the threshold of connection in pixels, and
+// moves its state while it animates.
+func pullToRefreshModifier(connection *pullToRefreshConnection, threshold unit.Dp) ui.Modifier {
+	return modifier.NewInspectableModifier(
+		modifier.NewModifier(&pullToRefreshElement{connection: connection, threshold: threshold}),
+		modifier.NewInspectorInfo("pullToRefresh", map[string]any{
+			"state":     connection.state,
+			"threshold": threshold,
+		}),
+	)
+}
+
+type pullToRefreshElement struct {
+	connection *pullToRefreshConnection
+	threshold  unit.Dp
+}
+
+func (e *pullToRefreshElement) Create() node.Node {
+	return newPullToRefreshNode(e)
+}
+
+func (e *pullToRefreshElement) Update(n node.Node) {
+	n.(*pullToRefreshNode).element = e
+}
+
+func (e *pullToRefreshElement) Equals(other modifier.Element) bool {
+	o, ok := other.(*pullToRefreshElement)
+	return ok && *o == *e
+}
+
+type pullToRefreshNode struct {
+	node.ChainNode
+	element *pullToRefreshElement
+}
+
+func newPullToRefreshNode(element *pullToRefreshElement) *pullToRefreshNode {
+	n := &pullToRefreshNode{element: element}
+	n.ChainNode = node.NewChainNode(
+		node.NewNodeID(),
+		node.NodeKindLayout,
+		node.LayoutPhase,
+		func(t node.TreeNode) {
+			t.(layoutnode.LayoutModifierNode).AttachLayoutModifier(func(widget layoutnode.LayoutWidget) layoutnode.LayoutWidget {
+				return layoutnode.NewLayoutWidget(func(gtx layoutnode.LayoutContext) layoutnode.LayoutDimensions {
+					connection := n.element.connection
+					connection.thresholdPx = float32(gtx.Dp(unit.DpToGioUnit(n.element.threshold)))
+					if connection.state.advance(gtx.Now) {
+						gtx.Execute(op.InvalidateCmd{})
+					}
+					return widget.Layout(gtx)
+				})
+			})
+		},
+	)
+	return n
+}
@@ -0,0 +1,109 @@
+package pulltorefresh
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/zodimo/go-compose/compose/material3"
+	"github.com/zodimo/go-compose/internal/animation"
+	"github.com/zodimo/go-compose/internal/gesturestate"
+)
+
+// PullToRefreshState is the state of a pull to refresh: how far the
+// indicator is pulled, as a fraction of the refresh threshold.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/pulltorefresh/PullToRefresh.kt
+type PullToRefreshState struct {
+	distanceFraction float32
+
+	// anim is the animation moving the indicator to the threshold or back.
+	anim *animation.FloatAnimation
+
+	revision gesturestate.Revision
+}
+
+// NewPullToRefreshState creates a hidden PullToRefreshState. Use
+// RememberPullToRefreshState to keep it across recompositions.
+func NewPullToRefreshState() *PullToRefreshState {
+	return &PullToRefreshState{revision: gesturestate.NewRevision()}
+}
+
+// RememberPullToRefreshState returns a hidden PullToRefreshState that
+// survives recompositions.
+func RememberPullToRefreshState(c Composer) *PullToRefreshState {
+	key := fmt.Sprintf("pullToRefreshState-%v", c.GenerateID())
+	revision := gesturestate.RememberRevision(c, key+"/revision")
+	return c.State(key, func() any {
+		s := NewPullToRefreshState()
+		s.revision = revision
+		return s
+	}).Get().(*PullToRefreshState)
+}
+
+// DistanceFraction returns how far the indicator is pulled: 0 when hidden,
+// 1 at the refresh threshold, and more when pulled past it.
+func (s *PullToRefreshState) DistanceFraction() float32 {
+	s.revision.Observe()
+	return s.distanceFraction
+}
+
+// IsAnimating reports whether the indicator moves to the threshold or back.
+func (s *PullToRefreshState) IsAnimating() bool {
+	s.revision.Observe()
+	return s.anim != nil
+}
+
+// AnimateToThreshold moves the indicator to the refresh threshold, where it
+// stays while refreshing.
+func (s *PullToRefreshState) AnimateToThreshold() {
+	s.animateTo(1)
+}
+
+// AnimateToHidden moves the indicator back out of view.
+func (s *PullToRefreshState) AnimateToHidden() {
+	s.animateTo(0)
+}
+
+// SnapTo moves the indicator to targetValue, a fraction of the refresh
+// threshold, at once. It stops the indicator animating.
+func (s *PullToRefreshState) SnapTo(targetValue float32) {
+	s.anim = nil
+	s.setDistanceFraction(targetValue)
+}
+
+func (s *PullToRefreshState) animateTo(targetValue float32) {
+	if s.anim == nil && s.distanceFraction == targetValue {
+		return
+	}
+	s.anim = &animation.FloatAnimation{
+		From:     s.distanceFraction,
+		To:       targetValue,
+		Duration: PullToRefreshDefaults.AnimationDuration(),
+		Started:  time.Now(),
+		Easing:   material3.EasingEmphasizedDecelerate.Transform,
+	}
+	s.revision.Bump()
+}
+
+func (s *PullToRefreshState) setDistanceFraction(fraction float32) {
+	if fraction == s.distanceFraction {
+		return
+	}
+	s.distanceFraction = fraction
+	s.revision.Bump()
+}
+
+// advance moves an animating indicator to now and reports whether it is
+// still animating.
+func (s *PullToRefreshState) advance(now time.Time) bool {
+	if s.anim == nil {
+		return false
+	}
+	fraction, done := s.anim.ValueAt(now)
+	if done {
+		s.anim = nil
+		s.revision.Bump()
+	}
+	s.setDistanceFraction(fraction)
+	return !done
+}
@@ -0,0 +1,136 @@
+package pulltorefresh
+
+import (
+	"image"
+	"testing"
+	"time"
+
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+	"github.com/zodimo/go-compose/compose/ui/input/nestedscroll"
+	"github.com/zodimo/go-compose/internal/layoutnode"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+	gioUnit "gioui.org/unit"
+)
+
+const drag = nestedscroll.NestedScrollSourceUserInput
+
+func newTestConnection(isRefreshing bool, onRefresh func()) *pullToRefreshConnection {
+	connection := &pullToRefreshConnection{thresholdPx: 80}
+	connection.update(NewPullToRefreshState(), isRefreshing, onRefresh, true)
+	return connection
+}
+
+func TestPullToRefreshConnectionPull(t *testing.T) {
+	refreshed := false
+	connection := newTestConnection(false, func() { refreshed = true })
+	state := connection.state
+
+	// What the content leaves of a pull down pulls the indicator at half
+	// the speed.
+	if got := connection.OnPostScroll(geometry.OffsetZero, geometry.NewOffset(0, 100), drag); got.Y() != 100 {
+		t.Errorf("post scroll consumed %v, want the whole pull", got)
+	}
+	if got := state.DistanceFraction(); got != 0.625 {
+		t.Errorf("distance fraction = %v, want 0.625", got)
+	}
+	// Scrolling back pushes the indicator back first, down to hidden.
+	if got := connection.OnPreScroll(geometry.NewOffset(0, -150), drag); got.Y() != -100 {
+		t.Errorf("pre scroll consumed %v, want the distance pulled", got)
+	}
+	if got := state.DistanceFraction(); got != 0 {
+		t.Errorf("distance fraction = %v, want 0", got)
+	}
+	// Flings do not pull.
+	if got := connection.OnPostScroll(geometry.OffsetZero, geometry.NewOffset(0, 100), nestedscroll.NestedScrollSourceSideEffect); got != geometry.OffsetZero {
+		t.Errorf("post scroll consumed %v of a fling, want none", got)
+	}
+
+	// Released short of the threshold, the indicator hides.
+	connection.OnPostScroll(geometry.OffsetZero, geometry.NewOffset(0, 100), drag)
+	connection.OnPreFling(geometry.OffsetZero)
+	if refreshed || !state.IsAnimating() {
+		t.Errorf("a short pull should hide the indicator, refreshed %v", refreshed)
+	}
+
+	// Released past it, it refreshes.
+	state.SnapTo(0)
+	connection.OnPostScroll(geometry.OffsetZero, geometry.NewOffset(0, 200), drag)
+	if got := connection.OnPreFling(geometry.NewOffset(0, 500)); got.Y() != 500 || !refreshed {
+		t.Errorf("pre fling consumed %v, refreshed %v; want the fling and a refresh", got, refreshed)
+	}
+	if connection.distancePulled != 0 {
+		t.Errorf("distance pulled = %v after the release, want 0", connection.distancePulled)
+	}
+}
+
+func TestPullToRefreshConnectionRefreshing(t *testing.T) {
+	connection := newTestConnection(true, nil)
+	if got := connection.state.DistanceFraction(); got != 1 {
+		t.Errorf("distance fraction = %v while refreshing, want the threshold", got)
+	}
+	if got := connection.OnPostScroll(geometry.OffsetZero, geometry.NewOffset(0, 100), drag); got != geometry.OffsetZero {
+		t.Errorf("post scroll consumed %v while refreshing, want none", got)
+	}
+
+	connection.update(connection.state, false, nil, true)
+	if !connection.state.IsAnimating() {
+		t.Fatal("the indicator should animate out of view once the refresh ends")
+	}
+	connection.state.advance(time.Now().Add(time.Second))
+	if got := connection.state.DistanceFraction(); got != 0 || connection.state.IsAnimating() {
+		t.Errorf("distance fraction = %v after the animation, want 0", got)
+	}
+}
+
+func TestVerticalOffset(t *testing.T) {
+	tests := []struct {
+		distancePulled float32
+		want           float32
+	}{
+		{0, 0},
+		{100, 50},
+		{160, 80},
+		{320, 80 + 80*0.75},
+		{1000, 160},
+	}
+	for _, tt := range tests {
+		connection := &pullToRefreshConnection{thresholdPx: 80, distancePulled: tt.distancePulled}
+		if got := connection.verticalOffset(); got != tt.want {
+			t.Errorf("verticalOffset(%v) = %v, want %v", tt.distancePulled, got, tt.want)
+		}
+	}
+}
+
+func TestArrowValues(t *testing.T) {
+	if got := newArrowValues(0.4); got.endAngle != got.startAngle || got.scale != 0 {
+		t.Errorf("arrow at 0.4 = %+v, want none", got)
+	}
+	at := newArrowValues(1)
+	if at.scale != 1 || at.endAngle-at.startAngle != 0.8*360 {
+		t.Errorf("arrow at the threshold = %+v, want a full head and arc", at)
+	}
+	if past := newArrowValues(1.5); past.startAngle <= at.startAngle || past.endAngle-past.startAngle != at.endAngle-at.startAngle {
+		t.Errorf("arrow past the threshold = %+v, want it turned further", past)
+	}
+}
+
+func TestLayoutIndicator(t *testing.T) {
+	gtx := layout.Context{
+		Ops:         new(op.Ops),
+		Constraints: layout.Constraints{Max: image.Pt(400, 800)},
+		Metric:      gioUnit.Metric{PxPerDp: 1, PxPerSp: 1},
+	}
+	drawn := 0
+	widget := layoutnode.NewLayoutWidget(func(gtx layoutnode.LayoutContext) layoutnode.LayoutDimensions {
+		drawn++
+		return layoutnode.LayoutDimensions{Size: image.Pt(40, 40)}
+	})
+	if dims := layoutIndicator(gtx, 0.5, false, 80, widget); dims.Size != image.Pt(40, 40) {
+		t.Errorf("size = %v, want the size of the indicator", dims.Size)
+	}
+	if drawn != 1 {
+		t.Errorf("indicator laid out %d times, want once", drawn)
+	}
+}
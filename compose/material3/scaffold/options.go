@@ -1,6 +1,7 @@
 package scaffold
 
 import (
+	"github.com/zodimo/go-compose/compose/foundation/layout/windowinsets"
 	"github.com/zodimo/go-compose/compose/material3"
 	"github.com/zodimo/go-compose/compose/ui"
 	"github.com/zodimo/go-compose/compose/ui/graphics"
@@ -16,6 +17,8 @@ type ScaffoldOptions struct {
 	FloatingActionButtonPosition FabPosition
 	ContainerColor               graphics.Color
 	ContentColor                 graphics.Color
+	// ContentWindowInsets pad the content on the sides without bars.
+	ContentWindowInsets windowinsets.WindowInsets
 }
 
 type ScaffoldOption func(*ScaffoldOptions)
@@ -32,6 +35,7 @@ func DefaultScaffoldOptions(c Composer) ScaffoldOptions {
 		FloatingActionButtonPosition: FabPositionEnd,
 		ContainerColor:               theme.ColorScheme().Surface,   //theme.ColorHelper.ColorSelector().SurfaceRoles.Surface,
 		ContentColor:                 theme.ColorScheme().OnSurface, //theme.ColorHelper.ColorSelector().SurfaceRoles.OnSurface,
+		ContentWindowInsets:          windowinsets.SystemBars,
 	}
 }
 
@@ -80,3 +84,8 @@ func WithContainerColor(col graphics.Color) ScaffoldOption {
 func WithContentColor(col graphics.Color) ScaffoldOption {
 	return func(o *ScaffoldOptions) { o.ContentColor = col }
 }
+
+// WithContentWindowInsets sets the insets that pad the content.
+func WithContentWindowInsets(insets windowinsets.WindowInsets) ScaffoldOption {
+	return func(o *ScaffoldOptions) { o.ContentWindowInsets = insets }
+}
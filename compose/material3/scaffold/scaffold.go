@@ -4,7 +4,10 @@ import (
 	"github.com/zodimo/go-compose/compose"
 	"github.com/zodimo/go-compose/compose/foundation/layout/box"
 	"github.com/zodimo/go-compose/compose/foundation/layout/column"
+	"github.com/zodimo/go-compose/compose/foundation/layout/windowinsets"
 	"github.com/zodimo/go-compose/compose/material3/surface"
+	"github.com/zodimo/go-compose/compose/ui/platform"
+	"github.com/zodimo/go-compose/compose/ui/unit"
 	padding_modifier "github.com/zodimo/go-compose/modifiers/padding"
 	"github.com/zodimo/go-compose/modifiers/size"
 	"github.com/zodimo/go-compose/modifiers/weight"
@@ -17,8 +20,28 @@ type FabPosition int
 const (
 	FabPositionCenter FabPosition = iota
 	FabPositionEnd
+	FabPositionStart
 )
 
+// LocalFabPosition is the position of the floating action button of the
+// enclosing Scaffold, for the button to align its own content with, as a
+// FloatingActionButtonMenu does its items.
+var LocalFabPosition = compose.CompositionLocalOf(func() FabPosition {
+	return FabPositionEnd
+})
+
+// alignment returns where in the scaffold the floating action button is,
+// the start and end following layoutDirection.
+func (p FabPosition) alignment(layoutDirection unit.LayoutDirection) layout.Direction {
+	if p == FabPositionCenter {
+		return layout.S
+	}
+	if (p == FabPositionStart) != (layoutDirection == unit.LayoutDirectionRtl) {
+		return layout.SW
+	}
+	return layout.SE
+}
+
 // Scaffold implements the basic material design visual layout structure.
 // This component provides API to put together several material components to construct your
 // screen, by ensuring proper layout strategy for them and collecting necessary data so these
@@ -33,6 +56,19 @@ func Scaffold(content Composable, options ...ScaffoldOption) Composable {
 			option(&opts)
 		}
 
+		// The content is padded by the insets of the sides without bars, the
+		// bars being padded by their own insets, and consumes them all.
+		contentSides := windowinsets.SidesHorizontal
+		if opts.TopBar == nil {
+			contentSides |= windowinsets.SidesTop
+		}
+		if opts.BottomBar == nil {
+			contentSides |= windowinsets.SidesBottom
+		}
+		contentModifier := windowinsets.WindowInsetsPadding(windowinsets.Only(opts.ContentWindowInsets, contentSides)).
+			Then(windowinsets.ConsumeWindowInsets(opts.ContentWindowInsets))
+		fabInsets := windowinsets.WindowInsetsPadding(windowinsets.Only(opts.ContentWindowInsets, windowinsets.SidesHorizontal|windowinsets.SidesBottom))
+
 		surfaceOpts := []surface.SurfaceOption{
 			surface.WithColor(opts.ContainerColor),
 			surface.WithContentColor(opts.ContentColor),
@@ -58,7 +94,7 @@ func Scaffold(content Composable, options ...ScaffoldOption) Composable {
 									content,
 									box.WithModifier(
 										// Expand to fill remaining vertical space
-										weight.Weight(1),
+										weight.Weight(1).Then(contentModifier),
 									),
 								),
 
@@ -71,25 +107,13 @@ func Scaffold(content Composable, options ...ScaffoldOption) Composable {
 						// Layer 2: Floating Action Button
 						c.When(
 							opts.FloatingActionButton != nil,
-							c.If(
-								opts.FloatingActionButtonPosition == FabPositionCenter,
-								box.Box(
-									opts.FloatingActionButton,
-									box.WithAlignment(layout.S), // Bottom Center
-									// Add standard padding for FAB
-									box.WithModifier(padding_modifier.All(16).
-										// Wrapper must fill max to align FAB relative to screen
-										Then(size.FillMax()),
-									),
-								),
-								box.Box(
-									opts.FloatingActionButton,
-									box.WithAlignment(layout.SE), // Bottom End
-									// Add standard padding for FAB
-									box.WithModifier(padding_modifier.All(16).
-										// Wrapper must fill max to align FAB relative to screen
-										Then(size.FillMax()),
-									),
+							box.Box(
+								compose.CompositionLocalProvider1(LocalFabPosition, opts.FloatingActionButtonPosition, opts.FloatingActionButton),
+								box.WithAlignment(opts.FloatingActionButtonPosition.alignment(platform.LocalLayoutDirection.Current(c))),
+								// Add standard padding for FAB
+								box.WithModifier(fabInsets.Then(padding_modifier.All(16)).
+									// Wrapper must fill max to align FAB relative to screen
+									Then(size.FillMax()),
 								),
 							),
 						),
@@ -0,0 +1,28 @@
+package scaffold
+
+import (
+	"testing"
+
+	"github.com/zodimo/go-compose/compose/ui/unit"
+
+	"gioui.org/layout"
+)
+
+func TestFabPosition_Alignment(t *testing.T) {
+	tests := []struct {
+		position        FabPosition
+		layoutDirection unit.LayoutDirection
+		want            layout.Direction
+	}{
+		{FabPositionStart, unit.LayoutDirectionLtr, layout.SW},
+		{FabPositionEnd, unit.LayoutDirectionLtr, layout.SE},
+		{FabPositionStart, unit.LayoutDirectionRtl, layout.SE},
+		{FabPositionEnd, unit.LayoutDirectionRtl, layout.SW},
+		{FabPositionCenter, unit.LayoutDirectionRtl, layout.S},
+	}
+	for _, tt := range tests {
+		if got := tt.position.alignment(tt.layoutDirection); got != tt.want {
+			t.Errorf("%v alignment in %v = %v, want %v", tt.position, tt.layoutDirection, got, tt.want)
+		}
+	}
+}
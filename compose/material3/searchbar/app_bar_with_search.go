@@ -0,0 +1,91 @@
+package searchbar
+
+import (
+	"github.com/zodimo/go-compose/compose"
+	"github.com/zodimo/go-compose/compose/foundation/layout/box"
+	"github.com/zodimo/go-compose/compose/foundation/layout/row"
+	"github.com/zodimo/go-compose/compose/foundation/layout/windowinsets"
+	"github.com/zodimo/go-compose/compose/material3"
+	"github.com/zodimo/go-compose/compose/material3/appbar"
+	"github.com/zodimo/go-compose/compose/material3/surface"
+	"github.com/zodimo/go-compose/compose/ui/graphics"
+	"github.com/zodimo/go-compose/modifiers/padding"
+	"github.com/zodimo/go-compose/modifiers/size"
+	"github.com/zodimo/go-compose/modifiers/weight"
+	"github.com/zodimo/go-compose/pkg/api"
+
+	"gioui.org/layout"
+)
+
+// AppBarWithSearch is a top app bar showing searchBar, a SearchBar or a
+// DockedSearchBar, between its navigation icon and its actions. It takes
+// the options of appbar.TopAppBar, and fits in the top bar of a Scaffold.
+//
+// expanded is whether searchBar is expanded: the app bar then hides its
+// navigation icon and actions and grows with searchBar, so that a
+// SearchBar fills the screen below the status bars and a DockedSearchBar
+// shows its content below its input field.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/SearchBar.kt
+func AppBarWithSearch(
+	searchBar Composable,
+	expanded bool,
+	options ...appbar.TopAppBarOption,
+) Composable {
+	return func(c Composer) Composer {
+		opts := appbar.DefaultTopAppBarOptions(c)
+		for _, option := range options {
+			if option == nil {
+				continue
+			}
+			option(&opts)
+		}
+
+		// The tree is the same collapsed and expanded, for the search bar to
+		// keep its state.
+		rowModifier := windowinsets.WindowInsetsPadding(opts.WindowInsets).Then(size.FillMaxWidth())
+		searchBarModifier := weight.Weight(1)
+		if !expanded {
+			rowModifier = rowModifier.Then(size.Height(64))
+			searchBarModifier = searchBarModifier.Then(padding.Horizontal(8, 8))
+		}
+
+		return surface.Surface(
+			row.Row(
+				compose.Sequence(
+					c.When(
+						opts.NavigationIcon != nil && !expanded,
+						box.Box(
+							surface.Surface(
+								opts.NavigationIcon,
+								surface.WithContentColor(opts.Colors.NavigationIconContentColor),
+								surface.WithColor(graphics.ColorTransparent),
+							),
+							box.WithModifier(padding.Padding(4, 0, 0, 0)),
+						),
+					),
+					box.Box(
+						searchBar,
+						box.WithAlignment(layout.Center),
+						box.WithModifier(searchBarModifier),
+					),
+					c.When(
+						len(opts.Actions) > 0 && !expanded,
+						compose.CompositionLocalProvider(
+							[]api.ProvidedValue{material3.LocalContentColor.Provides(opts.Colors.ActionIconContentColor)},
+							row.Row(
+								compose.Sequence(opts.Actions...),
+								row.WithAlignment(row.Middle),
+								row.WithModifier(padding.Padding(0, 0, 4, 0)),
+							),
+						),
+					),
+				),
+				row.WithModifier(rowModifier),
+				row.WithAlignment(row.Middle),
+			),
+			surface.WithModifier(opts.Modifier),
+			surface.WithColor(opts.Colors.ContainerColor),
+		)(c)
+	}
+}
@@ -0,0 +1,77 @@
+package searchbar
+
+import (
+	"github.com/zodimo/go-compose/compose/foundation/layout/windowinsets"
+	"github.com/zodimo/go-compose/compose/material3"
+	"github.com/zodimo/go-compose/compose/ui/graphics"
+	"github.com/zodimo/go-compose/compose/ui/graphics/shape"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+)
+
+// Dimensions of the search bars (SearchBar.kt).
+
+// InputFieldHeight is the height of the input field of a search bar.
+const InputFieldHeight unit.Dp = 56
+
+// SearchBarMinWidth is the minimum width of a collapsed search bar.
+const SearchBarMinWidth unit.Dp = 360
+
+// SearchBarMaxWidth is the maximum width of a collapsed search bar.
+const SearchBarMaxWidth unit.Dp = 720
+
+// DockedExpandedTableMinHeight is the minimum height of the content of an
+// expanded DockedSearchBar.
+const DockedExpandedTableMinHeight unit.Dp = 240
+
+// dockedExpandedTableMaxHeightFraction is the fraction of the available
+// height the content of an expanded DockedSearchBar takes at most.
+const dockedExpandedTableMaxHeightFraction = 2.0 / 3
+
+// SearchBarDefaults contains the default values used by SearchBar and
+// DockedSearchBar.
+var SearchBarDefaults = searchBarDefaults{}
+
+type searchBarDefaults struct{}
+
+// SearchBarColors are the colors of a search bar.
+type SearchBarColors struct {
+	ContainerColor graphics.Color
+	DividerColor   graphics.Color
+}
+
+// Colors returns the default colors of a search bar.
+func (searchBarDefaults) Colors(c Composer) SearchBarColors {
+	colorScheme := material3.Theme(c).ColorScheme()
+	return SearchBarColors{
+		ContainerColor: colorScheme.SurfaceContainerHigh,
+		DividerColor:   colorScheme.OutlineVariant,
+	}
+}
+
+func resolveSearchBarColors(c Composer, colors SearchBarColors) SearchBarColors {
+	defaults := SearchBarDefaults.Colors(c)
+	return SearchBarColors{
+		ContainerColor: colors.ContainerColor.TakeOrElse(defaults.ContainerColor),
+		DividerColor:   colors.DividerColor.TakeOrElse(defaults.DividerColor),
+	}
+}
+
+// InputFieldShape is the shape of a collapsed search bar.
+func (searchBarDefaults) InputFieldShape() shape.Shape {
+	return shape.CircleShape
+}
+
+// FullScreenShape is the shape of an expanded SearchBar.
+func (searchBarDefaults) FullScreenShape() shape.Shape {
+	return shape.ShapeRectangle
+}
+
+// DockedShape is the shape of an expanded DockedSearchBar.
+func (searchBarDefaults) DockedShape() shape.Shape {
+	return &shape.RoundedCornerShape{Radius: 28}
+}
+
+// WindowInsets are the insets a SearchBar is padded by: the status bars.
+func (searchBarDefaults) WindowInsets() windowinsets.WindowInsets {
+	return windowinsets.StatusBars
+}
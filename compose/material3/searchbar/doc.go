@@ -0,0 +1,22 @@
+/*
+Package searchbar contains the Material 3 search bars: SearchBar, which
+expands to fill its parent, usually the window, and DockedSearchBar, which
+expands in place. Both show an InputField, collapsed, and their content,
+usually suggestions or results, below it once expanded:
+
+	searchbar.SearchBar(
+		searchbar.InputField(query, setQuery, onSearch, expanded, setExpanded,
+			searchbar.WithPlaceholder("Search"),
+			searchbar.WithLeadingIcon(searchIcon),
+		),
+		expanded,
+		setExpanded,
+		results,
+	)
+
+AppBarWithSearch places a search bar in a top app bar, between its
+navigation icon and its actions.
+
+Reference: [Search](https://m3.material.io/components/search/overview)
+*/
+package searchbar
@@ -0,0 +1,216 @@
+package searchbar
+
+import (
+	"fmt"
+
+	"github.com/zodimo/go-compose/compose"
+	"github.com/zodimo/go-compose/compose/material"
+	"github.com/zodimo/go-compose/compose/material3"
+	"github.com/zodimo/go-compose/compose/ui"
+	"github.com/zodimo/go-compose/compose/ui/graphics"
+	"github.com/zodimo/go-compose/internal/layoutnode"
+	"github.com/zodimo/go-compose/modifiers/focus"
+	"github.com/zodimo/go-compose/modifiers/size"
+	"github.com/zodimo/go-compose/pkg/api"
+
+	"gioui.org/layout"
+	gioUnit "gioui.org/unit"
+	"gioui.org/widget"
+	gioMaterial "gioui.org/widget/material"
+)
+
+const InputFieldNodeID = "Material3SearchBarInputField"
+
+// InputField is the text field of a search bar, showing query.
+//
+// onQueryChange is called with the text as the user types.
+// onSearch is called with the text when the user presses Enter.
+// expanded is whether the search bar is expanded: the field expands it with
+// onExpandedChange when it takes the focus, and gives the focus up when it
+// collapses.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/SearchBar.kt
+func InputField(
+	query string,
+	onQueryChange func(string),
+	onSearch func(string),
+	expanded bool,
+	onExpandedChange func(bool),
+	options ...InputFieldOption,
+) Composable {
+	opts := DefaultInputFieldOptions()
+	for _, option := range options {
+		if option == nil {
+			continue
+		}
+		option(&opts)
+	}
+
+	return func(c Composer) Composer {
+		field := c.State(fmt.Sprintf("searchBarInputField-%v", c.GenerateID()), func() any {
+			return &inputField{
+				editor:   widget.Editor{SingleLine: true, Submit: true},
+				expanded: expanded,
+			}
+		}).Get().(*inputField)
+		field.onQueryChange = onQueryChange
+		field.onSearch = onSearch
+		if field.expanded && !expanded {
+			// The field keeps the focus of a collapsed bar from expanding it
+			// again on the next click.
+			focus.Manager(c).ClearFocus()
+		}
+		field.expanded = expanded
+
+		colorScheme := material3.Theme(c).ColorScheme()
+		iconColor := colorScheme.OnSurfaceVariant
+		if !opts.Enabled {
+			iconColor = graphics.SetOpacity(colorScheme.OnSurface, 0.38)
+		}
+		args := inputFieldArgs{
+			query:            query,
+			placeholder:      opts.Placeholder,
+			enabled:          opts.Enabled,
+			textColor:        colorScheme.OnSurface,
+			placeholderColor: colorScheme.OnSurfaceVariant,
+			selectionColor:   graphics.SetOpacity(colorScheme.Primary, 0.4),
+			leading:          opts.LeadingIcon != nil,
+			trailing:         opts.TrailingIcon != nil,
+		}
+		if !opts.Enabled {
+			args.textColor = graphics.SetOpacity(colorScheme.OnSurface, 0.38)
+			args.placeholderColor = args.textColor
+		}
+		theme := material.Theme(c)
+
+		c.StartBlock(InputFieldNodeID)
+		c.Modifier(func(m ui.Modifier) ui.Modifier {
+			return m.Then(size.FillMaxWidth()).
+				Then(size.Height(int(InputFieldHeight))).
+				Then(focus.OnFocusChanged(func(state focus.FocusState) {
+					if state.IsFocused && !field.expanded {
+						onExpandedChange(true)
+					}
+				})).
+				Then(opts.Modifier)
+		})
+		for _, icon := range []Composable{opts.LeadingIcon, opts.TrailingIcon} {
+			if icon != nil {
+				c.WithComposable(compose.CompositionLocalProvider(
+					[]api.ProvidedValue{material3.LocalContentColor.Provides(iconColor)},
+					icon,
+				))
+			}
+		}
+		c.SetWidgetConstructor(layoutnode.NewLayoutNodeWidgetConstructor(func(node layoutnode.LayoutNode) layoutnode.GioLayoutWidget {
+			return func(gtx layoutnode.LayoutContext) layoutnode.LayoutDimensions {
+				return field.layout(gtx, theme.GioMaterialTheme(), node.Children(), args)
+			}
+		}))
+		return c.EndBlock()
+	}
+}
+
+// inputField is the remembered state of an InputField. Its callbacks are
+// updated by every composition.
+type inputField struct {
+	editor        widget.Editor
+	onQueryChange func(string)
+	onSearch      func(string)
+	// expanded is the expanded state of the last composition.
+	expanded bool
+	// query is the query the editor was last synced with.
+	query string
+}
+
+type inputFieldArgs struct {
+	query            string
+	placeholder      string
+	enabled          bool
+	textColor        graphics.Color
+	placeholderColor graphics.Color
+	selectionColor   graphics.Color
+	leading          bool
+	trailing         bool
+}
+
+// sync sets the text of the editor to query when the query changed since
+// the last sync, keeping the caret within the text.
+func (f *inputField) sync(query string) {
+	if query == f.query {
+		return
+	}
+	f.query = query
+	if f.editor.Text() == query {
+		return
+	}
+	start, end := f.editor.Selection()
+	f.editor.SetText(query)
+	n := f.editor.Len()
+	f.editor.SetCaret(min(start, n), min(end, n))
+}
+
+func (f *inputField) layout(gtx layout.Context, th *gioMaterial.Theme, children []layoutnode.TreeNode, args inputFieldArgs) layout.Dimensions {
+	if !args.enabled {
+		gtx = gtx.Disabled()
+	}
+	f.sync(args.query)
+	for {
+		ev, ok := f.editor.Update(gtx)
+		if !ok {
+			break
+		}
+		switch ev.(type) {
+		case widget.ChangeEvent:
+			f.query = f.editor.Text()
+			if f.onQueryChange != nil {
+				f.onQueryChange(f.query)
+			}
+		case widget.SubmitEvent:
+			if f.onSearch != nil {
+				f.onSearch(f.editor.Text())
+			}
+		}
+	}
+
+	var leading, trailing layout.Widget
+	next := 0
+	slot := func(present bool) layout.Widget {
+		if !present || next >= len(children) {
+			return nil
+		}
+		child := children[next].(layoutnode.NodeCoordinator)
+		next++
+		return child.Layout
+	}
+	leading = slot(args.leading)
+	trailing = slot(args.trailing)
+
+	gtx.Constraints.Min.Y = gtx.Constraints.Max.Y
+	return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			if leading == nil {
+				return layout.Dimensions{}
+			}
+			return layout.Inset{Left: gioUnit.Dp(16)}.Layout(gtx, leading)
+		}),
+		layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+			return layout.UniformInset(gioUnit.Dp(16)).Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+				gtx.Constraints.Min.Y = 0
+				return focus.Target(gtx, &f.editor, func(gtx layout.Context) layout.Dimensions {
+					ed := gioMaterial.Editor(th, &f.editor, args.placeholder)
+					ed.Color = graphics.ColorToNRGBA(args.textColor)
+					ed.HintColor = graphics.ColorToNRGBA(args.placeholderColor)
+					ed.SelectionColor = graphics.ColorToNRGBA(args.selectionColor)
+					return ed.Layout(gtx)
+				})
+			})
+		}),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			if trailing == nil {
+				return layout.Dimensions{}
+			}
+			return layout.Inset{Right: gioUnit.Dp(16)}.Layout(gtx, trailing)
+		}),
+	)
+}
@@ -0,0 +1,121 @@
+package searchbar
+
+import (
+	"github.com/zodimo/go-compose/compose/foundation/layout/windowinsets"
+	"github.com/zodimo/go-compose/compose/ui"
+	"github.com/zodimo/go-compose/compose/ui/graphics"
+	"github.com/zodimo/go-compose/compose/ui/graphics/shape"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+)
+
+type SearchBarOptions struct {
+	Modifier ui.Modifier
+	// Shape is the shape of the search bar, collapsed or expanded. The shapes
+	// of SearchBarDefaults are used when nil.
+	Shape           shape.Shape
+	Colors          SearchBarColors
+	TonalElevation  unit.Dp
+	ShadowElevation unit.Dp
+	// WindowInsets pad a SearchBar, whose container extends behind them once
+	// expanded. DockedSearchBar ignores them.
+	WindowInsets windowinsets.WindowInsets
+}
+
+type SearchBarOption func(*SearchBarOptions)
+
+func DefaultSearchBarOptions() SearchBarOptions {
+	return SearchBarOptions{
+		Modifier: ui.EmptyModifier,
+		Colors: SearchBarColors{
+			ContainerColor: graphics.ColorUnspecified,
+			DividerColor:   graphics.ColorUnspecified,
+		},
+		WindowInsets: SearchBarDefaults.WindowInsets(),
+	}
+}
+
+func WithModifier(m ui.Modifier) SearchBarOption {
+	return func(o *SearchBarOptions) {
+		o.Modifier = m
+	}
+}
+
+func WithShape(s shape.Shape) SearchBarOption {
+	return func(o *SearchBarOptions) {
+		o.Shape = s
+	}
+}
+
+func WithColors(colors SearchBarColors) SearchBarOption {
+	return func(o *SearchBarOptions) {
+		o.Colors = colors
+	}
+}
+
+func WithTonalElevation(elevation unit.Dp) SearchBarOption {
+	return func(o *SearchBarOptions) {
+		o.TonalElevation = elevation
+	}
+}
+
+func WithShadowElevation(elevation unit.Dp) SearchBarOption {
+	return func(o *SearchBarOptions) {
+		o.ShadowElevation = elevation
+	}
+}
+
+func WithWindowInsets(insets windowinsets.WindowInsets) SearchBarOption {
+	return func(o *SearchBarOptions) {
+		o.WindowInsets = insets
+	}
+}
+
+// InputFieldOptions
+
+type InputFieldOptions struct {
+	Modifier     ui.Modifier
+	Enabled      bool
+	Placeholder  string
+	LeadingIcon  Composable
+	TrailingIcon Composable
+}
+
+type InputFieldOption func(*InputFieldOptions)
+
+func DefaultInputFieldOptions() InputFieldOptions {
+	return InputFieldOptions{
+		Modifier: ui.EmptyModifier,
+		Enabled:  true,
+	}
+}
+
+func WithInputFieldModifier(m ui.Modifier) InputFieldOption {
+	return func(o *InputFieldOptions) {
+		o.Modifier = m
+	}
+}
+
+func WithEnabled(enabled bool) InputFieldOption {
+	return func(o *InputFieldOptions) {
+		o.Enabled = enabled
+	}
+}
+
+// WithPlaceholder shows placeholder in the empty input field.
+func WithPlaceholder(placeholder string) InputFieldOption {
+	return func(o *InputFieldOptions) {
+		o.Placeholder = placeholder
+	}
+}
+
+func WithLeadingIcon(icon Composable) InputFieldOption {
+	return func(o *InputFieldOptions) {
+		o.LeadingIcon = icon
+	}
+}
+
+func WithTrailingIcon(icon Composable) InputFieldOption {
+	return func(o *InputFieldOptions) {
+		o.TrailingIcon = icon
+	}
+}
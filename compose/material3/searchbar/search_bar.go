@@ -0,0 +1,181 @@
+package searchbar
+
+import (
+	"github.com/zodimo/go-compose/compose"
+	"github.com/zodimo/go-compose/compose/foundation/layout/box"
+	"github.com/zodimo/go-compose/compose/foundation/layout/column"
+	"github.com/zodimo/go-compose/compose/foundation/layout/windowinsets"
+	"github.com/zodimo/go-compose/compose/material3/divider"
+	"github.com/zodimo/go-compose/compose/material3/surface"
+	"github.com/zodimo/go-compose/compose/ui"
+	uilayout "github.com/zodimo/go-compose/compose/ui/layout"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+	"github.com/zodimo/go-compose/modifiers/key"
+	"github.com/zodimo/go-compose/modifiers/size"
+	"github.com/zodimo/go-compose/modifiers/weight"
+)
+
+// SearchBar is a Material 3 search bar that expands to fill its parent,
+// usually a Box filling the window or the top bar of a Scaffold, showing
+// content below inputField, usually suggestions or results.
+//
+// inputField is usually an InputField.
+// expanded is whether the search bar is expanded.
+// onExpandedChange is called with false when Escape or Back is pressed in
+// the expanded search bar.
+//
+// Collapsed, the search bar is a pill between SearchBarMinWidth and
+// SearchBarMaxWidth wide, padded by the status bars.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/SearchBar.kt
+func SearchBar(
+	inputField Composable,
+	expanded bool,
+	onExpandedChange func(bool),
+	content Composable,
+	options ...SearchBarOption,
+) Composable {
+	return searchBar(inputField, expanded, onExpandedChange, content, false, options)
+}
+
+// DockedSearchBar is a Material 3 search bar that expands in place, showing
+// content below inputField, usually suggestions or results. Expanded, the
+// content is at least DockedExpandedTableMinHeight tall, and at most two
+// thirds of the height available below the input field.
+//
+// It takes the arguments and options of SearchBar.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/SearchBar.kt
+func DockedSearchBar(
+	inputField Composable,
+	expanded bool,
+	onExpandedChange func(bool),
+	content Composable,
+	options ...SearchBarOption,
+) Composable {
+	return searchBar(inputField, expanded, onExpandedChange, content, true, options)
+}
+
+// searchBar lays out both search bars, with the same tree collapsed and
+// expanded so that the input field keeps its state and focus.
+func searchBar(
+	inputField Composable,
+	expanded bool,
+	onExpandedChange func(bool),
+	content Composable,
+	docked bool,
+	options []SearchBarOption,
+) Composable {
+	return func(c Composer) Composer {
+		opts := DefaultSearchBarOptions()
+		for _, option := range options {
+			if option == nil {
+				continue
+			}
+			option(&opts)
+		}
+		colors := resolveSearchBarColors(c, opts.Colors)
+
+		barShape := opts.Shape
+		barModifier := opts.Modifier
+		inputModifier := ui.Modifier(ui.EmptyModifier)
+		columnModifier := ui.Modifier(ui.EmptyModifier)
+		fullScreen := expanded && !docked
+		switch {
+		case fullScreen:
+			if barShape == nil {
+				barShape = SearchBarDefaults.FullScreenShape()
+			}
+			// The container extends behind the insets, the input field is
+			// padded by them.
+			barModifier = barModifier.Then(size.FillMax())
+			columnModifier = size.FillMax()
+			inputModifier = windowinsets.WindowInsetsPadding(opts.WindowInsets)
+		case expanded:
+			if barShape == nil {
+				barShape = SearchBarDefaults.DockedShape()
+			}
+			barModifier = barModifier.Then(size.WidthIn(int(SearchBarMinWidth), int(SearchBarMaxWidth)))
+		default:
+			if barShape == nil {
+				barShape = SearchBarDefaults.InputFieldShape()
+			}
+			if !docked {
+				barModifier = barModifier.Then(windowinsets.WindowInsetsPadding(opts.WindowInsets))
+			}
+			barModifier = barModifier.Then(size.WidthIn(int(SearchBarMinWidth), int(SearchBarMaxWidth)))
+		}
+		if expanded {
+			barModifier = barModifier.Then(key.OnKeyEvent(func(e key.KeyEvent) bool {
+				if e.Type != key.KeyDown || (e.Key != key.KeyEscape && e.Key != key.KeyBack) {
+					return false
+				}
+				onExpandedChange(false)
+				return true
+			}))
+		}
+
+		var results Composable
+		if fullScreen {
+			results = box.Box(content, box.WithModifier(size.FillMaxWidth().Then(weight.Weight(1))))
+		} else {
+			results = dockedContent(content)
+		}
+
+		return surface.Surface(
+			column.Column(
+				compose.Sequence(
+					box.Box(inputField, box.WithModifier(inputModifier)),
+					c.When(expanded, divider.Divider(divider.WithColor(colors.DividerColor))),
+					c.When(expanded, results),
+				),
+				column.WithModifier(columnModifier),
+			),
+			surface.WithShape(barShape),
+			surface.WithColor(colors.ContainerColor),
+			surface.WithTonalElevation(opts.TonalElevation),
+			surface.WithShadowElevation(opts.ShadowElevation),
+			surface.WithModifier(barModifier),
+		)(c)
+	}
+}
+
+// dockedContent lays out the content of an expanded DockedSearchBar, its
+// height limited by dockedContentHeight.
+func dockedContent(content Composable) Composable {
+	return uilayout.Layout(
+		content,
+		uilayout.MeasurePolicyFunc(func(scope uilayout.MeasureScope, measurables []uilayout.Measurable, constraints unit.Constraints) uilayout.MeasureResult {
+			minHeight, maxHeight := dockedContentHeight(constraints, scope.DpRoundToPx(DockedExpandedTableMinHeight))
+			minWidth, maxWidth := constraints.MaxWidth(), constraints.MaxWidth()
+			if !constraints.HasBoundedWidth() {
+				minWidth = constraints.MinWidth()
+			}
+			width, height := minWidth, minHeight
+			placeables := make([]*uilayout.Placeable, len(measurables))
+			for i, m := range measurables {
+				placeables[i] = m.Measure(unit.NewConstraints(minWidth, maxWidth, minHeight, maxHeight))
+				width = max(width, placeables[i].Width())
+				height = max(height, placeables[i].Height())
+			}
+			return scope.Layout(width, height, func() {
+				for _, p := range placeables {
+					p.PlaceAt(0, 0)
+				}
+			})
+		}),
+		uilayout.WithModifier(size.FillMaxWidth()),
+	)
+}
+
+// dockedContentHeight returns the height range of the content of an
+// expanded DockedSearchBar: at least minHeight, and at most two thirds of
+// the available height, unless it is unbounded.
+func dockedContentHeight(constraints unit.Constraints, minHeight int) (int, int) {
+	if !constraints.HasBoundedHeight() {
+		return minHeight, unit.Infinity
+	}
+	available := constraints.MaxHeight()
+	maxHeight := max(minHeight, int(float32(available)*dockedExpandedTableMaxHeightFraction))
+	return min(minHeight, available), min(maxHeight, available)
+}
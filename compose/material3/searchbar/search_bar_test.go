@@ -0,0 +1,78 @@
+package searchbar
+
+import (
+	"image"
+	"testing"
+
+	"github.com/zodimo/go-compose/compose"
+	"github.com/zodimo/go-compose/compose/foundation/layout/box"
+	uilayout "github.com/zodimo/go-compose/compose/ui/layout"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+	"github.com/zodimo/go-compose/modifiers/size"
+	"github.com/zodimo/go-compose/runtime"
+	"github.com/zodimo/go-compose/state"
+	"github.com/zodimo/go-compose/store"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+	gioUnit "gioui.org/unit"
+)
+
+func TestDockedContentHeight(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		maxH     int
+		min, max int
+	}{
+		{"tall", 900, 240, 600},
+		{"short", 300, 240, 240},
+		{"shorter than the minimum", 100, 100, 100},
+		{"unbounded", unit.Infinity, 240, unit.Infinity},
+	} {
+		minH, maxH := dockedContentHeight(unit.NewConstraints(0, 400, 0, tt.maxH), 240)
+		if minH != tt.min || maxH != tt.max {
+			t.Errorf("%s: dockedContentHeight = %d, %d, want %d, %d", tt.name, minH, maxH, tt.min, tt.max)
+		}
+	}
+}
+
+func TestSearchBar_Size(t *testing.T) {
+	// A stand-in for the input field, as the text of InputField needs a
+	// theme.
+	field := box.Box(compose.Id(), box.WithModifier(size.FillMaxWidth().Then(size.Height(int(InputFieldHeight)))))
+	for _, tt := range []struct {
+		name      string
+		searchBar func(expanded bool, options ...SearchBarOption) Composable
+		expanded  bool
+		want      image.Point
+	}{
+		{"collapsed", searchBarOf(SearchBar, field), false, image.Pt(400, 56)},
+		{"expanded", searchBarOf(SearchBar, field), true, image.Pt(400, 900)},
+		{"docked collapsed", searchBarOf(DockedSearchBar, field), false, image.Pt(400, 56)},
+		// The input field, the divider and the minimum height of the
+		// content.
+		{"docked expanded", searchBarOf(DockedSearchBar, field), true, image.Pt(400, 56+1+240)},
+	} {
+		var got image.Point
+		c := compose.NewComposer(store.NewPersistentState(map[string]state.MutableValue{}))
+		node := tt.searchBar(tt.expanded, WithModifier(uilayout.OnGloballyPositioned(func(coordinates uilayout.LayoutCoordinates) {
+			b := coordinates.BoundsInWindow()
+			got = image.Pt(int(b.Right-b.Left), int(b.Bottom-b.Top))
+		})))(c).Build()
+		gtx := layout.Context{
+			Ops:         new(op.Ops),
+			Constraints: layout.Constraints{Max: image.Pt(400, 900)},
+			Metric:      gioUnit.Metric{PxPerDp: 1, PxPerSp: 1},
+		}
+		runtime.NewRuntime().Run(gtx, node).Add(gtx.Ops)
+		if got != tt.want {
+			t.Errorf("%s search bar size = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func searchBarOf(bar func(Composable, bool, func(bool), Composable, ...SearchBarOption) Composable, field Composable) func(bool, ...SearchBarOption) Composable {
+	return func(expanded bool, options ...SearchBarOption) Composable {
+		return bar(field, expanded, func(bool) {}, compose.Id(), options...)
+	}
+}
@@ -186,6 +186,7 @@ func SegmentedButton(
 			surface.WithContentColor(contentColor),
 			surface.WithBorder(opts.BorderWidth, opts.BorderColor),
 			surface.WithModifier(finalModifier),
+			surface.WithFocusIndicator(true),
 		}
 
 		// Wrap content with padding
@@ -1,5 +1,6 @@
 /*
-Package slider contains Material 3 Slider components.
+Package slider contains Material 3 sliders: Slider, to pick a value, and
+RangeSlider, to pick a range of values, along a horizontal or vertical track.
 
 Reference: [Sliders](https://m3.material.io/components/sliders/overview)
 Specs: [Sliders Specs](https://m3.material.io/components/sliders/specs)
@@ -0,0 +1,32 @@
+package slider
+
+// ClosedRange is the range of values a RangeSlider picks, from Start to End
+// included.
+type ClosedRange struct {
+	Start, End float32
+}
+
+// RangeSlider is a Material 3 slider picking a range of values, between
+// two thumbs that do not cross. It takes the options of Slider.
+//
+// value is the current range of the slider.
+// onValueChange is called when either end of the range changes.
+//
+// Each thumb is dragged, or moved with the keyboard once focused, as the
+// thumb of a Slider. A press on the track moves the nearest thumb.
+//
+// The thumbs are handles of ThumbWidth by ThumbHeight across a track of
+// HandleTrackHeight, with a gap of ThumbTrackGap on either side of them.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/Slider.kt
+func RangeSlider(value ClosedRange, onValueChange func(ClosedRange), options ...SliderOption) Composable {
+
+	opts := DefaultSliderOptions()
+	for _, opt := range options {
+		opt(&opts)
+	}
+
+	return slider([]float32{value.Start, value.End}, func(values []float32) {
+		onValueChange(ClosedRange{Start: values[0], End: values[1]})
+	}, opts, handleDimensions())
+}
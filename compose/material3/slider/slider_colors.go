@@ -18,6 +18,10 @@ type SliderColors struct {
 	DisabledActiveTick    graphics.Color
 	DisabledInactiveTrack graphics.Color
 	DisabledInactiveTick  graphics.Color
+	// ValueLabelContainerColor and ValueLabelContentColor are the colors of
+	// the label WithValueLabel shows above the thumb.
+	ValueLabelContainerColor graphics.Color
+	ValueLabelContentColor   graphics.Color
 }
 
 // ThumbColor returns the color of the thumb based on the enabled state.
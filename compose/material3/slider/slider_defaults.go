@@ -1,6 +1,8 @@
 package slider
 
 import (
+	"strconv"
+
 	gioUnit "gioui.org/unit"
 	"github.com/zodimo/go-compose/compose/ui/graphics"
 )
@@ -23,9 +25,17 @@ func (d sliderDefaults) Colors() SliderColors {
 		DisabledActiveTick:    graphics.ColorUnspecified,
 		DisabledInactiveTrack: graphics.ColorUnspecified,
 		DisabledInactiveTick:  graphics.ColorUnspecified,
+
+		ValueLabelContainerColor: graphics.ColorUnspecified,
+		ValueLabelContentColor:   graphics.ColorUnspecified,
 	}
 }
 
+// ValueLabel formats value as a whole number, for WithValueLabel.
+func (d sliderDefaults) ValueLabel(value float32) string {
+	return strconv.FormatFloat(float64(value), 'f', 0, 32)
+}
+
 // Dimensions constants
 // @TODO this should be compose.ui.unit
 var (
@@ -34,4 +44,20 @@ var (
 	ActiveThumbSize = gioUnit.Dp(28) // M3 State Layer/Enlarged handle
 	TickSize        = gioUnit.Dp(2)
 	ThumbTrackGap   = gioUnit.Dp(6) // Approximate
+	// HandleTrackHeight is the height of the track of a RangeSlider, whose
+	// thumbs are handles of ThumbWidth by ThumbHeight, narrowing to
+	// PressedThumbWidth while dragged.
+	HandleTrackHeight = gioUnit.Dp(16)
+	ThumbWidth        = gioUnit.Dp(4)
+	PressedThumbWidth = gioUnit.Dp(2)
+	ThumbHeight       = gioUnit.Dp(44)
+	// TrackInsideCornerSize is the radius of the corners of the track of a
+	// RangeSlider next to a thumb.
+	TrackInsideCornerSize = gioUnit.Dp(2)
+	// StopIndicatorSize is the size of the tick marks of a RangeSlider, and
+	// of the dots at the ends of its track when continuous.
+	StopIndicatorSize = gioUnit.Dp(4)
+	// MinTouchSize is the height of a horizontal slider, and the width of a
+	// vertical one.
+	MinTouchSize = gioUnit.Dp(48)
 )
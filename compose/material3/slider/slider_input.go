@@ -0,0 +1,203 @@
+package slider
+
+import (
+	"math"
+	"slices"
+
+	"github.com/zodimo/go-compose/compose/foundation/gestures"
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+	"github.com/zodimo/go-compose/modifiers/key"
+	"github.com/zodimo/go-compose/state"
+)
+
+// fraction returns where value is in the range of the slider, from 0 at its
+// start to 1 at its end.
+func (o SliderOptions) fraction(value float32) float32 {
+	d := o.ValueRange.Max - o.ValueRange.Min
+	if d <= 0 {
+		return 0
+	}
+	return min(max((value-o.ValueRange.Min)/d, 0), 1)
+}
+
+// valueAt returns the value at fraction of the range, on the nearest step
+// when the slider has steps.
+func (o SliderOptions) valueAt(fraction float32) float32 {
+	fraction = min(max(fraction, 0), 1)
+	d := o.ValueRange.Max - o.ValueRange.Min
+	if o.Steps > 0 {
+		n := float32(o.Steps + 1)
+		return o.ValueRange.Min + float32(math.Round(float64(fraction*n)))*d/n
+	}
+	return o.ValueRange.Min + fraction*d
+}
+
+// keySteps returns how far the arrow keys and the PageUp and PageDown keys
+// move a thumb: a step and 10% of the range in whole steps, or 1% and 10%
+// of the range without steps.
+func (o SliderOptions) keySteps() (arrow, page float32) {
+	d := o.ValueRange.Max - o.ValueRange.Min
+	if o.Steps > 0 {
+		step := d / float32(o.Steps+1)
+		return step, step * float32(max(1, (o.Steps+1)/10))
+	}
+	return d / 100, d / 10
+}
+
+// sliderArgs are the values and the options of a Slider or a RangeSlider
+// as last composed.
+type sliderArgs struct {
+	values        []float32
+	onValueChange func(values []float32)
+	opts          SliderOptions
+	rtl           bool
+	dimensions    sliderDimensions
+}
+
+func (a sliderArgs) vertical() bool {
+	return a.opts.Orientation == gestures.OrientationVertical
+}
+
+// sliderInput moves the thumbs of a slider with the pointer and the
+// keyboard. Its args are updated by every composition.
+type sliderInput struct {
+	args sliderArgs
+	// dragged is the thumb being dragged, or -1.
+	dragged state.MutableValueTyped[int]
+	// focused is the thumb with the keyboard focus, or -1.
+	focused state.MutableValueTyped[int]
+}
+
+// setValue moves thumb to value, on a step and without crossing the other
+// thumb of a RangeSlider, and reports whether the thumb moved.
+func (in *sliderInput) setValue(thumb int, value float32) bool {
+	a := in.args
+	value = a.opts.valueAt(a.opts.fraction(value))
+	if thumb > 0 {
+		value = max(value, a.values[thumb-1])
+	}
+	if thumb < len(a.values)-1 {
+		value = min(value, a.values[thumb+1])
+	}
+	if value == a.values[thumb] {
+		return false
+	}
+	values := slices.Clone(a.values)
+	values[thumb] = value
+	// Later events of the same frame start from the new values.
+	in.args.values = values
+	a.onValueChange(values)
+	return true
+}
+
+// fractionAt returns the fraction of the range at offset in a slider of
+// size.
+func (in *sliderInput) fractionAt(offset geometry.Offset, size unit.IntSize, density unit.Density) float32 {
+	along, length := offset.X(), float32(size.Width)
+	if in.args.vertical() {
+		// Vertical sliders increase upwards.
+		along, length = float32(size.Height)-offset.Y(), float32(size.Height)
+	} else if in.args.rtl {
+		along = length - along
+	}
+	inset := float32(density.DpRoundToPx(unit.Dp(in.args.dimensions.thumbWidth)) / 2)
+	if length <= 2*inset {
+		return 0
+	}
+	return (along - inset) / (length - 2*inset)
+}
+
+// press starts dragging the thumb nearest to fraction, and moves it there.
+func (in *sliderInput) press(fraction float32) {
+	a := in.args
+	thumb := 0
+	if len(a.values) == 2 {
+		start, end := a.opts.fraction(a.values[0]), a.opts.fraction(a.values[1])
+		if d0, d1 := abs(fraction-start), abs(fraction-end); d1 < d0 || (d1 == d0 && fraction > end) {
+			thumb = 1
+		}
+	}
+	in.dragged.Set(thumb)
+	in.setValue(thumb, a.opts.valueAt(fraction))
+}
+
+// drag moves the thumb being dragged to fraction. When both thumbs of a
+// RangeSlider are at the same value, the one in the direction of the drag
+// moves.
+func (in *sliderInput) drag(fraction float32) {
+	thumb := in.dragged.Get()
+	if thumb < 0 {
+		return
+	}
+	a := in.args
+	value := a.opts.valueAt(fraction)
+	if len(a.values) == 2 && a.values[0] == a.values[1] {
+		if value > a.values[1] {
+			thumb = 1
+		} else if value < a.values[0] {
+			thumb = 0
+		}
+		if thumb != in.dragged.Get() {
+			in.dragged.Set(thumb)
+		}
+	}
+	in.setValue(thumb, value)
+}
+
+// release ends a drag.
+func (in *sliderInput) release() {
+	if in.dragged.Get() < 0 {
+		return
+	}
+	in.dragged.Set(-1)
+	if in.args.opts.OnValueChangeFinished != nil {
+		in.args.opts.OnValueChangeFinished()
+	}
+}
+
+// onKey returns the key handler of thumb: the arrow keys, PageUp and
+// PageDown step its value, Home and End move it to the ends of the range.
+// Left and right are swapped in right to left layouts.
+func (in *sliderInput) onKey(thumb int) func(e key.KeyEvent) bool {
+	return func(e key.KeyEvent) bool {
+		if e.Type != key.KeyDown {
+			return false
+		}
+		a := in.args
+		arrow, page := a.opts.keySteps()
+		increase, decrease := key.KeyRightArrow, key.KeyLeftArrow
+		if a.rtl && !a.vertical() {
+			increase, decrease = decrease, increase
+		}
+		value := a.values[thumb]
+		switch e.Key {
+		case key.KeyUpArrow, increase:
+			value += arrow
+		case key.KeyDownArrow, decrease:
+			value -= arrow
+		case key.KeyPageUp:
+			value += page
+		case key.KeyPageDown:
+			value -= page
+		case key.KeyHome:
+			value = a.opts.ValueRange.Min
+		case key.KeyEnd:
+			value = a.opts.ValueRange.Max
+		default:
+			return false
+		}
+		// A key pressed at the end of the range leaves the value as it is.
+		if in.setValue(thumb, value) && a.opts.OnValueChangeFinished != nil {
+			a.opts.OnValueChangeFinished()
+		}
+		return true
+	}
+}
+
+func abs(v float32) float32 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
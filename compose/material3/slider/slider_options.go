@@ -1,6 +1,9 @@
 package slider
 
-import "github.com/zodimo/go-compose/compose/ui"
+import (
+	"github.com/zodimo/go-compose/compose/foundation/gestures"
+	"github.com/zodimo/go-compose/compose/ui"
+)
 
 // SliderOption defines the functional option pattern for Slider.
 type SliderOption func(*SliderOptions)
@@ -13,6 +16,13 @@ type SliderOptions struct {
 	Steps                 int
 	OnValueChangeFinished func()
 	Colors                SliderColors
+	// Orientation lays the slider out along the width, with values
+	// increasing towards the end, or along the height, with values
+	// increasing upwards.
+	Orientation gestures.Orientation
+	// ValueLabel formats the value shown above the thumb being dragged or
+	// focused. The label is hidden when it is nil.
+	ValueLabel func(value float32) string
 }
 
 // DefaultSliderOptions returns the default options.
@@ -24,6 +34,7 @@ func DefaultSliderOptions() SliderOptions {
 		Steps:                 0,
 		OnValueChangeFinished: nil,
 		Colors:                SliderDefaults.Colors(), // In practice, these should be resolved from theme if empty
+		Orientation:           gestures.OrientationHorizontal,
 	}
 }
 
@@ -69,3 +80,19 @@ func WithColors(colors SliderColors) SliderOption {
 		o.Colors = colors
 	}
 }
+
+// WithOrientation lays the slider out horizontally or vertically.
+func WithOrientation(orientation gestures.Orientation) SliderOption {
+	return func(o *SliderOptions) {
+		o.Orientation = orientation
+	}
+}
+
+// WithValueLabel shows the value of the thumb being dragged or focused in a
+// label above it, formatted by format. SliderDefaults.ValueLabel formats it
+// as a whole number.
+func WithValueLabel(format func(value float32) string) SliderOption {
+	return func(o *SliderOptions) {
+		o.ValueLabel = format
+	}
+}
@@ -0,0 +1,169 @@
+package slider
+
+import (
+	"image"
+	"testing"
+
+	"github.com/zodimo/go-compose/compose"
+	"github.com/zodimo/go-compose/compose/foundation/gestures"
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+	uilayout "github.com/zodimo/go-compose/compose/ui/layout"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+	"github.com/zodimo/go-compose/modifiers/key"
+	"github.com/zodimo/go-compose/runtime"
+	"github.com/zodimo/go-compose/state"
+	"github.com/zodimo/go-compose/store"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+	gioUnit "gioui.org/unit"
+)
+
+func newInput(values []float32, options ...SliderOption) (*sliderInput, *[]float32) {
+	opts := DefaultSliderOptions()
+	for _, option := range options {
+		option(&opts)
+	}
+	got := &values
+	in := &sliderInput{dragged: state.MutableStateOf(-1), focused: state.MutableStateOf(-1)}
+	in.args = sliderArgs{values: values, opts: opts, onValueChange: func(values []float32) { *got = values }, dimensions: handleDimensions()}
+	return in, got
+}
+
+func TestSliderOptions_ValueAt(t *testing.T) {
+	opts := DefaultSliderOptions()
+	WithValueRange(0, 100)(&opts)
+	if got := opts.valueAt(0.337); got != 33.7 {
+		t.Errorf("continuous valueAt(0.337) = %v, want 33.7", got)
+	}
+	if got := opts.valueAt(1.5); got != 100 {
+		t.Errorf("valueAt(1.5) = %v, want 100", got)
+	}
+	WithSteps(4)(&opts)
+	if got := opts.valueAt(0.337); got != 40 {
+		t.Errorf("valueAt(0.337) with 4 steps = %v, want 40", got)
+	}
+	if arrow, page := opts.keySteps(); arrow != 20 || page != 20 {
+		t.Errorf("keySteps with 4 steps = %v, %v, want 20, 20", arrow, page)
+	}
+	WithSteps(0)(&opts)
+	if arrow, page := opts.keySteps(); arrow != 1 || page != 10 {
+		t.Errorf("continuous keySteps = %v, %v, want 1, 10", arrow, page)
+	}
+}
+
+func TestSliderInput_Range(t *testing.T) {
+	in, got := newInput([]float32{20, 60}, WithValueRange(0, 100))
+
+	// The end thumb is nearer.
+	in.press(0.5)
+	if in.dragged.Get() != 1 || (*got)[1] != 50 {
+		t.Errorf("press at 0.5 dragged %d to %v, want the end thumb to 50", in.dragged.Get(), *got)
+	}
+	// The end thumb does not cross the start thumb.
+	in.drag(0.1)
+	if (*got)[0] != 20 || (*got)[1] != 20 {
+		t.Errorf("values after dragging the end thumb below the start = %v, want [20 20]", *got)
+	}
+	// Thumbs on top of each other: the drag picks the thumb by direction.
+	in.drag(0.05)
+	if in.dragged.Get() != 0 || (*got)[0] != 5 {
+		t.Errorf("drag below both thumbs dragged %d to %v, want the start thumb to 5", in.dragged.Get(), *got)
+	}
+
+	finished := 0
+	in.args.opts.OnValueChangeFinished = func() { finished++ }
+	in.release()
+	in.release()
+	if in.dragged.Get() != -1 || finished != 1 {
+		t.Errorf("after release: dragged %d, finished %d times, want -1, once", in.dragged.Get(), finished)
+	}
+}
+
+func TestSliderInput_Keys(t *testing.T) {
+	finished := 0
+	in, got := newInput([]float32{50}, WithValueRange(0, 100), WithSteps(9), WithOnValueChangeFinished(func() { finished++ }))
+	press := func(name key.Key) bool { return in.onKey(0)(key.KeyEvent{Type: key.KeyDown, Key: name}) }
+
+	press(key.KeyRightArrow)
+	if (*got)[0] != 60 {
+		t.Errorf("value after right = %v, want 60", *got)
+	}
+	press(key.KeyPageDown)
+	if (*got)[0] != 50 {
+		t.Errorf("value after PageDown = %v, want 50", *got)
+	}
+	press(key.KeyEnd)
+	press(key.KeyUpArrow)
+	if (*got)[0] != 100 {
+		t.Errorf("value after End, up = %v, want 100", *got)
+	}
+	if finished != 3 {
+		t.Errorf("OnValueChangeFinished called %d times, want 3: up at the end of the range changes nothing", finished)
+	}
+	press(key.KeyHome)
+	if (*got)[0] != 0 {
+		t.Errorf("value after Home = %v, want 0", *got)
+	}
+
+	in.args.rtl = true
+	press(key.KeyLeftArrow)
+	if (*got)[0] != 10 {
+		t.Errorf("value after left in a right to left layout = %v, want 10", *got)
+	}
+	if press("A") {
+		t.Errorf("letter key was handled")
+	}
+	if in.onKey(0)(key.KeyEvent{Type: key.KeyUp, Key: key.KeyRightArrow}) {
+		t.Errorf("key release was handled")
+	}
+}
+
+func TestSliderInput_FractionAt(t *testing.T) {
+	density := unit.NewDensity(1, 1)
+	size := unit.IntSize{Width: 204, Height: 48}
+	in, _ := newInput([]float32{0})
+	// The track is inset by half of the 4dp thumb at each end.
+	if got := in.fractionAt(geometry.NewOffset(52, 10), size, density); got != 0.25 {
+		t.Errorf("fractionAt(52) = %v, want 0.25", got)
+	}
+	in.args.rtl = true
+	if got := in.fractionAt(geometry.NewOffset(52, 10), size, density); got != 0.75 {
+		t.Errorf("fractionAt(52) in a right to left layout = %v, want 0.75", got)
+	}
+	in.args.opts.Orientation = gestures.OrientationVertical
+	size = unit.IntSize{Width: 48, Height: 204}
+	if got := in.fractionAt(geometry.NewOffset(10, 52), size, density); got != 0.75 {
+		t.Errorf("vertical fractionAt(52) = %v, want 0.75, increasing upwards", got)
+	}
+}
+
+func TestRangeSlider_Size(t *testing.T) {
+	for _, tt := range []struct {
+		orientation gestures.Orientation
+		want        image.Point
+	}{
+		{gestures.OrientationHorizontal, image.Pt(300, 48)},
+		{gestures.OrientationVertical, image.Pt(48, 400)},
+	} {
+		var got image.Point
+		c := compose.NewComposer(store.NewPersistentState(map[string]state.MutableValue{}))
+		node := RangeSlider(ClosedRange{Start: 0.2, End: 0.8}, func(ClosedRange) {},
+			WithSteps(4),
+			WithOrientation(tt.orientation),
+			WithModifier(uilayout.OnGloballyPositioned(func(coordinates uilayout.LayoutCoordinates) {
+				b := coordinates.BoundsInWindow()
+				got = image.Pt(int(b.Right-b.Left), int(b.Bottom-b.Top))
+			})),
+		)(c).Build()
+		gtx := layout.Context{
+			Ops:         new(op.Ops),
+			Constraints: layout.Constraints{Max: image.Pt(300, 400)},
+			Metric:      gioUnit.Metric{PxPerDp: 1, PxPerSp: 1},
+		}
+		runtime.NewRuntime().Run(gtx, node).Add(gtx.Ops)
+		if got != tt.want {
+			t.Errorf("%v RangeSlider size = %v, want %v", tt.orientation, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,396 @@
+package slider
+
+import (
+	"fmt"
+	"image"
+	"math"
+
+	"github.com/zodimo/go-compose/compose"
+	"github.com/zodimo/go-compose/compose/foundation/layout/box"
+	ftext "github.com/zodimo/go-compose/compose/foundation/text"
+	"github.com/zodimo/go-compose/compose/material3/surface"
+	"github.com/zodimo/go-compose/compose/material3/text"
+	"github.com/zodimo/go-compose/compose/ui/graphics"
+	"github.com/zodimo/go-compose/compose/ui/graphics/shape"
+	uilayout "github.com/zodimo/go-compose/compose/ui/layout"
+	"github.com/zodimo/go-compose/compose/ui/platform"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+	"github.com/zodimo/go-compose/internal/layoutnode"
+	"github.com/zodimo/go-compose/modifiers/focus"
+	"github.com/zodimo/go-compose/modifiers/key"
+	"github.com/zodimo/go-compose/modifiers/padding"
+	"github.com/zodimo/go-compose/modifiers/pointer"
+	"github.com/zodimo/go-compose/state"
+
+	"gioui.org/layout"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	gioUnit "gioui.org/unit"
+)
+
+// unboundedLength is the length of a slider without a bounded width, or
+// height when vertical, in dp.
+const unboundedLength = 240
+
+// sliderDimensions are the dimensions a slider is drawn with.
+type sliderDimensions struct {
+	trackHeight gioUnit.Dp
+	// insideCorner is the radius of the corners of the track next to a
+	// thumb, and gap the gap between them.
+	insideCorner gioUnit.Dp
+	gap          gioUnit.Dp
+	tickSize     gioUnit.Dp
+	// stopIndicatorSize is the size of the dots at the inactive ends of a
+	// continuous track, which has none when it is 0.
+	stopIndicatorSize gioUnit.Dp
+	// thumbWidth and thumbHeight are the size of a thumb, pressedWidth and
+	// pressedHeight the size of the thumb being dragged.
+	thumbWidth, thumbHeight     gioUnit.Dp
+	pressedWidth, pressedHeight gioUnit.Dp
+}
+
+// roundThumbDimensions are the dimensions of a Slider: a round thumb of
+// ThumbSize, growing to ActiveThumbSize while dragged, over a track of
+// TrackHeight.
+func roundThumbDimensions() sliderDimensions {
+	return sliderDimensions{
+		trackHeight:   TrackHeight,
+		insideCorner:  TrackHeight / 2,
+		tickSize:      TickSize,
+		thumbWidth:    ThumbSize,
+		thumbHeight:   ThumbSize,
+		pressedWidth:  ActiveThumbSize,
+		pressedHeight: ActiveThumbSize,
+	}
+}
+
+// handleDimensions are the dimensions of a RangeSlider: handles across a
+// track of HandleTrackHeight, with a gap around them.
+func handleDimensions() sliderDimensions {
+	return sliderDimensions{
+		trackHeight:       HandleTrackHeight,
+		insideCorner:      TrackInsideCornerSize,
+		gap:               ThumbTrackGap,
+		tickSize:          StopIndicatorSize,
+		stopIndicatorSize: StopIndicatorSize,
+		thumbWidth:        ThumbWidth,
+		thumbHeight:       ThumbHeight,
+		pressedWidth:      PressedThumbWidth,
+		pressedHeight:     ThumbHeight,
+	}
+}
+
+// slider lays out the track and the thumbs of a Slider, with one value, or
+// of a RangeSlider, with two, drawn with dimensions. Every thumb is a focus
+// target taking the keys of sliderInput.onKey.
+func slider(values []float32, onValueChange func(values []float32), opts SliderOptions, dimensions sliderDimensions) Composable {
+	return func(c Composer) Composer {
+		opts.Colors = resolveSliderColors(c, opts.Colors)
+
+		key := fmt.Sprintf("slider-%v", c.GenerateID())
+		dragged := state.MustState(c, key+"/dragged", func() int { return -1 })
+		focused := state.MustState(c, key+"/focused", func() int { return -1 })
+		in := c.State(key, func() any {
+			return &sliderInput{dragged: dragged, focused: focused}
+		}).Get().(*sliderInput)
+		in.args = sliderArgs{
+			values:        values,
+			onValueChange: onValueChange,
+			opts:          opts,
+			rtl:           platform.LocalLayoutDirection.Current(c) == unit.LayoutDirectionRtl,
+			dimensions:    dimensions,
+		}
+		args := in.args
+
+		children := []Composable{sliderTrack{args: args, dragged: dragged.Get()}.composable()}
+		for i := range values {
+			children = append(children, sliderThumb(in, i))
+		}
+		label := -1
+		if opts.ValueLabel != nil && opts.Enabled {
+			label = dragged.Get()
+			if label < 0 {
+				label = focused.Get()
+			}
+		}
+		if label >= len(values) {
+			label = -1
+		}
+		if label >= 0 {
+			children = append(children, valueLabel(opts.ValueLabel(values[label]), opts.Colors))
+		}
+
+		modifier := opts.Modifier
+		if opts.Enabled {
+			modifier = modifier.Then(pointer.PointerInput(func(scope pointer.PointerInputScope) {
+				scope.OnPointerEvent(func(event *pointer.PointerEvent) {
+					change := event.ChangedPointer()
+					if change.IsConsumed() {
+						return
+					}
+					switch {
+					case change.Pressed:
+						fraction := in.fractionAt(change.Position, scope.Size(), scope.Density())
+						if change.ChangedToDown() {
+							scope.Grab(change.ID)
+							in.press(fraction)
+						} else {
+							in.drag(fraction)
+						}
+						change.Consume()
+					case change.ChangedToUp():
+						in.release()
+						change.Consume()
+					}
+				})
+				scope.OnCancel(in.release)
+			}, in))
+		}
+		return uilayout.Layout(
+			compose.Sequence(children...),
+			uilayout.MeasurePolicyFunc(func(scope uilayout.MeasureScope, measurables []uilayout.Measurable, constraints unit.Constraints) uilayout.MeasureResult {
+				return measureSlider(scope, measurables, constraints, args, label)
+			}),
+			uilayout.WithModifier(modifier),
+		)(c)
+	}
+}
+
+// sliderThumb is the focus target of thumb, over the thumb the track draws.
+func sliderThumb(in *sliderInput, thumb int) Composable {
+	enabled := in.args.opts.Enabled
+	modifier := focus.OnFocusChanged(func(state focus.FocusState) {
+		switch {
+		case state.IsFocused && in.focused.Get() != thumb:
+			in.focused.Set(thumb)
+		case !state.IsFocused && in.focused.Get() == thumb:
+			in.focused.Set(-1)
+		}
+	})
+	if enabled {
+		modifier = modifier.Then(key.OnKeyEvent(in.onKey(thumb)))
+	}
+	return box.Box(compose.Id(), box.WithModifier(modifier.Then(focus.Focusable(focus.WithEnabled(enabled)))))
+}
+
+// valueLabel shows label above the thumb being dragged or focused.
+func valueLabel(label string, colors SliderColors) Composable {
+	return surface.Surface(
+		text.LabelLarge(label, ftext.WithColor(colors.ValueLabelContentColor)),
+		surface.WithShape(shape.CircleShape),
+		surface.WithColor(colors.ValueLabelContainerColor),
+		surface.WithContentColor(colors.ValueLabelContentColor),
+		surface.WithAlignment(box.Center),
+		surface.WithModifier(padding.Padding(16, 12, 16, 12)),
+	)
+}
+
+// thumbPosition returns the center of the thumb at fraction of a track of
+// length, in px from the start of the track. The thumb stays inset from the
+// ends of the track by half its width.
+func thumbPosition(fraction float32, length, inset int) int {
+	return inset + int(math.Round(float64(fraction*float32(length-2*inset))))
+}
+
+// measureSlider lays out the track, the first of measurables, the focus
+// targets of the thumbs over them and the label of thumb label, if any,
+// above it, or before it in a vertical slider.
+func measureSlider(scope uilayout.MeasureScope, measurables []uilayout.Measurable, constraints unit.Constraints, args sliderArgs, label int) uilayout.MeasureResult {
+	thickness := scope.DpRoundToPx(unit.Dp(MinTouchSize))
+	length := scope.DpRoundToPx(unboundedLength)
+	var width, height int
+	if args.vertical() {
+		if constraints.HasBoundedHeight() {
+			length = constraints.MaxHeight()
+		}
+		width, height = constraints.ConstrainWidth(thickness), constraints.ConstrainHeight(length)
+		thickness, length = width, height
+	} else {
+		if constraints.HasBoundedWidth() {
+			length = constraints.MaxWidth()
+		}
+		width, height = constraints.ConstrainWidth(length), constraints.ConstrainHeight(thickness)
+		thickness, length = height, width
+	}
+
+	track := measurables[0].Measure(unit.Fixed(width, height))
+	thumbSize := scope.DpRoundToPx(unit.Dp(MinTouchSize))
+	thumbs := make([]*uilayout.Placeable, len(args.values))
+	for i := range thumbs {
+		thumbs[i] = measurables[1+i].Measure(unit.Fixed(thumbSize, thumbSize))
+	}
+	var valueLabel *uilayout.Placeable
+	if label >= 0 {
+		valueLabel = measurables[1+len(thumbs)].Measure(unit.NewConstraints(0, unit.Infinity, 0, unit.Infinity))
+	}
+
+	inset := scope.DpRoundToPx(unit.Dp(args.dimensions.thumbWidth)) / 2
+	// center returns the center of a thumb at along px from the start of the
+	// track, in the slider.
+	center := func(along int) image.Point {
+		switch {
+		case args.vertical():
+			return image.Pt(thickness/2, length-along)
+		case args.rtl:
+			return image.Pt(length-along, thickness/2)
+		default:
+			return image.Pt(along, thickness/2)
+		}
+	}
+	return scope.Layout(width, height, func() {
+		track.PlaceAt(0, 0)
+		for i, p := range thumbs {
+			c := center(thumbPosition(args.opts.fraction(args.values[i]), length, inset))
+			p.PlaceAt(c.X-p.Width()/2, c.Y-p.Height()/2)
+		}
+		if valueLabel != nil {
+			c := center(thumbPosition(args.opts.fraction(args.values[label]), length, inset))
+			gap := scope.DpRoundToPx(4)
+			thumbHeight := min(scope.DpRoundToPx(unit.Dp(args.dimensions.thumbHeight)), thickness)
+			if args.vertical() {
+				valueLabel.PlaceAt(c.X-thumbHeight/2-gap-valueLabel.Width(), c.Y-valueLabel.Height()/2)
+			} else {
+				valueLabel.PlaceAt(c.X-valueLabel.Width()/2, c.Y-thumbHeight/2-gap-valueLabel.Height())
+			}
+		}
+	})
+}
+
+// sliderTrack draws the track of a slider, split around each thumb, its
+// tick marks or stop indicators, and the thumbs.
+type sliderTrack struct {
+	args sliderArgs
+	// dragged is the thumb being dragged, drawn at its pressed size, or -1.
+	dragged int
+}
+
+func (t sliderTrack) composable() Composable {
+	return func(c Composer) Composer {
+		c.StartBlock(SliderNodeID)
+		c.SetWidgetConstructor(layoutnode.NewLayoutNodeWidgetConstructor(func(node layoutnode.LayoutNode) layoutnode.GioLayoutWidget {
+			return func(gtx layoutnode.LayoutContext) layoutnode.LayoutDimensions {
+				return t.layout(gtx)
+			}
+		}))
+		return c.EndBlock()
+	}
+}
+
+func (t sliderTrack) layout(gtx layout.Context) layout.Dimensions {
+	sz := gtx.Constraints.Min
+	args, colors, enabled := t.args, t.args.opts.Colors, t.args.opts.Enabled
+	dims := args.dimensions
+	vertical := args.vertical()
+	length, thickness := sz.X, sz.Y
+	if vertical {
+		length, thickness = sz.Y, sz.X
+	}
+
+	// rrect maps a rectangle from along the track and across it, with the
+	// radius of its corners at the start and at the end of the track, to
+	// the slider.
+	rrect := func(a0, a1, c0, c1, start, end int) clip.RRect {
+		switch {
+		case vertical:
+			return clip.RRect{Rect: image.Rect(c0, length-a1, c1, length-a0), SW: start, SE: start, NW: end, NE: end}
+		case args.rtl:
+			return clip.RRect{Rect: image.Rect(length-a1, c0, length-a0, c1), NE: start, SE: start, NW: end, SW: end}
+		default:
+			return clip.RRect{Rect: image.Rect(a0, c0, a1, c1), NW: start, SW: start, NE: end, SE: end}
+		}
+	}
+	dot := func(along, across, size int, col graphics.Color) {
+		r := rrect(along-size/2, along-size/2+size, across-size/2, across-size/2+size, size/2, size/2)
+		paint.FillShape(gtx.Ops, graphics.ColorToNRGBA(col), r.Op(gtx.Ops))
+	}
+
+	inset := gtx.Dp(dims.thumbWidth) / 2
+	positions := make([]int, len(args.values))
+	for i, v := range args.values {
+		positions[i] = thumbPosition(args.opts.fraction(v), length, inset)
+	}
+	// active reports whether along is in the active part of the track,
+	// before the thumb of a Slider or between the thumbs of a RangeSlider.
+	active := func(along int) bool {
+		if len(positions) == 1 {
+			return along < positions[0]
+		}
+		return along > positions[0] && along < positions[1]
+	}
+	gap := gtx.Dp(dims.gap) + inset
+	inGap := func(along, size int) bool {
+		for _, p := range positions {
+			if along+size/2 > p-gap && along-size/2 < p+gap {
+				return true
+			}
+		}
+		return false
+	}
+
+	// The track, in segments between its ends and the gaps around the
+	// thumbs.
+	trackHeight := min(gtx.Dp(dims.trackHeight), thickness)
+	c0, c1 := (thickness-trackHeight)/2, (thickness+trackHeight)/2
+	outer, inner := trackHeight/2, gtx.Dp(dims.insideCorner)
+	edges := []int{0}
+	for _, p := range positions {
+		edges = append(edges, p-gap, p+gap)
+	}
+	edges = append(edges, length)
+	for i := 0; i < len(edges); i += 2 {
+		a0, a1 := edges[i], edges[i+1]
+		if a1 <= a0 {
+			continue
+		}
+		start, end := inner, inner
+		if i == 0 {
+			start = outer
+		}
+		if i == len(edges)-2 {
+			end = outer
+		}
+		start, end = min(start, (a1-a0)/2), min(end, (a1-a0)/2)
+		col := colors.Track(enabled, active((a0+a1)/2))
+		paint.FillShape(gtx.Ops, graphics.ColorToNRGBA(col), rrect(a0, a1, c0, c1, start, end).Op(gtx.Ops))
+	}
+
+	// Tick marks on the steps, or stop indicators at the inactive ends of
+	// a continuous track.
+	across := thickness / 2
+	if args.opts.Steps > 0 {
+		size := gtx.Dp(dims.tickSize)
+		n := args.opts.Steps + 1
+		for i := 0; i <= n; i++ {
+			along := thumbPosition(float32(i)/float32(n), length, inset)
+			along = min(max(along, outer), length-outer)
+			if inGap(along, size) {
+				continue
+			}
+			dot(along, across, size, colors.Tick(enabled, active(along)))
+		}
+	} else if dims.stopIndicatorSize > 0 {
+		size := gtx.Dp(dims.stopIndicatorSize)
+		ends := []int{length - outer}
+		if len(positions) == 2 {
+			ends = append(ends, outer)
+		}
+		for _, along := range ends {
+			if !inGap(along, size) {
+				dot(along, across, size, colors.Track(enabled, true))
+			}
+		}
+	}
+
+	// The thumbs, across the track.
+	for i, p := range positions {
+		w, h := gtx.Dp(dims.thumbWidth), gtx.Dp(dims.thumbHeight)
+		if i == t.dragged {
+			w, h = gtx.Dp(dims.pressedWidth), gtx.Dp(dims.pressedHeight)
+		}
+		h = min(h, thickness)
+		radius := min(w, h) / 2
+		r := rrect(p-w/2, p-w/2+w, (thickness-h)/2, (thickness-h)/2+h, radius, radius)
+		paint.FillShape(gtx.Ops, graphics.ColorToNRGBA(colors.Thumb(enabled)), r.Op(gtx.Ops))
+	}
+	return layout.Dimensions{Size: sz}
+}
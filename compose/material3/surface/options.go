@@ -25,6 +25,9 @@ type SurfaceOptions struct {
 	BorderWidth     Dp
 	BorderColor     graphics.Color
 	Alignment       box.Direction // Optional alignment for content inside surface
+	// FocusIndicator draws the focus indicator around the surface while the
+	// clickable inside it is focused.
+	FocusIndicator bool
 }
 
 type SurfaceOption func(*SurfaceOptions)
@@ -49,6 +52,12 @@ func WithModifier(m ui.Modifier) SurfaceOption {
 	}
 }
 
+func WithFocusIndicator(enabled bool) SurfaceOption {
+	return func(o *SurfaceOptions) {
+		o.FocusIndicator = enabled
+	}
+}
+
 func WithShape(s Shape) SurfaceOption {
 	return func(o *SurfaceOptions) {
 		o.Shape = s
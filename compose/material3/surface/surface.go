@@ -45,8 +45,15 @@ func Surface(
 		// 4. Border (respects clip)
 		// 5. Custom Modifiers (clickable, etc. - should respect clip)
 
+		// The focus indicator is drawn outside the surface, so it comes before the clip.
+		focusIndicator := modifier.EmptyModifier
+		if opts.FocusIndicator {
+			focusIndicator = material3.FocusIndicator(c, opts.Shape)
+		}
+
 		surfaceModifier := modifier.EmptyModifier.
 			Then(shadow.Simple(opts.ShadowElevation, opts.Shape)).
+			Then(focusIndicator).
 			Then(clip.Clip(opts.Shape)).
 			Then(background.Background(
 				opts.Color,
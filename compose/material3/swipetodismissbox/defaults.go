@@ -0,0 +1,12 @@
+package swipetodismissbox
+
+import "github.com/zodimo/go-compose/compose/ui/unit"
+
+// SwipeToDismissBoxDefaults holds the default values used by SwipeToDismissBox.
+var SwipeToDismissBoxDefaults = struct {
+	// PositionalThreshold is how far the content must be swiped to be
+	// dismissed without a fling.
+	PositionalThreshold unit.Dp
+}{
+	PositionalThreshold: unit.Dp(56),
+}
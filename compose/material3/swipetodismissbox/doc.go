@@ -0,0 +1,8 @@
+/*
+Package swipetodismissbox contains the Material 3 SwipeToDismissBox component,
+which lets users dismiss an item, such as a list item, by swiping it
+horizontally.
+
+Reference: [Lists](https://m3.material.io/components/lists/overview)
+*/
+package swipetodismissbox
@@ -0,0 +1,85 @@
+package swipetodismissbox
+
+import (
+	"github.com/zodimo/go-compose/compose/ui"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+)
+
+type SwipeToDismissBoxStateOptions struct {
+	InitialValue SwipeToDismissBoxValue
+	// ConfirmValueChange can veto a dismiss, or a return to Settled.
+	ConfirmValueChange func(value SwipeToDismissBoxValue) bool
+	// PositionalThreshold is how far the content must be swiped to be
+	// dismissed when released without a fling.
+	PositionalThreshold unit.Dp
+}
+
+type SwipeToDismissBoxStateOption func(*SwipeToDismissBoxStateOptions)
+
+func WithInitialValue(value SwipeToDismissBoxValue) SwipeToDismissBoxStateOption {
+	return func(o *SwipeToDismissBoxStateOptions) {
+		o.InitialValue = value
+	}
+}
+
+func WithConfirmValueChange(confirm func(value SwipeToDismissBoxValue) bool) SwipeToDismissBoxStateOption {
+	return func(o *SwipeToDismissBoxStateOptions) {
+		o.ConfirmValueChange = confirm
+	}
+}
+
+func WithPositionalThreshold(threshold unit.Dp) SwipeToDismissBoxStateOption {
+	return func(o *SwipeToDismissBoxStateOptions) {
+		o.PositionalThreshold = threshold
+	}
+}
+
+func DefaultSwipeToDismissBoxStateOptions() SwipeToDismissBoxStateOptions {
+	return SwipeToDismissBoxStateOptions{
+		InitialValue:        Settled,
+		ConfirmValueChange:  func(SwipeToDismissBoxValue) bool { return true },
+		PositionalThreshold: SwipeToDismissBoxDefaults.PositionalThreshold,
+	}
+}
+
+type SwipeToDismissBoxOptions struct {
+	Modifier                    ui.Modifier
+	EnableDismissFromStartToEnd bool
+	EnableDismissFromEndToStart bool
+	GesturesEnabled             bool
+}
+
+type SwipeToDismissBoxOption func(*SwipeToDismissBoxOptions)
+
+func WithModifier(m ui.Modifier) SwipeToDismissBoxOption {
+	return func(o *SwipeToDismissBoxOptions) {
+		o.Modifier = m
+	}
+}
+
+func WithEnableDismissFromStartToEnd(enabled bool) SwipeToDismissBoxOption {
+	return func(o *SwipeToDismissBoxOptions) {
+		o.EnableDismissFromStartToEnd = enabled
+	}
+}
+
+func WithEnableDismissFromEndToStart(enabled bool) SwipeToDismissBoxOption {
+	return func(o *SwipeToDismissBoxOptions) {
+		o.EnableDismissFromEndToStart = enabled
+	}
+}
+
+func WithGesturesEnabled(enabled bool) SwipeToDismissBoxOption {
+	return func(o *SwipeToDismissBoxOptions) {
+		o.GesturesEnabled = enabled
+	}
+}
+
+func DefaultSwipeToDismissBoxOptions() SwipeToDismissBoxOptions {
+	return SwipeToDismissBoxOptions{
+		Modifier:                    ui.EmptyModifier,
+		EnableDismissFromStartToEnd: true,
+		EnableDismissFromEndToStart: true,
+		GesturesEnabled:             true,
+	}
+}
@@ -0,0 +1,64 @@
+package swipetodismissbox
+
+import (
+	"github.com/zodimo/go-compose/compose/foundation/gestures"
+	"github.com/zodimo/go-compose/compose/foundation/layout/box"
+	"github.com/zodimo/go-compose/compose/ui/platform"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+	mBox "github.com/zodimo/go-compose/modifiers/box"
+)
+
+// SwipeToDismissBox lets content be swiped horizontally to dismiss it.
+// backgroundContent is drawn behind content and revealed while swiping; use
+// state.DismissDirection to pick what it shows.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/SwipeToDismissBox.kt
+func SwipeToDismissBox(state *SwipeToDismissBoxState, backgroundContent Composable, content Composable, options ...SwipeToDismissBoxOption) Composable {
+	return func(c Composer) Composer {
+		opts := DefaultSwipeToDismissBoxOptions()
+		for _, option := range options {
+			if option == nil {
+				continue
+			}
+			option(&opts)
+		}
+
+		isRtl := platform.LocalLayoutDirection.Current(c) == unit.LayoutDirectionRtl
+		anchors := func(size unit.IntSize) *gestures.DraggableAnchors[SwipeToDismissBoxValue] {
+			// The end edge is on the left in right-to-left layouts, so a swipe
+			// from start to end moves the content to negative offsets.
+			width := float32(size.Width)
+			if isRtl {
+				width = -width
+			}
+			values := []gestures.Anchor[SwipeToDismissBoxValue]{gestures.AnchorAt(Settled, 0)}
+			if opts.EnableDismissFromStartToEnd {
+				values = append(values, gestures.AnchorAt(StartToEnd, width))
+			}
+			if opts.EnableDismissFromEndToStart {
+				values = append(values, gestures.AnchorAt(EndToStart, -width))
+			}
+			return gestures.NewDraggableAnchors(values...)
+		}
+
+		return box.Box(
+			c.Sequence(
+				box.Box(
+					backgroundContent,
+					box.WithModifier(mBox.MatchParentSize()),
+				),
+				box.Box(
+					content,
+					box.WithModifier(gestures.AnchoredOffset(state.anchoredDraggableState, gestures.OrientationHorizontal, anchors)),
+				),
+			),
+			box.WithModifier(
+				opts.Modifier.Then(gestures.AnchoredDraggable(
+					state.anchoredDraggableState,
+					gestures.OrientationHorizontal,
+					gestures.WithEnabled(opts.GesturesEnabled),
+				)),
+			),
+		)(c)
+	}
+}
@@ -0,0 +1,149 @@
+package swipetodismissbox
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/zodimo/go-compose/compose/foundation/gestures"
+)
+
+// SwipeToDismissBoxValue is the anchor a SwipeToDismissBox rests at.
+type SwipeToDismissBoxValue int
+
+const (
+	// Settled means the content is not dismissed.
+	Settled SwipeToDismissBoxValue = iota
+	// StartToEnd means the content was swiped from the start edge to the end edge.
+	StartToEnd
+	// EndToStart means the content was swiped from the end edge to the start edge.
+	EndToStart
+)
+
+func (v SwipeToDismissBoxValue) String() string {
+	switch v {
+	case Settled:
+		return "Settled"
+	case StartToEnd:
+		return "StartToEnd"
+	case EndToStart:
+		return "EndToStart"
+	default:
+		return fmt.Sprintf("SwipeToDismissBoxValue(%d)", int(v))
+	}
+}
+
+// SwipeToDismissBoxState is the state of a SwipeToDismissBox.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/SwipeToDismissBox.kt
+type SwipeToDismissBoxState struct {
+	anchoredDraggableState *gestures.AnchoredDraggableState[SwipeToDismissBoxValue]
+}
+
+// NewSwipeToDismissBoxState creates a SwipeToDismissBoxState. Use
+// RememberSwipeToDismissBoxState to keep it across recompositions.
+func NewSwipeToDismissBoxState(options ...SwipeToDismissBoxStateOption) *SwipeToDismissBoxState {
+	s := &SwipeToDismissBoxState{}
+	opts := resolveStateOptions(options)
+	s.anchoredDraggableState = gestures.NewAnchoredDraggableState(opts.InitialValue, s.anchoredDraggableOptions(opts)...)
+	return s
+}
+
+// RememberSwipeToDismissBoxState returns a SwipeToDismissBoxState that
+// survives recompositions. The options are only read the first time.
+func RememberSwipeToDismissBoxState(c Composer, options ...SwipeToDismissBoxStateOption) *SwipeToDismissBoxState {
+	key := fmt.Sprintf("swipeToDismissBoxState-%v", c.GenerateID())
+	// The anchored draggable state is remembered on every composition, not only
+	// when this state is created, so it does not shift the IDs generated after
+	// it. Its options bind to s, which is only kept the first time, when the
+	// options are read.
+	s := &SwipeToDismissBoxState{}
+	opts := resolveStateOptions(options)
+	s.anchoredDraggableState = gestures.RememberAnchoredDraggableState(c, opts.InitialValue, s.anchoredDraggableOptions(opts)...)
+	return c.State(key, func() any {
+		return s
+	}).Get().(*SwipeToDismissBoxState)
+}
+
+func resolveStateOptions(options []SwipeToDismissBoxStateOption) SwipeToDismissBoxStateOptions {
+	opts := DefaultSwipeToDismissBoxStateOptions()
+	for _, option := range options {
+		if option == nil {
+			continue
+		}
+		option(&opts)
+	}
+	return opts
+}
+
+func (s *SwipeToDismissBoxState) anchoredDraggableOptions(opts SwipeToDismissBoxStateOptions) []gestures.AnchoredDraggableStateOption {
+	threshold := opts.PositionalThreshold
+	return []gestures.AnchoredDraggableStateOption{
+		gestures.WithPositionalThreshold(func(float32) float32 {
+			return s.anchoredDraggableState.Density().DpToPx(threshold)
+		}),
+		gestures.WithConfirmValueChange(opts.ConfirmValueChange),
+	}
+}
+
+// CurrentValue returns the value the box rests at, or last rested at while
+// being swiped.
+func (s *SwipeToDismissBoxState) CurrentValue() SwipeToDismissBoxValue {
+	return s.anchoredDraggableState.CurrentValue()
+}
+
+// TargetValue returns the value the box would settle at if released now, or
+// is animating to.
+func (s *SwipeToDismissBoxState) TargetValue() SwipeToDismissBoxValue {
+	return s.anchoredDraggableState.TargetValue()
+}
+
+// SettledValue returns the value of the anchor the content is at.
+func (s *SwipeToDismissBoxState) SettledValue() SwipeToDismissBoxValue {
+	return s.anchoredDraggableState.SettledValue()
+}
+
+// DismissDirection returns the direction the content is being swiped in, or
+// Settled when it is at rest.
+func (s *SwipeToDismissBoxState) DismissDirection() SwipeToDismissBoxValue {
+	offset := s.anchoredDraggableState.Offset()
+	if math.IsNaN(float64(offset)) || offset == 0 {
+		return Settled
+	}
+	anchors := s.anchoredDraggableState.Anchors()
+	for _, value := range []SwipeToDismissBoxValue{StartToEnd, EndToStart} {
+		if position, ok := anchors.PositionOf(value); ok && (position > 0) == (offset > 0) {
+			return value
+		}
+	}
+	return Settled
+}
+
+// Progress returns how far the content has been swiped towards the
+// dismissed anchor it is moving to, in [0, 1].
+func (s *SwipeToDismissBoxState) Progress() float32 {
+	direction := s.DismissDirection()
+	if direction == Settled {
+		return 0
+	}
+	return s.anchoredDraggableState.Progress(Settled, direction)
+}
+
+// IsAnimationRunning reports whether the content is settling at an anchor.
+func (s *SwipeToDismissBoxState) IsAnimationRunning() bool {
+	return s.anchoredDraggableState.IsAnimationRunning()
+}
+
+// Dismiss animates the content off screen in direction.
+func (s *SwipeToDismissBoxState) Dismiss(direction SwipeToDismissBoxValue) {
+	s.anchoredDraggableState.AnimateTo(direction)
+}
+
+// Reset animates the content back to Settled.
+func (s *SwipeToDismissBoxState) Reset() {
+	s.anchoredDraggableState.AnimateTo(Settled)
+}
+
+// SnapTo moves the content to the anchor of value without animation.
+func (s *SwipeToDismissBoxState) SnapTo(value SwipeToDismissBoxValue) {
+	s.anchoredDraggableState.SnapTo(value)
+}
@@ -1,18 +1,23 @@
 package tab
 
 import (
-	"github.com/zodimo/go-compose/compose/foundation/layout/box"
+	"math"
+
 	"github.com/zodimo/go-compose/compose/foundation/layout/column"
-	"github.com/zodimo/go-compose/compose/foundation/layout/row"
 	"github.com/zodimo/go-compose/compose/material3/surface"
 	"github.com/zodimo/go-compose/compose/ui/graphics"
-	"github.com/zodimo/go-compose/modifiers/background"
+	uilayout "github.com/zodimo/go-compose/compose/ui/layout"
+	"github.com/zodimo/go-compose/compose/ui/unit"
 	"github.com/zodimo/go-compose/modifiers/clickable"
 	"github.com/zodimo/go-compose/modifiers/padding"
 	"github.com/zodimo/go-compose/modifiers/size"
 )
 
-// TabRow contains a row of Tabs and displays an indicator underneath the currently selected Tab.
+// TabRow contains a row of Tabs of equal widths and displays an indicator
+// underneath the selected Tab. The indicator is at selectedTabIndex, or
+// follows the position WithIndicatorPosition or WithPagerState give it.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/TabRow.kt
 func TabRow(
 	selectedTabIndex int,
 	content Composable,
@@ -27,19 +32,6 @@ func TabRow(
 			option(&opts)
 		}
 
-		// Calculate indicator offset?
-		// For now, simpler implementation: standard row.
-		// Handling indicator animation requires knowing the positions of tabs.
-		// This is hard with a simple Row.
-		// We might need a custom layout or SubcomposeLayout equivalent if we want a sliding indicator.
-		// For MVP, we can render the indicator INSIDE the selected Tab?
-		// No, visual spec says it's a track at the bottom.
-
-		// Alternative: Use a standard Row, but wrap it in a Surface.
-		// The indicator handling is tricky without "SubcomposeLayout".
-		// But in Gio, we can probably do it if we know the Width/Count.
-		// If TabRow implies Equal Width tabs, the indicator position is just (Index * Width / Count).
-
 		SurfaceOptions := []surface.SurfaceOption{}
 		// Surface Options
 		if opts.ContainerColor.IsSpecified() {
@@ -51,50 +43,59 @@ func TabRow(
 
 		SurfaceOptions = append(SurfaceOptions, surface.WithModifier(opts.Modifier.Then(size.FillMaxWidth())))
 
-		// Let's implement fixed tabs (filled width) for now.
+		indicatorPosition := opts.IndicatorPosition
+		if indicatorPosition == nil {
+			indicatorPosition = func() float32 { return float32(selectedTabIndex) }
+		}
 		return surface.Surface(
-			// Stack: Row of Tabs + Indicator
-			// But Indicator needs to be top/bottom aligned?
-			// Actually, TabRow container usually handles it.
-
-			// Let's use a Column: Row(Tabs) then Indicator? No, indicator is overlay or below.
-			// In M3, indicator is at the bottom of the container.
-
-			// Let's use a Box to stack:
-			box.Box(
-				func(c Composer) Composer {
-					// 1. The Row of Tabs
-					row.Row(
-						content,
-						row.WithModifier(size.FillMaxWidth()),
-					)(c)
-
-					// 2. The Indicator (Absolute positioned? or just calculated)
-					// For Fixed TabRow, we can simulate the indicator by rendering it
-					// based on selectedTabIndex and total tabs (if we knew them).
-					// But we don't know total tabs count here easily unless valid content is analyzed.
-					// Or we require the User to pass the count? No.
-
-					// Workaround:
-					// If we only support "Indicator inside Tab" (active state), it's easier.
-					// But M3 has a continuous track.
-
-					// For now, let's skip the sliding indicator and just let Tabs render their own active state (border bottom?).
-					// Wait, TabRowDefaults.Indicator is a composable.
-					// If we want a proper indicator, we need strict layout.
-
-					// Let's simplify: Standard TabRow just renders the content in a Row.
-					// We can verify this basic behavior first.
-
-					return c
-				},
-				box.WithModifier(size.FillMaxWidth()),
-			),
+			uilayout.SubcomposeLayout(func(scope uilayout.SubcomposeMeasureScope, constraints unit.Constraints) uilayout.MeasureResult {
+				return measureTabRow(scope, constraints, content, opts.Indicator, indicatorPosition())
+			}, uilayout.WithModifier(size.FillMaxWidth())),
 			SurfaceOptions...,
 		)(c)
 	}
 }
 
+// measureTabRow lays out the tabs content emits side by side, sharing the
+// width of the row, and the indicator at the bottom of the tab at
+// indicatorPosition, between tabs when it is fractional.
+func measureTabRow(scope uilayout.SubcomposeMeasureScope, constraints unit.Constraints, content, indicator Composable, indicatorPosition float32) uilayout.MeasureResult {
+	tabs := scope.Subcompose("tabs", content)
+	width, tabWidth := constraints.MaxWidth(), 0
+	if !constraints.HasBoundedWidth() {
+		// Unbounded, tabs are as wide as the widest one.
+		for _, tab := range tabs {
+			tabWidth = max(tabWidth, tab.MaxIntrinsicWidth(constraints.MaxHeight()))
+		}
+		width = tabWidth * len(tabs)
+	} else if len(tabs) > 0 {
+		tabWidth = width / len(tabs)
+	}
+
+	placeables := make([]*uilayout.Placeable, len(tabs))
+	height := constraints.MinHeight()
+	for i, tab := range tabs {
+		placeables[i] = tab.Measure(unit.NewConstraints(tabWidth, tabWidth, 0, constraints.MaxHeight()))
+		height = max(height, placeables[i].Height())
+	}
+	var indicators []*uilayout.Placeable
+	if indicator != nil {
+		for _, m := range scope.Subcompose("indicator", indicator) {
+			indicators = append(indicators, m.Measure(unit.NewConstraints(tabWidth, tabWidth, 0, height)))
+		}
+	}
+
+	return scope.Layout(width, height, func() {
+		for i, p := range placeables {
+			p.PlaceRelative(i*tabWidth, 0)
+		}
+		x := int(math.Round(float64(indicatorPosition * float32(tabWidth))))
+		for _, p := range indicators {
+			p.PlaceRelative(x, height-p.Height())
+		}
+	})
+}
+
 // Tab is a single tab in a TabRow.
 func Tab(
 	selected bool,
@@ -129,7 +130,7 @@ func Tab(
 		// For now, let's use a simple Column to structure the tab:
 		// Icon (optional)
 		// Text (optional)
-		// Indicator (if selected and we render it here)
+		// The indicator is drawn by the TabRow.
 
 		contentColor := opts.UnselectedContentColor
 		if selected {
@@ -144,35 +145,10 @@ func Tab(
 						if content != nil {
 							content(c)
 						}
-
-						// If we render indicator here:
-						if selected {
-							// Render Indicator
-							box.Box(
-								func(c Composer) Composer { return c },
-								box.WithModifier(
-									size.FillMaxWidth().
-										Then(size.Height(3)). // 3dp
-										Then(background.Background(TabRowDefaults.IndicatorColor(c))),
-								),
-							)(c)
-						} else {
-							// Placeholder to keep height consistent?
-							// Or just align bottom.
-							box.Box(
-								func(c Composer) Composer { return c },
-								box.WithModifier(
-									size.FillMaxWidth().
-										Then(size.Height(3)).
-										Then(background.Background(graphics.ColorTransparent)), // Transparent
-								),
-							)(c)
-						}
 						return c
 					},
-					column.WithAlignment(column.Middle),     // Center content
-					column.WithSpacing(column.SpaceBetween), // Push content up, indicator down?
-					column.WithModifier(padding.All(12)),    // Padding
+					column.WithAlignment(column.Middle),  // Center content
+					column.WithModifier(padding.All(12)), // Padding
 				)(c)
 			},
 			surface.WithModifier(
@@ -180,6 +156,7 @@ func Tab(
 					Then(clickable.OnClick(onClick)), // Use clickable package
 			),
 			surface.WithColor(graphics.ColorTransparent), // Transparent container
+			surface.WithFocusIndicator(true),
 			surface.WithContentColor(contentColor),
 		)(c)
 	}
@@ -1,9 +1,12 @@
 package tab
 
 import (
+	"github.com/zodimo/go-compose/compose/foundation/layout/box"
 	"github.com/zodimo/go-compose/compose/material3"
 	"github.com/zodimo/go-compose/compose/ui/graphics"
 	"github.com/zodimo/go-compose/compose/ui/unit"
+	"github.com/zodimo/go-compose/modifiers/background"
+	"github.com/zodimo/go-compose/modifiers/size"
 )
 
 // TabRowDefaults holds default values for the TabRow and Tab components.
@@ -29,10 +32,19 @@ func (tabRowDefaults) IndicatorHeight() unit.Dp {
 	return unit.Dp(3)
 }
 
-// Indicator returns a default indicator composable.
-// In the future this should be a proper composable function.
+// Indicator returns the default indicator: a bar IndicatorHeight high, in
+// IndicatorColor.
 func (tabRowDefaults) Indicator() Composable {
-	return nil // Default handled in Tab or TabRow if nil
+	return func(c Composer) Composer {
+		return box.Box(
+			func(c Composer) Composer { return c },
+			box.WithModifier(
+				size.FillMaxWidth().
+					Then(size.Height(int(TabRowDefaults.IndicatorHeight()))).
+					Then(background.Background(TabRowDefaults.IndicatorColor(c))),
+			),
+		)(c)
+	}
 }
 
 func (tabDefaults) SelectedContentColor(c Composer) graphics.Color {
@@ -1,6 +1,7 @@
 package tab
 
 import (
+	"github.com/zodimo/go-compose/compose/foundation/pager"
 	"github.com/zodimo/go-compose/compose/ui"
 	"github.com/zodimo/go-compose/compose/ui/graphics"
 	"github.com/zodimo/go-compose/internal/modifier"
@@ -11,7 +12,11 @@ type TabRowOptions struct {
 	Modifier       ui.Modifier
 	ContainerColor graphics.Color
 	ContentColor   graphics.Color
-	Indicator      Composable
+	// Indicator is drawn at the bottom of the selected tab, as wide as it.
+	Indicator Composable
+	// IndicatorPosition returns the index of the tab the indicator is at,
+	// between two tabs when fractional. nil puts it at the selected tab.
+	IndicatorPosition func() float32
 }
 
 type TabRowOption func(options *TabRowOptions)
@@ -22,6 +27,37 @@ func WithTabRowModifier(modifier ui.Modifier) TabRowOption {
 	}
 }
 
+// WithIndicator replaces the indicator of the selected tab, nil for none.
+func WithIndicator(indicator Composable) TabRowOption {
+	return func(options *TabRowOptions) {
+		options.Indicator = indicator
+	}
+}
+
+// WithIndicatorPosition moves the indicator to the tab position returns,
+// read when the row is laid out.
+func WithIndicatorPosition(position func() float32) TabRowOption {
+	return func(options *TabRowOptions) {
+		options.IndicatorPosition = position
+	}
+}
+
+// WithPagerState links the indicator to a pager with a page per tab: the
+// indicator follows the pager as it is swiped. Select the current page, and
+// scroll to the page of a tab when it is clicked:
+//
+//	tab.TabRow(state.CurrentPage(), func(c tab.Composer) tab.Composer {
+//		for i, title := range titles {
+//			tab.Tab(state.CurrentPage() == i, func() { state.AnimateScrollToPage(i) }, text.Text(title))(c)
+//		}
+//		return c
+//	}, tab.WithPagerState(state))
+func WithPagerState(state *pager.PagerState) TabRowOption {
+	return WithIndicatorPosition(func() float32 {
+		return float32(state.CurrentPage()) + state.CurrentPageOffsetFraction()
+	})
+}
+
 // DefaultTabRowOptions returns the default options for TabRow.
 func DefaultTabRowOptions() TabRowOptions {
 	return TabRowOptions{
@@ -0,0 +1,54 @@
+package tab
+
+import (
+	"image"
+	"testing"
+
+	"github.com/zodimo/go-compose/compose"
+	"github.com/zodimo/go-compose/compose/foundation/layout/box"
+	uilayout "github.com/zodimo/go-compose/compose/ui/layout"
+	"github.com/zodimo/go-compose/modifiers/size"
+	"github.com/zodimo/go-compose/runtime"
+	"github.com/zodimo/go-compose/state"
+	"github.com/zodimo/go-compose/store"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/unit"
+)
+
+func TestTabRow_Indicator(t *testing.T) {
+	empty := func(c Composer) Composer { return c }
+	for _, tt := range []struct {
+		options []TabRowOption
+		want    image.Rectangle
+	}{
+		{nil, image.Rect(100, 47, 200, 50)},
+		{[]TabRowOption{WithIndicatorPosition(func() float32 { return 1.5 })}, image.Rect(150, 47, 250, 50)},
+	} {
+		var got image.Rectangle
+		indicator := box.Box(empty, box.WithModifier(size.FillMaxWidth().Then(size.Height(3)).Then(
+			uilayout.OnGloballyPositioned(func(coordinates uilayout.LayoutCoordinates) {
+				b := coordinates.BoundsInWindow()
+				got = image.Rect(int(b.Left), int(b.Top), int(b.Right), int(b.Bottom))
+			}),
+		)))
+		tabs := func(c Composer) Composer {
+			for i := 0; i < 3; i++ {
+				box.Box(empty, box.WithModifier(size.Height(50)))(c)
+			}
+			return c
+		}
+		c := compose.NewComposer(store.NewPersistentState(map[string]state.MutableValue{}))
+		node := TabRow(1, tabs, append([]TabRowOption{WithIndicator(indicator)}, tt.options...)...)(c).Build()
+		gtx := layout.Context{
+			Ops:         new(op.Ops),
+			Constraints: layout.Constraints{Max: image.Pt(300, 400)},
+			Metric:      unit.Metric{PxPerDp: 1, PxPerSp: 1},
+		}
+		runtime.NewRuntime().Run(gtx, node).Add(gtx.Ops)
+		if got != tt.want {
+			t.Errorf("indicator bounds = %v, want %v", got, tt.want)
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/zodimo/go-compose/compose/ui"
 	"github.com/zodimo/go-compose/compose/ui/graphics"
 	"github.com/zodimo/go-compose/internal/layoutnode"
+	"github.com/zodimo/go-compose/modifiers/focus"
 	"github.com/zodimo/go-compose/pkg/floatutils/lerp"
 	"github.com/zodimo/go-compose/pkg/sentinel"
 
@@ -222,7 +223,9 @@ func filledTextFieldWidgetConstructor(
 			w.Colors = opts.Colors
 
 			// 4. Layout
-			return w.Layout(gtx, th, opts.Label)
+			return focus.Target(gtx, &w.Editor, func(gtx layout.Context) layout.Dimensions {
+				return w.Layout(gtx, th, opts.Label)
+			})
 		}
 	})
 }
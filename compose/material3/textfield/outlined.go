@@ -10,6 +10,7 @@ import (
 	"github.com/zodimo/go-compose/compose/ui"
 	"github.com/zodimo/go-compose/compose/ui/graphics"
 	"github.com/zodimo/go-compose/internal/layoutnode"
+	"github.com/zodimo/go-compose/modifiers/focus"
 	"github.com/zodimo/go-compose/pkg/floatutils/lerp"
 	"github.com/zodimo/go-compose/pkg/sentinel"
 
@@ -230,7 +231,9 @@ func outlinedTextFieldWidgetConstructor(
 
 			w.Colors = opts.Colors
 
-			return w.Layout(gtx, th, opts.Label)
+			return focus.Target(gtx, &w.Editor, func(gtx layout.Context) layout.Dimensions {
+				return w.Layout(gtx, th, opts.Label)
+			})
 		}
 	})
 }
@@ -0,0 +1,467 @@
+package timepicker
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/zodimo/go-compose/compose"
+	"github.com/zodimo/go-compose/compose/foundation/layout/box"
+	ftext "github.com/zodimo/go-compose/compose/foundation/text"
+	"github.com/zodimo/go-compose/compose/material3/text"
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+	"github.com/zodimo/go-compose/compose/ui/graphics"
+	uilayout "github.com/zodimo/go-compose/compose/ui/layout"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+	"github.com/zodimo/go-compose/internal/layoutnode"
+	"github.com/zodimo/go-compose/modifiers/focus"
+	"github.com/zodimo/go-compose/modifiers/key"
+	"github.com/zodimo/go-compose/modifiers/pointer"
+	"github.com/zodimo/go-compose/modifiers/size"
+
+	"gioui.org/f32"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+)
+
+// Sizes of the clock dial, in dp.
+const (
+	// outerRadius and innerRadius are the distances from the center of the
+	// dial to the centers of its numbers; the inner ring holds the hours
+	// from 13 to 00 of the 24 hour format.
+	outerRadius = 101
+	innerRadius = 69
+	// selectorSize is the diameter of the circle at the end of the hand,
+	// and of the numbers of the dial.
+	selectorSize = 48
+	// trackWidth is the width of the hand and centerSize the diameter of
+	// the dot at its base.
+	trackWidth = 2
+	centerSize = 8
+	// dotSize is the diameter of the dot the selector shows on minutes
+	// between two numbers.
+	dotSize = 4
+)
+
+// handAnimationDuration is how long the hand takes to move to a new value.
+const handAnimationDuration = 200 * time.Millisecond
+
+// dialNumber is a number of the clock dial.
+type dialNumber struct {
+	// value is the hour, as shown, or the minute the number picks.
+	value int
+	label string
+	// angle is the angle of the number, in radians clockwise from 12
+	// o'clock.
+	angle float64
+	inner bool
+}
+
+// dialNumbers returns the numbers of the clock dial for selection.
+func dialNumbers(selection TimePickerSelectionMode, is24Hour bool) []dialNumber {
+	var numbers []dialNumber
+	for i := range 12 {
+		angle := float64(i) * 2 * math.Pi / 12
+		if selection == TimePickerSelectionModeMinute {
+			numbers = append(numbers, dialNumber{value: i * 5, label: fmt.Sprintf("%02d", i*5), angle: angle})
+			continue
+		}
+		hour := i
+		if hour == 0 {
+			hour = 12
+		}
+		numbers = append(numbers, dialNumber{value: hour, label: strconv.Itoa(hour), angle: angle})
+		if is24Hour {
+			inner := 0
+			if i > 0 {
+				inner = i + 12
+			}
+			numbers = append(numbers, dialNumber{value: inner, label: fmt.Sprintf("%02d", inner), angle: angle, inner: true})
+		}
+	}
+	return numbers
+}
+
+// dialValue returns the value of s the clock dial shows, which its numbers
+// are compared with.
+func (s *TimePickerState) dialValue() int {
+	if s.Selection() == TimePickerSelectionModeMinute {
+		return s.Minute()
+	}
+	return s.displayHour()
+}
+
+// dialPosition returns the angle and the ring of the value of s on the
+// clock dial.
+func (s *TimePickerState) dialPosition() (angle float64, inner bool) {
+	if s.Selection() == TimePickerSelectionModeMinute {
+		return float64(s.Minute()) * 2 * math.Pi / 60, false
+	}
+	hour := s.Hour()
+	return float64(hour%12) * 2 * math.Pi / 12, s.Is24Hour() && (hour == 0 || hour > 12)
+}
+
+// setDialPosition picks the value at angle on the clock dial, in the inner
+// ring when inner is set and the dial shows the hours of the 24 hour format.
+func (s *TimePickerState) setDialPosition(angle float64, inner bool) {
+	if s.Selection() == TimePickerSelectionModeMinute {
+		s.SetMinute(int(math.Round(angle*60/(2*math.Pi))) % 60)
+		return
+	}
+	i := int(math.Round(angle*12/(2*math.Pi))) % 12
+	switch {
+	case s.Is24Hour() && inner:
+		if i > 0 {
+			i += 12
+		}
+		s.SetHour(i)
+	case s.Is24Hour():
+		if i == 0 {
+			i = 12
+		}
+		s.SetHour(i)
+	default:
+		if i == 0 {
+			i = 12
+		}
+		s.setDisplayHour(i)
+	}
+}
+
+// step moves the value of the clock dial by delta hours or minutes, keeping
+// the period of the hour in the 12 hour format.
+func (s *TimePickerState) step(delta int) {
+	switch {
+	case s.Selection() == TimePickerSelectionModeMinute:
+		s.SetMinute(((s.Minute()+delta)%60 + 60) % 60)
+	case s.Is24Hour():
+		s.SetHour(((s.Hour()+delta)%24 + 24) % 24)
+	default:
+		s.setDisplayHour(((s.displayHour()-1+delta)%12+12)%12 + 1)
+	}
+}
+
+// setDialValue picks value as typed on the keyboard, and reports whether it
+// is valid.
+func (s *TimePickerState) setDialValue(value int) bool {
+	if s.Selection() == TimePickerSelectionModeMinute {
+		if value > 59 {
+			return false
+		}
+		s.SetMinute(value)
+		return true
+	}
+	return s.setDisplayHour(value)
+}
+
+// angleOf returns the angle of offset from the center of a dial of size, in
+// radians clockwise from 12 o'clock, and its distance to the center.
+func angleOf(offset geometry.Offset, size unit.IntSize) (angle, distance float64) {
+	dx := float64(offset.X()) - float64(size.Width)/2
+	dy := float64(offset.Y()) - float64(size.Height)/2
+	angle = math.Atan2(dx, -dy)
+	if angle < 0 {
+		angle += 2 * math.Pi
+	}
+	return angle, math.Hypot(dx, dy)
+}
+
+// angleDelta returns the shortest rotation from the angle from to the angle
+// to, in (-π, π].
+func angleDelta(from, to float64) float64 {
+	d := math.Mod(to-from+math.Pi, 2*math.Pi)
+	if d < 0 {
+		d += 2 * math.Pi
+	}
+	return d - math.Pi
+}
+
+// clockHand is the hand of a clock dial: it follows the pointer while the
+// user drags it, and otherwise moves to the value of the state in a short
+// animation.
+type clockHand struct {
+	state *TimePickerState
+
+	dragging  bool
+	dragAngle float64
+	dragInner bool
+
+	// angle and radius, in dp, are where the hand was drawn last.
+	angle, radius float64
+	// The animation runs from fromAngle and fromRadius to toAngle and
+	// toRadius, since start.
+	fromAngle, fromRadius float64
+	toAngle, toRadius     float64
+	start                 time.Time
+	started               bool
+
+	// typed holds the digits typed on the keyboard for the current value.
+	typed string
+}
+
+// position returns where to draw the hand at now, moving to angle and
+// radius, and whether it is still moving.
+func (h *clockHand) position(now time.Time, angle, radius float64) (float64, float64, bool) {
+	if !h.started || h.dragging {
+		h.started = true
+		h.angle, h.radius = angle, radius
+		h.fromAngle, h.fromRadius = angle, radius
+		h.toAngle, h.toRadius = angle, radius
+		return angle, radius, false
+	}
+	if angle != h.toAngle || radius != h.toRadius {
+		h.fromAngle, h.fromRadius = h.angle, h.radius
+		h.toAngle, h.toRadius = angle, radius
+		h.start = now
+	}
+	p := float64(now.Sub(h.start)) / float64(handAnimationDuration)
+	if p >= 1 {
+		h.angle, h.radius = angle, radius
+		return angle, radius, false
+	}
+	// Decelerate, as FastOutSlowIn does.
+	e := 1 - math.Pow(1-p, 3)
+	h.angle = h.fromAngle + angleDelta(h.fromAngle, h.toAngle)*e
+	h.radius = h.fromRadius + (h.toRadius-h.fromRadius)*e
+	return h.angle, h.radius, true
+}
+
+// drag moves the hand to the pointer at offset, in a dial of size.
+func (h *clockHand) drag(offset geometry.Offset, size unit.IntSize, density unit.Density) {
+	angle, distance := angleOf(offset, size)
+	h.dragging = true
+	h.dragAngle = angle
+	h.dragInner = h.state.Is24Hour() && h.state.Selection() == TimePickerSelectionModeHour &&
+		distance < float64(density.DpToPx((outerRadius+innerRadius)/2))
+	h.typed = ""
+	h.state.setDialPosition(angle, h.dragInner)
+}
+
+// release ends a drag, moving on to the minutes once an hour is picked.
+func (h *clockHand) release() {
+	if !h.dragging {
+		return
+	}
+	h.dragging = false
+	if h.state.Selection() == TimePickerSelectionModeHour {
+		h.state.SetSelection(TimePickerSelectionModeMinute)
+	}
+}
+
+// onKey handles the keys pressed on the focused dial: the arrows step the
+// value, digits type it in and Enter moves on to the minutes.
+func (h *clockHand) onKey(e key.KeyEvent) bool {
+	if e.Type != key.KeyDown {
+		return false
+	}
+	s := h.state
+	switch e.Key {
+	case key.KeyUpArrow, key.KeyRightArrow:
+		h.typed = ""
+		s.step(1)
+	case key.KeyDownArrow, key.KeyLeftArrow:
+		h.typed = ""
+		s.step(-1)
+	case key.KeyReturn, key.KeyEnter:
+		h.typed = ""
+		if s.Selection() == TimePickerSelectionModeHour {
+			s.SetSelection(TimePickerSelectionModeMinute)
+		}
+	default:
+		if len(e.Key) != 1 || e.Key[0] < '0' || e.Key[0] > '9' {
+			return false
+		}
+		h.typeDigit(string(e.Key))
+	}
+	return true
+}
+
+// typeDigit adds digit to the value typed, or starts a new one with it when
+// the value would be invalid. Two digits complete an hour, which moves on to
+// the minutes.
+func (h *clockHand) typeDigit(digit string) {
+	s := h.state
+	typed := h.typed + digit
+	if n, _ := strconv.Atoi(typed); !s.setDialValue(n) {
+		typed = digit
+		if n, _ := strconv.Atoi(typed); !s.setDialValue(n) {
+			typed = ""
+		}
+	}
+	h.typed = typed
+	if len(typed) == 2 {
+		h.typed = ""
+		if s.Selection() == TimePickerSelectionModeHour {
+			s.SetSelection(TimePickerSelectionModeMinute)
+		}
+	}
+}
+
+// clockDial is the dial of a TimePicker: the hours or the minutes around a
+// hand the user drags or taps to pick one. It takes the keyboard focus, for
+// the keys of clockHand.onKey.
+func clockDial(s *TimePickerState, colors TimePickerColors) Composable {
+	return func(c Composer) Composer {
+		hand := c.State(fmt.Sprintf("timePickerClockDial-%v", c.GenerateID()), func() any {
+			return &clockHand{}
+		}).Get().(*clockHand)
+		hand.state = s
+
+		numbers := dialNumbers(s.Selection(), s.Is24Hour())
+		value := s.dialValue()
+		angle, inner := s.dialPosition()
+		radius := float64(outerRadius)
+		if inner {
+			radius = innerRadius
+		}
+		face := dialFace{
+			hand:   hand,
+			angle:  angle,
+			radius: radius,
+			dot:    s.Selection() == TimePickerSelectionModeMinute && s.Minute()%5 != 0,
+			colors: colors,
+		}
+
+		children := []Composable{face.composable()}
+		for _, number := range numbers {
+			color := colors.ClockDialUnselectedContentColor
+			if number.value == value && number.inner == inner {
+				color = colors.ClockDialSelectedContentColor
+			}
+			children = append(children, box.Box(
+				text.BodyLarge(number.label, ftext.WithColor(color)),
+				box.WithAlignment(box.Center),
+				box.WithModifier(size.Size(selectorSize, selectorSize)),
+			))
+		}
+
+		dialSize := int(TimePickerDefaults.ClockDialSize())
+		return uilayout.Layout(
+			compose.Sequence(children...),
+			uilayout.MeasurePolicyFunc(func(scope uilayout.MeasureScope, measurables []uilayout.Measurable, constraints unit.Constraints) uilayout.MeasureResult {
+				return measureClockDial(scope, measurables, numbers)
+			}),
+			uilayout.WithModifier(
+				size.Size(dialSize, dialSize).
+					Then(pointer.PointerInput(func(scope pointer.PointerInputScope) {
+						scope.OnPointerEvent(func(event *pointer.PointerEvent) {
+							change := event.ChangedPointer()
+							if change.IsConsumed() {
+								return
+							}
+							switch {
+							case change.Pressed:
+								if change.ChangedToDown() {
+									scope.Grab(change.ID)
+								}
+								hand.drag(change.Position, scope.Size(), scope.Density())
+								change.Consume()
+							case change.ChangedToUp():
+								hand.release()
+								change.Consume()
+							}
+						})
+						scope.OnCancel(hand.release)
+					}, hand)).
+					Then(key.OnKeyEvent(hand.onKey)).
+					Then(focus.Focusable()),
+			),
+		)(c)
+	}
+}
+
+// measureClockDial places the face of the dial, the first of measurables,
+// under the numbers, around its center.
+func measureClockDial(scope uilayout.MeasureScope, measurables []uilayout.Measurable, numbers []dialNumber) uilayout.MeasureResult {
+	dialSize := scope.DpRoundToPx(TimePickerDefaults.ClockDialSize())
+	face := measurables[0].Measure(unit.NewConstraints(dialSize, dialSize, dialSize, dialSize))
+	numberSize := scope.DpRoundToPx(selectorSize)
+	placeables := make([]*uilayout.Placeable, len(numbers))
+	for i, m := range measurables[1:] {
+		placeables[i] = m.Measure(unit.NewConstraints(numberSize, numberSize, numberSize, numberSize))
+	}
+	center := float64(dialSize) / 2
+	return scope.Layout(dialSize, dialSize, func() {
+		face.PlaceAt(0, 0)
+		for i, p := range placeables {
+			radius := float64(scope.DpToPx(outerRadius))
+			if numbers[i].inner {
+				radius = float64(scope.DpToPx(innerRadius))
+			}
+			// The dial is a clock, it is not mirrored in right to left
+			// layouts.
+			x := center + radius*math.Sin(numbers[i].angle) - float64(p.Width())/2
+			y := center - radius*math.Cos(numbers[i].angle) - float64(p.Height())/2
+			p.PlaceAt(int(math.Round(x)), int(math.Round(y)))
+		}
+	})
+}
+
+// dialFace draws the dial and the hand of a clock dial.
+type dialFace struct {
+	hand *clockHand
+	// angle and radius, in dp, are where the hand points to when not
+	// dragged.
+	angle, radius float64
+	// dot shows a dot in the selector, for minutes between two numbers.
+	dot    bool
+	colors TimePickerColors
+}
+
+func (f dialFace) composable() Composable {
+	return func(c Composer) Composer {
+		c.StartBlock("TimePickerClockDialFace")
+		c.SetWidgetConstructor(layoutnode.NewLayoutNodeWidgetConstructor(func(node layoutnode.LayoutNode) layoutnode.GioLayoutWidget {
+			return func(gtx layoutnode.LayoutContext) layoutnode.LayoutDimensions {
+				return f.layout(gtx)
+			}
+		}))
+		return c.EndBlock()
+	}
+}
+
+func (f dialFace) layout(gtx layout.Context) layout.Dimensions {
+	sz := gtx.Constraints.Min
+	paint.FillShape(gtx.Ops, graphics.ColorToNRGBA(f.colors.ClockDialColor), clip.Ellipse{Max: sz}.Op(gtx.Ops))
+
+	angle, radius, dot := f.angle, f.radius, f.dot
+	if f.hand.dragging {
+		angle, radius, dot = f.hand.dragAngle, outerRadius, false
+		if f.hand.dragInner {
+			radius = innerRadius
+		}
+	}
+	angle, radius, moving := f.hand.position(gtx.Now, angle, radius)
+	if moving {
+		gtx.Execute(op.InvalidateCmd{})
+	}
+
+	selectorColor := graphics.ColorToNRGBA(f.colors.SelectorColor)
+	center := f32.Pt(float32(sz.X)/2, float32(sz.Y)/2)
+	r := float64(gtx.Metric.PxPerDp) * radius
+	end := center.Add(f32.Pt(float32(r*math.Sin(angle)), float32(-r*math.Cos(angle))))
+
+	var track clip.Path
+	track.Begin(gtx.Ops)
+	track.MoveTo(center)
+	track.LineTo(end)
+	paint.FillShape(gtx.Ops, selectorColor, clip.Stroke{Path: track.End(), Width: float32(gtx.Dp(trackWidth))}.Op())
+
+	fillCircle(gtx, center, gtx.Dp(centerSize), selectorColor)
+	fillCircle(gtx, end, gtx.Dp(selectorSize), selectorColor)
+	if dot {
+		fillCircle(gtx, end, gtx.Dp(dotSize), graphics.ColorToNRGBA(f.colors.ClockDialSelectedContentColor))
+	}
+	return layout.Dimensions{Size: sz}
+}
+
+// fillCircle fills the circle of diameter at center.
+func fillCircle(gtx layout.Context, center f32.Point, diameter int, col color.NRGBA) {
+	min := image.Pt(int(math.Round(float64(center.X)))-diameter/2, int(math.Round(float64(center.Y)))-diameter/2)
+	paint.FillShape(gtx.Ops, col, clip.Ellipse{Min: min, Max: min.Add(image.Pt(diameter, diameter))}.Op(gtx.Ops))
+}
@@ -0,0 +1,205 @@
+package timepicker
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+	"github.com/zodimo/go-compose/modifiers/key"
+)
+
+func TestDialNumbers(t *testing.T) {
+	for _, tt := range []struct {
+		selection TimePickerSelectionMode
+		is24Hour  bool
+		count     int
+		first     string
+	}{
+		{TimePickerSelectionModeHour, false, 12, "12"},
+		{TimePickerSelectionModeHour, true, 24, "12"},
+		{TimePickerSelectionModeMinute, true, 12, "00"},
+	} {
+		numbers := dialNumbers(tt.selection, tt.is24Hour)
+		if len(numbers) != tt.count || numbers[0].label != tt.first {
+			t.Errorf("dialNumbers(%v, %v) = %d numbers from %q, want %d from %q",
+				tt.selection, tt.is24Hour, len(numbers), numbers[0].label, tt.count, tt.first)
+		}
+	}
+	if n := dialNumbers(TimePickerSelectionModeHour, true)[1]; n.label != "00" || !n.inner {
+		t.Errorf("inner number at 12 o'clock = %+v, want 00", n)
+	}
+}
+
+func TestSetDialPosition(t *testing.T) {
+	hour := func(h float64) float64 { return h * 2 * math.Pi / 12 }
+	for _, tt := range []struct {
+		is24Hour    bool
+		hour        int
+		angle       float64
+		inner       bool
+		want        int
+		wantAngle   float64
+		wantInInner bool
+	}{
+		{false, 9, hour(3), false, 3, hour(3), false},
+		{false, 21, hour(3.2), false, 15, hour(3), false},
+		{false, 21, hour(0), false, 12, hour(0), false},
+		{false, 9, hour(11.9), false, 0, hour(0), false},
+		{true, 9, hour(3), false, 3, hour(3), false},
+		{true, 9, hour(3), true, 15, hour(3), true},
+		{true, 9, hour(0), false, 12, hour(0), false},
+		{true, 9, hour(0), true, 0, hour(0), true},
+	} {
+		s := NewTimePickerState(tt.hour, 0, WithIs24Hour(tt.is24Hour))
+		s.setDialPosition(tt.angle, tt.inner)
+		if got := s.Hour(); got != tt.want {
+			t.Errorf("24h=%v from %d: hour at %.2f, inner %v = %d, want %d", tt.is24Hour, tt.hour, tt.angle, tt.inner, got, tt.want)
+		}
+		if angle, inner := s.dialPosition(); math.Abs(angle-tt.wantAngle) > 1e-9 || inner != tt.wantInInner {
+			t.Errorf("dialPosition of %d = %.2f, %v, want %.2f, %v", s.Hour(), angle, inner, tt.wantAngle, tt.wantInInner)
+		}
+	}
+
+	s := NewTimePickerState(9, 0)
+	s.SetSelection(TimePickerSelectionModeMinute)
+	s.setDialPosition(2*math.Pi*0.999, false)
+	if got := s.Minute(); got != 0 {
+		t.Errorf("minute just before 12 o'clock = %d, want 0", got)
+	}
+	s.setDialPosition(2*math.Pi*17/60, false)
+	if got := s.Minute(); got != 17 {
+		t.Errorf("minute at 17 = %d, want 17", got)
+	}
+}
+
+func TestStep(t *testing.T) {
+	s := NewTimePickerState(23, 59, WithIs24Hour(false))
+	s.step(1)
+	if got := s.Hour(); got != 12 {
+		t.Errorf("hour after 11 PM + 1 = %d, want 12, keeping PM", got)
+	}
+	s.step(-1)
+	if got := s.Hour(); got != 23 {
+		t.Errorf("hour after 12 PM - 1 = %d, want 23", got)
+	}
+	s.SetIs24Hour(true)
+	s.step(1)
+	if got := s.Hour(); got != 0 {
+		t.Errorf("hour after 23 + 1 = %d, want 0", got)
+	}
+	s.SetSelection(TimePickerSelectionModeMinute)
+	s.step(1)
+	if got := s.Minute(); got != 0 {
+		t.Errorf("minute after 59 + 1 = %d, want 0", got)
+	}
+	s.step(-1)
+	if got := s.Minute(); got != 59 {
+		t.Errorf("minute after 0 - 1 = %d, want 59", got)
+	}
+}
+
+func TestClockHand_Keys(t *testing.T) {
+	s := NewTimePickerState(9, 0, WithIs24Hour(true))
+	h := &clockHand{state: s}
+	press := func(name key.Key) bool { return h.onKey(key.KeyEvent{Type: key.KeyDown, Key: name}) }
+
+	press("1")
+	if s.Hour() != 1 || s.Selection() != TimePickerSelectionModeHour {
+		t.Errorf("after 1: hour %d, selection %v, want 1, Hour", s.Hour(), s.Selection())
+	}
+	press("7")
+	if s.Hour() != 17 || s.Selection() != TimePickerSelectionModeMinute {
+		t.Errorf("after 17: hour %d, selection %v, want 17, Minute", s.Hour(), s.Selection())
+	}
+	press("7")
+	press("5")
+	if got := s.Minute(); got != 5 {
+		t.Errorf("minute after 7, 5 = %d, want 5 as 75 is invalid", got)
+	}
+	press(key.KeyUpArrow)
+	if got := s.Minute(); got != 6 {
+		t.Errorf("minute after up = %d, want 6", got)
+	}
+	if press("A") {
+		t.Errorf("letter key was handled")
+	}
+	if h.onKey(key.KeyEvent{Type: key.KeyUp, Key: "1"}) {
+		t.Errorf("key release was handled")
+	}
+
+	s.SetSelection(TimePickerSelectionModeHour)
+	press(key.KeyReturn)
+	if got := s.Selection(); got != TimePickerSelectionModeMinute {
+		t.Errorf("selection after Enter = %v, want Minute", got)
+	}
+}
+
+func TestClockHand_Drag(t *testing.T) {
+	s := NewTimePickerState(9, 0, WithIs24Hour(true))
+	h := &clockHand{state: s}
+	size := unit.IntSize{Width: 256, Height: 256}
+	density := unit.NewDensity(1, 1)
+
+	// Near the center, right of it: the inner ring at 3 o'clock.
+	h.drag(geometry.NewOffset(128+60, 128), size, density)
+	if got := s.Hour(); got != 15 {
+		t.Errorf("hour dragged to the inner ring at 3 = %d, want 15", got)
+	}
+	// Below the center, on the outer ring.
+	h.drag(geometry.NewOffset(128, 128+100), size, density)
+	if got := s.Hour(); got != 6 {
+		t.Errorf("hour dragged to the outer ring at 6 = %d, want 6", got)
+	}
+	h.release()
+	if got := s.Selection(); got != TimePickerSelectionModeMinute {
+		t.Errorf("selection after release = %v, want Minute", got)
+	}
+}
+
+func TestAngle(t *testing.T) {
+	size := unit.IntSize{Width: 200, Height: 200}
+	for _, tt := range []struct {
+		x, y  float32
+		angle float64
+	}{
+		{100, 0, 0},
+		{200, 100, math.Pi / 2},
+		{100, 200, math.Pi},
+		{0, 100, 3 * math.Pi / 2},
+	} {
+		angle, distance := angleOf(geometry.NewOffset(tt.x, tt.y), size)
+		if math.Abs(angle-tt.angle) > 1e-6 || math.Abs(distance-100) > 1e-6 {
+			t.Errorf("angleOf(%v, %v) = %.3f, %.1f, want %.3f, 100", tt.x, tt.y, angle, distance, tt.angle)
+		}
+	}
+	if got := angleDelta(2*math.Pi-0.1, 0.1); math.Abs(got-0.2) > 1e-9 {
+		t.Errorf("angleDelta across 12 o'clock = %v, want 0.2", got)
+	}
+	if got := angleDelta(0.1, 2*math.Pi-0.1); math.Abs(got+0.2) > 1e-9 {
+		t.Errorf("angleDelta back across 12 o'clock = %v, want -0.2", got)
+	}
+}
+
+func TestClockHand_Animation(t *testing.T) {
+	h := &clockHand{}
+	start := time.Now()
+	if a, r, moving := h.position(start, 0, outerRadius); a != 0 || r != outerRadius || moving {
+		t.Fatalf("first position = %v, %v, %v, want the target at rest", a, r, moving)
+	}
+	target := 2 * math.Pi * 11 / 12
+	a, r, moving := h.position(start, target, innerRadius)
+	if !moving || a != 0 || r != outerRadius {
+		t.Errorf("position at the start = %v, %v, %v, want the old position, moving", a, r, moving)
+	}
+	a, _, moving = h.position(start.Add(handAnimationDuration/2), target, innerRadius)
+	// The hand turns back across 12 o'clock rather than around the dial.
+	if !moving || a >= 0 || a < target-2*math.Pi {
+		t.Errorf("angle half way = %v, moving %v, want between 0 and %v", a, moving, target-2*math.Pi)
+	}
+	a, r, moving = h.position(start.Add(handAnimationDuration), target, innerRadius)
+	if moving || a != target || r != innerRadius {
+		t.Errorf("position at the end = %v, %v, %v, want the target at rest", a, r, moving)
+	}
+}
@@ -0,0 +1,81 @@
+package timepicker
+
+import (
+	"github.com/zodimo/go-compose/compose/material3"
+	"github.com/zodimo/go-compose/compose/ui/graphics"
+	"github.com/zodimo/go-compose/compose/ui/graphics/shape"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+)
+
+// TimePickerColors represents the colors of the time pickers.
+type TimePickerColors struct {
+	ContainerColor                         graphics.Color
+	ClockDialColor                         graphics.Color
+	ClockDialSelectedContentColor          graphics.Color
+	ClockDialUnselectedContentColor        graphics.Color
+	SelectorColor                          graphics.Color
+	PeriodSelectorBorderColor              graphics.Color
+	PeriodSelectorSelectedContainerColor   graphics.Color
+	PeriodSelectorUnselectedContainerColor graphics.Color
+	PeriodSelectorSelectedContentColor     graphics.Color
+	PeriodSelectorUnselectedContentColor   graphics.Color
+	TimeSelectorSelectedContainerColor     graphics.Color
+	TimeSelectorUnselectedContainerColor   graphics.Color
+	TimeSelectorSelectedContentColor       graphics.Color
+	TimeSelectorUnselectedContentColor     graphics.Color
+}
+
+// periodSelectorColors returns the content and container colors of a
+// period.
+func (c TimePickerColors) periodSelectorColors(selected bool) (content, container graphics.Color) {
+	if selected {
+		return c.PeriodSelectorSelectedContentColor, c.PeriodSelectorSelectedContainerColor
+	}
+	return c.PeriodSelectorUnselectedContentColor, c.PeriodSelectorUnselectedContainerColor
+}
+
+// timeSelectorColors returns the content and container colors of the hour
+// or the minute.
+func (c TimePickerColors) timeSelectorColors(selected bool) (content, container graphics.Color) {
+	if selected {
+		return c.TimeSelectorSelectedContentColor, c.TimeSelectorSelectedContainerColor
+	}
+	return c.TimeSelectorUnselectedContentColor, c.TimeSelectorUnselectedContainerColor
+}
+
+// TimePickerDefaults holds the default values of the time pickers.
+var TimePickerDefaults = timePickerDefaults{}
+
+type timePickerDefaults struct{}
+
+// Colors returns the default TimePickerColors, from the theme.
+func (d timePickerDefaults) Colors(c Composer) TimePickerColors {
+	scheme := material3.Theme(c).ColorScheme()
+	return TimePickerColors{
+		ContainerColor:                         scheme.SurfaceContainerHigh,
+		ClockDialColor:                         scheme.SurfaceContainerHighest,
+		ClockDialSelectedContentColor:          scheme.OnPrimary,
+		ClockDialUnselectedContentColor:        scheme.OnSurface,
+		SelectorColor:                          scheme.Primary,
+		PeriodSelectorBorderColor:              scheme.Outline,
+		PeriodSelectorSelectedContainerColor:   scheme.TertiaryContainer,
+		PeriodSelectorUnselectedContainerColor: graphics.ColorTransparent,
+		PeriodSelectorSelectedContentColor:     scheme.OnTertiaryContainer,
+		PeriodSelectorUnselectedContentColor:   scheme.OnSurfaceVariant,
+		TimeSelectorSelectedContainerColor:     scheme.PrimaryContainer,
+		TimeSelectorUnselectedContainerColor:   scheme.SurfaceContainerHighest,
+		TimeSelectorSelectedContentColor:       scheme.OnPrimaryContainer,
+		TimeSelectorUnselectedContentColor:     scheme.OnSurface,
+	}
+}
+
+// ClockDialSize returns the diameter of the clock dial.
+func (d timePickerDefaults) ClockDialSize() unit.Dp {
+	return 256
+}
+
+// Shape returns the shape of the hour and minute selectors and of the
+// period selector.
+func (d timePickerDefaults) Shape() shape.Shape {
+	return &shape.RoundedCornerShape{Radius: unit.Dp(8)}
+}
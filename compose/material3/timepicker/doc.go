@@ -0,0 +1,16 @@
+/*
+Package timepicker contains Material 3 time pickers: TimePicker, to pick a
+time on a clock dial, and TimeInput, to type a time in. TimePickerDialog shows
+them in a dialog with a button that switches between the two:
+
+	state := timepicker.RememberTimePickerState(c, 9, 30)
+	timepicker.TimePickerDialog(onDismiss, button.Text(onConfirm, "OK"), state)
+
+The 12 or 24 hour format follows the locale of platform.LocalLocale unless
+WithIs24Hour sets it. The dial hand can be dragged, and once the dial has
+focus the arrow keys step the hour or the minute and digits type them in.
+
+Reference: [Time pickers](https://m3.material.io/components/time-pickers/overview)
+Specs: [Time pickers Specs](https://m3.material.io/components/time-pickers/specs)
+*/
+package timepicker
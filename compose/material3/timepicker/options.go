@@ -0,0 +1,83 @@
+package timepicker
+
+import (
+	"github.com/zodimo/go-compose/compose/ui"
+)
+
+type TimePickerOptions struct {
+	Modifier ui.Modifier
+	Colors   TimePickerColors
+}
+
+type TimePickerOption func(*TimePickerOptions)
+
+func DefaultTimePickerOptions(c Composer) TimePickerOptions {
+	return TimePickerOptions{
+		Modifier: ui.EmptyModifier,
+		Colors:   TimePickerDefaults.Colors(c),
+	}
+}
+
+func WithModifier(m ui.Modifier) TimePickerOption {
+	return func(o *TimePickerOptions) {
+		o.Modifier = m
+	}
+}
+
+func WithColors(colors TimePickerColors) TimePickerOption {
+	return func(o *TimePickerOptions) {
+		o.Colors = colors
+	}
+}
+
+type TimePickerDialogOptions struct {
+	Modifier ui.Modifier
+	// Title replaces the title above the picker, "Select time" or "Enter
+	// time" by default.
+	Title         Composable
+	DismissButton Composable
+	// ShowModeToggle shows the button that switches between the clock dial
+	// and the text fields.
+	ShowModeToggle bool
+	// PickerOptions are passed to the TimePicker or TimeInput of the dialog.
+	PickerOptions []TimePickerOption
+}
+
+type TimePickerDialogOption func(*TimePickerDialogOptions)
+
+func DefaultTimePickerDialogOptions() TimePickerDialogOptions {
+	return TimePickerDialogOptions{
+		Modifier:       ui.EmptyModifier,
+		ShowModeToggle: true,
+	}
+}
+
+func WithDialogModifier(m ui.Modifier) TimePickerDialogOption {
+	return func(o *TimePickerDialogOptions) {
+		o.Modifier = m
+	}
+}
+
+func WithTitle(title Composable) TimePickerDialogOption {
+	return func(o *TimePickerDialogOptions) {
+		o.Title = title
+	}
+}
+
+func WithDismissButton(dismissButton Composable) TimePickerDialogOption {
+	return func(o *TimePickerDialogOptions) {
+		o.DismissButton = dismissButton
+	}
+}
+
+func WithShowModeToggle(show bool) TimePickerDialogOption {
+	return func(o *TimePickerDialogOptions) {
+		o.ShowModeToggle = show
+	}
+}
+
+func WithPickerOptions(options ...TimePickerOption) TimePickerDialogOption {
+	return func(o *TimePickerDialogOptions) {
+		o.PickerOptions = options
+	}
+}
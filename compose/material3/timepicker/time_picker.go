@@ -0,0 +1,222 @@
+package timepicker
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/zodimo/go-compose/compose"
+	"github.com/zodimo/go-compose/compose/foundation/layout/box"
+	"github.com/zodimo/go-compose/compose/foundation/layout/column"
+	"github.com/zodimo/go-compose/compose/foundation/layout/row"
+	"github.com/zodimo/go-compose/compose/foundation/layout/spacer"
+	ftext "github.com/zodimo/go-compose/compose/foundation/text"
+	"github.com/zodimo/go-compose/compose/material3/divider"
+	"github.com/zodimo/go-compose/compose/material3/surface"
+	"github.com/zodimo/go-compose/compose/material3/text"
+	"github.com/zodimo/go-compose/compose/material3/textfield"
+	"github.com/zodimo/go-compose/compose/ui/graphics"
+	"github.com/zodimo/go-compose/modifiers/background"
+	"github.com/zodimo/go-compose/modifiers/clickable"
+	"github.com/zodimo/go-compose/modifiers/size"
+	"github.com/zodimo/go-compose/state"
+)
+
+// Sizes of the hour and minute selectors and of the period selector, in dp.
+const (
+	timeSelectorWidth   = 96
+	timeSelectorHeight  = 80
+	timeInputHeight     = 72
+	separatorWidth      = 24
+	periodSelectorWidth = 52
+)
+
+// TimePicker lets the user pick a time on a clock dial, dragging or tapping
+// its hand to an hour and then to a minute. The hour and the minute above
+// the dial switch between them, and in the 12 hour format a period selector
+// picks AM or PM. Once focused, the dial also takes the arrow keys and typed
+// digits.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/TimePicker.kt
+func TimePicker(state *TimePickerState, options ...TimePickerOption) Composable {
+	return func(c Composer) Composer {
+		opts := DefaultTimePickerOptions(c)
+		for _, option := range options {
+			if option == nil {
+				continue
+			}
+			option(&opts)
+		}
+
+		return column.Column(
+			compose.Sequence(
+				clockDisplay(state, opts.Colors),
+				spacer.Height(36),
+				clockDial(state, opts.Colors),
+			),
+			column.WithAlignment(column.Middle),
+			column.WithModifier(opts.Modifier),
+		)(c)
+	}
+}
+
+// TimeInput lets the user type a time in, in an hour and a minute text
+// field, with a period selector in the 12 hour format.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/TimePicker.kt
+func TimeInput(state *TimePickerState, options ...TimePickerOption) Composable {
+	return func(c Composer) Composer {
+		opts := DefaultTimePickerOptions(c)
+		for _, option := range options {
+			if option == nil {
+				continue
+			}
+			option(&opts)
+		}
+
+		hourRange := "1 - 12"
+		if state.Is24Hour() {
+			hourRange = "0 - 23"
+		}
+		items := []Composable{
+			timeInputField("Hour", hourRange, state.Hour, state.displayHour, state.setDisplayHour),
+			timeSeparator(opts.Colors.TimeSelectorUnselectedContentColor),
+			timeInputField("Minute", "0 - 59", state.Minute, state.Minute, func(minute int) bool {
+				if minute < 0 || minute > 59 {
+					return false
+				}
+				state.SetMinute(minute)
+				return true
+			}),
+		}
+		if !state.Is24Hour() {
+			items = append(items, spacer.Width(12), periodSelector(state, timeInputHeight, opts.Colors))
+		}
+		return row.Row(
+			compose.Sequence(items...),
+			row.WithModifier(opts.Modifier),
+		)(c)
+	}
+}
+
+// clockDisplay shows the hour and the minute of a TimePicker, which switch
+// the dial between them, and the period selector in the 12 hour format.
+func clockDisplay(s *TimePickerState, colors TimePickerColors) Composable {
+	items := []Composable{
+		timeSelector(s.displayHour(), s.Selection() == TimePickerSelectionModeHour, func() {
+			s.SetSelection(TimePickerSelectionModeHour)
+		}, colors),
+		timeSeparator(colors.TimeSelectorUnselectedContentColor),
+		timeSelector(s.Minute(), s.Selection() == TimePickerSelectionModeMinute, func() {
+			s.SetSelection(TimePickerSelectionModeMinute)
+		}, colors),
+	}
+	if !s.Is24Hour() {
+		items = append(items, spacer.Width(12), periodSelector(s, timeSelectorHeight, colors))
+	}
+	return row.Row(compose.Sequence(items...), row.WithAlignment(row.Middle))
+}
+
+// timeSelector shows the hour or the minute of a TimePicker, highlighted
+// when the dial picks it.
+func timeSelector(value int, selected bool, onClick func(), colors TimePickerColors) Composable {
+	content, container := colors.timeSelectorColors(selected)
+	return surface.Surface(
+		text.DisplayLarge(fmt.Sprintf("%02d", value), ftext.WithColor(content)),
+		surface.WithShape(TimePickerDefaults.Shape()),
+		surface.WithColor(container),
+		surface.WithContentColor(content),
+		surface.WithAlignment(box.Center),
+		surface.WithModifier(size.Size(timeSelectorWidth, timeSelectorHeight).Then(clickable.OnClick(onClick))),
+	)
+}
+
+// timeSeparator is the colon between the hour and the minute.
+func timeSeparator(color graphics.Color) Composable {
+	return box.Box(
+		text.DisplayLarge(":", ftext.WithColor(color)),
+		box.WithAlignment(box.Center),
+		box.WithModifier(size.Width(separatorWidth)),
+	)
+}
+
+// periodSelector picks AM or PM, one above the other.
+func periodSelector(s *TimePickerState, height int, colors TimePickerColors) Composable {
+	period := func(label string, afternoon bool) Composable {
+		content, container := colors.periodSelectorColors(s.IsAfternoon() == afternoon)
+		return box.Box(
+			text.TitleMedium(label, ftext.WithColor(content)),
+			box.WithAlignment(box.Center),
+			box.WithModifier(
+				size.Size(periodSelectorWidth, height/2).
+					Then(background.Background(container)).
+					Then(clickable.OnClick(func() { s.SetAfternoon(afternoon) })),
+			),
+		)
+	}
+	return surface.Surface(
+		column.Column(compose.Sequence(
+			period("AM", false),
+			divider.Divider(divider.WithColor(colors.PeriodSelectorBorderColor)),
+			period("PM", true),
+		)),
+		surface.WithShape(TimePickerDefaults.Shape()),
+		surface.WithColor(colors.PeriodSelectorUnselectedContainerColor),
+		surface.WithBorder(1, colors.PeriodSelectorBorderColor),
+		surface.WithModifier(size.Size(periodSelectorWidth, height)),
+	)
+}
+
+// timeInput is the text typed in a field of a TimeInput, and the value of
+// the state it was typed for, which tells when the value changed elsewhere.
+type timeInput struct {
+	text  string
+	value int
+}
+
+// timeInputField lets the user type the hour or the minute in. value reads
+// the state the field shows as displayed, and setDisplayed sets the value
+// typed and reports whether it is valid.
+func timeInputField(label, valid string, value func() int, displayed func() int, setDisplayed func(int) bool) Composable {
+	return func(c Composer) Composer {
+		input := state.MustState(c, fmt.Sprintf("timeInputField-%v", c.GenerateID()), func() timeInput {
+			return timeInput{text: fmt.Sprintf("%02d", displayed()), value: value()}
+		})
+		current := input.Get()
+		if current.value != value() {
+			// The time was changed elsewhere, on the dial or by the period
+			// selector.
+			current = timeInput{text: fmt.Sprintf("%02d", displayed()), value: value()}
+			input.Set(current)
+		}
+
+		n, err := strconv.Atoi(current.text)
+		isError := err != nil || n != displayed()
+		supportingText := label
+		if isError {
+			supportingText = fmt.Sprintf("Enter a value from %s", valid)
+		}
+		return textfield.Outlined(
+			current.text,
+			func(text string) {
+				text = strings.Map(func(r rune) rune {
+					if r < '0' || r > '9' {
+						return -1
+					}
+					return r
+				}, text)
+				if len(text) > 2 {
+					text = text[len(text)-2:]
+				}
+				if n, err := strconv.Atoi(text); err == nil {
+					setDisplayed(n)
+				}
+				input.Set(timeInput{text: text, value: value()})
+			},
+			textfield.WithSupportingText(supportingText),
+			textfield.WithError(isError),
+			textfield.WithSingleLine(true),
+			textfield.WithModifier(size.Size(timeSelectorWidth, timeInputHeight)),
+		)(c)
+	}
+}
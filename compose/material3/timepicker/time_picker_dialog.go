@@ -0,0 +1,101 @@
+package timepicker
+
+import (
+	"github.com/zodimo/go-compose/compose"
+	"github.com/zodimo/go-compose/compose/foundation/layout/box"
+	"github.com/zodimo/go-compose/compose/foundation/layout/column"
+	"github.com/zodimo/go-compose/compose/foundation/layout/row"
+	"github.com/zodimo/go-compose/compose/foundation/layout/spacer"
+	ftext "github.com/zodimo/go-compose/compose/foundation/text"
+	"github.com/zodimo/go-compose/compose/material3"
+	"github.com/zodimo/go-compose/compose/material3/dialog"
+	"github.com/zodimo/go-compose/compose/material3/iconbutton"
+	"github.com/zodimo/go-compose/compose/material3/text"
+	"github.com/zodimo/go-compose/modifiers/size"
+	"github.com/zodimo/go-compose/modifiers/weight"
+
+	mdicons "golang.org/x/exp/shiny/materialdesign/icons"
+)
+
+// TimePickerDialog shows a TimePicker, or a TimeInput when the display mode
+// of state is DisplayModeInput, in a dialog with a title, a confirm button
+// and an optional dismiss button. A button next to them switches between
+// the two:
+//
+//	c.When(showPicker.Get(),
+//		timepicker.TimePickerDialog(
+//			onDismiss,
+//			button.Text(onConfirm, "OK"),
+//			state,
+//			timepicker.WithDismissButton(button.Text(onDismiss, "Cancel")),
+//		),
+//	)
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/TimePickerDialog.kt
+func TimePickerDialog(
+	onDismissRequest func(),
+	confirmButton Composable,
+	state *TimePickerState,
+	options ...TimePickerDialogOption,
+) Composable {
+	return func(c Composer) Composer {
+		opts := DefaultTimePickerDialogOptions()
+		for _, option := range options {
+			if option == nil {
+				continue
+			}
+			option(&opts)
+		}
+
+		title, picker := "Select time", TimePicker(state, opts.PickerOptions...)
+		if state.DisplayMode() == DisplayModeInput {
+			title, picker = "Enter time", TimeInput(state, opts.PickerOptions...)
+		}
+		if opts.Title == nil {
+			opts.Title = text.LabelMedium(title, ftext.WithColor(material3.Theme(c).ColorScheme().OnSurfaceVariant))
+		}
+
+		var buttons []Composable
+		if opts.DismissButton != nil {
+			buttons = append(buttons, opts.DismissButton)
+		}
+		if confirmButton != nil {
+			buttons = append(buttons, confirmButton)
+		}
+		modeToggle := compose.Id()
+		if opts.ShowModeToggle {
+			modeToggle = timePickerModeToggle(state)
+		}
+		bottomRow := []Composable{modeToggle, box.Box(compose.Id(), box.WithModifier(weight.Weight(1)))}
+		if len(buttons) > 0 {
+			bottomRow = append(bottomRow, dialog.DialogButtonRow(buttons...))
+		}
+
+		return dialog.BasicAlertDialog(
+			onDismissRequest,
+			column.Column(
+				compose.Sequence(
+					box.Box(opts.Title, box.WithModifier(size.FillMaxWidth())),
+					spacer.Height(20),
+					box.Box(picker, box.WithAlignment(box.Center), box.WithModifier(size.FillMaxWidth())),
+					spacer.Height(24),
+					row.Row(
+						compose.Sequence(bottomRow...),
+						row.WithAlignment(row.Middle),
+						row.WithModifier(size.FillMaxWidth()),
+					),
+				),
+				column.WithModifier(opts.Modifier),
+			),
+		)(c)
+	}
+}
+
+// timePickerModeToggle switches a TimePickerDialog between the clock dial
+// and the text fields.
+func timePickerModeToggle(s *TimePickerState) Composable {
+	if s.DisplayMode() == DisplayModePicker {
+		return iconbutton.Standard(func() { s.SetDisplayMode(DisplayModeInput) }, mdicons.HardwareKeyboard, "Switch to text input mode")
+	}
+	return iconbutton.Standard(func() { s.SetDisplayMode(DisplayModePicker) }, mdicons.ActionSchedule, "Switch to clock input mode")
+}
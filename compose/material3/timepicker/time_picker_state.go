@@ -0,0 +1,285 @@
+package timepicker
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zodimo/go-compose/compose/ui/platform"
+	"github.com/zodimo/go-compose/state"
+
+	"gioui.org/io/system"
+)
+
+// TimePickerSelectionMode is the part of the time the clock dial changes.
+type TimePickerSelectionMode int
+
+const (
+	TimePickerSelectionModeHour TimePickerSelectionMode = iota
+	TimePickerSelectionModeMinute
+)
+
+func (m TimePickerSelectionMode) String() string {
+	switch m {
+	case TimePickerSelectionModeHour:
+		return "Hour"
+	case TimePickerSelectionModeMinute:
+		return "Minute"
+	default:
+		return fmt.Sprintf("TimePickerSelectionMode(%d)", int(m))
+	}
+}
+
+// DisplayMode is how a TimePickerDialog lets the user pick the time.
+type DisplayMode int
+
+const (
+	// DisplayModePicker shows the clock dial of TimePicker.
+	DisplayModePicker DisplayMode = iota
+	// DisplayModeInput shows the text fields of TimeInput.
+	DisplayModeInput
+)
+
+func (m DisplayMode) String() string {
+	switch m {
+	case DisplayModePicker:
+		return "Picker"
+	case DisplayModeInput:
+		return "Input"
+	default:
+		return fmt.Sprintf("DisplayMode(%d)", int(m))
+	}
+}
+
+type TimePickerStateOptions struct {
+	// Is24Hour shows the hours from 0 to 23 instead of from 1 to 12 with a
+	// period selector. It defaults to the format of Locale.
+	Is24Hour           bool
+	InitialDisplayMode DisplayMode
+}
+
+type TimePickerStateOption func(*TimePickerStateOptions)
+
+func DefaultTimePickerStateOptions() TimePickerStateOptions {
+	return TimePickerStateOptions{
+		Is24Hour:           is24HourFormat(defaultLocale),
+		InitialDisplayMode: DisplayModePicker,
+	}
+}
+
+// WithIs24Hour sets the hour format, instead of the one of the locale.
+func WithIs24Hour(is24Hour bool) TimePickerStateOption {
+	return func(o *TimePickerStateOptions) {
+		o.Is24Hour = is24Hour
+	}
+}
+
+// WithLocale sets the hour format to the one of locale.
+func WithLocale(locale system.Locale) TimePickerStateOption {
+	return func(o *TimePickerStateOptions) {
+		o.Is24Hour = is24HourFormat(locale)
+	}
+}
+
+func WithInitialDisplayMode(mode DisplayMode) TimePickerStateOption {
+	return func(o *TimePickerStateOptions) {
+		o.InitialDisplayMode = mode
+	}
+}
+
+// defaultLocale is the locale of states created outside of a composition,
+// the default of platform.LocalLocale.
+var defaultLocale = system.Locale{Language: "en-US", Direction: system.LTR}
+
+// twelveHourRegions are the regions using a 12 hour clock, after CLDR.
+var twelveHourRegions = map[string]bool{
+	"US": true, "CA": true, "AU": true, "NZ": true, "IN": true, "PH": true, "PK": true,
+	"BD": true, "EG": true, "SA": true, "KR": true, "TW": true, "MY": true, "CO": true,
+}
+
+// is24HourFormat reports whether locale, whose Language is a BCP 47 tag
+// such as "en-US", writes times with a 24 hour clock. English without a
+// region is taken as American English.
+func is24HourFormat(locale system.Locale) bool {
+	parts := strings.FieldsFunc(locale.Language, func(r rune) bool { return r == '-' || r == '_' })
+	if len(parts) == 0 {
+		return false
+	}
+	region := ""
+	for _, part := range parts[1:] {
+		if len(part) == 2 {
+			region = strings.ToUpper(part)
+			break
+		}
+	}
+	if region == "" && strings.EqualFold(parts[0], "en") {
+		region = "US"
+	}
+	return !twelveHourRegions[region]
+}
+
+func timePickerStateOptions(options []TimePickerStateOption, locale system.Locale) TimePickerStateOptions {
+	opts := DefaultTimePickerStateOptions()
+	opts.Is24Hour = is24HourFormat(locale)
+	for _, option := range options {
+		if option == nil {
+			continue
+		}
+		option(&opts)
+	}
+	return opts
+}
+
+// TimePickerState holds the time picked in a TimePicker, a TimeInput or a
+// TimePickerDialog. Hours go from 0 to 23 whatever the hour format.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3/src/commonMain/kotlin/androidx/compose/material3/TimePicker.kt
+type TimePickerState struct {
+	hour        state.MutableValueTyped[int]
+	minute      state.MutableValueTyped[int]
+	is24Hour    state.MutableValueTyped[bool]
+	selection   state.MutableValueTyped[TimePickerSelectionMode]
+	displayMode state.MutableValueTyped[DisplayMode]
+}
+
+func checkTime(hour, minute int) {
+	if hour < 0 || hour > 23 {
+		panic(fmt.Sprintf("TimePickerState: hour %d is not in 0..23", hour))
+	}
+	if minute < 0 || minute > 59 {
+		panic(fmt.Sprintf("TimePickerState: minute %d is not in 0..59", minute))
+	}
+}
+
+// NewTimePickerState creates a TimePickerState at initialHour, from 0 to
+// 23, and initialMinute. Use RememberTimePickerState to keep it across
+// recompositions.
+func NewTimePickerState(initialHour, initialMinute int, options ...TimePickerStateOption) *TimePickerState {
+	checkTime(initialHour, initialMinute)
+	opts := timePickerStateOptions(options, defaultLocale)
+	return &TimePickerState{
+		hour:        state.MutableStateOf(initialHour),
+		minute:      state.MutableStateOf(initialMinute),
+		is24Hour:    state.MutableStateOf(opts.Is24Hour),
+		selection:   state.MutableStateOf(TimePickerSelectionModeHour),
+		displayMode: state.MutableStateOf(opts.InitialDisplayMode),
+	}
+}
+
+// RememberTimePickerState returns a TimePickerState that survives
+// recompositions, in the hour format of platform.LocalLocale unless
+// WithIs24Hour sets one. Changes to it schedule a new frame.
+func RememberTimePickerState(c Composer, initialHour, initialMinute int, options ...TimePickerStateOption) *TimePickerState {
+	checkTime(initialHour, initialMinute)
+	opts := timePickerStateOptions(options, platform.LocalLocale.Current(c))
+	key := fmt.Sprintf("timePickerState-%v", c.GenerateID())
+	hour := state.MustState(c, key+"/hour", func() int { return initialHour })
+	minute := state.MustState(c, key+"/minute", func() int { return initialMinute })
+	is24Hour := state.MustState(c, key+"/is24Hour", func() bool { return opts.Is24Hour })
+	selection := state.MustState(c, key+"/selection", func() TimePickerSelectionMode { return TimePickerSelectionModeHour })
+	displayMode := state.MustState(c, key+"/displayMode", func() DisplayMode { return opts.InitialDisplayMode })
+	return c.State(key, func() any {
+		return &TimePickerState{hour: hour, minute: minute, is24Hour: is24Hour, selection: selection, displayMode: displayMode}
+	}).Get().(*TimePickerState)
+}
+
+// Hour returns the hour picked, from 0 to 23.
+func (s *TimePickerState) Hour() int {
+	return s.hour.Get()
+}
+
+// SetHour picks hour, from 0 to 23. Other hours are ignored.
+func (s *TimePickerState) SetHour(hour int) {
+	if hour < 0 || hour > 23 {
+		return
+	}
+	s.hour.Set(hour)
+}
+
+// Minute returns the minute picked.
+func (s *TimePickerState) Minute() int {
+	return s.minute.Get()
+}
+
+// SetMinute picks minute, from 0 to 59. Other minutes are ignored.
+func (s *TimePickerState) SetMinute(minute int) {
+	if minute < 0 || minute > 59 {
+		return
+	}
+	s.minute.Set(minute)
+}
+
+// Is24Hour reports whether the hours go from 0 to 23, instead of from 1 to
+// 12 with a period selector.
+func (s *TimePickerState) Is24Hour() bool {
+	return s.is24Hour.Get()
+}
+
+func (s *TimePickerState) SetIs24Hour(is24Hour bool) {
+	s.is24Hour.Set(is24Hour)
+}
+
+// IsAfternoon reports whether the hour picked is PM.
+func (s *TimePickerState) IsAfternoon() bool {
+	return s.Hour() >= 12
+}
+
+// SetAfternoon moves the hour picked to PM, or to AM, keeping it on the
+// same clock position.
+func (s *TimePickerState) SetAfternoon(afternoon bool) {
+	if afternoon != s.IsAfternoon() {
+		s.hour.Set((s.Hour() + 12) % 24)
+	}
+}
+
+// Selection returns whether the clock dial changes the hour or the minute.
+func (s *TimePickerState) Selection() TimePickerSelectionMode {
+	return s.selection.Get()
+}
+
+func (s *TimePickerState) SetSelection(selection TimePickerSelectionMode) {
+	s.selection.Set(selection)
+}
+
+// DisplayMode returns whether a TimePickerDialog shows the clock dial or
+// the text fields.
+func (s *TimePickerState) DisplayMode() DisplayMode {
+	return s.displayMode.Get()
+}
+
+func (s *TimePickerState) SetDisplayMode(mode DisplayMode) {
+	s.displayMode.Set(mode)
+}
+
+// displayHour returns the hour as shown: from 1 to 12 in the 12 hour
+// format.
+func (s *TimePickerState) displayHour() int {
+	hour := s.Hour()
+	if s.Is24Hour() {
+		return hour
+	}
+	if hour%12 == 0 {
+		return 12
+	}
+	return hour % 12
+}
+
+// setDisplayHour picks the hour shown as hour, keeping the period in the 12
+// hour format. It reports whether hour is valid in the format.
+func (s *TimePickerState) setDisplayHour(hour int) bool {
+	if s.Is24Hour() {
+		if hour < 0 || hour > 23 {
+			return false
+		}
+		s.SetHour(hour)
+		return true
+	}
+	if hour < 1 || hour > 12 {
+		return false
+	}
+	hour %= 12
+	if s.IsAfternoon() {
+		hour += 12
+	}
+	s.SetHour(hour)
+	return true
+}
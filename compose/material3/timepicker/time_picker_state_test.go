@@ -0,0 +1,98 @@
+package timepicker
+
+import (
+	"testing"
+
+	"github.com/zodimo/go-compose/compose"
+	"github.com/zodimo/go-compose/compose/ui/platform"
+	"github.com/zodimo/go-compose/state"
+	"github.com/zodimo/go-compose/store"
+
+	"gioui.org/io/system"
+)
+
+func TestIs24HourFormat(t *testing.T) {
+	for _, tt := range []struct {
+		language string
+		want     bool
+	}{
+		{"en-US", false},
+		{"en", false},
+		{"en-GB", true},
+		{"de-DE", true},
+		{"fr", true},
+		{"ko-KR", false},
+		{"zh-Hant-TW", false},
+		{"", false},
+	} {
+		if got := is24HourFormat(system.Locale{Language: tt.language}); got != tt.want {
+			t.Errorf("is24HourFormat(%q) = %v, want %v", tt.language, got, tt.want)
+		}
+	}
+}
+
+func TestTimePickerState(t *testing.T) {
+	s := NewTimePickerState(0, 30)
+	if s.Is24Hour() {
+		t.Errorf("Is24Hour of en-US = true, want false")
+	}
+	if got := s.displayHour(); got != 12 {
+		t.Errorf("displayHour of 0:30 = %d, want 12", got)
+	}
+	s.SetAfternoon(true)
+	if got := s.Hour(); got != 12 {
+		t.Errorf("Hour after SetAfternoon(true) = %d, want 12", got)
+	}
+	if !s.setDisplayHour(5) || s.Hour() != 17 {
+		t.Errorf("Hour after setDisplayHour(5) in the afternoon = %d, want 17", s.Hour())
+	}
+	if s.setDisplayHour(13) || s.Hour() != 17 {
+		t.Errorf("setDisplayHour(13) in the 12 hour format was accepted, hour = %d", s.Hour())
+	}
+	s.SetAfternoon(false)
+	if got := s.Hour(); got != 5 {
+		t.Errorf("Hour after SetAfternoon(false) = %d, want 5", got)
+	}
+
+	s.SetHour(24)
+	s.SetMinute(60)
+	if s.Hour() != 5 || s.Minute() != 30 {
+		t.Errorf("time after invalid values = %d:%d, want 5:30", s.Hour(), s.Minute())
+	}
+
+	s = NewTimePickerState(13, 0, WithLocale(system.Locale{Language: "de-DE"}))
+	if !s.Is24Hour() {
+		t.Errorf("Is24Hour of de-DE = false, want true")
+	}
+	if got := s.displayHour(); got != 13 {
+		t.Errorf("displayHour of 13:00 in the 24 hour format = %d, want 13", got)
+	}
+}
+
+func TestNewTimePickerState_InvalidTime(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("NewTimePickerState(24, 0) did not panic")
+		}
+	}()
+	NewTimePickerState(24, 0)
+}
+
+func TestRememberTimePickerState(t *testing.T) {
+	store := store.NewPersistentState(map[string]state.MutableValue{})
+	var states []*TimePickerState
+	for range 2 {
+		c := compose.NewComposer(store)
+		compose.CompositionLocalProvider1(platform.LocalLocale, system.Locale{Language: "de-DE"}, func(c Composer) Composer {
+			s := RememberTimePickerState(c, 9, 30)
+			states = append(states, s)
+			return TimePicker(s)(c)
+		})(c).Build()
+	}
+	if states[0] != states[1] {
+		t.Fatalf("RememberTimePickerState returned a new state on recomposition")
+	}
+	if !states[0].Is24Hour() {
+		t.Errorf("Is24Hour of LocalLocale de-DE = false, want true")
+	}
+}
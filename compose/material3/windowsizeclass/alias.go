@@ -0,0 +1,6 @@
+package windowsizeclass
+
+import "github.com/zodimo/go-compose/pkg/api"
+
+type Composable = api.Composable
+type Composer = api.Composer
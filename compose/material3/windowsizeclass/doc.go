@@ -0,0 +1,14 @@
+/*
+Package windowsizeclass classifies windows by their width and height, so that
+layouts adapt to the space they have rather than to devices:
+
+	windowsizeclass.CalculateWindowSizeClass(func(sizeClass windowsizeclass.WindowSizeClass) windowsizeclass.Composable {
+		if sizeClass.WidthSizeClass >= windowsizeclass.WindowWidthSizeClassExpanded {
+			return TwoPaneContent()
+		}
+		return OnePaneContent()
+	})
+
+Reference: [Window size classes](https://m3.material.io/foundations/layout/applying-layout/window-size-classes)
+*/
+package windowsizeclass
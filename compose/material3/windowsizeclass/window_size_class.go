@@ -0,0 +1,131 @@
+package windowsizeclass
+
+import (
+	"fmt"
+
+	"github.com/zodimo/go-compose/compose/foundation/layout/box"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+)
+
+// WindowWidthSizeClass is the class of the width of a window. The classes
+// are ordered from the narrowest to the widest.
+type WindowWidthSizeClass int
+
+const (
+	// WindowWidthSizeClassCompact is narrower than 600dp, like phones in
+	// portrait.
+	WindowWidthSizeClassCompact WindowWidthSizeClass = iota
+	// WindowWidthSizeClassMedium is 600dp to 840dp wide, like tablets in
+	// portrait.
+	WindowWidthSizeClassMedium
+	// WindowWidthSizeClassExpanded is 840dp to 1200dp wide, like tablets in
+	// landscape.
+	WindowWidthSizeClassExpanded
+	// WindowWidthSizeClassLarge is 1200dp to 1600dp wide, like desktops.
+	WindowWidthSizeClassLarge
+	// WindowWidthSizeClassExtraLarge is 1600dp wide or more, like wide
+	// desktop windows.
+	WindowWidthSizeClassExtraLarge
+)
+
+func (c WindowWidthSizeClass) String() string {
+	switch c {
+	case WindowWidthSizeClassCompact:
+		return "Compact"
+	case WindowWidthSizeClassMedium:
+		return "Medium"
+	case WindowWidthSizeClassExpanded:
+		return "Expanded"
+	case WindowWidthSizeClassLarge:
+		return "Large"
+	case WindowWidthSizeClassExtraLarge:
+		return "ExtraLarge"
+	default:
+		return fmt.Sprintf("WindowWidthSizeClass(%d)", int(c))
+	}
+}
+
+// WindowHeightSizeClass is the class of the height of a window. The classes
+// are ordered from the shortest to the tallest.
+type WindowHeightSizeClass int
+
+const (
+	// WindowHeightSizeClassCompact is shorter than 480dp, like phones in
+	// landscape.
+	WindowHeightSizeClassCompact WindowHeightSizeClass = iota
+	// WindowHeightSizeClassMedium is 480dp to 900dp high.
+	WindowHeightSizeClassMedium
+	// WindowHeightSizeClassExpanded is 900dp high or more.
+	WindowHeightSizeClassExpanded
+)
+
+func (c WindowHeightSizeClass) String() string {
+	switch c {
+	case WindowHeightSizeClassCompact:
+		return "Compact"
+	case WindowHeightSizeClassMedium:
+		return "Medium"
+	case WindowHeightSizeClassExpanded:
+		return "Expanded"
+	default:
+		return fmt.Sprintf("WindowHeightSizeClass(%d)", int(c))
+	}
+}
+
+// WindowSizeClass is the class of the width and height of a window.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/material3/material3-window-size-class/src/commonMain/kotlin/androidx/compose/material3/windowsizeclass/WindowSizeClass.kt
+type WindowSizeClass struct {
+	WidthSizeClass  WindowWidthSizeClass
+	HeightSizeClass WindowHeightSizeClass
+}
+
+func (c WindowSizeClass) String() string {
+	return fmt.Sprintf("WindowSizeClass(%v, %v)", c.WidthSizeClass, c.HeightSizeClass)
+}
+
+// CalculateFromSize returns the class of a window of size.
+func CalculateFromSize(size unit.DpSize) WindowSizeClass {
+	return WindowSizeClass{
+		WidthSizeClass:  widthSizeClass(size.Width),
+		HeightSizeClass: heightSizeClass(size.Height),
+	}
+}
+
+func widthSizeClass(width unit.Dp) WindowWidthSizeClass {
+	switch {
+	case width < 600:
+		return WindowWidthSizeClassCompact
+	case width < 840:
+		return WindowWidthSizeClassMedium
+	case width < 1200:
+		return WindowWidthSizeClassExpanded
+	case width < 1600:
+		return WindowWidthSizeClassLarge
+	default:
+		return WindowWidthSizeClassExtraLarge
+	}
+}
+
+func heightSizeClass(height unit.Dp) WindowHeightSizeClass {
+	switch {
+	case height < 480:
+		return WindowHeightSizeClassCompact
+	case height < 900:
+		return WindowHeightSizeClassMedium
+	default:
+		return WindowHeightSizeClassExpanded
+	}
+}
+
+// CalculateWindowSizeClass composes content with the class of the window,
+// and again whenever the window is resized into another class.
+//
+// The window is measured by the space CalculateWindowSizeClass is laid out
+// in, so that it belongs at the root of the window, where that space is the
+// window less its decorations. An unbounded axis is in the largest class.
+func CalculateWindowSizeClass(content func(sizeClass WindowSizeClass) Composable, options ...box.BoxOption) Composable {
+	return box.BoxWithConstraints(func(scope box.BoxWithConstraintsScope) box.Composable {
+		return content(CalculateFromSize(unit.NewDpSize(scope.MaxWidth(), scope.MaxHeight())))
+	}, options...)
+}
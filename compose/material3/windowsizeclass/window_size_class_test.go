@@ -0,0 +1,55 @@
+package windowsizeclass
+
+import (
+	"image"
+	"testing"
+
+	"github.com/zodimo/go-compose/compose"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+	"github.com/zodimo/go-compose/runtime"
+	"github.com/zodimo/go-compose/state"
+	"github.com/zodimo/go-compose/store"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+	gioUnit "gioui.org/unit"
+)
+
+func TestCalculateFromSize(t *testing.T) {
+	tests := []struct {
+		width, height unit.Dp
+		want          WindowSizeClass
+	}{
+		{360, 800, WindowSizeClass{WindowWidthSizeClassCompact, WindowHeightSizeClassMedium}},
+		{800, 360, WindowSizeClass{WindowWidthSizeClassMedium, WindowHeightSizeClassCompact}},
+		{840, 900, WindowSizeClass{WindowWidthSizeClassExpanded, WindowHeightSizeClassExpanded}},
+		{1200, 480, WindowSizeClass{WindowWidthSizeClassLarge, WindowHeightSizeClassMedium}},
+		{1920, 1080, WindowSizeClass{WindowWidthSizeClassExtraLarge, WindowHeightSizeClassExpanded}},
+		{unit.DpInfinity, unit.DpInfinity, WindowSizeClass{WindowWidthSizeClassExtraLarge, WindowHeightSizeClassExpanded}},
+	}
+	for _, tt := range tests {
+		if got := CalculateFromSize(unit.NewDpSize(tt.width, tt.height)); got != tt.want {
+			t.Errorf("CalculateFromSize(%v, %v) = %v, want %v", tt.width, tt.height, got, tt.want)
+		}
+	}
+}
+
+func TestCalculateWindowSizeClass(t *testing.T) {
+	var got WindowSizeClass
+	content := CalculateWindowSizeClass(func(sizeClass WindowSizeClass) Composable {
+		got = sizeClass
+		return func(c Composer) Composer { return c }
+	})
+	c := compose.NewComposer(store.NewPersistentState(map[string]state.MutableValue{}))
+	node := content(c).Build()
+	gtx := layout.Context{
+		Ops:         new(op.Ops),
+		Constraints: layout.Exact(image.Pt(1400, 900)),
+		// The window is 700dp by 450dp.
+		Metric: gioUnit.Metric{PxPerDp: 2, PxPerSp: 2},
+	}
+	runtime.NewRuntime().Run(gtx, node).Add(gtx.Ops)
+	if want := (WindowSizeClass{WindowWidthSizeClassMedium, WindowHeightSizeClassCompact}); got != want {
+		t.Errorf("size class = %v, want %v", got, want)
+	}
+}
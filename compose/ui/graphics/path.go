@@ -126,8 +126,14 @@ type Path interface {
 	// GetBounds computes the bounds of the control points of the path.
 	GetBounds() geometry.Rect
 
-	// Op performs a boolean operation on two paths.
+	// Op sets the path to the result of operation on path1 and path2, and
+	// reports whether it succeeded. The path returned by NewPath has no
+	// geometry backend for boolean operations: its Op always fails.
 	Op(path1, path2 Path, operation PathOperation) bool
+
+	// Segments returns the segments that make up this path, in order.
+	// This is the Go equivalent of Path.iterator().
+	Segments() []PathSegment
 }
 
 // PathOperation specifies the boolean operation to perform on two paths.
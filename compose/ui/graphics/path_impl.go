@@ -0,0 +1,338 @@
+package graphics
+
+import (
+	"math"
+
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+)
+
+var _ Path = (*segmentPath)(nil)
+
+// NewPath creates a new, empty Path.
+//
+// The returned path records its segments in memory so that it can be replayed
+// onto any backend, for example as a Gio clip.Path by the shape package.
+// Arcs and ovals are approximated with cubic bezier segments.
+func NewPath() Path {
+	return &segmentPath{}
+}
+
+// segmentPath is the default Path implementation.
+type segmentPath struct {
+	fillType   PathFillType
+	segments   []PathSegment
+	current    geometry.Offset
+	start      geometry.Offset
+	hasCurrent bool
+}
+
+func (p *segmentPath) FillType() PathFillType {
+	return p.fillType
+}
+
+func (p *segmentPath) SetFillType(fillType PathFillType) {
+	p.fillType = fillType
+}
+
+// IsConvex reports whether the path is a single contour whose points all turn
+// in the same direction. Control points are taken into account, which makes
+// this a conservative answer for curved paths.
+func (p *segmentPath) IsConvex() bool {
+	points := []geometry.Offset{}
+	contours := 0
+	for _, segment := range p.segments {
+		switch segment.Type {
+		case PathSegmentTypeMove:
+			contours++
+			points = append(points, segment.Points[0])
+		case PathSegmentTypeClose:
+		default:
+			points = append(points, segment.Points[1:]...)
+		}
+	}
+	if contours > 1 || len(points) < 3 {
+		return false
+	}
+	sign := 0
+	n := len(points)
+	for i := 0; i < n; i++ {
+		a, b, c := points[i], points[(i+1)%n], points[(i+2)%n]
+		cross := (b.X()-a.X())*(c.Y()-b.Y()) - (b.Y()-a.Y())*(c.X()-b.X())
+		if cross == 0 {
+			continue
+		}
+		s := 1
+		if cross < 0 {
+			s = -1
+		}
+		if sign == 0 {
+			sign = s
+		} else if sign != s {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *segmentPath) IsEmpty() bool {
+	for _, segment := range p.segments {
+		if segment.Type != PathSegmentTypeMove {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *segmentPath) MoveTo(x, y float32) {
+	pt := geometry.NewOffset(x, y)
+	p.segments = append(p.segments, PathSegment{Type: PathSegmentTypeMove, Points: []geometry.Offset{pt}})
+	p.current = pt
+	p.start = pt
+	p.hasCurrent = true
+}
+
+func (p *segmentPath) RelativeMoveTo(dx, dy float32) {
+	cur := p.currentPoint()
+	p.MoveTo(cur.X()+dx, cur.Y()+dy)
+}
+
+func (p *segmentPath) LineTo(x, y float32) {
+	p.ensureContour()
+	pt := geometry.NewOffset(x, y)
+	p.segments = append(p.segments, PathSegment{Type: PathSegmentTypeLine, Points: []geometry.Offset{p.current, pt}})
+	p.current = pt
+}
+
+func (p *segmentPath) RelativeLineTo(dx, dy float32) {
+	cur := p.currentPoint()
+	p.LineTo(cur.X()+dx, cur.Y()+dy)
+}
+
+func (p *segmentPath) QuadraticTo(x1, y1, x2, y2 float32) {
+	p.ensureContour()
+	ctrl := geometry.NewOffset(x1, y1)
+	end := geometry.NewOffset(x2, y2)
+	p.segments = append(p.segments, PathSegment{Type: PathSegmentTypeQuadratic, Points: []geometry.Offset{p.current, ctrl, end}})
+	p.current = end
+}
+
+func (p *segmentPath) RelativeQuadraticTo(dx1, dy1, dx2, dy2 float32) {
+	cur := p.currentPoint()
+	p.QuadraticTo(cur.X()+dx1, cur.Y()+dy1, cur.X()+dx2, cur.Y()+dy2)
+}
+
+func (p *segmentPath) CubicTo(x1, y1, x2, y2, x3, y3 float32) {
+	p.ensureContour()
+	ctrl1 := geometry.NewOffset(x1, y1)
+	ctrl2 := geometry.NewOffset(x2, y2)
+	end := geometry.NewOffset(x3, y3)
+	p.segments = append(p.segments, PathSegment{Type: PathSegmentTypeCubic, Points: []geometry.Offset{p.current, ctrl1, ctrl2, end}})
+	p.current = end
+}
+
+func (p *segmentPath) RelativeCubicTo(dx1, dy1, dx2, dy2, dx3, dy3 float32) {
+	cur := p.currentPoint()
+	p.CubicTo(cur.X()+dx1, cur.Y()+dy1, cur.X()+dx2, cur.Y()+dy2, cur.X()+dx3, cur.Y()+dy3)
+}
+
+func (p *segmentPath) ArcTo(rect geometry.Rect, startAngleDegrees, sweepAngleDegrees float32, forceMoveTo bool) {
+	start := arcPoint(rect, degreesToRadians(startAngleDegrees))
+	if forceMoveTo || !p.hasCurrent {
+		p.MoveTo(start.X(), start.Y())
+	} else if !start.Equal(p.current) {
+		p.LineTo(start.X(), start.Y())
+	}
+	p.appendArc(rect, startAngleDegrees, sweepAngleDegrees)
+}
+
+func (p *segmentPath) AddRect(rect geometry.Rect, direction PathDirection) {
+	p.MoveTo(rect.Left, rect.Top)
+	if direction == PathDirectionClockwise {
+		p.LineTo(rect.Right, rect.Top)
+		p.LineTo(rect.Right, rect.Bottom)
+		p.LineTo(rect.Left, rect.Bottom)
+	} else {
+		p.LineTo(rect.Left, rect.Bottom)
+		p.LineTo(rect.Right, rect.Bottom)
+		p.LineTo(rect.Right, rect.Top)
+	}
+	p.Close()
+}
+
+func (p *segmentPath) AddOval(oval geometry.Rect, direction PathDirection) {
+	sweep := float32(360)
+	if direction == PathDirectionCounterClockwise {
+		sweep = -360
+	}
+	start := arcPoint(oval, 0)
+	p.MoveTo(start.X(), start.Y())
+	p.appendArc(oval, 0, sweep)
+	p.Close()
+}
+
+func (p *segmentPath) AddArc(oval geometry.Rect, startAngleDegrees, sweepAngleDegrees float32) {
+	start := arcPoint(oval, degreesToRadians(startAngleDegrees))
+	p.MoveTo(start.X(), start.Y())
+	p.appendArc(oval, startAngleDegrees, sweepAngleDegrees)
+}
+
+func (p *segmentPath) AddPath(path Path, offset geometry.Offset) {
+	if path == nil {
+		return
+	}
+	for _, segment := range path.Segments() {
+		points := make([]geometry.Offset, len(segment.Points))
+		for i, pt := range segment.Points {
+			points[i] = pt.Plus(offset)
+		}
+		p.segments = append(p.segments, PathSegment{Type: segment.Type, Points: points})
+		switch segment.Type {
+		case PathSegmentTypeMove:
+			p.start = points[0]
+			p.current = points[0]
+			p.hasCurrent = true
+		case PathSegmentTypeClose:
+			p.current = p.start
+		default:
+			p.current = points[len(points)-1]
+		}
+	}
+}
+
+func (p *segmentPath) Close() {
+	if !p.hasCurrent {
+		return
+	}
+	p.segments = append(p.segments, PathSegment{Type: PathSegmentTypeClose})
+	p.current = p.start
+}
+
+func (p *segmentPath) Reset() {
+	p.segments = nil
+	p.fillType = PathFillTypeNonZero
+	p.current = geometry.OffsetZero
+	p.start = geometry.OffsetZero
+	p.hasCurrent = false
+}
+
+func (p *segmentPath) Rewind() {
+	p.segments = p.segments[:0]
+	p.current = geometry.OffsetZero
+	p.start = geometry.OffsetZero
+	p.hasCurrent = false
+}
+
+func (p *segmentPath) Translate(offset geometry.Offset) {
+	for _, segment := range p.segments {
+		for i := range segment.Points {
+			segment.Points[i] = segment.Points[i].Plus(offset)
+		}
+	}
+	p.current = p.current.Plus(offset)
+	p.start = p.start.Plus(offset)
+}
+
+func (p *segmentPath) GetBounds() geometry.Rect {
+	first := true
+	var bounds geometry.Rect
+	for _, segment := range p.segments {
+		for _, pt := range segment.Points {
+			if first {
+				bounds = geometry.NewRect(pt.X(), pt.Y(), pt.X(), pt.Y())
+				first = false
+				continue
+			}
+			bounds.Left = minf(bounds.Left, pt.X())
+			bounds.Top = minf(bounds.Top, pt.Y())
+			bounds.Right = maxf(bounds.Right, pt.X())
+			bounds.Bottom = maxf(bounds.Bottom, pt.Y())
+		}
+	}
+	return bounds
+}
+
+// Op is not supported by this implementation: boolean operations require a
+// geometry backend. It always returns false and leaves the path unchanged.
+func (p *segmentPath) Op(path1, path2 Path, operation PathOperation) bool {
+	return false
+}
+
+func (p *segmentPath) Segments() []PathSegment {
+	segments := make([]PathSegment, len(p.segments))
+	for i, segment := range p.segments {
+		points := make([]geometry.Offset, len(segment.Points))
+		copy(points, segment.Points)
+		segments[i] = PathSegment{Type: segment.Type, Points: points}
+	}
+	return segments
+}
+
+// ensureContour starts a contour at the origin when drawing without a MoveTo,
+// matching the platform behaviour.
+func (p *segmentPath) ensureContour() {
+	if !p.hasCurrent {
+		p.MoveTo(0, 0)
+	}
+}
+
+func (p *segmentPath) currentPoint() geometry.Offset {
+	if !p.hasCurrent {
+		return geometry.OffsetZero
+	}
+	return p.current
+}
+
+// appendArc appends the arc as cubic segments, each spanning at most 90 degrees.
+// The current point must already be at the start of the arc.
+func (p *segmentPath) appendArc(rect geometry.Rect, startAngleDegrees, sweepAngleDegrees float32) {
+	if sweepAngleDegrees == 0 {
+		return
+	}
+	sweep := float64(sweepAngleDegrees)
+	if sweep > 360 {
+		sweep = 360
+	} else if sweep < -360 {
+		sweep = -360
+	}
+	steps := int(math.Ceil(math.Abs(sweep) / 90))
+	step := sweep / float64(steps)
+	angle := float64(startAngleDegrees)
+	for i := 0; i < steps; i++ {
+		a1 := degreesToRadians(float32(angle))
+		a2 := degreesToRadians(float32(angle + step))
+		c1, c2, end := arcCubic(rect, a1, a2)
+		p.CubicTo(c1.X(), c1.Y(), c2.X(), c2.Y(), end.X(), end.Y())
+		angle += step
+	}
+}
+
+func degreesToRadians(degrees float32) float64 {
+	return float64(degrees) * math.Pi / 180
+}
+
+func arcPoint(rect geometry.Rect, angle float64) geometry.Offset {
+	cx := float64(rect.Left+rect.Right) / 2
+	cy := float64(rect.Top+rect.Bottom) / 2
+	rx := float64(rect.Width()) / 2
+	ry := float64(rect.Height()) / 2
+	return geometry.NewOffset(float32(cx+rx*math.Cos(angle)), float32(cy+ry*math.Sin(angle)))
+}
+
+// arcCubic returns the control points and end point of the cubic bezier that
+// approximates the elliptical arc of rect between angles a1 and a2 (radians).
+func arcCubic(rect geometry.Rect, a1, a2 float64) (geometry.Offset, geometry.Offset, geometry.Offset) {
+	rx := float64(rect.Width()) / 2
+	ry := float64(rect.Height()) / 2
+	k := 4.0 / 3.0 * math.Tan((a2-a1)/4)
+	start := arcPoint(rect, a1)
+	end := arcPoint(rect, a2)
+	c1 := geometry.NewOffset(
+		start.X()-float32(k*rx*math.Sin(a1)),
+		start.Y()+float32(k*ry*math.Cos(a1)),
+	)
+	c2 := geometry.NewOffset(
+		end.X()+float32(k*rx*math.Sin(a2)),
+		end.Y()-float32(k*ry*math.Cos(a2)),
+	)
+	return c1, c2, end
+}
@@ -0,0 +1,160 @@
+package graphics
+
+import (
+	"testing"
+
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+)
+
+func TestPath_LineSegments(t *testing.T) {
+	p := NewPath()
+	if !p.IsEmpty() {
+		t.Error("new path should be empty")
+	}
+
+	p.MoveTo(10, 10)
+	p.LineTo(20, 10)
+	p.RelativeLineTo(0, 10)
+	p.Close()
+
+	if p.IsEmpty() {
+		t.Error("path with lines should not be empty")
+	}
+
+	segments := p.Segments()
+	want := []PathSegmentType{PathSegmentTypeMove, PathSegmentTypeLine, PathSegmentTypeLine, PathSegmentTypeClose}
+	if len(segments) != len(want) {
+		t.Fatalf("expected %d segments, got %d", len(want), len(segments))
+	}
+	for i, segment := range segments {
+		if segment.Type != want[i] {
+			t.Errorf("segment %d: expected %s, got %s", i, want[i], segment.Type)
+		}
+	}
+	if got := segments[2].EndPoint(); !got.Equal(geometry.NewOffset(20, 20)) {
+		t.Errorf("relative line should end at (20, 20), got %s", got)
+	}
+}
+
+func TestPath_ImplicitMoveTo(t *testing.T) {
+	p := NewPath()
+	p.LineTo(5, 5)
+
+	segments := p.Segments()
+	if len(segments) != 2 || segments[0].Type != PathSegmentTypeMove {
+		t.Fatalf("LineTo without MoveTo should start a contour at the origin, got %v", segments)
+	}
+	if !segments[0].Points[0].Equal(geometry.OffsetZero) {
+		t.Errorf("implicit contour should start at the origin, got %s", segments[0].Points[0])
+	}
+}
+
+func TestPath_BoundsAndTranslate(t *testing.T) {
+	p := NewPath()
+	p.AddRect(geometry.NewRect(0, 0, 10, 20), PathDirectionClockwise)
+
+	if got := p.GetBounds(); !got.Equal(geometry.NewRect(0, 0, 10, 20)) {
+		t.Errorf("unexpected bounds %s", got)
+	}
+
+	p.Translate(geometry.NewOffset(5, 5))
+	if got := p.GetBounds(); !got.Equal(geometry.NewRect(5, 5, 15, 25)) {
+		t.Errorf("unexpected translated bounds %s", got)
+	}
+}
+
+func TestPath_AddOvalApproximatesEllipse(t *testing.T) {
+	p := NewPath()
+	oval := geometry.NewRect(0, 0, 100, 50)
+	p.AddOval(oval, PathDirectionClockwise)
+
+	cubics := 0
+	for _, segment := range p.Segments() {
+		if segment.Type == PathSegmentTypeCubic {
+			cubics++
+			end := segment.EndPoint()
+			// Every quarter arc ends on the ellipse.
+			nx := (end.X() - 50) / 50
+			ny := (end.Y() - 25) / 25
+			if d := nx*nx + ny*ny; d < 0.99 || d > 1.01 {
+				t.Errorf("arc end point %s is not on the ellipse", end)
+			}
+		}
+	}
+	if cubics != 4 {
+		t.Errorf("expected 4 cubic segments for a full oval, got %d", cubics)
+	}
+
+	bounds := p.GetBounds()
+	if bounds.Left < -0.01 || bounds.Right > 100.01 {
+		t.Errorf("oval bounds exceed the rect horizontally: %s", bounds)
+	}
+}
+
+func TestPath_AddPathWithOffset(t *testing.T) {
+	src := NewPath()
+	src.MoveTo(0, 0)
+	src.LineTo(10, 0)
+
+	dst := NewPath()
+	dst.AddPath(src, geometry.NewOffset(0, 10))
+
+	segments := dst.Segments()
+	if len(segments) != 2 {
+		t.Fatalf("expected 2 segments, got %d", len(segments))
+	}
+	if got := segments[1].EndPoint(); !got.Equal(geometry.NewOffset(10, 10)) {
+		t.Errorf("expected offset end point (10, 10), got %s", got)
+	}
+}
+
+func TestPath_IsConvex(t *testing.T) {
+	square := NewPath()
+	square.AddRect(geometry.NewRect(0, 0, 10, 10), PathDirectionClockwise)
+	if !square.IsConvex() {
+		t.Error("a rectangle should be convex")
+	}
+
+	arrow := NewPath()
+	arrow.MoveTo(0, 0)
+	arrow.LineTo(10, 5)
+	arrow.LineTo(0, 10)
+	arrow.LineTo(3, 5)
+	arrow.Close()
+	if arrow.IsConvex() {
+		t.Error("an arrow head should not be convex")
+	}
+}
+
+func TestPath_ResetAndRewind(t *testing.T) {
+	p := NewPath()
+	p.SetFillType(PathFillTypeEvenOdd)
+	p.MoveTo(1, 1)
+	p.LineTo(2, 2)
+
+	p.Rewind()
+	if !p.IsEmpty() || p.FillType() != PathFillTypeEvenOdd {
+		t.Error("Rewind should clear segments and keep the fill type")
+	}
+
+	p.LineTo(2, 2)
+	p.Reset()
+	if !p.IsEmpty() || p.FillType() != PathFillTypeNonZero {
+		t.Error("Reset should clear segments and restore the fill type")
+	}
+}
+
+func TestPath_OpUnsupported(t *testing.T) {
+	p := NewPath()
+	p.MoveTo(1, 1)
+	p.LineTo(2, 2)
+	a, b := NewPath(), NewPath()
+	a.AddRect(geometry.NewRect(0, 0, 10, 10), PathDirectionClockwise)
+	b.AddRect(geometry.NewRect(5, 5, 15, 15), PathDirectionClockwise)
+	if p.Op(a, b, PathOperationUnion) {
+		t.Error("Op should report that boolean operations are not supported")
+	}
+	if len(p.Segments()) != 2 {
+		t.Error("a failed Op should leave the path unchanged")
+	}
+}
@@ -0,0 +1,70 @@
+package graphics
+
+import (
+	"fmt"
+
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+)
+
+// PathSegmentType is the type of a PathSegment.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/ui/ui-graphics/src/commonMain/kotlin/androidx/compose/ui/graphics/PathSegment.kt
+type PathSegmentType int
+
+const (
+	// PathSegmentTypeMove starts a new contour at Points[0].
+	PathSegmentTypeMove PathSegmentType = iota
+
+	// PathSegmentTypeLine is a straight line from Points[0] to Points[1].
+	PathSegmentTypeLine
+
+	// PathSegmentTypeQuadratic is a quadratic bezier from Points[0] to Points[2]
+	// with Points[1] as the control point.
+	PathSegmentTypeQuadratic
+
+	// PathSegmentTypeCubic is a cubic bezier from Points[0] to Points[3]
+	// with Points[1] and Points[2] as the control points.
+	PathSegmentTypeCubic
+
+	// PathSegmentTypeClose closes the current contour.
+	PathSegmentTypeClose
+)
+
+// String returns the string representation of the PathSegmentType.
+func (t PathSegmentType) String() string {
+	switch t {
+	case PathSegmentTypeMove:
+		return "Move"
+	case PathSegmentTypeLine:
+		return "Line"
+	case PathSegmentTypeQuadratic:
+		return "Quadratic"
+	case PathSegmentTypeCubic:
+		return "Cubic"
+	case PathSegmentTypeClose:
+		return "Close"
+	default:
+		return "Unknown"
+	}
+}
+
+// PathSegment is a single segment of a Path.
+// Points holds the absolute points of the segment, starting with the current
+// point of the contour (except for Move, which only holds the destination).
+type PathSegment struct {
+	Type   PathSegmentType
+	Points []geometry.Offset
+}
+
+// EndPoint returns the point at which the segment ends.
+func (s PathSegment) EndPoint() geometry.Offset {
+	if len(s.Points) == 0 {
+		return geometry.OffsetUnspecified
+	}
+	return s.Points[len(s.Points)-1]
+}
+
+// String returns a string representation of the segment.
+func (s PathSegment) String() string {
+	return fmt.Sprintf("PathSegment(%s, %v)", s.Type, s.Points)
+}
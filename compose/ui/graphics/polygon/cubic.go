@@ -0,0 +1,69 @@
+package polygon
+
+import (
+	"fmt"
+
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+)
+
+// PointTransformer maps a point to another point. It is used to transform
+// polygons and cubics, e.g. to scale a normalized polygon to a layout size.
+type PointTransformer func(point geometry.Offset) geometry.Offset
+
+// Cubic is a cubic bezier curve, the building block of a RoundedPolygon.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:graphics/graphics-shapes/src/commonMain/kotlin/androidx/graphics/shapes/Cubic.kt
+type Cubic struct {
+	Anchor0  geometry.Offset
+	Control0 geometry.Offset
+	Control1 geometry.Offset
+	Anchor1  geometry.Offset
+}
+
+// StraightLine creates a Cubic that draws a straight line from p0 to p1.
+func StraightLine(p0, p1 geometry.Offset) Cubic {
+	return Cubic{
+		Anchor0:  p0,
+		Control0: geometry.LerpOffset(p0, p1, 1.0/3.0),
+		Control1: geometry.LerpOffset(p0, p1, 2.0/3.0),
+		Anchor1:  p1,
+	}
+}
+
+// PointOnCurve returns the point on the curve at parameter t in [0, 1].
+func (c Cubic) PointOnCurve(t float32) geometry.Offset {
+	u := 1 - t
+	a := u * u * u
+	b := 3 * t * u * u
+	d := 3 * t * t * u
+	e := t * t * t
+	return geometry.NewOffset(
+		a*c.Anchor0.X()+b*c.Control0.X()+d*c.Control1.X()+e*c.Anchor1.X(),
+		a*c.Anchor0.Y()+b*c.Control0.Y()+d*c.Control1.Y()+e*c.Anchor1.Y(),
+	)
+}
+
+// Transformed returns a copy of the cubic with all points mapped by f.
+func (c Cubic) Transformed(f PointTransformer) Cubic {
+	return Cubic{
+		Anchor0:  f(c.Anchor0),
+		Control0: f(c.Control0),
+		Control1: f(c.Control1),
+		Anchor1:  f(c.Anchor1),
+	}
+}
+
+// String returns a string representation of the cubic.
+func (c Cubic) String() string {
+	return fmt.Sprintf("Cubic(%s, %s, %s, %s)", c.Anchor0, c.Control0, c.Control1, c.Anchor1)
+}
+
+// LerpCubic linearly interpolates between two cubics.
+func LerpCubic(start, stop Cubic, fraction float32) Cubic {
+	return Cubic{
+		Anchor0:  geometry.LerpOffset(start.Anchor0, stop.Anchor0, fraction),
+		Control0: geometry.LerpOffset(start.Control0, stop.Control0, fraction),
+		Control1: geometry.LerpOffset(start.Control1, stop.Control1, fraction),
+		Anchor1:  geometry.LerpOffset(start.Anchor1, stop.Anchor1, fraction),
+	}
+}
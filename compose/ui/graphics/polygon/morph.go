@@ -0,0 +1,142 @@
+package polygon
+
+import (
+	"math"
+
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+	"github.com/zodimo/go-compose/compose/ui/graphics"
+	"github.com/zodimo/go-compose/pkg/floatutils/lerp"
+)
+
+// morphSamples is the number of radial samples used to match two polygons.
+const morphSamples = 180
+
+// flattenSteps is the number of line segments each cubic is flattened into
+// when sampling its outline.
+const flattenSteps = 12
+
+// Morph animates between two RoundedPolygons.
+//
+// Both polygons are sampled radially around their centers at the same angles,
+// so the shapes must be star-shaped around their centers (which holds for
+// convex shapes and for all the Material shapes). At progress 0 and 1 the
+// exact start and end outlines are returned.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:graphics/graphics-shapes/src/commonMain/kotlin/androidx/graphics/shapes/Morph.kt
+type Morph struct {
+	start, end             *RoundedPolygon
+	startRadii, endRadii   []float32
+	startCenter, endCenter geometry.Offset
+}
+
+// NewMorph creates a Morph between start and end.
+func NewMorph(start, end *RoundedPolygon) *Morph {
+	return &Morph{
+		start:       start,
+		end:         end,
+		startRadii:  radialProfile(start),
+		endRadii:    radialProfile(end),
+		startCenter: start.Center,
+		endCenter:   end.Center,
+	}
+}
+
+// AsCubics returns the outline of the morph at progress, where 0 is the start
+// polygon and 1 is the end polygon.
+func (m *Morph) AsCubics(progress float32) []Cubic {
+	if progress <= 0 {
+		return m.start.Cubics
+	}
+	if progress >= 1 {
+		return m.end.Cubics
+	}
+	center := geometry.LerpOffset(m.startCenter, m.endCenter, progress)
+	points := make([]geometry.Offset, morphSamples)
+	for i := range points {
+		r := lerp.Between32(m.startRadii[i], m.endRadii[i], progress)
+		angle := sampleAngle(i)
+		points[i] = center.Plus(radialPoint(r, angle))
+	}
+	return catmullRomCubics(points)
+}
+
+// AddToPath appends the outline of the morph at progress to path.
+func (m *Morph) AddToPath(path graphics.Path, progress float32) {
+	addCubicsToPath(path, m.AsCubics(progress))
+}
+
+// ToPath returns a new path with the outline of the morph at progress.
+func (m *Morph) ToPath(progress float32) graphics.Path {
+	path := graphics.NewPath()
+	m.AddToPath(path, progress)
+	return path
+}
+
+func sampleAngle(i int) float64 {
+	return 2 * math.Pi * float64(i) / morphSamples
+}
+
+// radialProfile returns, for each sample angle, the distance from the
+// polygon's center to its outline.
+func radialProfile(p *RoundedPolygon) []float32 {
+	outline := make([]geometry.Offset, 0, len(p.Cubics)*flattenSteps)
+	for _, c := range p.Cubics {
+		for s := 0; s < flattenSteps; s++ {
+			outline = append(outline, c.PointOnCurve(float32(s)/flattenSteps))
+		}
+	}
+
+	radii := make([]float32, morphSamples)
+	cx, cy := float64(p.Center.X()), float64(p.Center.Y())
+	for i := range radii {
+		angle := sampleAngle(i)
+		dx, dy := math.Cos(angle), math.Sin(angle)
+		best := 0.0
+		for j := range outline {
+			a := outline[j]
+			b := outline[(j+1)%len(outline)]
+			if t, ok := raySegmentIntersection(cx, cy, dx, dy, a, b); ok && t > best {
+				best = t
+			}
+		}
+		radii[i] = float32(best)
+	}
+	return radii
+}
+
+// raySegmentIntersection returns the distance along the ray (origin, dir) at
+// which it crosses segment ab.
+func raySegmentIntersection(ox, oy, dx, dy float64, a, b geometry.Offset) (float64, bool) {
+	ax, ay := float64(a.X()), float64(a.Y())
+	ex, ey := float64(b.X())-ax, float64(b.Y())-ay
+	denom := dx*ey - dy*ex
+	if math.Abs(denom) < 1e-12 {
+		return 0, false
+	}
+	wx, wy := ax-ox, ay-oy
+	t := (wx*ey - wy*ex) / denom
+	u := (wx*dy - wy*dx) / denom
+	if t < 0 || u < 0 || u > 1 {
+		return 0, false
+	}
+	return t, true
+}
+
+// catmullRomCubics returns a closed, smooth curve through points.
+func catmullRomCubics(points []geometry.Offset) []Cubic {
+	n := len(points)
+	cubics := make([]Cubic, n)
+	for i := 0; i < n; i++ {
+		p0 := points[(i+n-1)%n]
+		p1 := points[i]
+		p2 := points[(i+1)%n]
+		p3 := points[(i+2)%n]
+		cubics[i] = Cubic{
+			Anchor0:  p1,
+			Control0: p1.Plus(p2.Minus(p0).Div(6)),
+			Control1: p2.Minus(p3.Minus(p1).Div(6)),
+			Anchor1:  p2,
+		}
+	}
+	return cubics
+}
@@ -0,0 +1,107 @@
+package polygon
+
+import (
+	"math"
+	"testing"
+
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+)
+
+func approx(a, b, epsilon float32) bool {
+	return float32(math.Abs(float64(a-b))) <= epsilon
+}
+
+func TestPolygon_IsClosed(t *testing.T) {
+	shapes := map[string]*RoundedPolygon{
+		"square":  Polygon(4, 1, Unrounded),
+		"rounded": Polygon(6, 1, CornerRounding{Radius: 0.2}),
+		"star":    Star(5, 1, 0.5, CornerRounding{Radius: 0.1}, Unrounded),
+		"circle":  Circle(8, 1),
+	}
+	for name, p := range shapes {
+		n := len(p.Cubics)
+		if n == 0 {
+			t.Fatalf("%s: no cubics", name)
+		}
+		for i := 0; i < n; i++ {
+			if !p.Cubics[i].Anchor1.Equal(p.Cubics[(i+1)%n].Anchor0) {
+				t.Errorf("%s: cubic %d does not connect to cubic %d", name, i, (i+1)%n)
+			}
+		}
+	}
+}
+
+func TestPolygon_SharpCornersKeepVertices(t *testing.T) {
+	p := Polygon(4, 1, Unrounded)
+	bounds := p.Bounds()
+	if !approx(bounds.Left, -1, 1e-4) || !approx(bounds.Right, 1, 1e-4) {
+		t.Errorf("unexpected bounds %s", bounds)
+	}
+}
+
+func TestPolygon_RoundingShrinksBounds(t *testing.T) {
+	sharp := Polygon(4, 1, Unrounded).Bounds()
+	round := Polygon(4, 1, CornerRounding{Radius: 0.3}).Bounds()
+	if round.Width() >= sharp.Width() {
+		t.Errorf("rounded polygon should be narrower than the sharp one: %s vs %s", round, sharp)
+	}
+}
+
+func TestCircle_StaysOnRadius(t *testing.T) {
+	p := Circle(8, 1)
+	for i, c := range p.Cubics {
+		for _, tt := range []float32{0, 0.5, 1} {
+			pt := c.PointOnCurve(tt)
+			if d := pt.GetDistance(); !approx(d, 1, 0.01) {
+				t.Errorf("cubic %d at %.1f is %.4f from the center", i, tt, d)
+			}
+		}
+	}
+}
+
+func TestRoundedPolygon_Normalized(t *testing.T) {
+	p := Rectangle(4, 2, Unrounded, nil).Normalized()
+	bounds := p.Bounds()
+	if !approx(bounds.Left, 0, 1e-4) || !approx(bounds.Right, 1, 1e-4) {
+		t.Errorf("normalized width should span [0, 1], got %s", bounds)
+	}
+	if !approx(bounds.Top, 0.25, 1e-4) || !approx(bounds.Bottom, 0.75, 1e-4) {
+		t.Errorf("normalized height should be centered, got %s", bounds)
+	}
+	if !p.Center.Equal(geometry.NewOffset(0.5, 0.5)) {
+		t.Errorf("normalized center should be (0.5, 0.5), got %s", p.Center)
+	}
+}
+
+func TestRoundedPolygon_Rotated(t *testing.T) {
+	p := Polygon(4, 1, Unrounded).Rotated(90)
+	first := p.Cubics[0].Anchor0
+	if !approx(first.X(), 0, 1e-4) || !approx(first.Y(), 1, 1e-4) {
+		t.Errorf("vertex at angle 0 should rotate to (0, 1), got %s", first)
+	}
+}
+
+func TestMorph_Endpoints(t *testing.T) {
+	start := Polygon(4, 1, Unrounded)
+	end := Circle(8, 1)
+	m := NewMorph(start, end)
+
+	if got := m.AsCubics(0); len(got) != len(start.Cubics) {
+		t.Errorf("progress 0 should return the start outline")
+	}
+	if got := m.AsCubics(1); len(got) != len(end.Cubics) {
+		t.Errorf("progress 1 should return the end outline")
+	}
+}
+
+func TestMorph_Interpolates(t *testing.T) {
+	small := Circle(8, 1)
+	large := Circle(8, 2)
+	m := NewMorph(small, large)
+
+	for _, c := range m.AsCubics(0.5) {
+		if d := c.Anchor0.GetDistance(); !approx(d, 1.5, 0.02) {
+			t.Fatalf("halfway morph between radius 1 and 2 should have radius 1.5, got %.4f", d)
+		}
+	}
+}
@@ -0,0 +1,330 @@
+package polygon
+
+import (
+	"math"
+
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+	"github.com/zodimo/go-compose/compose/ui/graphics"
+)
+
+// CornerRounding describes how a polygon corner is rounded.
+//
+// Radius is the radius of the circular arc that replaces the corner. Smoothing
+// in [0, 1] stretches the curve further along the adjacent edges for a softer,
+// "squircle"-like transition. Both are in the polygon's own coordinate space.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:graphics/graphics-shapes/src/commonMain/kotlin/androidx/graphics/shapes/CornerRounding.kt
+type CornerRounding struct {
+	Radius    float32
+	Smoothing float32
+}
+
+// Unrounded is a CornerRounding that leaves the corner sharp.
+var Unrounded = CornerRounding{}
+
+// RoundedPolygon is a closed shape made of cubic curves, described by its
+// vertices and the rounding applied at each of them.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:graphics/graphics-shapes/src/commonMain/kotlin/androidx/graphics/shapes/RoundedPolygon.kt
+type RoundedPolygon struct {
+	// Cubics is the closed outline of the polygon, in drawing order.
+	Cubics []Cubic
+	// Center is the point from which the polygon was constructed.
+	Center geometry.Offset
+}
+
+// NewRoundedPolygon creates a polygon from its vertices.
+//
+// rounding is applied to every vertex unless perVertexRounding is non-empty,
+// in which case it must contain one entry per vertex. When center is
+// unspecified the average of the vertices is used.
+func NewRoundedPolygon(vertices []geometry.Offset, rounding CornerRounding, perVertexRounding []CornerRounding, center geometry.Offset) *RoundedPolygon {
+	n := len(vertices)
+	if n < 3 {
+		panic("RoundedPolygon: at least 3 vertices are required")
+	}
+	if len(perVertexRounding) != 0 && len(perVertexRounding) != n {
+		panic("RoundedPolygon: perVertexRounding must have one entry per vertex")
+	}
+	if center.IsUnspecified() {
+		var cx, cy float32
+		for _, v := range vertices {
+			cx += v.X()
+			cy += v.Y()
+		}
+		center = geometry.NewOffset(cx/float32(n), cy/float32(n))
+	}
+
+	roundingAt := func(i int) CornerRounding {
+		if len(perVertexRounding) > 0 {
+			return perVertexRounding[i]
+		}
+		return rounding
+	}
+
+	// First pass: how far along each edge every corner wants to cut.
+	corners := make([]roundedCorner, n)
+	for i := 0; i < n; i++ {
+		corners[i] = newRoundedCorner(vertices[(i+n-1)%n], vertices[i], vertices[(i+1)%n], roundingAt(i))
+	}
+
+	// Second pass: scale down the cuts when two corners compete for the same edge.
+	// Edge i runs from vertex i to vertex i+1.
+	scales := make([]float32, n)
+	for i := range scales {
+		scales[i] = 1
+	}
+	for i := 0; i < n; i++ {
+		next := (i + 1) % n
+		edgeLength := distance(vertices[i], vertices[next])
+		required := corners[i].expandedCut + corners[next].expandedCut
+		if required > edgeLength && required > 0 {
+			scale := edgeLength / required
+			scales[i] = min(scales[i], scale)
+			scales[next] = min(scales[next], scale)
+		}
+	}
+
+	cornerCubics := make([]Cubic, n)
+	for i := 0; i < n; i++ {
+		cornerCubics[i] = corners[i].cubic(scales[i])
+	}
+
+	cubics := make([]Cubic, 0, 2*n)
+	for i := 0; i < n; i++ {
+		cubics = append(cubics, cornerCubics[i])
+		next := cornerCubics[(i+1)%n]
+		if !cornerCubics[i].Anchor1.Equal(next.Anchor0) {
+			cubics = append(cubics, StraightLine(cornerCubics[i].Anchor1, next.Anchor0))
+		}
+	}
+
+	return &RoundedPolygon{Cubics: cubics, Center: center}
+}
+
+// Polygon creates a regular polygon with numVertices vertices on a circle of
+// the given radius around the origin. The first vertex is at angle 0 (to the right).
+func Polygon(numVertices int, radius float32, rounding CornerRounding) *RoundedPolygon {
+	if numVertices < 3 {
+		panic("Polygon: numVertices must be at least 3")
+	}
+	vertices := make([]geometry.Offset, numVertices)
+	for i := range vertices {
+		vertices[i] = radialPoint(radius, 2*math.Pi*float64(i)/float64(numVertices))
+	}
+	return NewRoundedPolygon(vertices, rounding, nil, geometry.OffsetZero)
+}
+
+// Star creates a star with numVerticesPerRadius outer vertices on radius and
+// the same number of inner vertices on innerRadius, alternating.
+func Star(numVerticesPerRadius int, radius, innerRadius float32, rounding, innerRounding CornerRounding) *RoundedPolygon {
+	if numVerticesPerRadius < 3 {
+		panic("Star: numVerticesPerRadius must be at least 3")
+	}
+	if innerRadius <= 0 || innerRadius >= radius {
+		panic("Star: innerRadius must be between 0 and radius")
+	}
+	n := numVerticesPerRadius * 2
+	vertices := make([]geometry.Offset, n)
+	roundings := make([]CornerRounding, n)
+	for i := range vertices {
+		angle := math.Pi * float64(i) / float64(numVerticesPerRadius)
+		if i%2 == 0 {
+			vertices[i] = radialPoint(radius, angle)
+			roundings[i] = rounding
+		} else {
+			vertices[i] = radialPoint(innerRadius, angle)
+			roundings[i] = innerRounding
+		}
+	}
+	return NewRoundedPolygon(vertices, Unrounded, roundings, geometry.OffsetZero)
+}
+
+// Circle creates a circle of the given radius around the origin, approximated
+// by a fully rounded regular polygon with numVertices vertices.
+func Circle(numVertices int, radius float32) *RoundedPolygon {
+	if numVertices < 3 {
+		panic("Circle: numVertices must be at least 3")
+	}
+	// Push the vertices out so that the rounded corners touch the circle.
+	theta := math.Pi / float64(numVertices)
+	polygonRadius := radius / float32(math.Cos(theta))
+	return Polygon(numVertices, polygonRadius, CornerRounding{Radius: radius})
+}
+
+// Rectangle creates a rectangle of the given size centered on the origin.
+func Rectangle(width, height float32, rounding CornerRounding, perVertexRounding []CornerRounding) *RoundedPolygon {
+	hw, hh := width/2, height/2
+	vertices := []geometry.Offset{
+		geometry.NewOffset(hw, hh),
+		geometry.NewOffset(-hw, hh),
+		geometry.NewOffset(-hw, -hh),
+		geometry.NewOffset(hw, -hh),
+	}
+	return NewRoundedPolygon(vertices, rounding, perVertexRounding, geometry.OffsetZero)
+}
+
+// Bounds returns the bounds of the polygon's anchor and control points.
+func (p *RoundedPolygon) Bounds() geometry.Rect {
+	first := true
+	var bounds geometry.Rect
+	for _, c := range p.Cubics {
+		for _, pt := range []geometry.Offset{c.Anchor0, c.Control0, c.Control1, c.Anchor1} {
+			if first {
+				bounds = geometry.NewRect(pt.X(), pt.Y(), pt.X(), pt.Y())
+				first = false
+				continue
+			}
+			bounds.Left = min(bounds.Left, pt.X())
+			bounds.Top = min(bounds.Top, pt.Y())
+			bounds.Right = max(bounds.Right, pt.X())
+			bounds.Bottom = max(bounds.Bottom, pt.Y())
+		}
+	}
+	return bounds
+}
+
+// Transformed returns a copy of the polygon with every point mapped by f.
+func (p *RoundedPolygon) Transformed(f PointTransformer) *RoundedPolygon {
+	cubics := make([]Cubic, len(p.Cubics))
+	for i, c := range p.Cubics {
+		cubics[i] = c.Transformed(f)
+	}
+	return &RoundedPolygon{Cubics: cubics, Center: f(p.Center)}
+}
+
+// Rotated returns a copy of the polygon rotated clockwise by degrees around its center.
+func (p *RoundedPolygon) Rotated(degrees float32) *RoundedPolygon {
+	rad := float64(degrees) * math.Pi / 180
+	sin, cos := float32(math.Sin(rad)), float32(math.Cos(rad))
+	cx, cy := p.Center.X(), p.Center.Y()
+	return p.Transformed(func(pt geometry.Offset) geometry.Offset {
+		x, y := pt.X()-cx, pt.Y()-cy
+		return geometry.NewOffset(cx+x*cos-y*sin, cy+x*sin+y*cos)
+	})
+}
+
+// Scaled returns a copy of the polygon scaled by sx and sy around its center.
+func (p *RoundedPolygon) Scaled(sx, sy float32) *RoundedPolygon {
+	cx, cy := p.Center.X(), p.Center.Y()
+	return p.Transformed(func(pt geometry.Offset) geometry.Offset {
+		return geometry.NewOffset(cx+(pt.X()-cx)*sx, cy+(pt.Y()-cy)*sy)
+	})
+}
+
+// Normalized returns a copy of the polygon that fits in the unit square
+// [0, 1] x [0, 1], keeping its aspect ratio and centering it on the shorter axis.
+func (p *RoundedPolygon) Normalized() *RoundedPolygon {
+	bounds := p.Bounds()
+	side := max(bounds.Width(), bounds.Height())
+	if side == 0 {
+		return p
+	}
+	offsetX := (side - bounds.Width()) / 2
+	offsetY := (side - bounds.Height()) / 2
+	return p.Transformed(func(pt geometry.Offset) geometry.Offset {
+		return geometry.NewOffset(
+			(pt.X()-bounds.Left+offsetX)/side,
+			(pt.Y()-bounds.Top+offsetY)/side,
+		)
+	})
+}
+
+// AddToPath appends the polygon as a closed contour to path.
+func (p *RoundedPolygon) AddToPath(path graphics.Path) {
+	addCubicsToPath(path, p.Cubics)
+}
+
+// ToPath returns a new path containing the polygon.
+func (p *RoundedPolygon) ToPath() graphics.Path {
+	path := graphics.NewPath()
+	p.AddToPath(path)
+	return path
+}
+
+func addCubicsToPath(path graphics.Path, cubics []Cubic) {
+	if len(cubics) == 0 {
+		return
+	}
+	start := cubics[0].Anchor0
+	path.MoveTo(start.X(), start.Y())
+	for _, c := range cubics {
+		path.CubicTo(c.Control0.X(), c.Control0.Y(), c.Control1.X(), c.Control1.Y(), c.Anchor1.X(), c.Anchor1.Y())
+	}
+	path.Close()
+}
+
+// roundedCorner holds the geometry needed to replace a vertex by a curve.
+type roundedCorner struct {
+	vertex      geometry.Offset
+	toPrev      geometry.Offset // unit vector from the vertex towards the previous vertex
+	toNext      geometry.Offset // unit vector from the vertex towards the next vertex
+	cut         float32         // distance from the vertex to the arc's tangent points
+	expandedCut float32         // cut stretched by smoothing
+	radius      float32
+	sweep       float64 // angle swept by the arc
+	smoothing   float32
+}
+
+func newRoundedCorner(prev, vertex, next geometry.Offset, rounding CornerRounding) roundedCorner {
+	toPrev := direction(vertex, prev)
+	toNext := direction(vertex, next)
+	corner := roundedCorner{vertex: vertex, toPrev: toPrev, toNext: toNext, smoothing: clamp01(rounding.Smoothing)}
+	if rounding.Radius <= 0 {
+		return corner
+	}
+	cos := float64(toPrev.X()*toNext.X() + toPrev.Y()*toNext.Y())
+	angle := math.Acos(math.Max(-1, math.Min(1, cos)))
+	if angle < 1e-3 || math.Pi-angle < 1e-3 {
+		// Degenerate or straight corner: nothing to round.
+		return corner
+	}
+	corner.radius = rounding.Radius
+	corner.cut = rounding.Radius / float32(math.Tan(angle/2))
+	corner.expandedCut = corner.cut * (1 + corner.smoothing)
+	corner.sweep = math.Pi - angle
+	return corner
+}
+
+// cubic returns the curve that replaces the corner, with the cut scaled to fit.
+func (c roundedCorner) cubic(scale float32) Cubic {
+	if c.cut == 0 {
+		return Cubic{Anchor0: c.vertex, Control0: c.vertex, Control1: c.vertex, Anchor1: c.vertex}
+	}
+	cut := c.expandedCut * scale
+	radius := c.radius * scale
+	// Handle length of a circular arc, stretched towards the vertex by smoothing.
+	handle := float32(4.0/3.0*math.Tan(c.sweep/4)) * radius
+	handle = handle + (cut-handle)*c.smoothing
+	handle = min(handle, cut)
+
+	a0 := c.vertex.Plus(c.toPrev.Times(cut))
+	a1 := c.vertex.Plus(c.toNext.Times(cut))
+	return Cubic{
+		Anchor0:  a0,
+		Control0: a0.Minus(c.toPrev.Times(handle)),
+		Control1: a1.Minus(c.toNext.Times(handle)),
+		Anchor1:  a1,
+	}
+}
+
+func radialPoint(radius float32, angle float64) geometry.Offset {
+	return geometry.NewOffset(radius*float32(math.Cos(angle)), radius*float32(math.Sin(angle)))
+}
+
+func direction(from, to geometry.Offset) geometry.Offset {
+	d := to.Minus(from)
+	length := d.GetDistance()
+	if length == 0 {
+		return geometry.OffsetZero
+	}
+	return d.Div(length)
+}
+
+func distance(a, b geometry.Offset) float32 {
+	return b.Minus(a).GetDistance()
+}
+
+func clamp01(v float32) float32 {
+	return max(0, min(1, v))
+}
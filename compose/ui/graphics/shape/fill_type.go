@@ -0,0 +1,129 @@
+package shape
+
+import (
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+	"github.com/zodimo/go-compose/compose/ui/graphics"
+)
+
+// curveSteps is the number of lines a curve is flattened into to find which
+// contours contain each other.
+const curveSteps = 8
+
+// evenOddSegments returns segments with their contours wound so that the
+// non-zero winding rule, the only one Gio fills with, fills them like the
+// even-odd rule: a contour inside an odd number of others winds against the
+// contours around it, and cuts a hole in them. This matches the even-odd rule
+// for contours that do not cross each other or themselves.
+func evenOddSegments(segments []graphics.PathSegment) []graphics.PathSegment {
+	contours := splitContours(segments)
+	polygons := make([][]geometry.Offset, len(contours))
+	for i, contour := range contours {
+		polygons[i] = flattenContour(contour)
+	}
+	out := make([]graphics.PathSegment, 0, len(segments))
+	for i, contour := range contours {
+		if len(polygons[i]) > 0 {
+			depth := 0
+			for j, polygon := range polygons {
+				if j != i && polygonContains(polygon, polygons[i][0]) {
+					depth++
+				}
+			}
+			if (signedArea(polygons[i]) < 0) != (depth%2 == 1) {
+				contour = reverseContour(contour)
+			}
+		}
+		out = append(out, contour...)
+	}
+	return out
+}
+
+// splitContours splits segments at each move.
+func splitContours(segments []graphics.PathSegment) [][]graphics.PathSegment {
+	var contours [][]graphics.PathSegment
+	for i, segment := range segments {
+		if i == 0 || segment.Type == graphics.PathSegmentTypeMove {
+			contours = append(contours, nil)
+		}
+		contours[len(contours)-1] = append(contours[len(contours)-1], segment)
+	}
+	return contours
+}
+
+// flattenContour returns the points of contour, with its curves flattened.
+func flattenContour(contour []graphics.PathSegment) []geometry.Offset {
+	var points []geometry.Offset
+	for _, segment := range contour {
+		pts := segment.Points
+		switch segment.Type {
+		case graphics.PathSegmentTypeMove:
+			points = append(points, pts[0])
+		case graphics.PathSegmentTypeLine:
+			points = append(points, pts[1])
+		case graphics.PathSegmentTypeQuadratic:
+			for step := 1; step <= curveSteps; step++ {
+				t := float32(step) / curveSteps
+				u := 1 - t
+				points = append(points, pts[0].Times(u*u).Plus(pts[1].Times(2*u*t)).Plus(pts[2].Times(t*t)))
+			}
+		case graphics.PathSegmentTypeCubic:
+			for step := 1; step <= curveSteps; step++ {
+				t := float32(step) / curveSteps
+				u := 1 - t
+				points = append(points, pts[0].Times(u*u*u).Plus(pts[1].Times(3*u*u*t)).Plus(pts[2].Times(3*u*t*t)).Plus(pts[3].Times(t*t*t)))
+			}
+		}
+	}
+	return points
+}
+
+// signedArea returns the area of polygon, positive when it is wound
+// clockwise in the y-down coordinates of the window.
+func signedArea(polygon []geometry.Offset) float32 {
+	var area float32
+	for i, p := range polygon {
+		q := polygon[(i+1)%len(polygon)]
+		area += p.X()*q.Y() - q.X()*p.Y()
+	}
+	return area / 2
+}
+
+// polygonContains reports whether pt is inside polygon.
+func polygonContains(polygon []geometry.Offset, pt geometry.Offset) bool {
+	inside := false
+	for i, p := range polygon {
+		q := polygon[(i+1)%len(polygon)]
+		if (p.Y() > pt.Y()) != (q.Y() > pt.Y()) && pt.X() < p.X()+(pt.Y()-p.Y())*(q.X()-p.X())/(q.Y()-p.Y()) {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// reverseContour returns contour drawn from its end to its start.
+func reverseContour(contour []graphics.PathSegment) []graphics.PathSegment {
+	if len(contour) < 2 || contour[0].Type != graphics.PathSegmentTypeMove {
+		return contour
+	}
+	closed := contour[len(contour)-1].Type == graphics.PathSegmentTypeClose
+	drawn := contour[1:]
+	if closed {
+		drawn = drawn[:len(drawn)-1]
+	}
+	if len(drawn) == 0 {
+		return contour
+	}
+	out := make([]graphics.PathSegment, 0, len(contour))
+	out = append(out, graphics.PathSegment{Type: graphics.PathSegmentTypeMove, Points: []geometry.Offset{drawn[len(drawn)-1].EndPoint()}})
+	for i := len(drawn) - 1; i >= 0; i-- {
+		points := make([]geometry.Offset, len(drawn[i].Points))
+		for j, p := range drawn[i].Points {
+			points[len(points)-1-j] = p
+		}
+		out = append(out, graphics.PathSegment{Type: drawn[i].Type, Points: points})
+	}
+	if closed {
+		out = append(out, graphics.PathSegment{Type: graphics.PathSegmentTypeClose})
+	}
+	return out
+}
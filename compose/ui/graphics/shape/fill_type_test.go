@@ -0,0 +1,55 @@
+package shape
+
+import (
+	"testing"
+
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+	"github.com/zodimo/go-compose/compose/ui/graphics"
+)
+
+func TestEvenOddSegments_Nested(t *testing.T) {
+	path := graphics.NewPath()
+	path.AddRect(geometry.NewRect(0, 0, 100, 100), graphics.PathDirectionClockwise)
+	path.AddOval(geometry.NewRect(20, 20, 80, 80), graphics.PathDirectionClockwise)
+	path.AddRect(geometry.NewRect(40, 40, 60, 60), graphics.PathDirectionClockwise)
+
+	contours := splitContours(evenOddSegments(path.Segments()))
+	if len(contours) != 3 {
+		t.Fatalf("expected 3 contours, got %d", len(contours))
+	}
+	// The rings alternate, so that non-zero fills them like even-odd.
+	for i, wantClockwise := range []bool{true, false, true} {
+		if clockwise := signedArea(flattenContour(contours[i])) > 0; clockwise != wantClockwise {
+			t.Errorf("contour %d: clockwise = %v, want %v", i, clockwise, wantClockwise)
+		}
+	}
+}
+
+func TestEvenOddSegments_Reverse(t *testing.T) {
+	path := graphics.NewPath()
+	path.MoveTo(0, 0)
+	path.LineTo(10, 0)
+	path.QuadraticTo(10, 10, 0, 10)
+	path.Close()
+	reversed := reverseContour(path.Segments())
+
+	want := []graphics.PathSegment{
+		{Type: graphics.PathSegmentTypeMove, Points: []geometry.Offset{geometry.NewOffset(0, 10)}},
+		{Type: graphics.PathSegmentTypeQuadratic, Points: []geometry.Offset{geometry.NewOffset(0, 10), geometry.NewOffset(10, 10), geometry.NewOffset(10, 0)}},
+		{Type: graphics.PathSegmentTypeLine, Points: []geometry.Offset{geometry.NewOffset(10, 0), geometry.NewOffset(0, 0)}},
+		{Type: graphics.PathSegmentTypeClose},
+	}
+	if len(reversed) != len(want) {
+		t.Fatalf("reversed = %v, want %v", reversed, want)
+	}
+	for i := range want {
+		if reversed[i].Type != want[i].Type || len(reversed[i].Points) != len(want[i].Points) {
+			t.Fatalf("segment %d = %v, want %v", i, reversed[i], want[i])
+		}
+		for j := range want[i].Points {
+			if reversed[i].Points[j] != want[i].Points[j] {
+				t.Errorf("segment %d = %v, want %v", i, reversed[i], want[i])
+			}
+		}
+	}
+}
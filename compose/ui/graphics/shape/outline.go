@@ -0,0 +1,184 @@
+package shape
+
+import (
+	"image"
+
+	"gioui.org/f32"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+	"github.com/zodimo/go-compose/compose/ui/graphics"
+)
+
+// The Outline variants below mirror Compose's Outline.Rectangle, Outline.Rounded
+// and Outline.Generic. They are the building blocks for user-defined shapes.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/ui/ui-graphics/src/commonMain/kotlin/androidx/compose/ui/graphics/Outline.kt
+
+var _ Outline = RectangleOutline{}
+var _ Outline = RoundedOutline{}
+var _ Outline = GenericOutline{}
+
+// RectangleOutline is an Outline describing a rectangle.
+type RectangleOutline struct {
+	Rect geometry.Rect
+}
+
+// NewRectangleOutline creates a RectangleOutline.
+func NewRectangleOutline(rect geometry.Rect) RectangleOutline {
+	return RectangleOutline{Rect: rect}
+}
+
+// Bounds returns the bounding rectangle of the outline.
+func (o RectangleOutline) Bounds() geometry.Rect {
+	return o.Rect
+}
+
+func (o RectangleOutline) Push(ops *op.Ops) clip.Stack {
+	return o.Op(ops).Push(ops)
+}
+
+func (o RectangleOutline) Op(ops *op.Ops) clip.Op {
+	return clip.Outline{Path: o.Path(ops)}.Op()
+}
+
+func (o RectangleOutline) Path(ops *op.Ops) clip.PathSpec {
+	path := graphics.NewPath()
+	path.AddRect(o.Rect, graphics.PathDirectionClockwise)
+	return pathSpec(ops, path)
+}
+
+// RoundedOutline is an Outline describing a rectangle with rounded corners.
+type RoundedOutline struct {
+	Rect        geometry.Rect
+	TopLeft     geometry.CornerRadius
+	TopRight    geometry.CornerRadius
+	BottomRight geometry.CornerRadius
+	BottomLeft  geometry.CornerRadius
+}
+
+// NewRoundedOutline creates a RoundedOutline with the same radius on all corners.
+func NewRoundedOutline(rect geometry.Rect, radius geometry.CornerRadius) RoundedOutline {
+	return RoundedOutline{
+		Rect:        rect,
+		TopLeft:     radius,
+		TopRight:    radius,
+		BottomRight: radius,
+		BottomLeft:  radius,
+	}
+}
+
+// Bounds returns the bounding rectangle of the outline.
+func (o RoundedOutline) Bounds() geometry.Rect {
+	return o.Rect
+}
+
+func (o RoundedOutline) Push(ops *op.Ops) clip.Stack {
+	return o.Op(ops).Push(ops)
+}
+
+func (o RoundedOutline) Op(ops *op.Ops) clip.Op {
+	return clip.Outline{Path: o.Path(ops)}.Op()
+}
+
+func (o RoundedOutline) Path(ops *op.Ops) clip.PathSpec {
+	return pathSpec(ops, o.toPath())
+}
+
+func (o RoundedOutline) toPath() graphics.Path {
+	r := o.Rect
+	path := graphics.NewPath()
+	path.MoveTo(r.Left+o.TopLeft.X(), r.Top)
+	path.LineTo(r.Right-o.TopRight.X(), r.Top)
+	cornerArc(path, r.Right-2*o.TopRight.X(), r.Top, o.TopRight, 270)
+	path.LineTo(r.Right, r.Bottom-o.BottomRight.Y())
+	cornerArc(path, r.Right-2*o.BottomRight.X(), r.Bottom-2*o.BottomRight.Y(), o.BottomRight, 0)
+	path.LineTo(r.Left+o.BottomLeft.X(), r.Bottom)
+	cornerArc(path, r.Left, r.Bottom-2*o.BottomLeft.Y(), o.BottomLeft, 90)
+	path.LineTo(r.Left, r.Top+o.TopLeft.Y())
+	cornerArc(path, r.Left, r.Top, o.TopLeft, 180)
+	path.Close()
+	return path
+}
+
+// cornerArc appends a quarter arc for a corner whose radius box starts at (left, top).
+func cornerArc(path graphics.Path, left, top float32, radius geometry.CornerRadius, startAngle float32) {
+	if radius.X() <= 0 || radius.Y() <= 0 {
+		return
+	}
+	path.ArcTo(geometry.NewRect(left, top, left+2*radius.X(), top+2*radius.Y()), startAngle, 90, false)
+}
+
+// GenericOutline is an Outline described by an arbitrary path, filled with
+// the PathFillType of the path. Gio only fills with the non-zero winding
+// rule: an even-odd path has its contours rewound to be filled alike, which
+// is exact as long as they do not cross each other or themselves.
+type GenericOutline struct {
+	path graphics.Path
+}
+
+// NewGenericOutline creates a GenericOutline from path.
+func NewGenericOutline(path graphics.Path) GenericOutline {
+	return GenericOutline{path: path}
+}
+
+// Bounds returns the bounds of the path's control points.
+func (o GenericOutline) Bounds() geometry.Rect {
+	if o.path == nil {
+		return geometry.RectZero
+	}
+	return o.path.GetBounds()
+}
+
+// GraphicsPath returns the path describing the outline.
+func (o GenericOutline) GraphicsPath() graphics.Path {
+	return o.path
+}
+
+func (o GenericOutline) Push(ops *op.Ops) clip.Stack {
+	return o.Op(ops).Push(ops)
+}
+
+func (o GenericOutline) Op(ops *op.Ops) clip.Op {
+	return clip.Outline{Path: o.Path(ops)}.Op()
+}
+
+func (o GenericOutline) Path(ops *op.Ops) clip.PathSpec {
+	return pathSpec(ops, o.path)
+}
+
+// pathSpec replays a graphics.Path onto a Gio clip.Path.
+func pathSpec(ops *op.Ops, path graphics.Path) clip.PathSpec {
+	var p clip.Path
+	p.Begin(ops)
+	if path != nil {
+		segments := path.Segments()
+		if path.FillType() == graphics.PathFillTypeEvenOdd {
+			segments = evenOddSegments(segments)
+		}
+		for _, segment := range segments {
+			pts := segment.Points
+			switch segment.Type {
+			case graphics.PathSegmentTypeMove:
+				p.MoveTo(toF32(pts[0]))
+			case graphics.PathSegmentTypeLine:
+				p.LineTo(toF32(pts[1]))
+			case graphics.PathSegmentTypeQuadratic:
+				p.QuadTo(toF32(pts[1]), toF32(pts[2]))
+			case graphics.PathSegmentTypeCubic:
+				p.CubeTo(toF32(pts[1]), toF32(pts[2]), toF32(pts[3]))
+			case graphics.PathSegmentTypeClose:
+				p.Close()
+			}
+		}
+	}
+	return p.End()
+}
+
+func toF32(o geometry.Offset) f32.Point {
+	return f32.Pt(o.X(), o.Y())
+}
+
+func sizeOf(size image.Point) geometry.Size {
+	return geometry.NewSize(float32(size.X), float32(size.Y))
+}
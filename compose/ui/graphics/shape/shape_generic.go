@@ -0,0 +1,124 @@
+package shape
+
+import (
+	"fmt"
+	"image"
+
+	gioUnit "gioui.org/unit"
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+	"github.com/zodimo/go-compose/compose/ui/graphics"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+)
+
+// PathBuilder builds the path of a GenericShape for the given size, in pixels.
+type PathBuilder func(size geometry.Size, layoutDirection unit.LayoutDirection) graphics.Path
+
+// OutlineBuilder creates the outline of a CustomShape for the given size, in pixels.
+type OutlineBuilder func(size geometry.Size, layoutDirection unit.LayoutDirection, density unit.Density) Outline
+
+var _ DirectionalShape = (*genericShape)(nil)
+var _ DirectionalShape = (*customShape)(nil)
+
+// GenericShape creates a Shape whose outline is the path returned by builder.
+// This is the extension point for application-defined shapes: the result can
+// be used anywhere a Shape is accepted (clip, background, border, Surface, Card).
+//
+//	triangle := shape.GenericShape(func(size geometry.Size, _ unit.LayoutDirection) graphics.Path {
+//		path := graphics.NewPath()
+//		path.MoveTo(size.Width()/2, 0)
+//		path.LineTo(size.Width(), size.Height())
+//		path.LineTo(0, size.Height())
+//		path.Close()
+//		return path
+//	})
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/foundation/foundation/src/commonMain/kotlin/androidx/compose/foundation/shape/GenericShape.kt
+func GenericShape(builder PathBuilder) Shape {
+	if builder == nil {
+		panic("GenericShape: builder cannot be nil")
+	}
+	return &genericShape{builder: builder}
+}
+
+type genericShape struct {
+	builder PathBuilder
+}
+
+func (g *genericShape) CreateOutline(size image.Point, metric gioUnit.Metric) Outline {
+	return g.CreateOutlineWithDirection(size, metric, unit.LayoutDirectionLtr)
+}
+
+func (g *genericShape) CreateOutlineWithDirection(size image.Point, metric gioUnit.Metric, layoutDirection unit.LayoutDirection) Outline {
+	return NewGenericOutline(g.builder(sizeOf(size), layoutDirection))
+}
+
+func (g *genericShape) mergeShape(other Shape) Shape {
+	return other
+}
+
+// Generic shapes are only the same when they are the same instance, as the
+// builder functions cannot be compared.
+func (g *genericShape) sameShape(other Shape) bool {
+	return g == other
+}
+
+func (g *genericShape) semanticEqualShape(other Shape) bool {
+	return g == other
+}
+
+func (g *genericShape) copyShape(options ...ShapeOption) Shape {
+	if len(options) > 0 {
+		panic("GenericShape.copyShape: options not supported")
+	}
+	return g
+}
+
+func (g *genericShape) stringShape() string {
+	return fmt.Sprintf("GenericShape(%p)", g)
+}
+
+// CustomShape creates a Shape from a function that returns its Outline.
+// It mirrors implementing Compose's Shape interface directly and is useful
+// when the outline needs the density, e.g. to convert Dp values to pixels.
+func CustomShape(name string, createOutline OutlineBuilder) Shape {
+	if createOutline == nil {
+		panic("CustomShape: createOutline cannot be nil")
+	}
+	return &customShape{name: name, createOutline: createOutline}
+}
+
+type customShape struct {
+	name          string
+	createOutline OutlineBuilder
+}
+
+func (c *customShape) CreateOutline(size image.Point, metric gioUnit.Metric) Outline {
+	return c.CreateOutlineWithDirection(size, metric, unit.LayoutDirectionLtr)
+}
+
+func (c *customShape) CreateOutlineWithDirection(size image.Point, metric gioUnit.Metric, layoutDirection unit.LayoutDirection) Outline {
+	return c.createOutline(sizeOf(size), layoutDirection, unit.DensityFromMetric(metric))
+}
+
+func (c *customShape) mergeShape(other Shape) Shape {
+	return other
+}
+
+func (c *customShape) sameShape(other Shape) bool {
+	return c == other
+}
+
+func (c *customShape) semanticEqualShape(other Shape) bool {
+	return c == other
+}
+
+func (c *customShape) copyShape(options ...ShapeOption) Shape {
+	if len(options) > 0 {
+		panic("CustomShape.copyShape: options not supported")
+	}
+	return c
+}
+
+func (c *customShape) stringShape() string {
+	return fmt.Sprintf("CustomShape(%s)", c.name)
+}
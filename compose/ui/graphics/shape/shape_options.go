@@ -15,6 +15,8 @@ func WithRadius(radius unit.Dp) ShapeOption {
 			shape.Radius = radius
 		case *CutCornerShape:
 			shape.Radius = radius
+		case *TicketShape:
+			shape.Radius = radius
 		default:
 			panic(fmt.Sprintf("WithRadius: not supported on shape type %s", s.stringShape()))
 		}
@@ -0,0 +1,92 @@
+package shape
+
+import (
+	"fmt"
+	"image"
+
+	gioUnit "gioui.org/unit"
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+	"github.com/zodimo/go-compose/compose/ui/graphics"
+	"github.com/zodimo/go-compose/compose/ui/graphics/polygon"
+)
+
+var _ Shape = (*polygonShape)(nil)
+
+// PolygonShape creates a regular polygon with the given number of sides,
+// pointing up. rounding is the corner radius as a fraction of the polygon's
+// radius, in [0, 1].
+func PolygonShape(sides int, rounding float32) Shape {
+	p := polygon.Polygon(sides, 1, polygon.CornerRounding{Radius: rounding}).Rotated(-90)
+	return newPolygonShape(fmt.Sprintf("PolygonShape{Sides: %d, Rounding: %.2f}", sides, rounding), p.Normalized().Cubics)
+}
+
+// StarShape creates a star with the given number of points, pointing up.
+// innerRadius is the radius of the inner vertices as a fraction of the outer
+// radius, and rounding the corner radius as a fraction of the outer radius.
+func StarShape(points int, innerRadius, rounding float32) Shape {
+	corner := polygon.CornerRounding{Radius: rounding}
+	p := polygon.Star(points, 1, innerRadius, corner, corner).Rotated(-90)
+	return newPolygonShape(fmt.Sprintf("StarShape{Points: %d, InnerRadius: %.2f, Rounding: %.2f}", points, innerRadius, rounding), p.Normalized().Cubics)
+}
+
+// RoundedPolygonShape creates a Shape from a RoundedPolygon. The polygon is
+// normalized and stretched to fill the layout size.
+func RoundedPolygonShape(p *polygon.RoundedPolygon) Shape {
+	return newPolygonShape("RoundedPolygonShape", p.Normalized().Cubics)
+}
+
+// MorphShape creates a Shape from the outline of m at progress. The morph is
+// expected to be between normalized polygons, such as the Material shapes,
+// and is stretched to fill the layout size.
+func MorphShape(m *polygon.Morph, progress float32) Shape {
+	return newPolygonShape(fmt.Sprintf("MorphShape{Progress: %.2f}", progress), m.AsCubics(progress))
+}
+
+// polygonShape draws cubics expressed in the unit square, scaled to the layout size.
+type polygonShape struct {
+	name   string
+	cubics []polygon.Cubic
+}
+
+func newPolygonShape(name string, cubics []polygon.Cubic) *polygonShape {
+	return &polygonShape{name: name, cubics: cubics}
+}
+
+func (p *polygonShape) CreateOutline(size image.Point, metric gioUnit.Metric) Outline {
+	w, h := float32(size.X), float32(size.Y)
+	path := graphics.NewPath()
+	for i, c := range p.cubics {
+		c = c.Transformed(func(pt geometry.Offset) geometry.Offset {
+			return geometry.NewOffset(pt.X()*w, pt.Y()*h)
+		})
+		if i == 0 {
+			path.MoveTo(c.Anchor0.X(), c.Anchor0.Y())
+		}
+		path.CubicTo(c.Control0.X(), c.Control0.Y(), c.Control1.X(), c.Control1.Y(), c.Anchor1.X(), c.Anchor1.Y())
+	}
+	path.Close()
+	return NewGenericOutline(path)
+}
+
+func (p *polygonShape) mergeShape(other Shape) Shape {
+	return other
+}
+
+func (p *polygonShape) sameShape(other Shape) bool {
+	return p == other
+}
+
+func (p *polygonShape) semanticEqualShape(other Shape) bool {
+	return p == other
+}
+
+func (p *polygonShape) copyShape(options ...ShapeOption) Shape {
+	if len(options) > 0 {
+		panic("polygonShape.copyShape: options not supported")
+	}
+	return p
+}
+
+func (p *polygonShape) stringShape() string {
+	return p.name
+}
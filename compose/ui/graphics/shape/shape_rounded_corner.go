@@ -35,7 +35,14 @@ type RoundedCornerShape struct {
 	BottomStart unit.Dp
 }
 
+var _ DirectionalShape = (*RoundedCornerShape)(nil)
+
 func (r *RoundedCornerShape) CreateOutline(size image.Point, metric gioUnit.Metric) Outline {
+	return r.CreateOutlineWithDirection(size, metric, unit.LayoutDirectionLtr)
+}
+
+// CreateOutlineWithDirection mirrors the start and end corners in right-to-left layouts.
+func (r *RoundedCornerShape) CreateOutlineWithDirection(size image.Point, metric gioUnit.Metric, layoutDirection unit.LayoutDirection) Outline {
 	rValid := coalesceRoundedCornerShape(r, RoundedCornerShapeUnspecified)
 
 	var nw, ne, se, sw int
@@ -62,6 +69,11 @@ func (r *RoundedCornerShape) CreateOutline(size image.Point, metric gioUnit.Metr
 		}
 	}
 
+	if layoutDirection == unit.LayoutDirectionRtl {
+		nw, ne = ne, nw
+		sw, se = se, sw
+	}
+
 	// If all corners are 0, use a simple rectangle
 	if nw == 0 && ne == 0 && se == 0 && sw == 0 {
 		return rectOutline{clip.Rect{Max: size}}
@@ -0,0 +1,74 @@
+package shape
+
+import (
+	"image"
+
+	"gioui.org/op/clip"
+	gioUnit "gioui.org/unit"
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+	"github.com/zodimo/go-compose/compose/ui/graphics"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+)
+
+var _ Shape = (*TicketShape)(nil)
+
+// TicketShape is a rectangle with a semicircular notch cut into the middle of
+// its start and end edges, like a tear-off ticket or coupon.
+type TicketShape struct {
+	// Radius of the notches.
+	Radius unit.Dp
+}
+
+func (t *TicketShape) CreateOutline(size image.Point, metric gioUnit.Metric) Outline {
+	radius := float32(t.Radius.TakeOrElse(0)) * metric.PxPerDp
+	w, h := float32(size.X), float32(size.Y)
+	radius = min(radius, h/2, w/2)
+	if radius <= 0 {
+		return rectOutline{clip.Rect{Max: size}}
+	}
+
+	path := graphics.NewPath()
+	path.MoveTo(0, 0)
+	path.LineTo(w, 0)
+	path.LineTo(w, h/2-radius)
+	// End notch, curving inwards.
+	path.ArcTo(geometry.NewRect(w-radius, h/2-radius, w+radius, h/2+radius), 270, -180, false)
+	path.LineTo(w, h)
+	path.LineTo(0, h)
+	path.LineTo(0, h/2+radius)
+	// Start notch, curving inwards.
+	path.ArcTo(geometry.NewRect(-radius, h/2-radius, radius, h/2+radius), 90, -180, false)
+	path.Close()
+	return NewGenericOutline(path)
+}
+
+func (t *TicketShape) mergeShape(other Shape) Shape {
+	if otherTicket, ok := other.(*TicketShape); ok {
+		return &TicketShape{Radius: otherTicket.Radius.TakeOrElse(t.Radius)}
+	}
+	return other
+}
+
+func (t *TicketShape) sameShape(other Shape) bool {
+	_, ok := other.(*TicketShape)
+	return ok
+}
+
+func (t *TicketShape) semanticEqualShape(other Shape) bool {
+	if otherTicket, ok := other.(*TicketShape); ok {
+		return otherTicket.Radius == t.Radius
+	}
+	return false
+}
+
+func (t *TicketShape) copyShape(options ...ShapeOption) Shape {
+	copy := *t
+	for _, option := range options {
+		option(&copy)
+	}
+	return &copy
+}
+
+func (t *TicketShape) stringShape() string {
+	return "TicketShape{Radius: " + t.Radius.String() + "}"
+}
@@ -7,6 +7,7 @@ import (
 	"gioui.org/op"
 	"gioui.org/op/clip"
 	gioUnit "gioui.org/unit"
+	"github.com/zodimo/go-compose/compose/ui/unit"
 )
 
 // https://developer.android.com/reference/kotlin/androidx/compose/ui/graphics/Shape
@@ -28,6 +29,22 @@ type Outline interface {
 	Path(ops *op.Ops) clip.PathSpec
 }
 
+// DirectionalShape is implemented by shapes whose outline depends on the
+// layout direction, such as shapes with start/end specific corners.
+type DirectionalShape interface {
+	Shape
+	CreateOutlineWithDirection(size image.Point, metric gioUnit.Metric, layoutDirection unit.LayoutDirection) Outline
+}
+
+// CreateOutline creates the outline of s for the given size and layout direction.
+// Shapes that do not depend on the layout direction ignore it.
+func CreateOutline(s Shape, size image.Point, metric gioUnit.Metric, layoutDirection unit.LayoutDirection) Outline {
+	if directional, ok := s.(DirectionalShape); ok {
+		return directional.CreateOutlineWithDirection(size, metric, layoutDirection)
+	}
+	return s.CreateOutline(size, metric)
+}
+
 func IsSpecifiedShape(s Shape) bool {
 	return s != nil && s != ShapeUnspecified
 }
@@ -114,6 +131,14 @@ func CopyShape(s Shape, options ...ShapeOption) Shape {
 			option(&copy)
 		}
 		return &copy
+	case *TicketShape:
+		copy := *shape
+		for _, option := range options {
+			option(&copy)
+		}
+		return &copy
+	case *genericShape, *customShape, *polygonShape:
+		return shape.copyShape(options...)
 
 	default:
 		panic(fmt.Sprintf("CopyShape: unknown shape type %s", s.stringShape()))
@@ -0,0 +1,14 @@
+/*
+Package nestedscroll lets elements take part in the scrolling of the
+scrollable content they contain: a collapsing app bar, a bottom sheet or a
+pull to refresh indicator consuming part of a drag before or after a
+LazyColumn scrolls by it.
+
+A scrollable dispatches each scroll delta with a NestedScrollDispatcher to
+the NestedScrollConnections of the NestedScroll modifiers around it, from
+the innermost to the outermost, before and after consuming what is left of
+it. Flings are dispatched the same way, as velocities.
+
+Reference: [Nested scrolling](https://developer.android.com/develop/ui/compose/touch-input/pointer-input/scroll#nested-scrolling)
+*/
+package nestedscroll
@@ -0,0 +1,218 @@
+package nestedscroll
+
+import (
+	"github.com/zodimo/go-compose/compose/ui"
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+	node "github.com/zodimo/go-compose/internal/Node"
+	"github.com/zodimo/go-compose/internal/layoutnode"
+	"github.com/zodimo/go-compose/internal/layoutvalues"
+	"github.com/zodimo/go-compose/internal/modifier"
+
+	"gioui.org/layout"
+)
+
+// NestedScrollSource is the cause of a scroll delta.
+type NestedScrollSource int
+
+const (
+	// NestedScrollSourceUserInput is a delta of a drag or a mouse wheel.
+	NestedScrollSourceUserInput NestedScrollSource = iota
+	// NestedScrollSourceSideEffect is a delta of an animation, such as a
+	// fling after a drag.
+	NestedScrollSourceSideEffect
+)
+
+func (s NestedScrollSource) String() string {
+	switch s {
+	case NestedScrollSourceUserInput:
+		return "UserInput"
+	case NestedScrollSourceSideEffect:
+		return "SideEffect"
+	}
+	return "Unknown"
+}
+
+// NestedScrollConnection takes part in the scrolling of the content of a
+// NestedScroll modifier.
+//
+// Deltas are in pixels and velocities in pixels per second, in the
+// direction the content moves: a drag down, towards the start of a
+// LazyColumn, is positive. The methods return the part of what is available
+// that they consume.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/ui/ui/src/commonMain/kotlin/androidx/compose/ui/input/nestedscroll/NestedScrollModifier.kt
+type NestedScrollConnection interface {
+	// OnPreScroll is called before the content scrolls by available.
+	OnPreScroll(available geometry.Offset, source NestedScrollSource) geometry.Offset
+	// OnPostScroll is called once the content consumed consumed, with what
+	// is left of the delta.
+	OnPostScroll(consumed, available geometry.Offset, source NestedScrollSource) geometry.Offset
+	// OnPreFling is called as a drag ends, before the content flings with
+	// the velocity available.
+	OnPreFling(available geometry.Offset) geometry.Offset
+	// OnPostFling is called once the content flung, with the velocity it
+	// consumed and the velocity left.
+	OnPostFling(consumed, available geometry.Offset) geometry.Offset
+}
+
+// NestedScrollDispatcher dispatches the scrolling of a scrollable to the
+// NestedScroll modifiers around it. A zero dispatcher, or one outside of
+// any NestedScroll modifier, consumes nothing.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/ui/ui/src/commonMain/kotlin/androidx/compose/ui/input/nestedscroll/NestedScrollModifier.kt
+type NestedScrollDispatcher struct {
+	parent *nestedScrollNode
+}
+
+// Attach makes the dispatcher dispatch to the NestedScroll modifiers around
+// gtx. Scrollables laid out outside of a NestedScroll modifier of their own
+// call it on every layout.
+func (d *NestedScrollDispatcher) Attach(gtx layout.Context) {
+	d.parent, _ = gtx.Values[parentValueKey].(*nestedScrollNode)
+}
+
+// HasParent reports whether there is a NestedScroll modifier to dispatch to.
+func (d *NestedScrollDispatcher) HasParent() bool {
+	return d.parent != nil
+}
+
+// DispatchPreScroll dispatches available before the scrollable consumes it,
+// and returns what the parents consumed.
+func (d *NestedScrollDispatcher) DispatchPreScroll(available geometry.Offset, source NestedScrollSource) geometry.Offset {
+	if d.parent == nil {
+		return geometry.OffsetZero
+	}
+	return d.parent.OnPreScroll(available, source)
+}
+
+// DispatchPostScroll dispatches what is left of a delta once the scrollable
+// consumed consumed, and returns what the parents consumed.
+func (d *NestedScrollDispatcher) DispatchPostScroll(consumed, available geometry.Offset, source NestedScrollSource) geometry.Offset {
+	if d.parent == nil {
+		return geometry.OffsetZero
+	}
+	return d.parent.OnPostScroll(consumed, available, source)
+}
+
+// DispatchPreFling dispatches the velocity of a drag that ended, before the
+// scrollable flings, and returns what the parents consumed.
+func (d *NestedScrollDispatcher) DispatchPreFling(available geometry.Offset) geometry.Offset {
+	if d.parent == nil {
+		return geometry.OffsetZero
+	}
+	return d.parent.OnPreFling(available)
+}
+
+// DispatchPostFling dispatches the velocity left once the scrollable flung,
+// and returns what the parents consumed.
+func (d *NestedScrollDispatcher) DispatchPostFling(consumed, available geometry.Offset) geometry.Offset {
+	if d.parent == nil {
+		return geometry.OffsetZero
+	}
+	return d.parent.OnPostFling(consumed, available)
+}
+
+const parentValueKey = "nestedscroll/parent"
+
+// NestedScroll makes connection take part in the scrolling of the
+// scrollables in the element. dispatcher, when not nil, is attached to the
+// NestedScroll modifiers around the element, for the element to dispatch
+// scrolling of its own, such as the drag of a sheet by its handle.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/ui/ui/src/commonMain/kotlin/androidx/compose/ui/input/nestedscroll/NestedScrollModifier.kt
+func NestedScroll(connection NestedScrollConnection, dispatcher *NestedScrollDispatcher) ui.Modifier {
+	return modifier.NewInspectableModifier(
+		modifier.NewModifier(&nestedScrollElement{connection: connection, dispatcher: dispatcher}),
+		modifier.NewInspectorInfo("nestedScroll", map[string]any{
+			"connection": connection,
+			"dispatcher": dispatcher,
+		}),
+	)
+}
+
+type nestedScrollElement struct {
+	connection NestedScrollConnection
+	dispatcher *NestedScrollDispatcher
+}
+
+func (e *nestedScrollElement) Create() node.Node {
+	return newNestedScrollNode(e)
+}
+
+func (e *nestedScrollElement) Update(n node.Node) {
+	n.(*nestedScrollNode).element = e
+}
+
+func (e *nestedScrollElement) Equals(other modifier.Element) bool {
+	o, ok := other.(*nestedScrollElement)
+	return ok && o.connection == e.connection && o.dispatcher == e.dispatcher
+}
+
+// nestedScrollNode is the connection of a NestedScroll modifier to the
+// scrollables in its element: it passes their scrolling on to its own
+// connection and to the modifiers around it.
+type nestedScrollNode struct {
+	node.ChainNode
+	element *nestedScrollElement
+	parent  *nestedScrollNode
+}
+
+func newNestedScrollNode(element *nestedScrollElement) *nestedScrollNode {
+	n := &nestedScrollNode{element: element}
+	n.ChainNode = node.NewChainNode(
+		node.NewNodeID(),
+		node.NodeKindLayout,
+		node.LayoutPhase,
+		func(t node.TreeNode) {
+			t.(layoutnode.LayoutModifierNode).AttachLayoutModifier(func(widget layoutnode.LayoutWidget) layoutnode.LayoutWidget {
+				return layoutnode.NewLayoutWidget(func(gtx layoutnode.LayoutContext) layoutnode.LayoutDimensions {
+					n.parent, _ = gtx.Values[parentValueKey].(*nestedScrollNode)
+					if d := n.element.dispatcher; d != nil {
+						d.parent = n.parent
+					}
+					gtx.Values = layoutvalues.With(gtx.Values, parentValueKey, n)
+					return widget.Layout(gtx)
+				})
+			})
+		},
+	)
+	return n
+}
+
+// The parents are offered deltas first before a scroll, and last after it.
+
+func (n *nestedScrollNode) OnPreScroll(available geometry.Offset, source NestedScrollSource) geometry.Offset {
+	var parentConsumed geometry.Offset
+	if n.parent != nil {
+		parentConsumed = n.parent.OnPreScroll(available, source)
+	}
+	consumed := n.element.connection.OnPreScroll(available.Minus(parentConsumed), source)
+	return parentConsumed.Plus(consumed)
+}
+
+func (n *nestedScrollNode) OnPostScroll(consumed, available geometry.Offset, source NestedScrollSource) geometry.Offset {
+	selfConsumed := n.element.connection.OnPostScroll(consumed, available, source)
+	if n.parent == nil {
+		return selfConsumed
+	}
+	parentConsumed := n.parent.OnPostScroll(consumed.Plus(selfConsumed), available.Minus(selfConsumed), source)
+	return selfConsumed.Plus(parentConsumed)
+}
+
+func (n *nestedScrollNode) OnPreFling(available geometry.Offset) geometry.Offset {
+	var parentConsumed geometry.Offset
+	if n.parent != nil {
+		parentConsumed = n.parent.OnPreFling(available)
+	}
+	consumed := n.element.connection.OnPreFling(available.Minus(parentConsumed))
+	return parentConsumed.Plus(consumed)
+}
+
+func (n *nestedScrollNode) OnPostFling(consumed, available geometry.Offset) geometry.Offset {
+	selfConsumed := n.element.connection.OnPostFling(consumed, available)
+	if n.parent == nil {
+		return selfConsumed
+	}
+	parentConsumed := n.parent.OnPostFling(consumed.Plus(selfConsumed), available.Minus(selfConsumed))
+	return selfConsumed.Plus(parentConsumed)
+}
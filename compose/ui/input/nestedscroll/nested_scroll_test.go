@@ -0,0 +1,86 @@
+package nestedscroll
+
+import (
+	"testing"
+
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+
+	"gioui.org/layout"
+)
+
+// halfConnection consumes half of every delta and velocity it is offered,
+// recording the calls in calls.
+type halfConnection struct {
+	name  string
+	calls *[]string
+}
+
+func (h *halfConnection) OnPreScroll(available geometry.Offset, source NestedScrollSource) geometry.Offset {
+	*h.calls = append(*h.calls, h.name+".pre")
+	return available.Times(0.5)
+}
+
+func (h *halfConnection) OnPostScroll(consumed, available geometry.Offset, source NestedScrollSource) geometry.Offset {
+	*h.calls = append(*h.calls, h.name+".post")
+	return available.Times(0.5)
+}
+
+func (h *halfConnection) OnPreFling(available geometry.Offset) geometry.Offset {
+	*h.calls = append(*h.calls, h.name+".preFling")
+	return available.Times(0.5)
+}
+
+func (h *halfConnection) OnPostFling(consumed, available geometry.Offset) geometry.Offset {
+	*h.calls = append(*h.calls, h.name+".postFling")
+	return available.Times(0.5)
+}
+
+func nestedNodes(calls *[]string) *nestedScrollNode {
+	outer := &nestedScrollNode{element: &nestedScrollElement{connection: &halfConnection{"outer", calls}}}
+	return &nestedScrollNode{
+		element: &nestedScrollElement{connection: &halfConnection{"inner", calls}},
+		parent:  outer,
+	}
+}
+
+func TestDispatcherOrder(t *testing.T) {
+	var calls []string
+	inner := nestedNodes(&calls)
+	var d NestedScrollDispatcher
+	d.Attach(layout.Context{Values: map[string]any{parentValueKey: inner}})
+
+	if got, want := d.DispatchPreScroll(geometry.NewOffset(0, 8), NestedScrollSourceUserInput), geometry.NewOffset(0, 6); got != want {
+		t.Errorf("pre scroll consumed %v, want %v", got, want)
+	}
+	if got, want := d.DispatchPostScroll(geometry.OffsetZero, geometry.NewOffset(0, 8), NestedScrollSourceUserInput), geometry.NewOffset(0, 6); got != want {
+		t.Errorf("post scroll consumed %v, want %v", got, want)
+	}
+	d.DispatchPreFling(geometry.NewOffset(0, 100))
+	d.DispatchPostFling(geometry.OffsetZero, geometry.NewOffset(0, 100))
+
+	want := []string{
+		"outer.pre", "inner.pre",
+		"inner.post", "outer.post",
+		"outer.preFling", "inner.preFling",
+		"inner.postFling", "outer.postFling",
+	}
+	if len(calls) != len(want) {
+		t.Fatalf("calls = %v, want %v", calls, want)
+	}
+	for i := range want {
+		if calls[i] != want[i] {
+			t.Fatalf("calls = %v, want %v", calls, want)
+		}
+	}
+}
+
+func TestDispatcherWithoutParent(t *testing.T) {
+	var d NestedScrollDispatcher
+	d.Attach(layout.Context{})
+	if d.HasParent() {
+		t.Error("HasParent() = true outside of any NestedScroll")
+	}
+	if got := d.DispatchPreScroll(geometry.NewOffset(0, 8), NestedScrollSourceUserInput); got != geometry.OffsetZero {
+		t.Errorf("pre scroll consumed %v, want none", got)
+	}
+}
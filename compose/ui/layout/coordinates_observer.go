@@ -0,0 +1,123 @@
+package layout
+
+import (
+	"image"
+
+	"github.com/zodimo/go-compose/compose/ui/unit"
+
+	"gioui.org/layout"
+)
+
+// coordinatesObserver reports the size and coordinates of an element to the
+// OnSizeChanged, OnPlaced and OnGloballyPositioned callbacks.
+type coordinatesObserver struct {
+	onSizeChanged        func(size unit.IntSize)
+	onPlaced             func(coordinates LayoutCoordinates)
+	onGloballyPositioned func(coordinates LayoutCoordinates)
+
+	// size and placements are collected during the frame.
+	size       image.Point
+	placements []*placement
+
+	attached bool
+	// The values last reported, to only report changes.
+	sizeNotified     bool
+	notifiedSize     image.Point
+	placed           bool
+	placedPosition   image.Point
+	placedSize       image.Point
+	positioned       bool
+	positionedBounds image.Rectangle
+	positionedSize   image.Point
+}
+
+func (o *coordinatesObserver) needsPosition() bool {
+	return o.onPlaced != nil || o.onGloballyPositioned != nil
+}
+
+func (o *coordinatesObserver) beginFrame() {
+	o.placements = o.placements[:0]
+}
+
+// detach marks the element as removed, so that it is reported again once it
+// is laid out.
+func (o *coordinatesObserver) detach() {
+	o.attached = false
+	o.sizeNotified = false
+	o.placed = false
+	o.positioned = false
+}
+
+// notify calls the callbacks whose values changed in the frame.
+func (o *coordinatesObserver) notify(root *placement) {
+	o.attached = true
+	if o.onSizeChanged != nil && (!o.sizeNotified || o.size != o.notifiedSize) {
+		o.sizeNotified = true
+		o.notifiedSize = o.size
+		o.onSizeChanged(unit.IntSize{Width: o.size.X, Height: o.size.Y})
+	}
+	if !o.needsPosition() {
+		return
+	}
+	var p *placement
+	for _, candidate := range o.placements {
+		if candidate.found {
+			p = candidate
+			break
+		}
+	}
+	if p == nil {
+		return
+	}
+	coordinates := &layoutCoordinates{observer: o, size: p.size, bounds: p.bounds}
+	if root != nil && root.found {
+		coordinates.root = root.bounds
+	}
+
+	if o.onPlaced != nil {
+		position := p.bounds.Min
+		if parent := p.node.parent(); parent != nil && parent.found {
+			position = position.Sub(parent.bounds.Min)
+		}
+		if !o.placed || position != o.placedPosition || p.size != o.placedSize {
+			o.placed = true
+			o.placedPosition = position
+			o.placedSize = p.size
+			o.onPlaced(coordinates)
+		}
+	}
+	if o.onGloballyPositioned != nil {
+		if !o.positioned || p.bounds != o.positionedBounds || p.size != o.positionedSize {
+			o.positioned = true
+			o.positionedBounds = p.bounds
+			o.positionedSize = p.size
+			o.onGloballyPositioned(coordinates)
+		}
+	}
+}
+
+// parent returns the placement of the parent of the node of p.
+func (p *placement) parent() *placement {
+	if p == nil {
+		return nil
+	}
+	return p.node
+}
+
+// observe lays out widget, and reports its size and coordinates to observer.
+func observe(gtx layout.Context, observer *coordinatesObserver, widget layout.Widget) layout.Dimensions {
+	owner, _ := gtx.Values[ownerValueKey].(*coordinatesOwner)
+	if _, measuring := IntrinsicQueryOf(gtx); owner == nil || measuring {
+		return widget(gtx)
+	}
+	locating := owner.register(gtx, observer)
+	dims := widget(gtx)
+	observer.size = dims.Size
+	if locating && observer.needsPosition() {
+		node, _ := gtx.Values[placementValueKey].(*placement)
+		p := owner.newPlacement(node)
+		owner.mark(gtx, p, dims.Size)
+		observer.placements = append(observer.placements, p)
+	}
+	return dims
+}
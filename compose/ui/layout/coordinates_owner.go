@@ -0,0 +1,182 @@
+package layout
+
+import (
+	"image"
+	"strconv"
+	"strings"
+
+	"github.com/zodimo/go-compose/internal/layoutnode"
+	"github.com/zodimo/go-compose/internal/layoutvalues"
+	"github.com/zodimo/go-compose/state"
+
+	"gioui.org/io/input"
+	"gioui.org/io/semantic"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+)
+
+// CoordinatesOwner tracks the LayoutCoordinates of the elements of a window
+// that observe them. The runtime lays out a frame with the context returned
+// by BeginFrame, and calls EndFrame once the frame is laid out.
+//
+// Gio containers place their children with transformations that are only
+// resolved by an input router, so the layout pass of the frame is recorded
+// into operations of the owner: while elements observe their position, every
+// node and every observer marks its area there, and an input router of the
+// owner finds where the areas ended up.
+type CoordinatesOwner interface {
+	// BeginFrame returns the context to lay out the frame with, recording
+	// the layout into the operations of the owner, through which the
+	// observers find the owner.
+	BeginFrame(gtx layout.Context) layout.Context
+	// EndFrame finds the coordinates of the observers in the recorded layout
+	// and notifies them.
+	EndFrame()
+}
+
+const (
+	ownerStateKey = "layout/coordinatesOwner"
+
+	ownerValueKey = "layout/coordinatesOwner"
+	// placementValueKey is set to the placement of the node being laid out,
+	// while the frame marks the areas of the nodes.
+	placementValueKey = "layout/placement"
+
+	placementLabelPrefix = "layout/placement:"
+)
+
+// Owner returns the CoordinatesOwner of the window whose state is s.
+func Owner(s state.SupportState) CoordinatesOwner {
+	return s.State(ownerStateKey, func() any { return &coordinatesOwner{} }).Get().(*coordinatesOwner)
+}
+
+var _ CoordinatesOwner = (*coordinatesOwner)(nil)
+
+type coordinatesOwner struct {
+	// observers are the observers laid out in the last frame.
+	observers      []*coordinatesObserver
+	frameObservers []*coordinatesObserver
+	frameIndex     map[*coordinatesObserver]bool
+	// positioned is set when an observer of the frame needs its position.
+	positioned bool
+	// locating is set when the frame marks the areas of the nodes and
+	// observers, idle when no observer needed its position in the last frame,
+	// in which case the next one does not.
+	locating bool
+	idle     bool
+
+	ops        op.Ops
+	router     input.Router
+	placements []*placement
+	semantic   []input.SemanticNode
+}
+
+// placement is an area whose bounds in the window are looked up, marked by a
+// node or an observer.
+type placement struct {
+	id int
+	// node is the placement of the node that laid out this one: the node
+	// itself for the placement of an observer, the parent node for the
+	// placement of a node.
+	node   *placement
+	size   image.Point
+	bounds image.Rectangle
+	found  bool
+}
+
+func (o *coordinatesOwner) BeginFrame(gtx layout.Context) layout.Context {
+	o.frameObservers = o.frameObservers[:0]
+	o.frameIndex = map[*coordinatesObserver]bool{}
+	o.positioned = false
+	o.locating = !o.idle
+	o.placements = o.placements[:0]
+	o.ops.Reset()
+	gtx.Ops = &o.ops
+	if !o.locating {
+		gtx.Values = layoutvalues.With(gtx.Values, ownerValueKey, o)
+		return gtx
+	}
+	gtx.Values = layoutvalues.With(gtx.Values, ownerValueKey, o, placementValueKey, (*placement)(nil))
+	return layoutnode.WithPlacementRecorder(gtx, o.recordNode)
+}
+
+func (o *coordinatesOwner) EndFrame() {
+	if o.locating && o.positioned {
+		o.locate()
+	}
+	o.idle = !o.positioned
+
+	for _, observer := range o.observers {
+		if !o.frameIndex[observer] {
+			observer.detach()
+		}
+	}
+	var root *placement
+	if len(o.placements) > 0 {
+		root = o.placements[0]
+	}
+	for _, observer := range o.frameObservers {
+		observer.notify(root)
+	}
+	o.observers = append(o.observers[:0], o.frameObservers...)
+}
+
+// register adds observer to the frame, and returns whether the frame marks
+// the areas of the observers. When an observer needs its position in a frame
+// that does not, another frame is requested.
+func (o *coordinatesOwner) register(gtx layout.Context, observer *coordinatesObserver) bool {
+	if !o.frameIndex[observer] {
+		o.frameIndex[observer] = true
+		o.frameObservers = append(o.frameObservers, observer)
+		observer.beginFrame()
+	}
+	if observer.needsPosition() {
+		if !o.positioned && !o.locating {
+			gtx.Execute(op.InvalidateCmd{})
+		}
+		o.positioned = true
+	}
+	return o.locating
+}
+
+// locate runs the recorded layout through the input router of the owner, and
+// looks the bounds of the marked areas up.
+func (o *coordinatesOwner) locate() {
+	o.router.Frame(&o.ops)
+	o.semantic = o.router.AppendSemantics(o.semantic[:0])
+	for _, n := range o.semantic {
+		id, ok := strings.CutPrefix(n.Desc.Label, placementLabelPrefix)
+		if !ok {
+			continue
+		}
+		if i, err := strconv.Atoi(id); err == nil && i < len(o.placements) {
+			o.placements[i].bounds = n.Desc.Bounds
+			o.placements[i].found = true
+		}
+	}
+}
+
+// recordNode lays out a node with widget and marks its area.
+func (o *coordinatesOwner) recordNode(gtx layout.Context, widget layout.Widget) layout.Dimensions {
+	parent, _ := gtx.Values[placementValueKey].(*placement)
+	p := o.newPlacement(parent)
+	gtx.Values = layoutvalues.With(gtx.Values, placementValueKey, p)
+	dims := widget(gtx)
+	o.mark(gtx, p, dims.Size)
+	return dims
+}
+
+func (o *coordinatesOwner) newPlacement(node *placement) *placement {
+	p := &placement{id: len(o.placements), node: node}
+	o.placements = append(o.placements, p)
+	return p
+}
+
+// mark adds the area of p, of size, at the current position.
+func (o *coordinatesOwner) mark(gtx layout.Context, p *placement, size image.Point) {
+	p.size = size
+	area := clip.Rect{Max: size}.Push(gtx.Ops)
+	semantic.LabelOp(placementLabelPrefix + strconv.Itoa(p.id)).Add(gtx.Ops)
+	area.Pop()
+}
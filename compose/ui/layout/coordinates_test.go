@@ -0,0 +1,121 @@
+package layout
+
+import (
+	"image"
+	"testing"
+
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+)
+
+// coordinatesTest lays out frames of nodes with a coordinates owner.
+type coordinatesTest struct {
+	owner *coordinatesOwner
+}
+
+func (c *coordinatesTest) frame(content layout.Widget) {
+	var ops op.Ops
+	gtx := layout.Context{
+		Ops:         &ops,
+		Constraints: layout.Exact(image.Pt(200, 200)),
+	}
+	content(c.owner.BeginFrame(gtx))
+	c.owner.EndFrame()
+}
+
+// testNode lays out content as a layout node, at offset in its parent.
+func (c *coordinatesTest) node(gtx layout.Context, offset image.Point, content layout.Widget) layout.Dimensions {
+	defer op.Offset(offset).Push(gtx.Ops).Pop()
+	if _, locating := gtx.Values[placementValueKey]; locating {
+		return c.owner.recordNode(gtx, content)
+	}
+	return content(gtx)
+}
+
+func sized(size image.Point) layout.Widget {
+	return func(gtx layout.Context) layout.Dimensions {
+		return layout.Dimensions{Size: size}
+	}
+}
+
+func TestCoordinatesObserver_Notify(t *testing.T) {
+	c := &coordinatesTest{owner: &coordinatesOwner{}}
+	var sizes []unit.IntSize
+	var placed, positioned []LayoutCoordinates
+	observer := &coordinatesObserver{
+		onSizeChanged:        func(size unit.IntSize) { sizes = append(sizes, size) },
+		onPlaced:             func(coordinates LayoutCoordinates) { placed = append(placed, coordinates) },
+		onGloballyPositioned: func(coordinates LayoutCoordinates) { positioned = append(positioned, coordinates) },
+	}
+	parentOffset, childOffset := image.Pt(10, 20), image.Pt(5, 5)
+	show := true
+	ui := func(gtx layout.Context) layout.Dimensions {
+		return c.node(gtx, image.Point{}, func(gtx layout.Context) layout.Dimensions {
+			return c.node(gtx, parentOffset, func(gtx layout.Context) layout.Dimensions {
+				if show {
+					c.node(gtx, childOffset, func(gtx layout.Context) layout.Dimensions {
+						return observe(gtx, observer, sized(image.Pt(30, 40)))
+					})
+				}
+				return layout.Dimensions{Size: image.Pt(100, 100)}
+			})
+		})
+	}
+
+	c.frame(ui)
+	if len(sizes) != 1 || len(placed) != 1 || len(positioned) != 1 {
+		t.Fatalf("callbacks should be called once, got %d, %d, %d", len(sizes), len(placed), len(positioned))
+	}
+	if want := (unit.IntSize{Width: 30, Height: 40}); sizes[0] != want {
+		t.Errorf("size = %v, want %v", sizes[0], want)
+	}
+	coordinates := positioned[0]
+	if !coordinates.IsAttached() {
+		t.Error("coordinates should be attached")
+	}
+	if got, want := coordinates.PositionInWindow(), geometry.NewOffset(15, 25); got != want {
+		t.Errorf("PositionInWindow = %v, want %v", got, want)
+	}
+	if got, want := coordinates.BoundsInWindow(), geometry.NewRect(15, 25, 45, 65); got != want {
+		t.Errorf("BoundsInWindow = %v, want %v", got, want)
+	}
+
+	// Moving the parent changes the position in the window, not in the parent.
+	parentOffset = image.Pt(50, 20)
+	c.frame(ui)
+	if len(sizes) != 1 || len(placed) != 1 || len(positioned) != 2 {
+		t.Fatalf("only onGloballyPositioned should be called, got %d, %d, %d", len(sizes), len(placed), len(positioned))
+	}
+	if got, want := positioned[1].PositionInRoot(), geometry.NewOffset(55, 25); got != want {
+		t.Errorf("PositionInRoot = %v, want %v", got, want)
+	}
+	if got, want := positioned[1].LocalPositionOf(coordinates, geometry.NewOffset(1, 1)), geometry.NewOffset(-39, 1); got != want {
+		t.Errorf("LocalPositionOf = %v, want %v", got, want)
+	}
+
+	childOffset = image.Pt(0, 0)
+	c.frame(ui)
+	if len(placed) != 2 {
+		t.Errorf("onPlaced should be called when the position in the parent changes, got %d calls", len(placed))
+	}
+
+	show = false
+	c.frame(ui)
+	if coordinates.IsAttached() {
+		t.Error("coordinates should be detached once the element is removed")
+	}
+	// Without observers needing their position, the next frame does not mark
+	// the areas: the position is reported the frame after.
+	show = true
+	c.frame(ui)
+	if len(sizes) != 2 || len(placed) != 2 {
+		t.Errorf("only onSizeChanged should be called in the first frame once attached, got %d, %d", len(sizes), len(placed))
+	}
+	c.frame(ui)
+	if len(sizes) != 2 || len(placed) != 3 {
+		t.Errorf("onPlaced should be called again once attached, got %d, %d", len(sizes), len(placed))
+	}
+}
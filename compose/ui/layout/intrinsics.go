@@ -0,0 +1,118 @@
+package layout
+
+import (
+	"image"
+
+	"github.com/zodimo/go-compose/compose/ui/unit"
+	"github.com/zodimo/go-compose/internal/layoutvalues"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+)
+
+// Intrinsic is an intrinsic measurement of a layout: the size it needs on
+// one axis, given the size of the other one.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/ui/ui/src/commonMain/kotlin/androidx/compose/ui/layout/IntrinsicMeasurable.kt
+type Intrinsic int
+
+const (
+	// IntrinsicMinWidth is the smallest width the layout can be laid out in
+	// without clipping its content, such as the longest word of a text.
+	IntrinsicMinWidth Intrinsic = iota
+	// IntrinsicMaxWidth is the smallest width beyond which the layout no
+	// longer grows, such as the width of a text on one line.
+	IntrinsicMaxWidth
+	// IntrinsicMinHeight is the smallest height the layout can be laid out in
+	// without clipping its content.
+	IntrinsicMinHeight
+	// IntrinsicMaxHeight is the smallest height beyond which the layout no
+	// longer grows.
+	IntrinsicMaxHeight
+)
+
+// IsWidth reports whether the measurement is a width, given a height.
+func (i Intrinsic) IsWidth() bool {
+	return i == IntrinsicMinWidth || i == IntrinsicMaxWidth
+}
+
+// IsMin reports whether the measurement is a minimum.
+func (i Intrinsic) IsMin() bool {
+	return i == IntrinsicMinWidth || i == IntrinsicMinHeight
+}
+
+func (i Intrinsic) String() string {
+	switch i {
+	case IntrinsicMinWidth:
+		return "IntrinsicMinWidth"
+	case IntrinsicMaxWidth:
+		return "IntrinsicMaxWidth"
+	case IntrinsicMinHeight:
+		return "IntrinsicMinHeight"
+	case IntrinsicMaxHeight:
+		return "IntrinsicMaxHeight"
+	default:
+		return "Intrinsic(unknown)"
+	}
+}
+
+const intrinsicValueKey = "layout/intrinsic"
+
+// IntrinsicQuery is the intrinsic measurement a widget is laid out for.
+type IntrinsicQuery struct {
+	Intrinsic Intrinsic
+	// Size is the size of the other axis, or unit.Infinity.
+	Size int
+}
+
+// Dimensions returns the dimensions a widget answers the query with, for a
+// measurement of value.
+func (q IntrinsicQuery) Dimensions(value int) layout.Dimensions {
+	if q.Intrinsic.IsWidth() {
+		return layout.Dimensions{Size: image.Pt(value, 0)}
+	}
+	return layout.Dimensions{Size: image.Pt(0, value)}
+}
+
+// IntrinsicQueryOf returns the intrinsic measurement the widget laid out
+// with gtx is asked for, if any.
+//
+// Widgets that do not look at the query are laid out as usual, within the
+// constraints of the query, and the size they return is their measurement.
+// The query is passed down to their children, so a Gio container of texts
+// measures the minimum width of the texts. Layouts whose children would not
+// be measured that way, such as Row with weighted children, answer the query
+// themselves from MeasureIntrinsic of their children.
+func IntrinsicQueryOf(gtx layout.Context) (IntrinsicQuery, bool) {
+	q, ok := gtx.Values[intrinsicValueKey].(IntrinsicQuery)
+	return q, ok
+}
+
+// MeasureIntrinsic returns the intrinsic measurement of widget, given the
+// size of the other axis, which may be unit.Infinity or, as in Gio
+// constraints, unit.GioInfinity.
+//
+// The widget is laid out with the query into operations that are discarded,
+// and without input events, so that the measurement does not change the
+// frame.
+func MeasureIntrinsic(gtx layout.Context, widget layout.Widget, intrinsic Intrinsic, size int) int {
+	bounded := size >= 0 && size < unit.GioInfinity && size != unit.Infinity
+	if !bounded {
+		size = unit.Infinity
+	}
+	gtx = gtx.Disabled()
+	gtx.Ops = new(op.Ops)
+	gtx.Constraints = layout.Constraints{Max: image.Pt(unit.GioInfinity, unit.GioInfinity)}
+	switch {
+	case bounded && intrinsic.IsWidth():
+		gtx.Constraints.Max.Y = size
+	case bounded:
+		gtx.Constraints.Max.X = size
+	}
+	gtx.Values = layoutvalues.With(gtx.Values, intrinsicValueKey, IntrinsicQuery{Intrinsic: intrinsic, Size: size})
+	dims := widget(gtx)
+	if intrinsic.IsWidth() {
+		return dims.Size.X
+	}
+	return dims.Size.Y
+}
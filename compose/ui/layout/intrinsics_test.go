@@ -0,0 +1,109 @@
+package layout
+
+import (
+	"image"
+	"testing"
+
+	"github.com/zodimo/go-compose/compose/ui/unit"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+)
+
+// wrappingChild is laid out like a text of width words of 10 by 10 pixels,
+// which wraps between words.
+type wrappingChild struct {
+	words int
+}
+
+func (c *wrappingChild) layout(gtx layout.Context) layout.Dimensions {
+	if q, ok := IntrinsicQueryOf(gtx); ok && q.Intrinsic == IntrinsicMinWidth {
+		return q.Dimensions(10)
+	}
+	perLine := max(min(c.words, gtx.Constraints.Max.X/10), 1)
+	lines := (c.words + perLine - 1) / perLine
+	return layout.Dimensions{Size: gtx.Constraints.Constrain(image.Pt(perLine*10, lines*10))}
+}
+
+func TestMeasureIntrinsic(t *testing.T) {
+	gtx := layout.Context{Ops: new(op.Ops), Constraints: layout.Exact(image.Pt(100, 100))}
+	child := &wrappingChild{words: 5}
+	cases := []struct {
+		intrinsic Intrinsic
+		size      int
+		want      int
+	}{
+		{IntrinsicMinWidth, unit.Infinity, 10},
+		{IntrinsicMaxWidth, unit.Infinity, 50},
+		{IntrinsicMinHeight, 20, 30},
+		{IntrinsicMaxHeight, unit.Infinity, 10},
+	}
+	for _, tc := range cases {
+		if got := MeasureIntrinsic(gtx, child.layout, tc.intrinsic, tc.size); got != tc.want {
+			t.Errorf("%v(%d) = %d, want %d", tc.intrinsic, tc.size, got, tc.want)
+		}
+	}
+}
+
+// columnIntrinsicPolicy stacks its children, as wide as the widest.
+type columnIntrinsicPolicy struct {
+	MeasurePolicyFunc
+}
+
+func (columnIntrinsicPolicy) MinIntrinsicWidth(scope IntrinsicMeasureScope, measurables []IntrinsicMeasurable, height int) int {
+	width := 0
+	for _, m := range measurables {
+		width = max(width, m.MinIntrinsicWidth(unit.Infinity))
+	}
+	return width
+}
+
+func (p columnIntrinsicPolicy) MaxIntrinsicWidth(scope IntrinsicMeasureScope, measurables []IntrinsicMeasurable, height int) int {
+	width := 0
+	for _, m := range measurables {
+		width = max(width, m.MaxIntrinsicWidth(unit.Infinity))
+	}
+	return width
+}
+
+func (columnIntrinsicPolicy) MinIntrinsicHeight(scope IntrinsicMeasureScope, measurables []IntrinsicMeasurable, width int) int {
+	height := 0
+	for _, m := range measurables {
+		height += m.MinIntrinsicHeight(width)
+	}
+	return height
+}
+
+func (p columnIntrinsicPolicy) MaxIntrinsicHeight(scope IntrinsicMeasureScope, measurables []IntrinsicMeasurable, width int) int {
+	return p.MinIntrinsicHeight(scope, measurables, width)
+}
+
+func TestMeasureIntrinsic_Policy(t *testing.T) {
+	gtx := layout.Context{Ops: new(op.Ops)}
+	children := []*wrappingChild{{words: 3}, {words: 6}}
+	measurables := func(scope *measureScope) []Measurable {
+		measurables := make([]Measurable, len(children))
+		for i, child := range children {
+			measurables[i] = &measurable{scope: scope, layout: child.layout}
+		}
+		return measurables
+	}
+	policy := columnIntrinsicPolicy{}
+	widget := func(gtx layout.Context) layout.Dimensions {
+		if q, ok := IntrinsicQueryOf(gtx); ok {
+			return measureIntrinsic(gtx, unit.LayoutDirectionLtr, q, measurables, policy)
+		}
+		return measureLayout(gtx, unit.LayoutDirectionLtr, measurables, rowPolicy)
+	}
+
+	if got := MeasureIntrinsic(gtx, widget, IntrinsicMaxWidth, unit.Infinity); got != 60 {
+		t.Errorf("IntrinsicMaxWidth = %d, want 60", got)
+	}
+	if got := MeasureIntrinsic(gtx, widget, IntrinsicMinWidth, unit.Infinity); got != 10 {
+		t.Errorf("IntrinsicMinWidth = %d, want 10", got)
+	}
+	// 2 lines for the first child and 3 for the second.
+	if got := MeasureIntrinsic(gtx, widget, IntrinsicMinHeight, 20); got != 50 {
+		t.Errorf("IntrinsicMinHeight = %d, want 50", got)
+	}
+}
@@ -0,0 +1,83 @@
+package layout
+
+import (
+	"github.com/zodimo/go-compose/compose/ui"
+	"github.com/zodimo/go-compose/compose/ui/platform"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+	"github.com/zodimo/go-compose/internal/layoutnode"
+	"github.com/zodimo/go-compose/pkg/api"
+)
+
+// Layout lays out the children emitted by content with measurePolicy, for
+// layouts that Box, Row and Column cannot express:
+//
+//	layout.Layout(content, layout.MeasurePolicyFunc(func(scope layout.MeasureScope, measurables []layout.Measurable, constraints unit.Constraints) layout.MeasureResult {
+//		placeables := make([]*layout.Placeable, len(measurables))
+//		height := 0
+//		for i, m := range measurables {
+//			placeables[i] = m.Measure(constraints.CopyMaxDimensions())
+//			height += placeables[i].Height()
+//		}
+//		return scope.Layout(constraints.MaxWidth(), height, func() {
+//			y := 0
+//			for _, p := range placeables {
+//				p.PlaceRelative(0, y)
+//				y += p.Height()
+//			}
+//		})
+//	}))
+//
+// The layout direction of the MeasureScope is platform.LocalLayoutDirection.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/ui/ui/src/commonMain/kotlin/androidx/compose/ui/layout/Layout.kt
+func Layout(content api.Composable, measurePolicy MeasurePolicy, options ...LayoutOption) api.Composable {
+	if measurePolicy == nil {
+		panic("Layout: measurePolicy cannot be nil")
+	}
+	opts := DefaultLayoutOptions()
+	for _, option := range options {
+		if option == nil {
+			continue
+		}
+		option(&opts)
+	}
+	return func(c api.Composer) api.Composer {
+		layoutDirection := platform.LocalLayoutDirection.Current(c)
+
+		c.StartBlock("Layout")
+		c.Modifier(func(modifier ui.Modifier) ui.Modifier {
+			return modifier.Then(opts.Modifier)
+		})
+		if content != nil {
+			c.WithComposable(content)
+		}
+		c.SetWidgetConstructor(layoutWidgetConstructor(measurePolicy, layoutDirection))
+		return c.EndBlock()
+	}
+}
+
+func layoutWidgetConstructor(measurePolicy MeasurePolicy, layoutDirection unit.LayoutDirection) layoutnode.LayoutNodeWidgetConstructor {
+	return layoutnode.NewLayoutNodeWidgetConstructor(func(node layoutnode.LayoutNode) layoutnode.GioLayoutWidget {
+		return func(gtx layoutnode.LayoutContext) layoutnode.LayoutDimensions {
+			measurables := func(scope *measureScope) []Measurable {
+				children := node.Children()
+				measurables := make([]Measurable, len(children))
+				for i, child := range children {
+					coordinator := child.(layoutnode.NodeCoordinator)
+					measurables[i] = &measurable{
+						scope:    scope,
+						layout:   coordinator.Layout,
+						elements: coordinator.Elements(),
+					}
+				}
+				return measurables
+			}
+			if policy, ok := measurePolicy.(IntrinsicMeasurePolicy); ok {
+				if q, ok := IntrinsicQueryOf(gtx); ok {
+					return measureIntrinsic(gtx, layoutDirection, q, measurables, policy)
+				}
+			}
+			return measureLayout(gtx, layoutDirection, measurables, measurePolicy)
+		}
+	})
+}
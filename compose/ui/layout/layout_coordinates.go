@@ -0,0 +1,62 @@
+package layout
+
+import (
+	"image"
+
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+)
+
+var _ LayoutCoordinates = (*layoutCoordinates)(nil)
+
+// layoutCoordinates are the coordinates of an element as placed in a frame.
+type layoutCoordinates struct {
+	observer *coordinatesObserver
+	size     image.Point
+	// bounds are the bounds of the element in the window, root the bounds of
+	// the root of the composition.
+	bounds image.Rectangle
+	root   image.Rectangle
+}
+
+func (c *layoutCoordinates) IsAttached() bool {
+	return c.observer.attached
+}
+
+func (c *layoutCoordinates) Size() unit.IntSize {
+	return unit.IntSize{Width: c.size.X, Height: c.size.Y}
+}
+
+func (c *layoutCoordinates) PositionInRoot() geometry.Offset {
+	return pointToOffset(c.bounds.Min.Sub(c.root.Min))
+}
+
+func (c *layoutCoordinates) PositionInWindow() geometry.Offset {
+	return pointToOffset(c.bounds.Min)
+}
+
+func (c *layoutCoordinates) LocalPositionOf(sourceCoordinates LayoutCoordinates, relativeToSource geometry.Offset) geometry.Offset {
+	return sourceCoordinates.PositionInWindow().Plus(relativeToSource).Minus(c.PositionInWindow())
+}
+
+// VisibleBounds returns the part of the element inside the root of the
+// composition. Clipping by the parents of the element is not accounted for.
+func (c *layoutCoordinates) VisibleBounds() geometry.Rect {
+	visible := c.bounds.Intersect(c.root)
+	if visible.Empty() {
+		return geometry.Rect{}
+	}
+	return rectangleToRect(visible.Sub(c.bounds.Min))
+}
+
+func (c *layoutCoordinates) BoundsInWindow() geometry.Rect {
+	return rectangleToRect(c.bounds)
+}
+
+func pointToOffset(p image.Point) geometry.Offset {
+	return geometry.NewOffset(float32(p.X), float32(p.Y))
+}
+
+func rectangleToRect(r image.Rectangle) geometry.Rect {
+	return geometry.NewRect(float32(r.Min.X), float32(r.Min.Y), float32(r.Max.X), float32(r.Max.Y))
+}
@@ -0,0 +1,63 @@
+package layout
+
+import (
+	"github.com/zodimo/go-compose/compose/ui"
+	node "github.com/zodimo/go-compose/internal/Node"
+	"github.com/zodimo/go-compose/internal/layoutnode"
+	"github.com/zodimo/go-compose/internal/modifier"
+)
+
+const layoutIdKey = "layoutId"
+
+// LayoutId tags the element with id, so that the MeasurePolicy of its parent
+// can find its Measurable with Measurable.LayoutId. id must be comparable.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/ui/ui/src/commonMain/kotlin/androidx/compose/ui/layout/LayoutId.kt
+func LayoutId(id any) ui.Modifier {
+	return modifier.NewInspectableModifier(
+		modifier.NewModifier(&LayoutIdElement{id: id}),
+		modifier.NewInspectorInfo("layoutId", map[string]any{
+			"layoutId": id,
+		}),
+	)
+}
+
+type LayoutIdElement struct {
+	id any
+}
+
+func (e *LayoutIdElement) Create() node.Node {
+	return NewLayoutIdNode(e)
+}
+
+func (e *LayoutIdElement) Update(n node.Node) {
+	n.(*LayoutIdNode).element = e
+}
+
+func (e *LayoutIdElement) Equals(other modifier.Element) bool {
+	o, ok := other.(*LayoutIdElement)
+	return ok && o.id == e.id
+}
+
+type LayoutIdNode struct {
+	node.ChainNode
+	element *LayoutIdElement
+}
+
+func NewLayoutIdNode(element *LayoutIdElement) *LayoutIdNode {
+	n := &LayoutIdNode{
+		element: element,
+	}
+	n.ChainNode = node.NewChainNode(
+		node.NewNodeID(),
+		node.NodeKindParentData,
+		node.LayoutPhase,
+		func(t node.TreeNode) {
+			no := t.(layoutnode.ParentDataModifierNode)
+			no.AttachParentDataModifier(func(store layoutnode.ElementStore) layoutnode.ElementStore {
+				return store.SetElement(layoutIdKey, n.element)
+			})
+		},
+	)
+	return n
+}
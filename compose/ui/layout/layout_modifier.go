@@ -0,0 +1,90 @@
+package layout
+
+import (
+	"github.com/zodimo/go-compose/compose/ui"
+	"github.com/zodimo/go-compose/compose/ui/platform"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+	node "github.com/zodimo/go-compose/internal/Node"
+	"github.com/zodimo/go-compose/internal/layoutnode"
+	"github.com/zodimo/go-compose/internal/modifier"
+)
+
+// MeasureFunc measures and places the single measurable of a LayoutModifier.
+type MeasureFunc func(scope MeasureScope, measurable Measurable, constraints unit.Constraints) MeasureResult
+
+// LayoutModifier changes how the element is measured and placed, like
+// Modifier.layout in Compose: measure receives the element wrapped by the
+// modifier as a Measurable, and places it in a layout of its own size.
+//
+//	layout.LayoutModifier(func(scope layout.MeasureScope, measurable layout.Measurable, constraints unit.Constraints) layout.MeasureResult {
+//		p := measurable.Measure(constraints)
+//		return scope.Layout(p.Width(), p.Height()+top, func() { p.PlaceRelative(0, top) })
+//	})
+//
+// The layout direction of the MeasureScope is platform.LocalLayoutDirection
+// where the element is composed.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/ui/ui/src/commonMain/kotlin/androidx/compose/ui/layout/LayoutModifier.kt
+func LayoutModifier(measure MeasureFunc) ui.Modifier {
+	if measure == nil {
+		panic("LayoutModifier: measure cannot be nil")
+	}
+	return modifier.NewInspectableModifier(
+		modifier.NewModifier(&LayoutModifierElement{measure: measure}),
+		modifier.NewInspectorInfo("layout", map[string]any{
+			"measure": measure,
+		}),
+	)
+}
+
+type LayoutModifierElement struct {
+	measure MeasureFunc
+}
+
+func (e *LayoutModifierElement) Create() node.Node {
+	return NewLayoutModifierNode(e.measure)
+}
+
+func (e *LayoutModifierElement) Update(n node.Node) {
+	n.(*LayoutModifierNode).measure = e.measure
+}
+
+// Equals is always false, as functions cannot be compared.
+func (e *LayoutModifierElement) Equals(other modifier.Element) bool {
+	return false
+}
+
+type LayoutModifierNode struct {
+	node.ChainNode
+	measure MeasureFunc
+}
+
+func NewLayoutModifierNode(measure MeasureFunc) *LayoutModifierNode {
+	n := &LayoutModifierNode{
+		measure: measure,
+	}
+	n.ChainNode = node.NewChainNode(
+		node.NewNodeID(),
+		node.NodeKindLayout,
+		node.LayoutPhase,
+		func(t node.TreeNode) {
+			no := t.(layoutnode.LayoutModifierNode)
+			no.AttachLayoutModifier(func(widget layoutnode.LayoutWidget) layoutnode.LayoutWidget {
+				return layoutnode.NewLayoutWidget(func(gtx layoutnode.LayoutContext) layoutnode.LayoutDimensions {
+					var elements modifier.ElementStore
+					if coordinator, ok := t.(layoutnode.NodeCoordinator); ok {
+						elements = coordinator.Elements()
+					}
+					policy := MeasurePolicyFunc(func(scope MeasureScope, measurables []Measurable, constraints unit.Constraints) MeasureResult {
+						return n.measure(scope, measurables[0], constraints)
+					})
+					layoutDirection := platform.LocalLayoutDirection.CurrentAt(no)
+					return measureLayout(gtx, layoutDirection, func(scope *measureScope) []Measurable {
+						return []Measurable{&measurable{scope: scope, layout: widget.Layout, elements: elements}}
+					}, policy)
+				})
+			})
+		},
+	)
+	return n
+}
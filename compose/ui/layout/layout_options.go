@@ -0,0 +1,21 @@
+package layout
+
+import "github.com/zodimo/go-compose/compose/ui"
+
+type LayoutOptions struct {
+	Modifier ui.Modifier
+}
+
+type LayoutOption func(*LayoutOptions)
+
+func DefaultLayoutOptions() LayoutOptions {
+	return LayoutOptions{
+		Modifier: ui.EmptyModifier,
+	}
+}
+
+func WithModifier(m ui.Modifier) LayoutOption {
+	return func(o *LayoutOptions) {
+		o.Modifier = m
+	}
+}
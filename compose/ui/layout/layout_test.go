@@ -0,0 +1,152 @@
+package layout
+
+import (
+	"image"
+	"testing"
+
+	"github.com/zodimo/go-compose/compose/ui/unit"
+
+	"gioui.org/f32"
+	"gioui.org/io/event"
+	"gioui.org/io/input"
+	"gioui.org/io/pointer"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+)
+
+type testChild struct {
+	size        image.Point
+	constraints layout.Constraints
+}
+
+func (c *testChild) layout(gtx layout.Context) layout.Dimensions {
+	c.constraints = gtx.Constraints
+	gtx.Event(pointer.Filter{Target: c, Kinds: pointer.Press})
+	defer clip.Rect{Max: c.size}.Push(gtx.Ops).Pop()
+	event.Op(gtx.Ops, c)
+	return layout.Dimensions{Size: c.size}
+}
+
+// rowPolicy places its children side by side, from the start edge.
+var rowPolicy = MeasurePolicyFunc(func(scope MeasureScope, measurables []Measurable, constraints unit.Constraints) MeasureResult {
+	placeables := make([]*Placeable, len(measurables))
+	width, height := 0, 0
+	for i, m := range measurables {
+		placeables[i] = m.Measure(constraints.CopyMaxDimensions())
+		width += placeables[i].Width()
+		height = max(height, placeables[i].Height())
+	}
+	return scope.Layout(width, height, func() {
+		x := 0
+		for _, p := range placeables {
+			p.PlaceRelative(x, 0)
+			x += p.Width()
+		}
+	})
+})
+
+func layoutChildren(router *input.Router, direction unit.LayoutDirection, constraints layout.Constraints, policy MeasurePolicy, children ...*testChild) layout.Dimensions {
+	var ops op.Ops
+	gtx := layout.Context{Ops: &ops, Source: router.Source(), Constraints: constraints}
+	dims := measureLayout(gtx, direction, func(scope *measureScope) []Measurable {
+		measurables := make([]Measurable, len(children))
+		for i, c := range children {
+			measurables[i] = &measurable{scope: scope, layout: c.layout}
+		}
+		return measurables
+	}, policy)
+	router.Frame(&ops)
+	return dims
+}
+
+// hit returns the index of the child at x, y, or -1.
+func hit(router *input.Router, x, y float32, children ...*testChild) int {
+	router.Queue(pointer.Event{
+		Kind:     pointer.Press,
+		Source:   pointer.Mouse,
+		Buttons:  pointer.ButtonPrimary,
+		Position: f32.Pt(x, y),
+	})
+	defer router.Queue(pointer.Event{Kind: pointer.Release, Source: pointer.Mouse, Position: f32.Pt(x, y)})
+	found := -1
+	for i, c := range children {
+		for {
+			ev, ok := router.Event(pointer.Filter{Target: c, Kinds: pointer.Press})
+			if !ok {
+				break
+			}
+			if e, ok := ev.(pointer.Event); ok && e.Kind == pointer.Press {
+				found = i
+			}
+		}
+	}
+	return found
+}
+
+func TestLayout_MeasureAndPlace(t *testing.T) {
+	for _, tt := range []struct {
+		direction unit.LayoutDirection
+		// xs are points inside the first and second child.
+		xs [2]float32
+	}{
+		{unit.LayoutDirectionLtr, [2]float32{5, 25}},
+		{unit.LayoutDirectionRtl, [2]float32{45, 25}},
+	} {
+		t.Run(tt.direction.String(), func(t *testing.T) {
+			var router input.Router
+			a := &testChild{size: image.Pt(10, 20)}
+			b := &testChild{size: image.Pt(30, 10)}
+			constraints := layout.Constraints{Min: image.Pt(50, 0), Max: image.Pt(100, 100)}
+
+			dims := layoutChildren(&router, tt.direction, constraints, rowPolicy, a, b)
+			// The width is coerced into the minimum of the constraints.
+			if want := image.Pt(50, 20); dims.Size != want {
+				t.Errorf("size = %v, want %v", dims.Size, want)
+			}
+			if want := (layout.Constraints{Max: image.Pt(100, 100)}); a.constraints != want {
+				t.Errorf("child constraints = %v, want %v", a.constraints, want)
+			}
+			if got := hit(&router, tt.xs[0], 5, a, b); got != 0 {
+				t.Errorf("hit at %v = %d, want the first child", tt.xs[0], got)
+			}
+			if got := hit(&router, tt.xs[1], 5, a, b); got != 1 {
+				t.Errorf("hit at %v = %d, want the second child", tt.xs[1], got)
+			}
+		})
+	}
+}
+
+func TestLayout_UnboundedConstraints(t *testing.T) {
+	var router input.Router
+	a := &testChild{size: image.Pt(10, 10)}
+	constraints := layout.Constraints{Max: image.Pt(200, 1e6)}
+	var got unit.Constraints
+	policy := MeasurePolicyFunc(func(scope MeasureScope, measurables []Measurable, constraints unit.Constraints) MeasureResult {
+		got = constraints
+		p := measurables[0].Measure(constraints)
+		return scope.Layout(p.Width(), p.Height(), func() { p.PlaceAt(0, 0) })
+	})
+	layoutChildren(&router, unit.LayoutDirectionLtr, constraints, policy, a)
+	if got.HasBoundedHeight() || got.MaxWidth() != 200 {
+		t.Errorf("constraints = %v, want an unbounded height", got)
+	}
+	if a.constraints.Max.Y != unit.GioInfinity {
+		t.Errorf("child max height = %d, want %d", a.constraints.Max.Y, int(unit.GioInfinity))
+	}
+}
+
+func TestLayout_MeasureTwicePanics(t *testing.T) {
+	var router input.Router
+	policy := MeasurePolicyFunc(func(scope MeasureScope, measurables []Measurable, constraints unit.Constraints) MeasureResult {
+		measurables[0].Measure(constraints)
+		measurables[0].Measure(constraints)
+		return scope.Layout(0, 0, nil)
+	})
+	defer func() {
+		if recover() == nil {
+			t.Error("measuring twice should panic")
+		}
+	}()
+	layoutChildren(&router, unit.LayoutDirectionLtr, layout.Constraints{Max: image.Pt(10, 10)}, policy, &testChild{})
+}
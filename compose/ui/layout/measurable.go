@@ -0,0 +1,168 @@
+package layout
+
+import (
+	"fmt"
+	"image"
+
+	"github.com/zodimo/go-compose/compose/ui/unit"
+	"github.com/zodimo/go-compose/internal/modifier"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+)
+
+// IntrinsicMeasurable is a child of a layout whose intrinsic measurements
+// can be queried, any number of times, before it is measured.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/ui/ui/src/commonMain/kotlin/androidx/compose/ui/layout/IntrinsicMeasurable.kt
+type IntrinsicMeasurable interface {
+	// ParentData returns the parent data element set under key by the
+	// modifiers of the child, such as the weight of a row child.
+	ParentData(key string) (any, bool)
+
+	// MinIntrinsicWidth returns the smallest width the child can take
+	// without clipping its content, given height, which may be
+	// unit.Infinity.
+	MinIntrinsicWidth(height int) int
+	// MaxIntrinsicWidth returns the smallest width beyond which the child
+	// no longer grows, given height.
+	MaxIntrinsicWidth(height int) int
+	// MinIntrinsicHeight returns the smallest height the child can take
+	// without clipping its content, given width, which may be
+	// unit.Infinity.
+	MinIntrinsicHeight(width int) int
+	// MaxIntrinsicHeight returns the smallest height beyond which the child
+	// no longer grows, given width.
+	MaxIntrinsicHeight(width int) int
+}
+
+// Measurable is a child of a layout, to be measured once.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/ui/ui/src/commonMain/kotlin/androidx/compose/ui/layout/Measurable.kt
+type Measurable interface {
+	IntrinsicMeasurable
+
+	// Measure measures the child with constraints, and returns it ready to be
+	// placed. It panics when called twice.
+	Measure(constraints unit.Constraints) *Placeable
+
+	// LayoutId returns the id set with the LayoutId modifier, or nil.
+	LayoutId() any
+}
+
+var _ Measurable = (*measurable)(nil)
+
+type measurable struct {
+	scope    *measureScope
+	layout   layout.Widget
+	elements modifier.ElementStore
+	measured bool
+}
+
+func (m *measurable) Measure(constraints unit.Constraints) *Placeable {
+	if m.measured {
+		panic("Measure may not be called multiple times on the same Measurable")
+	}
+	m.measured = true
+
+	gtx := m.scope.gtx
+	gtx.Constraints = unit.ConstraintsToGio(constraints)
+	macro := op.Record(gtx.Ops)
+	dims := m.layout(gtx)
+	call := macro.Stop()
+
+	size := constraints.ConstrainSize(unit.IntSize{Width: dims.Size.X, Height: dims.Size.Y})
+	return &Placeable{
+		scope:    m.scope,
+		width:    size.Width,
+		height:   size.Height,
+		baseline: dims.Size.Y - dims.Baseline,
+		call:     call,
+	}
+}
+
+func (m *measurable) ParentData(key string) (any, bool) {
+	if m.elements == nil {
+		return nil, false
+	}
+	element := m.elements.GetElement(key)
+	if element.IsNone() {
+		return nil, false
+	}
+	return element.UnwrapUnsafe(), true
+}
+
+func (m *measurable) MinIntrinsicWidth(height int) int {
+	return MeasureIntrinsic(m.scope.gtx, m.layout, IntrinsicMinWidth, height)
+}
+
+func (m *measurable) MaxIntrinsicWidth(height int) int {
+	return MeasureIntrinsic(m.scope.gtx, m.layout, IntrinsicMaxWidth, height)
+}
+
+func (m *measurable) MinIntrinsicHeight(width int) int {
+	return MeasureIntrinsic(m.scope.gtx, m.layout, IntrinsicMinHeight, width)
+}
+
+func (m *measurable) MaxIntrinsicHeight(width int) int {
+	return MeasureIntrinsic(m.scope.gtx, m.layout, IntrinsicMaxHeight, width)
+}
+
+func (m *measurable) LayoutId() any {
+	if element, ok := m.ParentData(layoutIdKey); ok {
+		return element.(*LayoutIdElement).id
+	}
+	return nil
+}
+
+// Placeable is a measured child, placed by the placement block of its
+// MeasureResult.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/ui/ui/src/commonMain/kotlin/androidx/compose/ui/layout/Placeable.kt
+type Placeable struct {
+	scope    *measureScope
+	width    int
+	height   int
+	baseline int
+	call     op.CallOp
+}
+
+// Width returns the measured width, within the measurement constraints.
+func (p *Placeable) Width() int {
+	return p.width
+}
+
+// Height returns the measured height, within the measurement constraints.
+func (p *Placeable) Height() int {
+	return p.height
+}
+
+// Baseline returns the distance from the top of the child to its first
+// baseline, or the bottom of its content when it has no text.
+func (p *Placeable) Baseline() int {
+	return p.baseline
+}
+
+// PlaceAt places the child with its top left corner at x, y in the layout.
+func (p *Placeable) PlaceAt(x, y int) {
+	p.place(x, y)
+}
+
+// PlaceRelative places the child like PlaceAt in left to right layouts; in
+// right to left layouts, x is the distance from the right edge of the layout
+// to the right edge of the child.
+func (p *Placeable) PlaceRelative(x, y int) {
+	if p.scope.layoutDirection == unit.LayoutDirectionRtl {
+		x = p.scope.width - p.width - x
+	}
+	p.place(x, y)
+}
+
+func (p *Placeable) place(x, y int) {
+	if !p.scope.placing {
+		panic(fmt.Sprintf("Placeable (%dx%d) must be placed in the placement block of MeasureScope.Layout", p.width, p.height))
+	}
+	stack := op.Offset(image.Pt(x, y)).Push(p.scope.gtx.Ops)
+	p.call.Add(p.scope.gtx.Ops)
+	stack.Pop()
+}
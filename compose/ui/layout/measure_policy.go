@@ -0,0 +1,70 @@
+package layout
+
+import (
+	"github.com/zodimo/go-compose/compose/ui/unit"
+)
+
+// MeasurePolicy measures and places the children of a Layout.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/ui/ui/src/commonMain/kotlin/androidx/compose/ui/layout/MeasurePolicy.kt
+type MeasurePolicy interface {
+	// Measure measures the children with constraints of its choice, each at
+	// most once, and returns the size of the layout with the placement of
+	// the children, made with scope.Layout.
+	Measure(scope MeasureScope, measurables []Measurable, constraints unit.Constraints) MeasureResult
+}
+
+// MeasurePolicyFunc is a MeasurePolicy implemented by a function.
+type MeasurePolicyFunc func(scope MeasureScope, measurables []Measurable, constraints unit.Constraints) MeasureResult
+
+func (f MeasurePolicyFunc) Measure(scope MeasureScope, measurables []Measurable, constraints unit.Constraints) MeasureResult {
+	return f(scope, measurables, constraints)
+}
+
+// IntrinsicMeasurePolicy is a MeasurePolicy that computes the intrinsic
+// measurements of its layout from the ones of its children. Without it, the
+// intrinsic measurements of a Layout are the size Measure returns when the
+// children are measured to their intrinsic size.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/ui/ui/src/commonMain/kotlin/androidx/compose/ui/layout/MeasurePolicy.kt
+type IntrinsicMeasurePolicy interface {
+	MeasurePolicy
+
+	MinIntrinsicWidth(scope IntrinsicMeasureScope, measurables []IntrinsicMeasurable, height int) int
+	MaxIntrinsicWidth(scope IntrinsicMeasureScope, measurables []IntrinsicMeasurable, height int) int
+	MinIntrinsicHeight(scope IntrinsicMeasureScope, measurables []IntrinsicMeasurable, width int) int
+	MaxIntrinsicHeight(scope IntrinsicMeasureScope, measurables []IntrinsicMeasurable, width int) int
+}
+
+// IntrinsicMeasureScope is the receiver of an intrinsic measurement, with the
+// density and layout direction of the layout.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/ui/ui/src/commonMain/kotlin/androidx/compose/ui/layout/IntrinsicMeasureScope.kt
+type IntrinsicMeasureScope interface {
+	unit.Density
+
+	LayoutDirection() unit.LayoutDirection
+}
+
+// MeasureScope is the receiver of a measurement, with the density and layout
+// direction of the layout.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/ui/ui/src/commonMain/kotlin/androidx/compose/ui/layout/MeasureScope.kt
+type MeasureScope interface {
+	IntrinsicMeasureScope
+
+	// Layout returns the result of a measurement: the size of the layout, in
+	// pixels, and placementBlock, which places the measured children with
+	// Placeable.PlaceAt and Placeable.PlaceRelative. The size is coerced into
+	// the constraints of the layout.
+	Layout(width, height int, placementBlock func()) MeasureResult
+}
+
+// MeasureResult is the size of a measured layout, and the placement of its
+// children.
+type MeasureResult struct {
+	Width  int
+	Height int
+
+	placementBlock func()
+}
@@ -0,0 +1,80 @@
+package layout
+
+import (
+	"image"
+
+	"github.com/zodimo/go-compose/compose/ui/unit"
+
+	"gioui.org/layout"
+)
+
+var _ MeasureScope = (*measureScope)(nil)
+
+// density is embedded in measureScope, whose Density method comes from it.
+type density = unit.Density
+
+type measureScope struct {
+	density
+	gtx             layout.Context
+	layoutDirection unit.LayoutDirection
+
+	// placing is set while the placement block runs, in a layout of width.
+	placing bool
+	width   int
+}
+
+func newMeasureScope(gtx layout.Context, layoutDirection unit.LayoutDirection) *measureScope {
+	return &measureScope{
+		density:         unit.DensityFromMetric(gtx.Metric),
+		gtx:             gtx,
+		layoutDirection: layoutDirection,
+	}
+}
+
+func (s *measureScope) LayoutDirection() unit.LayoutDirection {
+	return s.layoutDirection
+}
+
+func (s *measureScope) Layout(width, height int, placementBlock func()) MeasureResult {
+	return MeasureResult{Width: width, Height: height, placementBlock: placementBlock}
+}
+
+// measureLayout runs policy in gtx, and places the children at the current
+// position of gtx.Ops.
+func measureLayout(gtx layout.Context, layoutDirection unit.LayoutDirection, measurables func(scope *measureScope) []Measurable, policy MeasurePolicy) layout.Dimensions {
+	scope := newMeasureScope(gtx, layoutDirection)
+	constraints := unit.ConstraintsFromGio(gtx.Constraints)
+	result := policy.Measure(scope, measurables(scope), constraints)
+
+	size := constraints.ConstrainSize(unit.IntSize{Width: result.Width, Height: result.Height})
+	if result.placementBlock != nil {
+		scope.placing = true
+		scope.width = size.Width
+		result.placementBlock()
+		scope.placing = false
+	}
+	return layout.Dimensions{Size: image.Pt(size.Width, size.Height)}
+}
+
+// measureIntrinsic answers the intrinsic query q of a layout with the
+// intrinsic measurements of policy.
+func measureIntrinsic(gtx layout.Context, layoutDirection unit.LayoutDirection, q IntrinsicQuery, measurables func(scope *measureScope) []Measurable, policy IntrinsicMeasurePolicy) layout.Dimensions {
+	scope := newMeasureScope(gtx, layoutDirection)
+	children := measurables(scope)
+	intrinsicMeasurables := make([]IntrinsicMeasurable, len(children))
+	for i, m := range children {
+		intrinsicMeasurables[i] = m
+	}
+	var value int
+	switch q.Intrinsic {
+	case IntrinsicMinWidth:
+		value = policy.MinIntrinsicWidth(scope, intrinsicMeasurables, q.Size)
+	case IntrinsicMaxWidth:
+		value = policy.MaxIntrinsicWidth(scope, intrinsicMeasurables, q.Size)
+	case IntrinsicMinHeight:
+		value = policy.MinIntrinsicHeight(scope, intrinsicMeasurables, q.Size)
+	case IntrinsicMaxHeight:
+		value = policy.MaxIntrinsicHeight(scope, intrinsicMeasurables, q.Size)
+	}
+	return q.Dimensions(value)
+}
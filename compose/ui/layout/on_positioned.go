@@ -0,0 +1,113 @@
+package layout
+
+import (
+	"fmt"
+
+	"github.com/zodimo/go-compose/compose/ui"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+	node "github.com/zodimo/go-compose/internal/Node"
+	"github.com/zodimo/go-compose/internal/layoutnode"
+	"github.com/zodimo/go-compose/internal/modifier"
+)
+
+// OnGloballyPositioned calls onGloballyPositioned with the coordinates of the
+// element once it is laid out, and again when its bounds in the window
+// change.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/ui/ui/src/commonMain/kotlin/androidx/compose/ui/layout/OnGloballyPositionedModifier.kt
+func OnGloballyPositioned(onGloballyPositioned func(coordinates LayoutCoordinates)) ui.Modifier {
+	return modifier.NewInspectableModifier(
+		modifier.NewModifier(&CoordinatesObserverElement{
+			kind:                 node.NodeKindGlobalPositionAware,
+			onGloballyPositioned: onGloballyPositioned,
+		}),
+		modifier.NewInspectorInfo("onGloballyPositioned", map[string]any{
+			"onGloballyPositioned": onGloballyPositioned,
+		}),
+	)
+}
+
+// OnPlaced calls onPlaced with the coordinates of the element once it is
+// laid out, and again when its size or its position in its parent layout
+// change.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/ui/ui/src/commonMain/kotlin/androidx/compose/ui/layout/OnPlacedModifier.kt
+func OnPlaced(onPlaced func(coordinates LayoutCoordinates)) ui.Modifier {
+	return modifier.NewInspectableModifier(
+		modifier.NewModifier(&CoordinatesObserverElement{
+			kind:     node.NodeKindLayoutAware,
+			onPlaced: onPlaced,
+		}),
+		modifier.NewInspectorInfo("onPlaced", map[string]any{
+			"onPlaced": onPlaced,
+		}),
+	)
+}
+
+// OnSizeChanged calls onSizeChanged with the size of the element once it is
+// laid out, and again when it changes.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/ui/ui/src/commonMain/kotlin/androidx/compose/ui/layout/OnRemeasuredModifier.kt
+func OnSizeChanged(onSizeChanged func(size unit.IntSize)) ui.Modifier {
+	return modifier.NewInspectableModifier(
+		modifier.NewModifier(&CoordinatesObserverElement{
+			kind:          node.NodeKindLayoutAware,
+			onSizeChanged: onSizeChanged,
+		}),
+		modifier.NewInspectorInfo("onSizeChanged", map[string]any{
+			"onSizeChanged": onSizeChanged,
+		}),
+	)
+}
+
+type CoordinatesObserverElement struct {
+	kind                 node.NodeKind
+	onSizeChanged        func(size unit.IntSize)
+	onPlaced             func(coordinates LayoutCoordinates)
+	onGloballyPositioned func(coordinates LayoutCoordinates)
+}
+
+func (e *CoordinatesObserverElement) Create() node.Node {
+	return NewCoordinatesObserverNode(e)
+}
+
+func (e *CoordinatesObserverElement) Update(n node.Node) {
+	n.(*CoordinatesObserverNode).element = e
+}
+
+// Equals is always false, as functions are not comparable.
+func (e *CoordinatesObserverElement) Equals(other modifier.Element) bool {
+	return false
+}
+
+type CoordinatesObserverNode struct {
+	node.ChainNode
+	element *CoordinatesObserverElement
+}
+
+func NewCoordinatesObserverNode(element *CoordinatesObserverElement) *CoordinatesObserverNode {
+	n := &CoordinatesObserverNode{
+		element: element,
+	}
+	n.ChainNode = node.NewChainNode(
+		node.NewNodeID(),
+		element.kind,
+		node.LayoutPhase,
+		func(t node.TreeNode) {
+			lno := t.(layoutnode.LayoutNode)
+			statePath := fmt.Sprintf("%d/coordinatesObserver", lno.GenerateID())
+			observer := lno.State(statePath, func() any { return &coordinatesObserver{} }).Get().(*coordinatesObserver)
+
+			no := t.(layoutnode.LayoutModifierNode)
+			no.AttachLayoutModifier(func(widget layoutnode.LayoutWidget) layoutnode.LayoutWidget {
+				return layoutnode.NewLayoutWidget(func(gtx layoutnode.LayoutContext) layoutnode.LayoutDimensions {
+					observer.onSizeChanged = n.element.onSizeChanged
+					observer.onPlaced = n.element.onPlaced
+					observer.onGloballyPositioned = n.element.onGloballyPositioned
+					return observe(gtx, observer, widget.Layout)
+				})
+			})
+		},
+	)
+	return n
+}
@@ -0,0 +1,124 @@
+package layout
+
+import (
+	"fmt"
+
+	"github.com/zodimo/go-compose/compose/ui"
+	"github.com/zodimo/go-compose/compose/ui/platform"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+	"github.com/zodimo/go-compose/internal/layoutnode"
+	"github.com/zodimo/go-compose/pkg/api"
+)
+
+// SubcomposeMeasureScope is the receiver of the measurement of a
+// SubcomposeLayout, which composes its children while measuring.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/ui/ui/src/commonMain/kotlin/androidx/compose/ui/layout/SubcomposeLayout.kt
+type SubcomposeMeasureScope interface {
+	MeasureScope
+
+	// Subcompose composes content in the slot slotId, and returns the
+	// measurables of the layouts it emits. Each slot may be composed once per
+	// measurement, and slotId must be comparable. The state of the content is
+	// kept by slot from one measurement to the next.
+	Subcompose(slotId any, content api.Composable) []Measurable
+}
+
+// SubcomposeMeasurePolicy measures and places the children a SubcomposeLayout
+// composes with scope.Subcompose.
+type SubcomposeMeasurePolicy func(scope SubcomposeMeasureScope, constraints unit.Constraints) MeasureResult
+
+// SubcomposeLayout is a Layout whose children are composed during the
+// measurement, so that they can depend on the constraints of the layout or on
+// the size of other children:
+//
+//	layout.SubcomposeLayout(func(scope layout.SubcomposeMeasureScope, constraints unit.Constraints) layout.MeasureResult {
+//		header := scope.Subcompose("header", Header())[0].Measure(constraints)
+//		body := scope.Subcompose("body", Body(header.Height()))[0].Measure(constraints)
+//		return scope.Layout(constraints.MaxWidth(), header.Height()+body.Height(), func() {
+//			header.PlaceRelative(0, 0)
+//			body.PlaceRelative(0, header.Height())
+//		})
+//	})
+//
+// The children are composed with the composition locals of the
+// SubcomposeLayout. They are composed again each time the layout is measured,
+// so SubcomposeLayout costs more than Layout and should only be used when the
+// composition depends on the measurement.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/ui/ui/src/commonMain/kotlin/androidx/compose/ui/layout/SubcomposeLayout.kt
+func SubcomposeLayout(measurePolicy SubcomposeMeasurePolicy, options ...LayoutOption) api.Composable {
+	if measurePolicy == nil {
+		panic("SubcomposeLayout: measurePolicy cannot be nil")
+	}
+	opts := DefaultLayoutOptions()
+	for _, option := range options {
+		if option == nil {
+			continue
+		}
+		option(&opts)
+	}
+	return func(c api.Composer) api.Composer {
+		layoutDirection := platform.LocalLayoutDirection.Current(c)
+		subcomposition := c.NewSubcomposition()
+
+		c.StartBlock("SubcomposeLayout")
+		c.Modifier(func(modifier ui.Modifier) ui.Modifier {
+			return modifier.Then(opts.Modifier)
+		})
+		c.SetWidgetConstructor(subcomposeLayoutWidgetConstructor(subcomposition, measurePolicy, layoutDirection))
+		return c.EndBlock()
+	}
+}
+
+func subcomposeLayoutWidgetConstructor(subcomposition api.Subcomposition, measurePolicy SubcomposeMeasurePolicy, layoutDirection unit.LayoutDirection) layoutnode.LayoutNodeWidgetConstructor {
+	return layoutnode.NewLayoutNodeWidgetConstructor(func(node layoutnode.LayoutNode) layoutnode.GioLayoutWidget {
+		return func(gtx layoutnode.LayoutContext) layoutnode.LayoutDimensions {
+			var scope *measureScope
+			measurables := func(s *measureScope) []Measurable {
+				scope = s
+				return nil
+			}
+			policy := MeasurePolicyFunc(func(_ MeasureScope, _ []Measurable, constraints unit.Constraints) MeasureResult {
+				return measurePolicy(&subcomposeMeasureScope{
+					measureScope:   scope,
+					subcomposition: subcomposition,
+					composed:       map[any]bool{},
+				}, constraints)
+			})
+			return measureLayout(gtx, layoutDirection, measurables, policy)
+		}
+	})
+}
+
+var _ SubcomposeMeasureScope = (*subcomposeMeasureScope)(nil)
+
+type subcomposeMeasureScope struct {
+	*measureScope
+	subcomposition api.Subcomposition
+	// composed are the slots composed in the measurement.
+	composed map[any]bool
+}
+
+func (s *subcomposeMeasureScope) Subcompose(slotId any, content api.Composable) []Measurable {
+	if s.composed[slotId] {
+		panic(fmt.Sprintf("Subcompose: slot %v was already composed in this measurement", slotId))
+	}
+	s.composed[slotId] = true
+
+	root := layoutnode.NewNodeCoordinator(s.subcomposition.Compose(slotId, content))
+	// The modifiers get their identifiers in the order of the tree, whatever
+	// the order the children are measured in.
+	root.Expand()
+	children := root.Children()
+	measurables := make([]Measurable, len(children))
+	for i, child := range children {
+		coordinator := child.(layoutnode.NodeCoordinator)
+		measurables[i] = &measurable{
+			scope:    s.measureScope,
+			layout:   coordinator.Layout,
+			elements: coordinator.Elements(),
+		}
+	}
+	return measurables
+}
@@ -0,0 +1,16 @@
+package platform
+
+import (
+	"github.com/zodimo/go-compose/compose"
+
+	"gioui.org/io/system"
+)
+
+// LocalLocale is a CompositionLocal that provides the locale of the user, for
+// components that format dates, numbers or names. Apps provide the locale
+// they set on the layout context:
+//
+//	compose.CompositionLocalProvider1(platform.LocalLocale, gtx.Locale, content)
+var LocalLocale = compose.StaticCompositionLocalOf[system.Locale](func() system.Locale {
+	return system.Locale{Language: "en-US", Direction: system.LTR}
+})
@@ -1,6 +1,10 @@
 package unit
 
 import (
+	"image"
+
+	"gioui.org/io/system"
+	"gioui.org/layout"
 	gioUnit "gioui.org/unit"
 )
 
@@ -17,3 +21,65 @@ func TextUnitToGioSp(tu TextUnit) gioUnit.Sp {
 	}
 	return gioUnit.Sp(tu.Value())
 }
+
+// LayoutDirectionFromTextDirection maps a Gio text direction, as found in
+// gtx.Locale.Direction, to a LayoutDirection.
+func LayoutDirectionFromTextDirection(direction system.TextDirection) LayoutDirection {
+	if direction.Progression() == system.TowardOrigin {
+		return LayoutDirectionRtl
+	}
+	return LayoutDirectionLtr
+}
+
+// DensityFromMetric creates a Density from a Gio metric, as found in gtx.Metric.
+func DensityFromMetric(metric gioUnit.Metric) Density {
+	fontScale := float32(1)
+	if metric.PxPerDp != 0 && metric.PxPerSp != 0 {
+		fontScale = metric.PxPerSp / metric.PxPerDp
+	}
+	return NewDensity(metric.PxPerDp, fontScale)
+}
+
+// GioInfinity is the size Gio constraints use for Infinity, as Gio lists do.
+const GioInfinity = 1e6
+
+// ConstraintsFromGio converts Gio constraints, as found in gtx.Constraints.
+// Maximums that cannot be represented, such as the unbounded size Gio lists
+// measure their children with, convert to Infinity.
+func ConstraintsFromGio(c layout.Constraints) Constraints {
+	maxWidth, maxHeight := c.Max.X, c.Max.Y
+	if maxWidth > maxAllowedForMaxNonFocusBits {
+		maxWidth = Infinity
+	}
+	if maxHeight > maxAllowedForMaxNonFocusBits {
+		maxHeight = Infinity
+	}
+	minWidth := max(min(min(c.Min.X, maxWidth), maxAllowedForMaxNonFocusBits), 0)
+	minHeight := max(min(min(c.Min.Y, maxHeight), maxAllowedForMaxNonFocusBits), 0)
+	if maxWidth != Infinity && maxHeight != Infinity &&
+		bitsNeededForSizeUnchecked(maxWidth)+bitsNeededForSizeUnchecked(maxHeight) > 31 {
+		// Too large to be represented together: the larger one is unbounded.
+		if maxWidth > maxHeight {
+			maxWidth = Infinity
+		} else {
+			maxHeight = Infinity
+		}
+	}
+	return NewConstraints(minWidth, maxWidth, minHeight, maxHeight)
+}
+
+// ConstraintsToGio converts c to Gio constraints, with GioInfinity for
+// Infinity.
+func ConstraintsToGio(c Constraints) layout.Constraints {
+	maxWidth, maxHeight := c.MaxWidth(), c.MaxHeight()
+	if maxWidth == Infinity {
+		maxWidth = GioInfinity
+	}
+	if maxHeight == Infinity {
+		maxHeight = GioInfinity
+	}
+	return layout.Constraints{
+		Min: image.Pt(c.MinWidth(), c.MinHeight()),
+		Max: image.Pt(maxWidth, maxHeight),
+	}
+}
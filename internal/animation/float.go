@@ -0,0 +1,28 @@
+package animation
+
+import "time"
+
+// FloatAnimation animates a value from From to To for Duration from
+// Started, its progress eased by Easing when set.
+type FloatAnimation struct {
+	From, To float32
+	Duration time.Duration
+	Started  time.Time
+	Easing   func(fraction float32) float32
+}
+
+// ValueAt returns the value at now, and whether the animation has ended.
+func (a FloatAnimation) ValueAt(now time.Time) (float32, bool) {
+	if a.Duration <= 0 {
+		return a.To, true
+	}
+	progress := float32(now.Sub(a.Started)) / float32(a.Duration)
+	if progress >= 1 {
+		return a.To, true
+	}
+	progress = max(progress, 0)
+	if a.Easing != nil {
+		progress = a.Easing(progress)
+	}
+	return a.From + (a.To-a.From)*progress, false
+}
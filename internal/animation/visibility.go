@@ -32,10 +32,16 @@ func (v *VisibilityAnimation) Revealed(gtx layout.Context) float32 {
 	if v.Animating() {
 		gtx.Execute(op.InvalidateCmd{})
 	}
+	return v.RevealedAt(gtx.Now)
+}
+
+// RevealedAt returns the fraction revealed at now, as Revealed does, without requesting a
+// frame. It lets composition read the progress of an animation that is driven by layout.
+func (v *VisibilityAnimation) RevealedAt(now time.Time) float32 {
 	if v.Duration == time.Duration(0) {
 		v.Duration = time.Second
 	}
-	progress := float32(gtx.Now.Sub(v.Started).Milliseconds()) / float32(v.Duration.Milliseconds())
+	progress := float32(now.Sub(v.Started).Milliseconds()) / float32(v.Duration.Milliseconds())
 	if progress >= 1 {
 		if v.State == Appearing {
 			v.State = Visible
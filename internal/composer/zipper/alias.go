@@ -23,6 +23,7 @@ var EmptyElementMemo = state.EmptyMemo[Element]()
 type IdentityManager = identity.IdentityManager
 
 var GetScopedIdentityManager = identity.GetScopedIdentityManager
+var GetSubcompositionIdentityManager = identity.GetSubcompositionIdentityManager
 
 // compose-identifier.api.Identifier
 type Identifier = idApi.Identifier // Public API of the composer
@@ -36,6 +37,7 @@ type RootNode = node.TreeNode
 
 type Composable = api.Composable
 type Composer = api.Composer
+type Subcomposition = api.Subcomposition
 
 type ProvidedValue = api.ProvidedValue
 
@@ -23,8 +23,9 @@ type composer struct {
 	memo           Memo       // remember cache for this composition run
 	state          PersistentState
 	idManager      IdentityManager
-	overrideID     *Identifier // single override ID for c.Key (one Key affects one component)
-	idPrefixStack  []string    // stack of ID prefixes for scoped identity (used by c.Key)
+	nodeIdManager  IdentityManager // identities of the modifiers of the layout nodes
+	overrideID     *Identifier     // single override ID for c.Key (one Key affects one component)
+	idPrefixStack  []string        // stack of ID prefixes for scoped identity (used by c.Key)
 	locals         map[interface{}]interface{}
 	providersStack []map[interface{}]interface{}
 }
@@ -32,7 +33,10 @@ type composer struct {
 // Tree Builder operations
 func (c *composer) StartBlock(key string) Composer {
 
-	newNode := layoutnode.NewLayoutNode(c.GenerateID(), key, EmptyMemo, EmptyMemo, c.state)
+	newNode := layoutnode.NewLayoutNodeWithIdentityManager(c.GenerateID(), key, EmptyMemo, EmptyMemo, c.state, c.nodeIdManager)
+	// The locals are replaced, never changed, by StartProviders, so the node
+	// can keep the map.
+	newNode.WithCompositionLocals(c.locals)
 
 	if c.focus == nil {
 		//The Root Node
@@ -254,6 +258,43 @@ func (c *composer) Consume(key interface{}) interface{} {
 	return c.locals[key]
 }
 
+func (c *composer) NewSubcomposition() Subcomposition {
+	return &subcomposition{
+		state:  c.state,
+		locals: c.locals,
+		scope:  "subcomposition/" + c.GenerateID().String(),
+	}
+}
+
+var _ Subcomposition = (*subcomposition)(nil)
+
+// subcomposition composes its slots with composers of their own, whose
+// identifiers are hashed with the scope of the slot. Their state keys are then
+// unique, while the state itself is shared with the composition.
+type subcomposition struct {
+	state  PersistentState
+	locals map[interface{}]interface{}
+	scope  string
+}
+
+func (s *subcomposition) Compose(slotId any, content Composable) LayoutNode {
+	scope := s.scope + "/" + fmt.Sprint(slotId)
+	c := newComposer(
+		s.state,
+		GetSubcompositionIdentityManager("composer/"+scope),
+		GetSubcompositionIdentityManager("layout_nodes/"+scope),
+	)
+	c.locals = s.locals
+
+	c.StartBlock("Subcomposition")
+	c.SetWidgetConstructor(layoutnode.EmptyWidgetConstructor)
+	if content != nil {
+		c.WithComposable(content)
+	}
+	c.EndBlock()
+	return c.Build()
+}
+
 func emptyComposable() Composable {
 	return func(c Composer) Composer {
 		return c
@@ -1,8 +1,14 @@
 package zipper
 
 func NewComposer(state PersistentState) Composer {
+	return newComposer(
+		state,
+		GetScopedIdentityManager("composer"),
+		GetScopedIdentityManager("layout_nodes"),
+	)
+}
 
-	idManager := GetScopedIdentityManager("composer")
+func newComposer(state PersistentState, idManager, nodeIdManager IdentityManager) *composer {
 	idManager.ResetKeyCounter()
 
 	return &composer{
@@ -11,6 +17,7 @@ func NewComposer(state PersistentState) Composer {
 		memo:           EmptyMemo,
 		state:          state,
 		idManager:      idManager,
+		nodeIdManager:  nodeIdManager,
 		locals:         make(map[interface{}]interface{}),
 		providersStack: []map[interface{}]interface{}{},
 	}
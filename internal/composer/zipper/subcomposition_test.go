@@ -0,0 +1,51 @@
+package zipper
+
+import (
+	"testing"
+
+	"github.com/zodimo/go-compose/state"
+	"github.com/zodimo/go-compose/store"
+)
+
+// TestSubcompositionIdentity verifies that the nodes of a subcomposition get
+// identifiers of their own, stable from one composition to the next, so that
+// their state neither collides with the composition nor with other slots.
+func TestSubcompositionIdentity(t *testing.T) {
+	ps := store.NewPersistentState(make(map[string]state.MutableValue))
+	type local struct{}
+
+	compose := func() (main, a, b, again string, value any) {
+		c := NewComposer(ps)
+		c.StartBlock("root")
+		c.StartProviders([]ProvidedValue{{CompositionLocal: local{}, Value: "provided"}})
+		sub := c.NewSubcomposition()
+		c.EndProviders()
+		c.StartBlock("child")
+		c.EndBlock()
+		main = c.Build().LayoutNodeChildren()[0].GetID().String()
+
+		child := func(c Composer) Composer {
+			value = c.Consume(local{})
+			c.StartBlock("child")
+			return c.EndBlock()
+		}
+		a = sub.Compose("a", child).LayoutNodeChildren()[0].GetID().String()
+		b = sub.Compose("b", child).LayoutNodeChildren()[0].GetID().String()
+		again = sub.Compose("a", child).LayoutNodeChildren()[0].GetID().String()
+		return
+	}
+
+	main, a, b, again, value := compose()
+	if a == main || a == b {
+		t.Errorf("slots should have identifiers of their own, got main %v, a %v, b %v", main, a, b)
+	}
+	if again != a {
+		t.Errorf("a slot should keep its identifiers, got %v then %v", a, again)
+	}
+	if value != "provided" {
+		t.Errorf("slots should see the locals of the subcomposition, got %v", value)
+	}
+	if _, nextA, _, _, _ := compose(); nextA != a {
+		t.Errorf("identifiers should be stable across compositions, got %v then %v", a, nextA)
+	}
+}
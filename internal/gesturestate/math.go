@@ -0,0 +1,16 @@
+package gesturestate
+
+import "math"
+
+// Abs returns the absolute value of v.
+func Abs(v float32) float32 {
+	return float32(math.Abs(float64(v)))
+}
+
+// Clamp returns v limited to the range between lo and hi, in either order.
+func Clamp(v, lo, hi float32) float32 {
+	if lo > hi {
+		lo, hi = hi, lo
+	}
+	return max(lo, min(v, hi))
+}
@@ -0,0 +1,36 @@
+// Package gesturestate holds what the states moved by gestures, such as
+// AnchoredDraggableState or TopAppBarState, have in common. Such a state
+// keeps plain fields, changed outside of composition by the gesture, and a
+// Revision: its getters read the revision and every change bumps it, so
+// that a state remembered in a composition schedules a new frame when it
+// changes.
+package gesturestate
+
+import "github.com/zodimo/go-compose/state"
+
+// Revision counts the changes of a state.
+type Revision struct {
+	value state.MutableValue
+}
+
+// NewRevision returns the revision of a state created outside of
+// composition.
+func NewRevision() Revision {
+	return Revision{value: state.NewMutableValue(0, nil, nil)}
+}
+
+// RememberRevision returns the revision of a state remembered at key, kept
+// in the persistent state of s so that its changes schedule a new frame.
+func RememberRevision(s state.SupportState, key string) Revision {
+	return Revision{value: s.State(key, func() any { return 0 })}
+}
+
+// Observe reads the revision, for the reader to see the next change.
+func (r Revision) Observe() {
+	r.value.Get()
+}
+
+// Bump records a change.
+func (r Revision) Bump() {
+	r.value.Set(r.value.Get().(int) + 1)
+}
@@ -0,0 +1,39 @@
+package identity
+
+import "strconv"
+
+var _ IdentityManager = subcompositionIdentityManager{}
+
+// subcompositionScope is the scope of the key manager that hashes the
+// identifiers of every subcomposition. Key managers are kept for the lifetime
+// of the program, so the slots do not get one each.
+const subcompositionScope = "subcomposition"
+
+// subcompositionIdentityManager counts identifiers like a scoped identity
+// manager, and hashes them with its scope so that they do not collide with the
+// identifiers of the composition, which share the same state. The counter is
+// its own rather than a key manager, which would never be released.
+type subcompositionIdentityManager struct {
+	IdentityManager
+	counter *uint32
+	scope   string
+}
+
+func (im subcompositionIdentityManager) GenerateID() Identifier {
+	*im.counter++
+	return im.CreateID(im.scope + "/" + strconv.FormatUint(uint64(*im.counter), 10))
+}
+
+func (im subcompositionIdentityManager) ResetKeyCounter() {
+	*im.counter = 0
+}
+
+// GetSubcompositionIdentityManager returns the identity manager of a
+// subcomposition, whose identifiers are unique to scope.
+func GetSubcompositionIdentityManager(scope string) IdentityManager {
+	return subcompositionIdentityManager{
+		IdentityManager: GetScopedIdentityManager(subcompositionScope),
+		counter:         new(uint32),
+		scope:           scope,
+	}
+}
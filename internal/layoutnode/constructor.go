@@ -10,6 +10,12 @@ import (
 )
 
 func NewLayoutNode(id NodeID, key string, slotStore immap.ImmutableMap[any], memo Memo, persistentState PersistentState) LayoutNode {
+	return NewLayoutNodeWithIdentityManager(id, key, slotStore, memo, persistentState, GetScopedIdentityManager("layout_nodes"))
+}
+
+// NewLayoutNodeWithIdentityManager returns a layout node whose modifiers get
+// their identifiers from idManager, such as the nodes of a subcomposition.
+func NewLayoutNodeWithIdentityManager(id NodeID, key string, slotStore immap.ImmutableMap[any], memo Memo, persistentState PersistentState, idManager IdentityManager) LayoutNode {
 	return &layoutNode{
 		id:           id,
 		key:          key,
@@ -19,7 +25,7 @@ func NewLayoutNode(id NodeID, key string, slotStore immap.ImmutableMap[any], mem
 		memo:         memo,
 		state:        persistentState,
 		layoutResult: maybe.None[LayoutResult](),
-		idManager:    GetScopedIdentityManager("layout_nodes"),
+		idManager:    idManager,
 	}
 }
 
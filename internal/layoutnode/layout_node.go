@@ -23,6 +23,13 @@ type LayoutNode interface {
 	WithSlotsAssoc(k string, v any) LayoutNode // this can be better
 	FindSlot(k string) maybe.Maybe[any]
 
+	// WithCompositionLocals records the composition locals provided where the
+	// node is composed, for its modifiers to read while laying it out.
+	WithCompositionLocals(locals map[any]any) LayoutNode
+	// CompositionLocal returns the value provided for key where the node is
+	// composed, or nil.
+	CompositionLocal(key any) any
+
 	GenerateID() Identifier
 	ResetIdentifierKeyCounter()
 	SupportState
@@ -11,6 +11,7 @@ type layoutNode struct {
 	id                     NodeID
 	key                    string
 	slots                  Slots
+	locals                 map[any]any
 	memo                   Memo
 	state                  PersistentState
 	children               []LayoutNode
@@ -72,6 +73,15 @@ func (n *layoutNode) FindSlot(key string) maybe.Maybe[any] {
 	return maybe.Some(slot)
 }
 
+func (n *layoutNode) WithCompositionLocals(locals map[any]any) LayoutNode {
+	n.locals = locals
+	return n
+}
+
+func (n *layoutNode) CompositionLocal(key any) any {
+	return n.locals[key]
+}
+
 func (c *layoutNode) GenerateID() Identifier {
 	return c.idManager.GenerateID()
 }
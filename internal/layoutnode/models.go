@@ -104,6 +104,9 @@ func (nc *nodeCoordinator) Layout(gtx LayoutContext) LayoutDimensions {
 		nc.Expand()
 	}
 
+	if record, ok := gtx.Values[placementRecorderValueKey].(PlacementRecorder); ok {
+		return record(gtx, nc.layoutCallChain.Layout)
+	}
 	return nc.layoutCallChain.Layout(gtx)
 }
 
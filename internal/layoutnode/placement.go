@@ -0,0 +1,19 @@
+package layoutnode
+
+// PlacementRecorder lays out every node of a layout context set with
+// WithPlacementRecorder with layout, recording where the node is placed.
+type PlacementRecorder func(gtx LayoutContext, layout GioLayoutWidget) LayoutDimensions
+
+const placementRecorderValueKey = "layoutnode/placementRecorder"
+
+// WithPlacementRecorder returns gtx with the nodes laid out with it recorded
+// by recorder.
+func WithPlacementRecorder(gtx LayoutContext, recorder PlacementRecorder) LayoutContext {
+	values := make(map[string]any, len(gtx.Values)+1)
+	for k, v := range gtx.Values {
+		values[k] = v
+	}
+	values[placementRecorderValueKey] = recorder
+	gtx.Values = values
+	return gtx
+}
@@ -0,0 +1,16 @@
+// Package layoutvalues passes values down the layout of a frame, through
+// layout.Context.Values, from an element to the elements laid out inside it.
+package layoutvalues
+
+// With returns a copy of values with the given key value pairs set, so that
+// siblings and ancestors laid out with the original map do not see them.
+func With(values map[string]any, keyValues ...any) map[string]any {
+	out := make(map[string]any, len(values)+len(keyValues)/2)
+	for k, v := range values {
+		out[k] = v
+	}
+	for i := 0; i+1 < len(keyValues); i += 2 {
+		out[keyValues[i].(string)] = keyValues[i+1]
+	}
+	return out
+}
@@ -2,6 +2,8 @@ package background
 
 import (
 	"github.com/zodimo/go-compose/compose/ui/graphics"
+	"github.com/zodimo/go-compose/compose/ui/graphics/shape"
+	"github.com/zodimo/go-compose/compose/ui/unit"
 	node "github.com/zodimo/go-compose/internal/Node"
 	"github.com/zodimo/go-compose/internal/layoutnode"
 
@@ -36,7 +38,7 @@ func NewBackGroundNode(background BackgroundData) ChainNode {
 							func(gtx layout.Context) layout.Dimensions {
 								// shape
 								// color
-								defer background.Shape.CreateOutline(gtx.Constraints.Min, gtx.Metric).Push(gtx.Ops).Pop()
+								defer shape.CreateOutline(background.Shape, gtx.Constraints.Min, gtx.Metric, unit.LayoutDirectionFromTextDirection(gtx.Locale.Direction)).Push(gtx.Ops).Pop()
 
 								paint.Fill(gtx.Ops, nrgba)
 
@@ -49,7 +49,7 @@ func NewBorderNode(element BorderElement) *BorderNode {
 					if !shape.IsSpecifiedShape(n.borderData.Shape) {
 						panic("BorderNode: Shape is not specified")
 					}
-					outline := n.borderData.Shape.CreateOutline(dims.Size, gtx.Metric)
+					outline := shape.CreateOutline(n.borderData.Shape, dims.Size, gtx.Metric, unit.LayoutDirectionFromTextDirection(gtx.Locale.Direction))
 					macro := op.Record(gtx.Ops)
 
 					strokeWidth := float32(gtx.Metric.Dp(unit.DpToGioUnit(width)))
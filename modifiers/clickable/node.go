@@ -5,6 +5,7 @@ import (
 
 	node "github.com/zodimo/go-compose/internal/Node"
 	"github.com/zodimo/go-compose/internal/layoutnode"
+	"github.com/zodimo/go-compose/modifiers/focus"
 
 	"gioui.org/widget/material"
 )
@@ -47,7 +48,11 @@ func NewClickableNode(element ClickableElement) ChainNode {
 						if clickable.Clicked(gtx) {
 							onClick()
 						}
-						return material.Clickable(gtx, clickable, widget.Layout)
+						// The clickable is a focus target, so focus modifiers around
+						// it apply to it and Enter or Space click it when focused.
+						return focus.Target(gtx, clickable, func(gtx layoutnode.LayoutContext) layoutnode.LayoutDimensions {
+							return material.Clickable(gtx, clickable, widget.Layout)
+						})
 					})
 				})
 
@@ -2,6 +2,7 @@ package clip
 
 import (
 	"github.com/zodimo/go-compose/compose/ui/graphics/shape"
+	"github.com/zodimo/go-compose/compose/ui/unit"
 	node "github.com/zodimo/go-compose/internal/Node"
 	"github.com/zodimo/go-compose/internal/layoutnode"
 
@@ -57,6 +58,6 @@ func NewClipNode(element ClipElement) ChainNode {
 	}
 }
 
-func ClipShape(shape shape.Shape, gtx layout.Context, dimensions layoutnode.LayoutDimensions) clip.Stack {
-	return shape.CreateOutline(dimensions.Size, gtx.Metric).Push(gtx.Ops)
+func ClipShape(s shape.Shape, gtx layout.Context, dimensions layoutnode.LayoutDimensions) clip.Stack {
+	return shape.CreateOutline(s, dimensions.Size, gtx.Metric, unit.LayoutDirectionFromTextDirection(gtx.Locale.Direction)).Push(gtx.Ops)
 }
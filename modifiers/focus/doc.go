@@ -0,0 +1,19 @@
+// Package focus provides keyboard focus for composables: focus targets,
+// requesters to move focus programmatically, focus properties to customise
+// traversal, and a FocusManager.
+//
+// Focus targets are the Focusable modifier, and widgets that register their
+// Gio focus tag with Target, such as clickables and text fields. Tab and
+// Shift+Tab move focus between targets in layout order. Modifiers such as
+// Requester, Properties and OnFocusChanged apply to the first focus target
+// they wrap:
+//
+//	requester := focus.RememberFocusRequester(c)
+//	mod := focus.Requester(requester).
+//		Then(focus.OnFocusChanged(func(s focus.FocusState) { focused.Set(s.IsFocused) })).
+//		Then(focus.Focusable())
+//
+// The focus owner of a window is driven by the runtime, once per frame.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/ui/ui/src/commonMain/kotlin/androidx/compose/ui/focus/
+package focus
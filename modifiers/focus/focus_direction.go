@@ -0,0 +1,41 @@
+package focus
+
+import "fmt"
+
+// FocusDirection is the direction to move focus in with FocusManager.MoveFocus.
+type FocusDirection int
+
+const (
+	// FocusDirectionNext moves focus to the next target, as Tab does.
+	FocusDirectionNext FocusDirection = iota
+	// FocusDirectionPrevious moves focus to the previous target, as Shift+Tab does.
+	FocusDirectionPrevious
+	FocusDirectionLeft
+	FocusDirectionRight
+	FocusDirectionUp
+	FocusDirectionDown
+)
+
+func (d FocusDirection) String() string {
+	switch d {
+	case FocusDirectionNext:
+		return "Next"
+	case FocusDirectionPrevious:
+		return "Previous"
+	case FocusDirectionLeft:
+		return "Left"
+	case FocusDirectionRight:
+		return "Right"
+	case FocusDirectionUp:
+		return "Up"
+	case FocusDirectionDown:
+		return "Down"
+	default:
+		return fmt.Sprintf("FocusDirection(%d)", int(d))
+	}
+}
+
+// forward reports whether d moves through targets in layout order.
+func (d FocusDirection) forward() bool {
+	return d == FocusDirectionNext || d == FocusDirectionRight || d == FocusDirectionDown
+}
@@ -0,0 +1,153 @@
+package focus
+
+import (
+	"fmt"
+	"image"
+
+	"github.com/zodimo/go-compose/compose/ui"
+	"github.com/zodimo/go-compose/compose/ui/graphics"
+	"github.com/zodimo/go-compose/compose/ui/graphics/shape"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+	node "github.com/zodimo/go-compose/internal/Node"
+	"github.com/zodimo/go-compose/internal/layoutnode"
+	"github.com/zodimo/go-compose/internal/modifier"
+
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+)
+
+type IndicatorOptions struct {
+	Shape shape.Shape
+	// Thickness is the width of the indicator stroke.
+	Thickness unit.Dp
+	// Offset is the gap between the element and the indicator.
+	Offset unit.Dp
+}
+
+type IndicatorOption func(*IndicatorOptions)
+
+func WithIndicatorShape(s shape.Shape) IndicatorOption {
+	return func(o *IndicatorOptions) {
+		o.Shape = s
+	}
+}
+
+func WithIndicatorThickness(thickness unit.Dp) IndicatorOption {
+	return func(o *IndicatorOptions) {
+		o.Thickness = thickness
+	}
+}
+
+func WithIndicatorOffset(offset unit.Dp) IndicatorOption {
+	return func(o *IndicatorOptions) {
+		o.Offset = offset
+	}
+}
+
+// DefaultIndicatorOptions follow the Material 3 focus indicator: a 3dp stroke
+// drawn 2dp outside the element.
+func DefaultIndicatorOptions() IndicatorOptions {
+	return IndicatorOptions{
+		Shape:     shape.ShapeRectangle,
+		Thickness: unit.Dp(3),
+		Offset:    unit.Dp(2),
+	}
+}
+
+// Indicator draws an outline of color around the element while the first
+// focus target inside it is focused.
+//
+// https://m3.material.io/foundations/interaction/states/state-layers#focus
+func Indicator(color graphics.Color, options ...IndicatorOption) ui.Modifier {
+	opt := DefaultIndicatorOptions()
+	for _, option := range options {
+		if option == nil {
+			continue
+		}
+		option(&opt)
+	}
+	return modifier.NewInspectableModifier(
+		modifier.NewModifier(&IndicatorElement{color: color, options: opt}),
+		modifier.NewInspectorInfo("focusIndicator", map[string]any{
+			"color":   color,
+			"options": opt,
+		}),
+	)
+}
+
+type IndicatorElement struct {
+	color   graphics.Color
+	options IndicatorOptions
+}
+
+func (e *IndicatorElement) Create() node.Node {
+	return NewIndicatorNode(e.color, e.options)
+}
+
+func (e *IndicatorElement) Update(n node.Node) {
+	no := n.(*IndicatorNode)
+	no.color = e.color
+	no.options = e.options
+}
+
+// Equals is always false, as shapes are not always comparable.
+func (e *IndicatorElement) Equals(other modifier.Element) bool {
+	return false
+}
+
+type IndicatorNode struct {
+	node.ChainNode
+	color   graphics.Color
+	options IndicatorOptions
+}
+
+func NewIndicatorNode(color graphics.Color, options IndicatorOptions) *IndicatorNode {
+	n := &IndicatorNode{
+		color:   color,
+		options: options,
+	}
+	n.ChainNode = node.NewChainNode(
+		node.NewNodeID(),
+		node.NodeKindDraw,
+		node.DrawPhase,
+		func(t node.TreeNode) {
+			lno := t.(layoutnode.LayoutNode)
+			statePath := fmt.Sprintf("%d/focusIndicator", lno.GenerateID())
+			observer := lno.State(statePath, func() any { return &focusObserver{} }).Get().(*focusObserver)
+
+			no := t.(layoutnode.DrawModifierNode)
+			no.AttachDrawModifier(func(widget layoutnode.LayoutWidget) layoutnode.LayoutWidget {
+				return layoutnode.NewLayoutWidget(func(gtx layoutnode.LayoutContext) layoutnode.LayoutDimensions {
+					// The observer is updated by the target while the content is
+					// laid out, so the indicator follows the state of this frame.
+					dims := widget.Layout(withModifier(gtx, focusModifier{observer: observer}))
+					if !observer.state.IsFocused || !n.color.IsSpecified() {
+						return dims
+					}
+
+					offset := gtx.Metric.Dp(unit.DpToGioUnit(n.options.Offset))
+					thickness := float32(gtx.Metric.Dp(unit.DpToGioUnit(n.options.Thickness)))
+					// The stroke is centred on the outline, which is moved out so
+					// that the inner edge of the stroke is offset from the element.
+					inset := offset + int(thickness/2)
+					size := dims.Size.Add(image.Pt(2*inset, 2*inset))
+					s := n.options.Shape
+					if !shape.IsSpecifiedShape(s) {
+						s = shape.ShapeRectangle
+					}
+					outline := shape.CreateOutline(s, size, gtx.Metric, unit.LayoutDirectionFromTextDirection(gtx.Locale.Direction))
+
+					stack := op.Offset(image.Pt(-inset, -inset)).Push(gtx.Ops)
+					paint.FillShape(gtx.Ops, graphics.ColorToNRGBA(n.color), clip.Stroke{
+						Path:  outline.Path(gtx.Ops),
+						Width: thickness,
+					}.Op())
+					stack.Pop()
+					return dims
+				})
+			})
+		},
+	)
+	return n
+}
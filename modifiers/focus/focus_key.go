@@ -0,0 +1,96 @@
+package focus
+
+import (
+	"github.com/zodimo/go-compose/internal/layoutvalues"
+
+	"gioui.org/io/key"
+	"gioui.org/layout"
+)
+
+const keyScopeValueKey = "focus/keyScope"
+
+// KeyHandler handles a key event, and reports whether it consumed it.
+type KeyHandler func(e key.Event) bool
+
+// keyScope holds the key handlers of a KeyInput, for the focus targets laid
+// out inside it.
+type keyScope struct {
+	parent    *keyScope
+	onPreview KeyHandler
+	onKey     KeyHandler
+}
+
+// KeyInput lays out content with handlers for the key events of the focused
+// target inside it. Either handler may be nil.
+//
+// Key events the focused widget does not handle itself are passed to the
+// preview handlers from the outermost KeyInput to the innermost, then to the
+// key handlers from the innermost to the outermost, until one consumes the
+// event. The preview handlers only see the events after the focused widget:
+// a Gio key event goes to the first widget reading it, and cannot be given
+// back. Events that are left go to the handlers registered with
+// FocusOwner.OnUnconsumedKeyEvent. Tab and Shift+Tab are kept for focus
+// traversal and never reach the handlers.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/ui/ui/src/commonMain/kotlin/androidx/compose/ui/input/key/KeyInputModifier.kt
+func KeyInput(gtx layout.Context, onPreviewKeyEvent, onKeyEvent KeyHandler, content layout.Widget) layout.Dimensions {
+	if owner, _ := gtx.Values[ownerValueKey].(*focusOwner); owner != nil {
+		owner.frameKeyInput = true
+	}
+	parent, _ := gtx.Values[keyScopeValueKey].(*keyScope)
+	scope := &keyScope{parent: parent, onPreview: onPreviewKeyEvent, onKey: onKeyEvent}
+	gtx.Values = layoutvalues.With(gtx.Values, keyScopeValueKey, scope)
+	return content(gtx)
+}
+
+// keyEventFilter matches the key events no widget handled in the frame.
+// System events, Tab and Shift+Tab, are not matched and move focus.
+var keyEventFilter = key.Filter{
+	Optional: key.ModCtrl | key.ModCommand | key.ModShift | key.ModAlt | key.ModSuper,
+}
+
+// dispatchKeys passes the key events left in the frame to the key handlers.
+// It is called at the end of the frame, once every widget had the chance to
+// handle its own events.
+func (o *focusOwner) dispatchKeys(gtx layout.Context) {
+	if !o.frameKeyInput && len(o.frameUnconsumed) == 0 {
+		return
+	}
+	// scopes are ordered from the innermost to the outermost.
+	var scopes []*keyScope
+	if t := o.frameIndex[o.frameFocused]; o.frameFocused != nil && t != nil {
+		for s := t.keyScope; s != nil; s = s.parent {
+			scopes = append(scopes, s)
+		}
+	}
+	for {
+		ev, ok := gtx.Event(keyEventFilter)
+		if !ok {
+			break
+		}
+		if e, ok := ev.(key.Event); ok {
+			o.dispatchKey(scopes, e)
+		}
+	}
+}
+
+func (o *focusOwner) dispatchKey(scopes []*keyScope, e key.Event) bool {
+	for i := len(scopes) - 1; i >= 0; i-- {
+		if h := scopes[i].onPreview; h != nil && h(e) {
+			return true
+		}
+	}
+	for _, s := range scopes {
+		if s.onKey != nil && s.onKey(e) {
+			return true
+		}
+	}
+	// The last registered handler goes first, so that the content shown on
+	// top, such as a dialog, takes precedence.
+	for i := len(o.frameUnconsumed) - 1; i >= 0; i-- {
+		if o.frameUnconsumed[i](e) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,129 @@
+package focus
+
+import (
+	"fmt"
+
+	"github.com/zodimo/go-compose/compose/ui"
+	node "github.com/zodimo/go-compose/internal/Node"
+	"github.com/zodimo/go-compose/internal/layoutnode"
+	"github.com/zodimo/go-compose/internal/modifier"
+)
+
+// Requester attaches requester to the first focus target inside the element,
+// so that requester.RequestFocus focuses it.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/ui/ui/src/commonMain/kotlin/androidx/compose/ui/focus/FocusRequesterModifier.kt
+func Requester(requester *FocusRequester) ui.Modifier {
+	if requester == nil {
+		panic("Requester: requester cannot be nil")
+	}
+	return modifier.NewInspectableModifier(
+		modifier.NewModifier(&FocusModifierElement{
+			kind:      node.NodeKindFocusTarget,
+			requester: requester,
+		}),
+		modifier.NewInspectorInfo("focusRequester", map[string]any{
+			"focusRequester": requester,
+		}),
+	)
+}
+
+// Properties sets the FocusProperties of the first focus target inside the
+// element. scope receives the properties with their defaults, or as set by
+// inner Properties modifiers.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/ui/ui/src/commonMain/kotlin/androidx/compose/ui/focus/FocusProperties.kt
+func Properties(scope func(p *FocusProperties)) ui.Modifier {
+	if scope == nil {
+		panic("Properties: scope cannot be nil")
+	}
+	return modifier.NewInspectableModifier(
+		modifier.NewModifier(&FocusModifierElement{
+			kind:       node.NodeKindFocusProperties,
+			properties: scope,
+		}),
+		modifier.NewInspectorInfo("focusProperties", map[string]any{
+			"scope": scope,
+		}),
+	)
+}
+
+// OnFocusChanged calls onFocusChanged with the focus state of the first focus
+// target inside the element, when the element is first laid out and every
+// time the state changes.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/ui/ui/src/commonMain/kotlin/androidx/compose/ui/focus/FocusChangedModifier.kt
+func OnFocusChanged(onFocusChanged func(state FocusState)) ui.Modifier {
+	if onFocusChanged == nil {
+		panic("OnFocusChanged: onFocusChanged cannot be nil")
+	}
+	return modifier.NewInspectableModifier(
+		modifier.NewModifier(&FocusModifierElement{
+			kind:           node.NodeKindFocusEvent,
+			onFocusChanged: onFocusChanged,
+		}),
+		modifier.NewInspectorInfo("onFocusChanged", map[string]any{
+			"onFocusChanged": onFocusChanged,
+		}),
+	)
+}
+
+// FocusModifierElement is the element of the modifiers that apply to the
+// next focus target: Requester, Properties and OnFocusChanged.
+type FocusModifierElement struct {
+	kind           node.NodeKind
+	requester      *FocusRequester
+	properties     func(p *FocusProperties)
+	onFocusChanged func(state FocusState)
+}
+
+func (e *FocusModifierElement) Create() node.Node {
+	return NewFocusModifierNode(*e)
+}
+
+func (e *FocusModifierElement) Update(n node.Node) {
+	no := n.(*FocusModifierNode)
+	no.element = *e
+}
+
+// Equals is always false, as callbacks cannot be compared.
+func (e *FocusModifierElement) Equals(other modifier.Element) bool {
+	return false
+}
+
+type FocusModifierNode struct {
+	node.ChainNode
+	element FocusModifierElement
+}
+
+func NewFocusModifierNode(element FocusModifierElement) *FocusModifierNode {
+	n := &FocusModifierNode{
+		element: element,
+	}
+	n.ChainNode = node.NewChainNode(
+		node.NewNodeID(),
+		element.kind,
+		node.LayoutPhase,
+		func(t node.TreeNode) {
+			var observer *focusObserver
+			if n.element.onFocusChanged != nil {
+				lno := t.(layoutnode.LayoutNode)
+				statePath := fmt.Sprintf("%d/onFocusChanged", lno.GenerateID())
+				observer = lno.State(statePath, func() any { return &focusObserver{} }).Get().(*focusObserver)
+				observer.onChanged = n.element.onFocusChanged
+			}
+
+			no := t.(layoutnode.LayoutModifierNode)
+			no.AttachLayoutModifier(func(widget layoutnode.LayoutWidget) layoutnode.LayoutWidget {
+				return layoutnode.NewLayoutWidget(func(gtx layoutnode.LayoutContext) layoutnode.LayoutDimensions {
+					return widget.Layout(withModifier(gtx, focusModifier{
+						requester:  n.element.requester,
+						properties: n.element.properties,
+						observer:   observer,
+					}))
+				})
+			})
+		},
+	)
+	return n
+}
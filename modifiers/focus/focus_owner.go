@@ -0,0 +1,306 @@
+package focus
+
+import (
+	"github.com/zodimo/go-compose/internal/layoutvalues"
+	"github.com/zodimo/go-compose/state"
+
+	"gioui.org/io/event"
+	"gioui.org/io/key"
+	"gioui.org/layout"
+	"gioui.org/op"
+)
+
+// FocusManager moves and clears focus.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/ui/ui/src/commonMain/kotlin/androidx/compose/ui/focus/FocusManager.kt
+type FocusManager interface {
+	// MoveFocus moves focus in direction, and returns false when there is no
+	// target to move to. Targets are visited in layout order, unless the
+	// focused target overrides direction with FocusProperties: Right and
+	// Down move like Next, Left and Up like Previous.
+	MoveFocus(direction FocusDirection) bool
+	// ClearFocus removes focus from the focused target, unless it captured
+	// focus.
+	ClearFocus()
+}
+
+// FocusOwner is the FocusManager of a window. The runtime calls BeginFrame
+// before laying out a frame and EndFrame after it.
+type FocusOwner interface {
+	FocusManager
+	// BeginFrame returns the context to lay out the frame with, through which
+	// focus targets find the owner.
+	BeginFrame(gtx layout.Context) layout.Context
+	// EndFrame dispatches the key events of the frame, and applies the focus
+	// changes requested during the frame.
+	EndFrame(gtx layout.Context)
+	// OnUnconsumedKeyEvent registers handler for the key events of a frame
+	// that no key handler consumed. Handlers registered while composing apply
+	// to the next frame, handlers registered during layout to the current one;
+	// they are dropped once the frame ends.
+	OnUnconsumedKeyEvent(handler KeyHandler)
+}
+
+const ownerStateKey = "focus/owner"
+
+// Owner returns the focus owner of the window whose state is s.
+func Owner(s state.SupportState) FocusOwner {
+	return ownerOf(s)
+}
+
+// Manager returns the FocusManager of the window whose state is s, usually
+// the Composer.
+func Manager(s state.SupportState) FocusManager {
+	return ownerOf(s)
+}
+
+func ownerOf(s state.SupportState) *focusOwner {
+	revision := s.State(ownerStateKey+"/revision", func() any { return 0 })
+	return s.State(ownerStateKey, func() any { return newFocusOwner(revision) }).Get().(*focusOwner)
+}
+
+var _ FocusOwner = (*focusOwner)(nil)
+
+type focusOwner struct {
+	// targets are the focus targets of the last frame, in layout order.
+	targets []*focusTarget
+	// focused is the tag of the target that was focused in the last frame.
+	focused  event.Tag
+	captured event.Tag
+
+	// frameTargets and frameFocused are collected during the current frame.
+	frameTargets []*focusTarget
+	frameIndex   map[event.Tag]*focusTarget
+	frameFocused event.Tag
+	inFrame      bool
+
+	// frameKeyInput is set when a KeyInput was laid out in the frame.
+	frameKeyInput bool
+	// frameUnconsumed are the unconsumed key event handlers of the frame,
+	// nextUnconsumed the ones registered for the next frame.
+	frameUnconsumed []KeyHandler
+	nextUnconsumed  []KeyHandler
+
+	// pending is the focus change to apply at the end of the frame.
+	pending *focusRequest
+	// revision schedules a frame for changes requested between frames.
+	revision state.MutableValue
+}
+
+type focusRequest struct {
+	// tag is the target to focus, nil to clear focus.
+	tag event.Tag
+}
+
+func newFocusOwner(revision state.MutableValue) *focusOwner {
+	return &focusOwner{revision: revision}
+}
+
+func (o *focusOwner) BeginFrame(gtx layout.Context) layout.Context {
+	o.inFrame = true
+	o.frameTargets = nil
+	o.frameIndex = map[event.Tag]*focusTarget{}
+	o.frameFocused = nil
+	o.frameKeyInput = false
+	o.frameUnconsumed, o.nextUnconsumed = o.nextUnconsumed, nil
+	o.execute(gtx)
+	gtx.Values = layoutvalues.With(gtx.Values, ownerValueKey, o)
+	return gtx
+}
+
+func (o *focusOwner) EndFrame(gtx layout.Context) {
+	o.targets = o.frameTargets
+	o.focused = o.frameFocused
+	if o.captured != nil && o.lookup(o.captured) == nil {
+		o.captured = nil
+	}
+	o.dispatchKeys(gtx)
+	o.frameUnconsumed = nil
+	o.inFrame = false
+	o.execute(gtx)
+}
+
+func (o *focusOwner) OnUnconsumedKeyEvent(handler KeyHandler) {
+	if o.inFrame {
+		o.frameUnconsumed = append(o.frameUnconsumed, handler)
+	} else {
+		o.nextUnconsumed = append(o.nextUnconsumed, handler)
+	}
+}
+
+func (o *focusOwner) MoveFocus(direction FocusDirection) bool {
+	if o.captured != nil {
+		return false
+	}
+	target, ok := o.next(direction)
+	if !ok {
+		return false
+	}
+	return o.requestFocus(target.tag)
+}
+
+func (o *focusOwner) ClearFocus() {
+	if o.captured != nil {
+		return
+	}
+	o.pending = &focusRequest{}
+	o.invalidate()
+}
+
+// next returns the target to focus when moving in direction.
+func (o *focusOwner) next(direction FocusDirection) (*focusTarget, bool) {
+	targets := o.targets
+	current := -1
+	for i, t := range targets {
+		if t.tag == o.focused {
+			current = i
+			break
+		}
+	}
+	if current >= 0 {
+		if r := targets[current].properties.requester(direction); r != nil {
+			if r == FocusRequesterCancel || r.owner != o {
+				return nil, false
+			}
+			t := o.lookup(r.tag)
+			if t == nil || !t.canFocus {
+				return nil, false
+			}
+			return t, true
+		}
+	}
+
+	n := len(targets)
+	step, start := 1, current
+	if !direction.forward() {
+		step = -1
+		if current < 0 {
+			start = n
+		}
+	}
+	for i := 1; i <= n; i++ {
+		index := ((start+step*i)%n + n) % n
+		if index == current {
+			continue
+		}
+		if targets[index].canFocus {
+			return targets[index], true
+		}
+	}
+	return nil, false
+}
+
+func (o *focusOwner) requestFocus(tag event.Tag) bool {
+	if o.captured != nil && o.captured != tag {
+		return false
+	}
+	if t := o.lookup(tag); t == nil || !t.canFocus {
+		return false
+	}
+	o.pending = &focusRequest{tag: tag}
+	o.invalidate()
+	return true
+}
+
+// lookup returns the target of tag in the current frame, or the last one.
+func (o *focusOwner) lookup(tag event.Tag) *focusTarget {
+	if t, ok := o.frameIndex[tag]; ok && o.inFrame {
+		return t
+	}
+	for _, t := range o.targets {
+		if t.tag == tag {
+			return t
+		}
+	}
+	return nil
+}
+
+// register returns the target of tag for the current frame. Targets are laid
+// out several times per frame; they keep their place from the first layout.
+func (o *focusOwner) register(tag event.Tag, parent *focusTarget) *focusTarget {
+	var t *focusTarget
+	if o != nil {
+		t = o.frameIndex[tag]
+	}
+	if t == nil {
+		t = &focusTarget{tag: tag}
+		if o != nil {
+			o.frameIndex[tag] = t
+			o.frameTargets = append(o.frameTargets, t)
+		}
+	}
+	t.parent = parent
+	t.properties = DefaultFocusProperties()
+	t.childFocused = false
+	return t
+}
+
+// handleKeys moves focus on the keys the focused target overrides with
+// FocusProperties, and swallows Tab while it captured focus.
+func (o *focusOwner) handleKeys(gtx layout.Context, t *focusTarget) {
+	if !gtx.Focused(t.tag) {
+		return
+	}
+	captured := o.captured == t.tag
+	var filters []event.Filter
+	if captured {
+		filters = append(filters, key.Filter{Focus: t.tag, Name: key.NameTab, Optional: key.ModShift})
+	} else {
+		for _, b := range focusKeyBindings {
+			if t.properties.requester(b.direction) != nil {
+				filters = append(filters, key.Filter{Focus: t.tag, Name: b.name, Required: b.modifiers})
+			}
+		}
+	}
+	if len(filters) == 0 {
+		return
+	}
+	for {
+		ev, ok := gtx.Event(filters...)
+		if !ok {
+			break
+		}
+		e, ok := ev.(key.Event)
+		if !ok || e.State != key.Press || captured {
+			continue
+		}
+		for _, b := range focusKeyBindings {
+			if e.Name == b.name && e.Modifiers == b.modifiers {
+				o.MoveFocus(b.direction)
+				break
+			}
+		}
+	}
+}
+
+func (o *focusOwner) execute(gtx layout.Context) {
+	if o.pending == nil {
+		return
+	}
+	gtx.Execute(key.FocusCmd{Tag: o.pending.tag})
+	gtx.Execute(op.InvalidateCmd{})
+	o.pending = nil
+}
+
+func (o *focusOwner) invalidate() {
+	if !o.inFrame {
+		o.revision.Set(o.revision.Get().(int) + 1)
+	}
+}
+
+type focusKeyBinding struct {
+	name      key.Name
+	modifiers key.Modifiers
+	direction FocusDirection
+}
+
+// focusKeyBindings are the keys that move focus when the focused target
+// overrides their direction.
+var focusKeyBindings = []focusKeyBinding{
+	{name: key.NameTab, direction: FocusDirectionNext},
+	{name: key.NameTab, modifiers: key.ModShift, direction: FocusDirectionPrevious},
+	{name: key.NameLeftArrow, direction: FocusDirectionLeft},
+	{name: key.NameRightArrow, direction: FocusDirectionRight},
+	{name: key.NameUpArrow, direction: FocusDirectionUp},
+	{name: key.NameDownArrow, direction: FocusDirectionDown},
+}
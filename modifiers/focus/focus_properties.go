@@ -0,0 +1,46 @@
+package focus
+
+// FocusProperties customise how focus moves to and from a focus target. Set
+// them with the Properties modifier.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/ui/ui/src/commonMain/kotlin/androidx/compose/ui/focus/FocusProperties.kt
+type FocusProperties struct {
+	// CanFocus is false for targets that cannot take focus, and are skipped
+	// by focus traversal.
+	CanFocus bool
+	// Next, Previous, Left, Right, Up and Down are the targets to move focus
+	// to in each direction. A nil requester keeps the default traversal, and
+	// FocusRequesterCancel keeps focus where it is.
+	Next     *FocusRequester
+	Previous *FocusRequester
+	Left     *FocusRequester
+	Right    *FocusRequester
+	Up       *FocusRequester
+	Down     *FocusRequester
+}
+
+func DefaultFocusProperties() FocusProperties {
+	return FocusProperties{
+		CanFocus: true,
+	}
+}
+
+// requester returns the requester set for direction, or nil.
+func (p FocusProperties) requester(direction FocusDirection) *FocusRequester {
+	switch direction {
+	case FocusDirectionNext:
+		return p.Next
+	case FocusDirectionPrevious:
+		return p.Previous
+	case FocusDirectionLeft:
+		return p.Left
+	case FocusDirectionRight:
+		return p.Right
+	case FocusDirectionUp:
+		return p.Up
+	case FocusDirectionDown:
+		return p.Down
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,81 @@
+package focus
+
+import (
+	"fmt"
+
+	"github.com/zodimo/go-compose/pkg/api"
+
+	"gioui.org/io/event"
+	"gioui.org/layout"
+)
+
+// FocusRequester moves focus to the focus target it is attached to with the
+// Requester modifier, or names that target in FocusProperties.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/ui/ui/src/commonMain/kotlin/androidx/compose/ui/focus/FocusRequester.kt
+type FocusRequester struct {
+	owner *focusOwner
+	// tag is the tag of the target the requester was last attached to.
+	tag event.Tag
+}
+
+// FocusRequesterCancel blocks focus from moving in a direction when used in
+// FocusProperties. A nil requester keeps the default behaviour.
+var FocusRequesterCancel = &FocusRequester{}
+
+// NewFocusRequester creates a FocusRequester. Use RememberFocusRequester
+// inside a composition.
+func NewFocusRequester() *FocusRequester {
+	return &FocusRequester{}
+}
+
+// RememberFocusRequester returns a FocusRequester that survives recompositions.
+func RememberFocusRequester(c api.Composer) *FocusRequester {
+	key := fmt.Sprintf("focusRequester-%v", c.GenerateID())
+	return c.State(key, func() any { return NewFocusRequester() }).Get().(*FocusRequester)
+}
+
+// RequestFocus moves focus to the attached target. It returns false when the
+// requester is not attached, or focus is captured by another target. Focus
+// moves at the end of the current frame, or on the next one.
+func (r *FocusRequester) RequestFocus() bool {
+	if r.owner == nil {
+		return false
+	}
+	return r.owner.requestFocus(r.tag)
+}
+
+// CaptureFocus keeps focus on the attached target until FreeFocus is called:
+// focus cannot be moved away with the keyboard or a FocusManager. It returns
+// false when the target is not focused.
+func (r *FocusRequester) CaptureFocus() bool {
+	if r.owner == nil || r.owner.focused != r.tag {
+		return false
+	}
+	r.owner.captured = r.tag
+	return true
+}
+
+// FreeFocus releases focus captured with CaptureFocus. It returns false when
+// the target did not capture focus.
+func (r *FocusRequester) FreeFocus() bool {
+	if r.owner == nil || r.owner.captured != r.tag {
+		return false
+	}
+	r.owner.captured = nil
+	return true
+}
+
+// Focused reports whether the attached target has the focus in gtx, for
+// widgets around the target that handle keys before it does.
+func (r *FocusRequester) Focused(gtx layout.Context) bool {
+	return r.owner != nil && r.tag != nil && gtx.Focused(r.tag)
+}
+
+func (r *FocusRequester) attach(owner *focusOwner, tag event.Tag) {
+	if r == FocusRequesterCancel {
+		return
+	}
+	r.owner = owner
+	r.tag = tag
+}
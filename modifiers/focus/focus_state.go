@@ -0,0 +1,15 @@
+package focus
+
+// FocusState is the focus state of a focus target, as reported to
+// OnFocusChanged.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/ui/ui/src/commonMain/kotlin/androidx/compose/ui/focus/FocusState.kt
+type FocusState struct {
+	// IsFocused reports whether the target itself is focused.
+	IsFocused bool
+	// HasFocus reports whether the target or one of its descendants is focused.
+	HasFocus bool
+	// IsCaptured reports whether the target is focused and captured focus
+	// with FocusRequester.CaptureFocus.
+	IsCaptured bool
+}
@@ -0,0 +1,141 @@
+package focus
+
+import (
+	"github.com/zodimo/go-compose/internal/layoutvalues"
+
+	"gioui.org/io/event"
+	"gioui.org/io/key"
+	"gioui.org/layout"
+)
+
+// Keys of the values passed down the layout in layout.Context.Values.
+const (
+	ownerValueKey     = "focus/owner"
+	parentValueKey    = "focus/parent"
+	modifiersValueKey = "focus/modifiers"
+)
+
+type focusTarget struct {
+	tag        event.Tag
+	parent     *focusTarget
+	properties FocusProperties
+	canFocus   bool
+	// childFocused is set by a descendant target that has focus.
+	childFocused bool
+	// keyScope is the innermost KeyInput around the target.
+	keyScope *keyScope
+}
+
+// focusModifier is a focus modifier waiting to be applied to the first focus
+// target laid out inside it.
+type focusModifier struct {
+	requester  *FocusRequester
+	properties func(p *FocusProperties)
+	observer   *focusObserver
+}
+
+// focusObserver reports the focus state of a target when it changes.
+type focusObserver struct {
+	notified  bool
+	state     FocusState
+	onChanged func(state FocusState)
+}
+
+func (o *focusObserver) update(state FocusState) {
+	if o.notified && o.state == state {
+		return
+	}
+	o.notified = true
+	o.state = state
+	if o.onChanged != nil {
+		o.onChanged(state)
+	}
+}
+
+// Target lays out content as the focus target of tag, for widgets that
+// handle key.FocusFilter for tag themselves, such as a widget.Clickable or a
+// widget.Editor. Focus modifiers around the widget apply to tag, and Tab
+// moves focus to it in layout order.
+//
+// Gio widgets take focus on their own, on clicks for example, so a target
+// with FocusProperties.CanFocus false gives focus up when it receives it.
+func Target(gtx layout.Context, tag event.Tag, content layout.Widget) layout.Dimensions {
+	gtx, scope := beginTarget(gtx, tag, true)
+	dims := content(gtx)
+	scope.end(gtx)
+	return dims
+}
+
+type targetScope struct {
+	owner     *focusOwner
+	target    *focusTarget
+	modifiers []focusModifier
+}
+
+// beginTarget registers the target of tag, applies the pending focus
+// modifiers to it, and returns the context for its content.
+func beginTarget(gtx layout.Context, tag event.Tag, focusable bool) (layout.Context, *targetScope) {
+	owner, _ := gtx.Values[ownerValueKey].(*focusOwner)
+	parent, _ := gtx.Values[parentValueKey].(*focusTarget)
+	modifiers, _ := gtx.Values[modifiersValueKey].([]focusModifier)
+
+	t := owner.register(tag, parent)
+	t.keyScope, _ = gtx.Values[keyScopeValueKey].(*keyScope)
+	// Modifiers further out are applied last, so they take precedence.
+	for i := len(modifiers) - 1; i >= 0; i-- {
+		if p := modifiers[i].properties; p != nil {
+			p(&t.properties)
+		}
+	}
+	for _, m := range modifiers {
+		if m.requester != nil {
+			m.requester.attach(owner, tag)
+		}
+	}
+	t.canFocus = focusable && t.properties.CanFocus
+	if owner != nil {
+		owner.handleKeys(gtx, t)
+	}
+
+	gtx.Values = layoutvalues.With(gtx.Values, parentValueKey, t, modifiersValueKey, nil)
+	return gtx, &targetScope{owner: owner, target: t, modifiers: modifiers}
+}
+
+// end computes the focus state of the target once its content was laid out,
+// and reports it to the observers around it.
+func (s *targetScope) end(gtx layout.Context) FocusState {
+	t := s.target
+	focused := gtx.Focused(t.tag)
+	if focused && !t.canFocus {
+		gtx.Execute(key.FocusCmd{})
+		focused = false
+	}
+	state := FocusState{IsFocused: focused, HasFocus: focused || t.childFocused}
+	if s.owner != nil {
+		captured := s.owner.captured == t.tag
+		if focused {
+			s.owner.frameFocused = t.tag
+		} else if captured {
+			// Focus was taken away, by a click on another widget for example.
+			gtx.Execute(key.FocusCmd{Tag: t.tag})
+		}
+		state.IsCaptured = focused && captured
+	}
+	if state.HasFocus && t.parent != nil {
+		t.parent.childFocused = true
+	}
+	for _, m := range s.modifiers {
+		if m.observer != nil {
+			m.observer.update(state)
+		}
+	}
+	return state
+}
+
+// withModifier returns gtx with m waiting for the next focus target.
+func withModifier(gtx layout.Context, m focusModifier) layout.Context {
+	modifiers, _ := gtx.Values[modifiersValueKey].([]focusModifier)
+	modifiers = append(modifiers[:len(modifiers):len(modifiers)], m)
+	gtx.Values = layoutvalues.With(gtx.Values, modifiersValueKey, modifiers)
+	return gtx
+}
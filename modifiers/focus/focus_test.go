@@ -0,0 +1,258 @@
+package focus
+
+import (
+	"image"
+	"testing"
+
+	"github.com/zodimo/go-compose/state"
+
+	"gioui.org/io/event"
+	"gioui.org/io/input"
+	"gioui.org/io/key"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+)
+
+type testTag struct {
+	_ byte
+}
+
+// focusTest lays out frames of focus targets with a Gio router.
+type focusTest struct {
+	router input.Router
+	owner  *focusOwner
+}
+
+func newFocusTest() *focusTest {
+	return &focusTest{owner: newFocusOwner(state.NewMutableValue(0, nil, nil))}
+}
+
+func (f *focusTest) frame(content func(gtx layout.Context)) {
+	var ops op.Ops
+	gtx := layout.Context{
+		Ops:         &ops,
+		Source:      f.router.Source(),
+		Constraints: layout.Exact(image.Pt(100, 100)),
+	}
+	gtx = f.owner.BeginFrame(gtx)
+	content(gtx)
+	f.owner.EndFrame(gtx)
+	f.router.Frame(&ops)
+}
+
+// target lays out a focus target for tag, as a Gio widget handling its own focus.
+func target(gtx layout.Context, tag event.Tag, content func(gtx layout.Context)) {
+	Target(gtx, tag, func(gtx layout.Context) layout.Dimensions {
+		for {
+			if _, ok := gtx.Event(key.FocusFilter{Target: tag}); !ok {
+				break
+			}
+		}
+		area := clip.Rect{Max: image.Pt(10, 10)}.Push(gtx.Ops)
+		event.Op(gtx.Ops, tag)
+		if content != nil {
+			content(gtx)
+		}
+		area.Pop()
+		return layout.Dimensions{Size: image.Pt(10, 10)}
+	})
+}
+
+func (f *focusTest) focused(tags ...*testTag) int {
+	for i, tag := range tags {
+		if f.router.Source().Focused(tag) {
+			return i
+		}
+	}
+	return -1
+}
+
+func TestMoveFocus_LayoutOrder(t *testing.T) {
+	f := newFocusTest()
+	tags := []*testTag{{}, {}, {}}
+	ui := func(gtx layout.Context) {
+		for _, tag := range tags {
+			target(gtx, tag, nil)
+		}
+	}
+	f.frame(ui)
+
+	for _, want := range []int{0, 1, 2, 0} {
+		if !f.owner.MoveFocus(FocusDirectionNext) {
+			t.Fatal("MoveFocus should find a target")
+		}
+		f.frame(ui)
+		if got := f.focused(tags...); got != want {
+			t.Fatalf("Next should focus target %d, got %d", want, got)
+		}
+	}
+	f.owner.MoveFocus(FocusDirectionPrevious)
+	f.frame(ui)
+	if got := f.focused(tags...); got != 2 {
+		t.Errorf("Previous should wrap around to the last target, got %d", got)
+	}
+
+	f.owner.ClearFocus()
+	f.frame(ui)
+	if got := f.focused(tags...); got != -1 {
+		t.Errorf("ClearFocus should remove focus, got %d", got)
+	}
+}
+
+func TestMoveFocus_Properties(t *testing.T) {
+	f := newFocusTest()
+	tags := []*testTag{{}, {}, {}}
+	first := NewFocusRequester()
+	ui := func(gtx layout.Context) {
+		target(withModifier(gtx, focusModifier{requester: first}), tags[0], nil)
+		target(withModifier(gtx, focusModifier{properties: func(p *FocusProperties) {
+			p.CanFocus = false
+		}}), tags[1], nil)
+		target(withModifier(gtx, focusModifier{properties: func(p *FocusProperties) {
+			p.Next = first
+			p.Previous = FocusRequesterCancel
+		}}), tags[2], nil)
+	}
+	f.frame(ui)
+
+	f.owner.MoveFocus(FocusDirectionNext)
+	f.frame(ui)
+	f.owner.MoveFocus(FocusDirectionNext)
+	f.frame(ui)
+	if got := f.focused(tags...); got != 2 {
+		t.Fatalf("a target that cannot focus should be skipped, got %d", got)
+	}
+	if f.owner.MoveFocus(FocusDirectionPrevious) {
+		t.Error("FocusRequesterCancel should block the move")
+	}
+	f.owner.MoveFocus(FocusDirectionNext)
+	f.frame(ui)
+	if got := f.focused(tags...); got != 0 {
+		t.Errorf("Next should follow the requester in the properties, got %d", got)
+	}
+}
+
+func TestFocusRequester_OnFocusChanged(t *testing.T) {
+	f := newFocusTest()
+	parent, child := &testTag{}, &testTag{}
+	requester := NewFocusRequester()
+	var parentStates, childStates []FocusState
+	parentObserver := &focusObserver{onChanged: func(s FocusState) { parentStates = append(parentStates, s) }}
+	childObserver := &focusObserver{onChanged: func(s FocusState) { childStates = append(childStates, s) }}
+	ui := func(gtx layout.Context) {
+		target(withModifier(gtx, focusModifier{observer: parentObserver}), parent, func(gtx layout.Context) {
+			gtx = withModifier(gtx, focusModifier{requester: requester})
+			target(withModifier(gtx, focusModifier{observer: childObserver}), child, nil)
+		})
+	}
+	f.frame(ui)
+	if len(childStates) != 1 || childStates[0].HasFocus {
+		t.Fatalf("observers should get the initial state, got %v", childStates)
+	}
+
+	if !requester.RequestFocus() {
+		t.Fatal("RequestFocus should succeed on an attached requester")
+	}
+	f.frame(ui)
+	f.frame(ui)
+	if !f.router.Source().Focused(child) {
+		t.Fatal("RequestFocus should focus the child")
+	}
+	if got := childStates[len(childStates)-1]; !got.IsFocused || !got.HasFocus {
+		t.Errorf("child should be focused, got %+v", got)
+	}
+	if got := parentStates[len(parentStates)-1]; got.IsFocused || !got.HasFocus {
+		t.Errorf("parent should have focus without being focused, got %+v", got)
+	}
+	if n := len(childStates); n != 2 {
+		t.Errorf("observers should only be called on changes, got %d calls", n)
+	}
+}
+
+func TestFocusRequester_Capture(t *testing.T) {
+	f := newFocusTest()
+	a, b := &testTag{}, &testTag{}
+	requester := NewFocusRequester()
+	ui := func(gtx layout.Context) {
+		target(withModifier(gtx, focusModifier{requester: requester}), a, nil)
+		target(gtx, b, nil)
+	}
+	f.frame(ui)
+	if requester.CaptureFocus() {
+		t.Fatal("an unfocused target cannot capture focus")
+	}
+	requester.RequestFocus()
+	f.frame(ui)
+	f.frame(ui)
+	if !requester.CaptureFocus() {
+		t.Fatal("a focused target should capture focus")
+	}
+	if f.owner.MoveFocus(FocusDirectionNext) {
+		t.Error("focus should not move while captured")
+	}
+	if !requester.FreeFocus() {
+		t.Fatal("FreeFocus should release the capture")
+	}
+	if !f.owner.MoveFocus(FocusDirectionNext) {
+		t.Error("focus should move once freed")
+	}
+	f.frame(ui)
+	if !f.router.Source().Focused(b) {
+		t.Error("focus should have moved to the next target")
+	}
+}
+
+func TestKeyInput_Dispatch(t *testing.T) {
+	f := newFocusTest()
+	a, b := &testTag{}, &testTag{}
+	requester := NewFocusRequester()
+	var calls []string
+	handler := func(name string, consume bool) KeyHandler {
+		return func(e key.Event) bool {
+			if e.State == key.Press {
+				calls = append(calls, name+":"+string(e.Name))
+			}
+			return consume
+		}
+	}
+	ui := func(gtx layout.Context) {
+		KeyInput(gtx, handler("outerPreview", false), handler("outer", false), func(gtx layout.Context) layout.Dimensions {
+			KeyInput(gtx, handler("innerPreview", false), handler("inner", false), func(gtx layout.Context) layout.Dimensions {
+				target(withModifier(gtx, focusModifier{requester: requester}), a, nil)
+				return layout.Dimensions{}
+			})
+			target(gtx, b, nil)
+			return layout.Dimensions{}
+		})
+	}
+	f.frame(ui)
+	requester.RequestFocus()
+	f.frame(ui)
+
+	f.owner.OnUnconsumedKeyEvent(handler("first", true))
+	f.owner.OnUnconsumedKeyEvent(handler("last", false))
+	f.router.Queue(key.Event{Name: "A", State: key.Press})
+	f.frame(ui)
+	want := []string{"outerPreview:A", "innerPreview:A", "inner:A", "outer:A", "last:A", "first:A"}
+	if len(calls) != len(want) {
+		t.Fatalf("calls = %v, want %v", calls, want)
+	}
+	for i := range want {
+		if calls[i] != want[i] {
+			t.Fatalf("calls = %v, want %v", calls, want)
+		}
+	}
+
+	// Handlers registered outside a frame only apply to the next one, and
+	// key handlers only see the events of the focused target inside them.
+	calls = nil
+	f.owner.MoveFocus(FocusDirectionNext)
+	f.frame(ui)
+	f.router.Queue(key.Event{Name: "B", State: key.Press})
+	f.frame(ui)
+	want = []string{"outerPreview:B", "outer:B"}
+	if len(calls) != len(want) || calls[0] != want[0] || calls[1] != want[1] {
+		t.Errorf("calls = %v, want %v", calls, want)
+	}
+}
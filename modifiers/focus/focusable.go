@@ -0,0 +1,131 @@
+package focus
+
+import (
+	"fmt"
+
+	"github.com/zodimo/go-compose/compose/ui"
+	node "github.com/zodimo/go-compose/internal/Node"
+	"github.com/zodimo/go-compose/internal/layoutnode"
+	"github.com/zodimo/go-compose/internal/modifier"
+
+	"gioui.org/io/event"
+	"gioui.org/io/key"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+)
+
+type FocusableOptions struct {
+	Enabled bool
+}
+
+type FocusableOption func(*FocusableOptions)
+
+func WithEnabled(enabled bool) FocusableOption {
+	return func(o *FocusableOptions) {
+		o.Enabled = enabled
+	}
+}
+
+func DefaultFocusableOptions() FocusableOptions {
+	return FocusableOptions{
+		Enabled: true,
+	}
+}
+
+// Focusable makes the element a focus target, that Tab and FocusRequester can
+// focus. A disabled element is skipped by focus traversal.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/foundation/foundation/src/commonMain/kotlin/androidx/compose/foundation/Focusable.kt
+func Focusable(options ...FocusableOption) ui.Modifier {
+	opt := DefaultFocusableOptions()
+	for _, option := range options {
+		if option == nil {
+			continue
+		}
+		option(&opt)
+	}
+	return modifier.NewInspectableModifier(
+		modifier.NewModifier(&FocusableElement{options: opt}),
+		modifier.NewInspectorInfo("focusable", map[string]any{
+			"enabled": opt.Enabled,
+		}),
+	)
+}
+
+type FocusableElement struct {
+	options FocusableOptions
+}
+
+func (e *FocusableElement) Create() node.Node {
+	return NewFocusableNode(e.options)
+}
+
+func (e *FocusableElement) Update(n node.Node) {
+	no := n.(*FocusableNode)
+	no.options = e.options
+}
+
+func (e *FocusableElement) Equals(other modifier.Element) bool {
+	o, ok := other.(*FocusableElement)
+	return ok && o.options == e.options
+}
+
+// focusableTag is the persistent focus tag of a Focusable element. It is not
+// empty, so that every tag has its own address.
+type focusableTag struct {
+	_ byte
+}
+
+type FocusableNode struct {
+	node.ChainNode
+	options FocusableOptions
+}
+
+func NewFocusableNode(options FocusableOptions) *FocusableNode {
+	n := &FocusableNode{
+		options: options,
+	}
+	n.ChainNode = node.NewChainNode(
+		node.NewNodeID(),
+		node.NodeKindFocusTarget,
+		node.LayoutPhase,
+		func(t node.TreeNode) {
+			lno := t.(layoutnode.LayoutNode)
+			statePath := fmt.Sprintf("%d/focusable", lno.GenerateID())
+			tag := lno.State(statePath, func() any { return &focusableTag{} }).Get().(*focusableTag)
+
+			no := t.(layoutnode.LayoutModifierNode)
+			no.AttachLayoutModifier(func(widget layoutnode.LayoutWidget) layoutnode.LayoutWidget {
+				return layoutnode.NewLayoutWidget(func(gtx layoutnode.LayoutContext) layoutnode.LayoutDimensions {
+					gtx, scope := beginTarget(gtx, tag, n.options.Enabled)
+					canFocus := scope.target.canFocus
+					if canFocus {
+						for {
+							if _, ok := gtx.Event(key.FocusFilter{Target: tag}); !ok {
+								break
+							}
+						}
+					}
+
+					macro := op.Record(gtx.Ops)
+					dims := widget.Layout(gtx)
+					call := macro.Stop()
+					scope.end(gtx)
+
+					if !canFocus {
+						call.Add(gtx.Ops)
+						return dims
+					}
+					// The content is added inside the focus area, so that the
+					// area does not hide the handlers of the content.
+					area := clip.Rect{Max: dims.Size}.Push(gtx.Ops)
+					event.Op(gtx.Ops, tag)
+					call.Add(gtx.Ops)
+					area.Pop()
+					return dims
+				})
+			})
+		},
+	)
+	return n
+}
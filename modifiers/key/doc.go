@@ -0,0 +1,22 @@
+// Package key provides keyboard input for composables: modifiers receiving
+// the key events of the focused element, and keyboard shortcuts.
+//
+// OnKeyEvent and OnPreviewUnhandledKeyEvent receive the key events of the
+// focused target they wrap, typically a focus.Focusable, that the target did
+// not handle itself:
+//
+//	mod := key.OnKeyEvent(func(e key.KeyEvent) bool {
+//		if e.Type == key.KeyDown && e.Key == key.KeyDeleteForward {
+//			remove()
+//			return true
+//		}
+//		return false
+//	}).Then(focus.Focusable())
+//
+// Shortcuts are registered while composing, so only the shortcuts of the
+// composables currently shown are active:
+//
+//	key.Shortcuts(c).Register(key.NewKeyboardShortcut("S", key.ModShortcut), save)
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/ui/ui/src/commonMain/kotlin/androidx/compose/ui/input/key/
+package key
@@ -0,0 +1,103 @@
+package key
+
+import (
+	gioKey "gioui.org/io/key"
+)
+
+// Key is the name of a key, as reported by Gio: upper case letters and
+// digits, or one of the Key constants.
+type Key = gioKey.Name
+
+const (
+	KeyLeftArrow      = gioKey.NameLeftArrow
+	KeyRightArrow     = gioKey.NameRightArrow
+	KeyUpArrow        = gioKey.NameUpArrow
+	KeyDownArrow      = gioKey.NameDownArrow
+	KeyReturn         = gioKey.NameReturn
+	KeyEnter          = gioKey.NameEnter
+	KeyEscape         = gioKey.NameEscape
+	KeyHome           = gioKey.NameHome
+	KeyEnd            = gioKey.NameEnd
+	KeyDeleteBackward = gioKey.NameDeleteBackward
+	KeyDeleteForward  = gioKey.NameDeleteForward
+	KeyPageUp         = gioKey.NamePageUp
+	KeyPageDown       = gioKey.NamePageDown
+	KeyTab            = gioKey.NameTab
+	KeySpace          = gioKey.NameSpace
+	// KeyBack is the back button of Android.
+	KeyBack = gioKey.NameBack
+)
+
+// Modifiers is a set of modifier keys.
+type Modifiers = gioKey.Modifiers
+
+const (
+	ModCtrl = gioKey.ModCtrl
+	// ModMeta is the Command key on macOS.
+	ModMeta  = gioKey.ModCommand
+	ModShift = gioKey.ModShift
+	ModAlt   = gioKey.ModAlt
+	// ModSuper is the Windows or Super key.
+	ModSuper = gioKey.ModSuper
+	// ModShortcut is the modifier of the platform's shortcuts: Command on
+	// macOS and iOS, Ctrl elsewhere.
+	ModShortcut = gioKey.ModShortcut
+	// ModShortcutAlt is the alternative shortcut modifier: Option on macOS
+	// and iOS, Ctrl elsewhere.
+	ModShortcutAlt = gioKey.ModShortcutAlt
+)
+
+// KeyEventType is the kind of a KeyEvent.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/ui/ui/src/commonMain/kotlin/androidx/compose/ui/input/key/KeyEventType.kt
+type KeyEventType uint8
+
+const (
+	KeyEventTypeUnknown KeyEventType = iota
+	KeyDown
+	KeyUp
+)
+
+func (t KeyEventType) String() string {
+	switch t {
+	case KeyDown:
+		return "KeyDown"
+	case KeyUp:
+		return "KeyUp"
+	default:
+		return "Unknown"
+	}
+}
+
+// KeyEvent is a key press or release.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/ui/ui/src/commonMain/kotlin/androidx/compose/ui/input/key/KeyEvent.kt
+type KeyEvent struct {
+	Key       Key
+	Type      KeyEventType
+	Modifiers Modifiers
+}
+
+func (e KeyEvent) IsCtrlPressed() bool {
+	return e.Modifiers.Contain(ModCtrl)
+}
+
+func (e KeyEvent) IsMetaPressed() bool {
+	return e.Modifiers.Contain(ModMeta)
+}
+
+func (e KeyEvent) IsShiftPressed() bool {
+	return e.Modifiers.Contain(ModShift)
+}
+
+func (e KeyEvent) IsAltPressed() bool {
+	return e.Modifiers.Contain(ModAlt)
+}
+
+func keyEventFromGio(e gioKey.Event) KeyEvent {
+	t := KeyDown
+	if e.State == gioKey.Release {
+		t = KeyUp
+	}
+	return KeyEvent{Key: e.Name, Type: t, Modifiers: e.Modifiers}
+}
@@ -0,0 +1,113 @@
+package key
+
+import (
+	"github.com/zodimo/go-compose/compose/ui"
+	node "github.com/zodimo/go-compose/internal/Node"
+	"github.com/zodimo/go-compose/internal/layoutnode"
+	"github.com/zodimo/go-compose/internal/modifier"
+	"github.com/zodimo/go-compose/modifiers/focus"
+
+	gioKey "gioui.org/io/key"
+)
+
+// OnKeyEvent calls onKeyEvent with the key events of the focused target
+// inside the element, after the OnKeyEvent modifiers inside it. onKeyEvent
+// returns true to consume the event and stop its propagation.
+//
+// Events the focused widget handles itself, such as text typed in a text
+// field, are not seen. Tab and Shift+Tab are kept for focus traversal.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/ui/ui/src/commonMain/kotlin/androidx/compose/ui/input/key/KeyInputModifier.kt
+func OnKeyEvent(onKeyEvent func(e KeyEvent) bool) ui.Modifier {
+	if onKeyEvent == nil {
+		panic("OnKeyEvent: onKeyEvent cannot be nil")
+	}
+	return modifier.NewInspectableModifier(
+		modifier.NewModifier(&KeyInputElement{onKeyEvent: onKeyEvent}),
+		modifier.NewInspectorInfo("onKeyEvent", map[string]any{
+			"onKeyEvent": onKeyEvent,
+		}),
+	)
+}
+
+// OnPreviewUnhandledKeyEvent is OnKeyEvent with the opposite order: it sees
+// the key events the focused target inside the element did not handle before
+// the OnPreviewUnhandledKeyEvent modifiers inside the element, and before any
+// OnKeyEvent modifier. onPreviewUnhandledKeyEvent returns true to consume the
+// event and stop its propagation.
+//
+// Unlike onPreviewKeyEvent in Compose, it does not see the events before the
+// focused widget does: Gio hands each key event to the first widget reading
+// it, and cannot give an event back once it is read, so a preview pass ahead
+// of the focused widget would take the keys, such as the arrows of a text
+// field, that it handles itself.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/ui/ui/src/commonMain/kotlin/androidx/compose/ui/input/key/KeyInputModifier.kt
+func OnPreviewUnhandledKeyEvent(onPreviewUnhandledKeyEvent func(e KeyEvent) bool) ui.Modifier {
+	if onPreviewUnhandledKeyEvent == nil {
+		panic("OnPreviewUnhandledKeyEvent: onPreviewUnhandledKeyEvent cannot be nil")
+	}
+	return modifier.NewInspectableModifier(
+		modifier.NewModifier(&KeyInputElement{onPreviewUnhandledKeyEvent: onPreviewUnhandledKeyEvent}),
+		modifier.NewInspectorInfo("onPreviewUnhandledKeyEvent", map[string]any{
+			"onPreviewUnhandledKeyEvent": onPreviewUnhandledKeyEvent,
+		}),
+	)
+}
+
+type KeyInputElement struct {
+	onKeyEvent                 func(e KeyEvent) bool
+	onPreviewUnhandledKeyEvent func(e KeyEvent) bool
+}
+
+func (e *KeyInputElement) Create() node.Node {
+	return NewKeyInputNode(*e)
+}
+
+func (e *KeyInputElement) Update(n node.Node) {
+	no := n.(*KeyInputNode)
+	no.element = *e
+}
+
+// Equals is always false, as callbacks cannot be compared.
+func (e *KeyInputElement) Equals(other modifier.Element) bool {
+	return false
+}
+
+type KeyInputNode struct {
+	node.ChainNode
+	element KeyInputElement
+}
+
+func NewKeyInputNode(element KeyInputElement) *KeyInputNode {
+	n := &KeyInputNode{
+		element: element,
+	}
+	n.ChainNode = node.NewChainNode(
+		node.NewNodeID(),
+		node.NodeKindKeyInput,
+		node.LayoutPhase,
+		func(t node.TreeNode) {
+			no := t.(layoutnode.LayoutModifierNode)
+			no.AttachLayoutModifier(func(widget layoutnode.LayoutWidget) layoutnode.LayoutWidget {
+				return layoutnode.NewLayoutWidget(func(gtx layoutnode.LayoutContext) layoutnode.LayoutDimensions {
+					return focus.KeyInput(gtx,
+						keyHandler(n.element.onPreviewUnhandledKeyEvent),
+						keyHandler(n.element.onKeyEvent),
+						widget.Layout,
+					)
+				})
+			})
+		},
+	)
+	return n
+}
+
+func keyHandler(handler func(e KeyEvent) bool) focus.KeyHandler {
+	if handler == nil {
+		return nil
+	}
+	return func(e gioKey.Event) bool {
+		return handler(keyEventFromGio(e))
+	}
+}
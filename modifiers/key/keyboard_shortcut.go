@@ -0,0 +1,99 @@
+package key
+
+import (
+	"runtime"
+	"strings"
+)
+
+// KeyboardShortcut is a key combination such as Ctrl+S.
+type KeyboardShortcut struct {
+	Key Key
+	// Modifiers must be pressed exactly; use ModShortcut for the modifier
+	// that suits the platform.
+	Modifiers Modifiers
+}
+
+// NewKeyboardShortcut returns the shortcut of k pressed with modifiers.
+// Letters are converted to upper case, as Gio reports them.
+func NewKeyboardShortcut(k Key, modifiers ...Modifiers) KeyboardShortcut {
+	s := KeyboardShortcut{Key: Key(strings.ToUpper(string(k)))}
+	for _, m := range modifiers {
+		s.Modifiers |= m
+	}
+	return s
+}
+
+// Matches reports whether e is a press of the shortcut.
+func (s KeyboardShortcut) Matches(e KeyEvent) bool {
+	return e.Type == KeyDown && e.Modifiers == s.Modifiers && strings.EqualFold(string(e.Key), string(s.Key))
+}
+
+// String returns the accelerator text of the shortcut for menus, following
+// the conventions of the platform: ⇧⌘S on macOS, Ctrl+Shift+S elsewhere.
+func (s KeyboardShortcut) String() string {
+	return s.label(isApple)
+}
+
+var isApple = runtime.GOOS == "darwin" || runtime.GOOS == "ios"
+
+func (s KeyboardShortcut) label(apple bool) string {
+	if apple {
+		// macOS orders modifiers Control, Option, Shift, Command.
+		var b strings.Builder
+		for _, m := range []struct {
+			mod    Modifiers
+			symbol string
+		}{
+			{ModCtrl, "⌃"},
+			{ModAlt, "⌥"},
+			{ModShift, "⇧"},
+			{ModMeta, "⌘"},
+			{ModSuper, "❖"},
+		} {
+			if s.Modifiers.Contain(m.mod) {
+				b.WriteString(m.symbol)
+			}
+		}
+		b.WriteString(string(s.Key))
+		return b.String()
+	}
+
+	var parts []string
+	for _, m := range []struct {
+		mod  Modifiers
+		name string
+	}{
+		{ModCtrl, "Ctrl"},
+		{ModSuper, "Super"},
+		{ModMeta, "Meta"},
+		{ModAlt, "Alt"},
+		{ModShift, "Shift"},
+	} {
+		if s.Modifiers.Contain(m.mod) {
+			parts = append(parts, m.name)
+		}
+	}
+	name := string(s.Key)
+	if n, ok := keyLabels[s.Key]; ok {
+		name = n
+	}
+	return strings.Join(append(parts, name), "+")
+}
+
+// keyLabels are the names of the keys Gio reports with a symbol, outside of
+// macOS.
+var keyLabels = map[Key]string{
+	KeyLeftArrow:      "Left",
+	KeyRightArrow:     "Right",
+	KeyUpArrow:        "Up",
+	KeyDownArrow:      "Down",
+	KeyReturn:         "Enter",
+	KeyEnter:          "Enter",
+	KeyEscape:         "Esc",
+	KeyHome:           "Home",
+	KeyEnd:            "End",
+	KeyDeleteBackward: "Backspace",
+	KeyDeleteForward:  "Delete",
+	KeyPageUp:         "PgUp",
+	KeyPageDown:       "PgDn",
+}
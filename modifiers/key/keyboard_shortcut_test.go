@@ -0,0 +1,41 @@
+package key
+
+import "testing"
+
+func TestKeyboardShortcut_Label(t *testing.T) {
+	tests := []struct {
+		shortcut KeyboardShortcut
+		apple    string
+		other    string
+	}{
+		{NewKeyboardShortcut("s", ModCtrl), "⌃S", "Ctrl+S"},
+		{NewKeyboardShortcut("S", ModMeta, ModShift), "⇧⌘S", "Meta+Shift+S"},
+		{NewKeyboardShortcut("K", ModCtrl|ModAlt|ModShift), "⌃⌥⇧K", "Ctrl+Alt+Shift+K"},
+		{NewKeyboardShortcut(KeyDeleteBackward, ModAlt), "⌥⌫", "Alt+Backspace"},
+		{NewKeyboardShortcut("F5"), "F5", "F5"},
+	}
+	for _, tt := range tests {
+		if got := tt.shortcut.label(true); got != tt.apple {
+			t.Errorf("%v: apple label = %q, want %q", tt.shortcut, got, tt.apple)
+		}
+		if got := tt.shortcut.label(false); got != tt.other {
+			t.Errorf("%v: label = %q, want %q", tt.shortcut, got, tt.other)
+		}
+	}
+}
+
+func TestKeyboardShortcut_Matches(t *testing.T) {
+	s := NewKeyboardShortcut("s", ModShortcut)
+	if !s.Matches(KeyEvent{Key: "S", Type: KeyDown, Modifiers: ModShortcut}) {
+		t.Error("the shortcut should match its key press")
+	}
+	if s.Matches(KeyEvent{Key: "S", Type: KeyUp, Modifiers: ModShortcut}) {
+		t.Error("the shortcut should not match a release")
+	}
+	if s.Matches(KeyEvent{Key: "S", Type: KeyDown, Modifiers: ModShortcut | ModShift}) {
+		t.Error("the shortcut should not match extra modifiers")
+	}
+	if s.Matches(KeyEvent{Key: "S", Type: KeyDown}) {
+		t.Error("the shortcut should not match without its modifiers")
+	}
+}
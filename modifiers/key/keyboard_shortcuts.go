@@ -0,0 +1,48 @@
+package key
+
+import (
+	"github.com/zodimo/go-compose/modifiers/focus"
+	"github.com/zodimo/go-compose/pkg/api"
+
+	gioKey "gioui.org/io/key"
+)
+
+// KeyboardShortcuts is the registry of the keyboard shortcuts of a window.
+//
+// Shortcuts are registered while composing and last for the frame that
+// follows, so they are active exactly while the composable that registers
+// them is shown: the shortcuts of a screen stop once navigation moves away
+// from it. Shortcuts receive the key events that no focused element
+// consumed; when several match, the last registered wins.
+type KeyboardShortcuts struct {
+	owner focus.FocusOwner
+}
+
+// Shortcuts returns the KeyboardShortcuts of the window composed by c.
+func Shortcuts(c api.Composer) KeyboardShortcuts {
+	return KeyboardShortcuts{owner: focus.Owner(c)}
+}
+
+// Register calls onShortcut when shortcut is pressed in the next frame.
+// Call it on every composition while the shortcut applies.
+func (s KeyboardShortcuts) Register(shortcut KeyboardShortcut, onShortcut func()) {
+	if onShortcut == nil {
+		return
+	}
+	s.owner.OnUnconsumedKeyEvent(func(e gioKey.Event) bool {
+		if !shortcut.Matches(keyEventFromGio(e)) {
+			return false
+		}
+		onShortcut()
+		return true
+	})
+}
+
+// OnKeyEvent calls onKeyEvent with the key events of the next frame that no
+// focused element or shortcut registered after it consumed.
+func (s KeyboardShortcuts) OnKeyEvent(onKeyEvent func(e KeyEvent) bool) {
+	if onKeyEvent == nil {
+		return
+	}
+	s.owner.OnUnconsumedKeyEvent(keyHandler(onKeyEvent))
+}
@@ -0,0 +1,98 @@
+package offset
+
+import (
+	"image"
+	"math"
+
+	"github.com/zodimo/go-compose/compose/ui"
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+	node "github.com/zodimo/go-compose/internal/Node"
+	"github.com/zodimo/go-compose/internal/layoutnode"
+	"github.com/zodimo/go-compose/internal/modifier"
+
+	"gioui.org/op"
+)
+
+// OffsetProvider returns an offset in pixels for content of the given size.
+type OffsetProvider func(size unit.IntSize) geometry.Offset
+
+// OffsetFunc translates the element by the offset returned by provider.
+// provider runs at layout time, after the content was measured, so the offset
+// can follow state that changes every frame, such as a drag or an animation,
+// and depend on the content's size.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/foundation/foundation-layout/src/commonMain/kotlin/androidx/compose/foundation/layout/Offset.kt
+func OffsetFunc(provider OffsetProvider) ui.Modifier {
+	if provider == nil {
+		panic("OffsetFunc: provider cannot be nil")
+	}
+	return modifier.NewInspectableModifier(
+		modifier.NewModifier(
+			&OffsetFuncElement{provider: provider},
+		),
+		modifier.NewInspectorInfo(
+			"offset",
+			map[string]any{
+				"offset": provider,
+			},
+		),
+	)
+}
+
+type OffsetFuncElement struct {
+	provider OffsetProvider
+}
+
+func (e *OffsetFuncElement) Create() node.Node {
+	return NewOffsetFuncNode(e.provider)
+}
+
+func (e *OffsetFuncElement) Update(n node.Node) {
+	no := n.(*OffsetFuncNode)
+	no.provider = e.provider
+}
+
+// Equals is always false, as providers cannot be compared.
+func (e *OffsetFuncElement) Equals(other modifier.Element) bool {
+	return false
+}
+
+type OffsetFuncNode struct {
+	node.ChainNode
+	provider OffsetProvider
+}
+
+func NewOffsetFuncNode(provider OffsetProvider) *OffsetFuncNode {
+	n := &OffsetFuncNode{
+		provider: provider,
+	}
+	n.ChainNode = node.NewChainNode(
+		node.NewNodeID(),
+		node.NodeKindLayout,
+		node.LayoutPhase,
+		func(t node.TreeNode) {
+			no := t.(layoutnode.LayoutModifierNode)
+			no.AttachLayoutModifier(func(widget layoutnode.LayoutWidget) layoutnode.LayoutWidget {
+				return layoutnode.NewLayoutWidget(func(gtx layoutnode.LayoutContext) layoutnode.LayoutDimensions {
+					macro := op.Record(gtx.Ops)
+					dims := widget.Layout(gtx)
+					call := macro.Stop()
+
+					offset := n.provider(unit.IntSize{Width: dims.Size.X, Height: dims.Size.Y})
+					pt := image.Point{}
+					if offset.IsValid() {
+						pt = image.Pt(int(math.Round(float64(offset.X()))), int(math.Round(float64(offset.Y()))))
+					}
+
+					stack := op.Offset(pt).Push(gtx.Ops)
+					call.Add(gtx.Ops)
+					stack.Pop()
+
+					return dims
+				})
+			})
+		},
+	)
+	return n
+}
@@ -8,3 +8,75 @@ import (
 func BlockPointer() ui.Modifier {
 	return modifier.NewModifier(InputBlockerElement{})
 }
+
+type PointerInputOptions struct {
+	ViewConfiguration ViewConfiguration
+}
+
+type PointerInputOption func(*PointerInputOptions)
+
+func WithViewConfiguration(viewConfiguration ViewConfiguration) PointerInputOption {
+	return func(options *PointerInputOptions) {
+		options.ViewConfiguration = viewConfiguration
+	}
+}
+
+func DefaultPointerInputOptions() PointerInputOptions {
+	return PointerInputOptions{
+		ViewConfiguration: DefaultViewConfiguration(),
+	}
+}
+
+// PointerInput installs the handlers registered by block on a
+// PointerInputScope covering the modified element.
+//
+// block runs once, and again on a new scope whenever one of keys changes;
+// until then the installed handlers are kept, so values they read that change
+// between compositions should either be among keys or be read through state.
+// Keys must be comparable, e.g. strings, pointers or structs of comparable
+// fields. The scope is canceled once the element leaves the window, and
+// block runs again if it comes back.
+//
+//	pointer.PointerInput(func(scope pointer.PointerInputScope) {
+//		gestures.DetectTapGestures(scope, gestures.WithOnDoubleTap(func(offset geometry.Offset) {
+//			zoomed.Set(!zoomed.Get())
+//		}))
+//	}, "taps")
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/ui/ui/src/commonMain/kotlin/androidx/compose/ui/input/pointer/SuspendingPointerInputFilter.kt
+func PointerInput(block PointerInputBlock, keys ...any) ui.Modifier {
+	return PointerInputWithOptions(block, keys)
+}
+
+// PointerInputWithOptions is PointerInput with options, such as the
+// ViewConfiguration of the gesture detectors.
+func PointerInputWithOptions(block PointerInputBlock, keys []any, options ...PointerInputOption) ui.Modifier {
+	if block == nil {
+		panic("PointerInput: block cannot be nil")
+	}
+	opt := DefaultPointerInputOptions()
+	for _, option := range options {
+		if option == nil {
+			continue
+		}
+		option(&opt)
+	}
+	return modifier.NewInspectableModifier(
+		modifier.NewModifier(
+			&PointerInputElement{
+				data: PointerInputData{
+					Keys:              keys,
+					Block:             block,
+					ViewConfiguration: opt.ViewConfiguration,
+				},
+			},
+		),
+		modifier.NewInspectorInfo(
+			"pointerInput",
+			map[string]any{
+				"keys":    keys,
+				"options": opt,
+			},
+		),
+	)
+}
@@ -0,0 +1,154 @@
+package pointer
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+
+	"gioui.org/io/key"
+	gioPointer "gioui.org/io/pointer"
+)
+
+// PointerID identifies a pointer from the moment it is pressed until it is
+// released. Each finger on a touch screen has its own PointerID.
+type PointerID = gioPointer.ID
+
+// PointerEventType is the kind of a PointerEvent.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/ui/ui/src/commonMain/kotlin/androidx/compose/ui/input/pointer/PointerEvent.kt
+type PointerEventType uint8
+
+const (
+	PointerEventTypeUnknown PointerEventType = iota
+	PointerEventTypePress
+	PointerEventTypeRelease
+	PointerEventTypeMove
+	PointerEventTypeEnter
+	PointerEventTypeExit
+	PointerEventTypeScroll
+)
+
+func (t PointerEventType) String() string {
+	switch t {
+	case PointerEventTypePress:
+		return "Press"
+	case PointerEventTypeRelease:
+		return "Release"
+	case PointerEventTypeMove:
+		return "Move"
+	case PointerEventTypeEnter:
+		return "Enter"
+	case PointerEventTypeExit:
+		return "Exit"
+	case PointerEventTypeScroll:
+		return "Scroll"
+	default:
+		return "Unknown"
+	}
+}
+
+// PointerType is the device that produced a pointer change.
+type PointerType uint8
+
+const (
+	PointerTypeUnknown PointerType = iota
+	PointerTypeMouse
+	PointerTypeTouch
+)
+
+func (t PointerType) String() string {
+	switch t {
+	case PointerTypeMouse:
+		return "Mouse"
+	case PointerTypeTouch:
+		return "Touch"
+	default:
+		return "Unknown"
+	}
+}
+
+// PointerInputChange describes the change of a single pointer between two
+// events. Positions are in pixels, relative to the pointer input node.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/ui/ui/src/commonMain/kotlin/androidx/compose/ui/input/pointer/PointerEvent.kt
+type PointerInputChange struct {
+	ID               PointerID
+	Type             PointerType
+	Position         geometry.Offset
+	PreviousPosition geometry.Offset
+	Pressed          bool
+	PreviousPressed  bool
+	// Uptime is the time of the change. It is only meaningful relative to
+	// other changes.
+	Uptime         time.Duration
+	PreviousUptime time.Duration
+	// ScrollDelta is the scroll amount, in pixels, of a scroll event.
+	ScrollDelta geometry.Offset
+
+	consumed bool
+}
+
+// Consume marks the change as used, so that gesture detectors handling the
+// event later ignore it.
+func (c *PointerInputChange) Consume() {
+	c.consumed = true
+}
+
+// IsConsumed reports whether the change was consumed.
+func (c *PointerInputChange) IsConsumed() bool {
+	return c.consumed
+}
+
+// PositionChange returns the movement of the pointer since the previous event.
+func (c *PointerInputChange) PositionChange() geometry.Offset {
+	return c.Position.Minus(c.PreviousPosition)
+}
+
+// ChangedToDown reports whether the pointer was pressed in this change.
+func (c *PointerInputChange) ChangedToDown() bool {
+	return c.Pressed && !c.PreviousPressed
+}
+
+// ChangedToUp reports whether the pointer was released in this change.
+func (c *PointerInputChange) ChangedToUp() bool {
+	return !c.Pressed && c.PreviousPressed
+}
+
+func (c *PointerInputChange) String() string {
+	return fmt.Sprintf("PointerInputChange{ID: %d, Type: %s, Position: %s, Pressed: %t, Consumed: %t}", c.ID, c.Type, c.Position, c.Pressed, c.consumed)
+}
+
+// PointerEvent is a pointer event delivered to a PointerInput handler.
+// Changes holds one entry per pointer currently down, plus the pointer that
+// triggered the event; on multi-touch screens this lets handlers follow all
+// fingers at once.
+type PointerEvent struct {
+	Type    PointerEventType
+	Changes []*PointerInputChange
+	Buttons gioPointer.Buttons
+	// KeyboardModifiers are the modifier keys held when the event happened.
+	KeyboardModifiers key.Modifiers
+	// Time is the frame time at which the event was dispatched.
+	Time time.Time
+}
+
+// Change returns the change for the pointer with the given id.
+func (e *PointerEvent) Change(id PointerID) (*PointerInputChange, bool) {
+	for _, c := range e.Changes {
+		if c.ID == id {
+			return c, true
+		}
+	}
+	return nil, false
+}
+
+// ChangedPointer returns the change of the pointer that triggered the event.
+// It is the last entry of Changes.
+func (e *PointerEvent) ChangedPointer() *PointerInputChange {
+	return e.Changes[len(e.Changes)-1]
+}
+
+func (e *PointerEvent) String() string {
+	return fmt.Sprintf("PointerEvent{Type: %s, Changes: %v}", e.Type, e.Changes)
+}
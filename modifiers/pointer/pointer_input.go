@@ -0,0 +1,68 @@
+package pointer
+
+import (
+	"reflect"
+
+	node "github.com/zodimo/go-compose/internal/Node"
+	"github.com/zodimo/go-compose/internal/modifier"
+)
+
+// PointerInputBlock installs handlers on a PointerInputScope.
+type PointerInputBlock func(scope PointerInputScope)
+
+type PointerInputData struct {
+	Keys              []any
+	Block             PointerInputBlock
+	ViewConfiguration ViewConfiguration
+}
+
+type PointerInputElement struct {
+	data PointerInputData
+}
+
+var _ modifier.Element = (*PointerInputElement)(nil)
+
+func (e *PointerInputElement) Create() node.Node {
+	return NewPointerInputNode(e.data)
+}
+
+func (e *PointerInputElement) Update(n node.Node) {
+	no := n.(*PointerInputNode)
+	no.data = e.data
+}
+
+// Equals reports whether both elements have the same keys. Blocks cannot be
+// compared, which is why the keys decide when handlers are reinstalled.
+func (e *PointerInputElement) Equals(other modifier.Element) bool {
+	o, ok := other.(*PointerInputElement)
+	if !ok {
+		return false
+	}
+	return keysEqual(e.data.Keys, o.data.Keys) && e.data.ViewConfiguration == o.data.ViewConfiguration
+}
+
+// keysEqual compares two lists of keys, key by key.
+func keysEqual(a, b []any) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !keyEqual(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// keyEqual compares two keys, treating keys that are not comparable as
+// always different.
+func keyEqual(a, b any) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	ta, tb := reflect.TypeOf(a), reflect.TypeOf(b)
+	if ta != tb || !ta.Comparable() {
+		return false
+	}
+	return a == b
+}
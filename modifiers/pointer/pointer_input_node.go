@@ -0,0 +1,89 @@
+package pointer
+
+import (
+	"fmt"
+
+	node "github.com/zodimo/go-compose/internal/Node"
+	"github.com/zodimo/go-compose/internal/layoutnode"
+
+	"gioui.org/io/event"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+)
+
+var _ node.ChainNode = (*PointerInputNode)(nil)
+
+type PointerInputNode struct {
+	node.ChainNode
+	data  PointerInputData
+	state *pointerInputState
+}
+
+// pointerInputState survives recompositions and holds the scope whose
+// handlers were installed for keys.
+type pointerInputState struct {
+	keys  []any
+	scope *pointerInputScope
+	// pending is the block still to run on scope, on the next layout.
+	pending PointerInputBlock
+}
+
+func NewPointerInputNode(data PointerInputData) *PointerInputNode {
+	n := &PointerInputNode{
+		data: data,
+	}
+	n.ChainNode = node.NewChainNode(
+		node.NewNodeID(),
+		node.NodeKindPointerInput,
+		node.PointerInputPhase,
+		func(t node.TreeNode) {
+			lno := t.(layoutnode.LayoutNode)
+			statePath := fmt.Sprintf("%d/pointerInput", lno.GenerateID())
+			state := lno.State(statePath, func() any { return &pointerInputState{} }).Get().(*pointerInputState)
+			n.state = state
+			owner := ownerOf(lno)
+			// The block runs again on a new scope once the keys change, or the
+			// element comes back after it left the window.
+			if state.scope == nil || state.scope.disposed || !keysEqual(state.keys, n.data.Keys) || state.scope.viewConfiguration != n.data.ViewConfiguration {
+				if state.scope != nil {
+					state.scope.dispose()
+				}
+				state.keys = n.data.Keys
+				state.scope = newPointerInputScope(n.data.ViewConfiguration)
+				state.pending = n.data.Block
+			}
+
+			no := t.(layoutnode.PointerInputModifierNode)
+			no.AttachPointerInputModifier(func(widget layoutnode.LayoutWidget) layoutnode.LayoutWidget {
+				return layoutnode.NewLayoutWidget(func(gtx layoutnode.LayoutContext) layoutnode.LayoutDimensions {
+					scope := state.scope
+					owner.register(scope)
+					if block := state.pending; block != nil {
+						state.pending = nil
+						block(scope)
+					}
+					scope.update(gtx)
+
+					macro := op.Record(gtx.Ops)
+					dims := widget.Layout(gtx)
+					call := macro.Stop()
+					scope.size.Width, scope.size.Height = dims.Size.X, dims.Size.Y
+
+					// The content is added inside the input area, so that its own
+					// handlers are nested in ours and both receive events.
+					area := clip.Rect{Max: dims.Size}.Push(gtx.Ops)
+					event.Op(gtx.Ops, scope)
+					call.Add(gtx.Ops)
+					area.Pop()
+					return dims
+				})
+			})
+		},
+		node.NewChainNodeWithOnDetach(func() {
+			if n.state != nil && n.state.scope != nil {
+				n.state.scope.dispose()
+			}
+		}),
+	)
+	return n
+}
@@ -0,0 +1,49 @@
+package pointer
+
+import (
+	"github.com/zodimo/go-compose/state"
+)
+
+// PointerInputOwner cancels the pointer input of the elements that leave a
+// window. The runtime calls EndFrame once a frame is laid out.
+type PointerInputOwner interface {
+	// EndFrame cancels the scopes of the PointerInput modifiers laid out in
+	// the last frame but not in this one.
+	EndFrame()
+}
+
+const ownerStateKey = "pointer/owner"
+
+// Owner returns the PointerInputOwner of the window whose state is s.
+func Owner(s state.SupportState) PointerInputOwner {
+	return ownerOf(s)
+}
+
+func ownerOf(s state.SupportState) *pointerInputOwner {
+	return s.State(ownerStateKey, func() any { return &pointerInputOwner{} }).Get().(*pointerInputOwner)
+}
+
+var _ PointerInputOwner = (*pointerInputOwner)(nil)
+
+type pointerInputOwner struct {
+	// scopes are the scopes laid out in the last frame, frameScopes the ones
+	// laid out so far in the current frame.
+	scopes      map[*pointerInputScope]bool
+	frameScopes map[*pointerInputScope]bool
+}
+
+func (o *pointerInputOwner) EndFrame() {
+	for scope := range o.scopes {
+		if !o.frameScopes[scope] {
+			scope.dispose()
+		}
+	}
+	o.scopes, o.frameScopes = o.frameScopes, nil
+}
+
+func (o *pointerInputOwner) register(scope *pointerInputScope) {
+	if o.frameScopes == nil {
+		o.frameScopes = map[*pointerInputScope]bool{}
+	}
+	o.frameScopes[scope] = true
+}
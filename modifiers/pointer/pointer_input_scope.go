@@ -0,0 +1,312 @@
+package pointer
+
+import (
+	"math"
+	"time"
+
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+
+	gioPointer "gioui.org/io/pointer"
+	"gioui.org/layout"
+	"gioui.org/op"
+)
+
+// PointerEventHandler receives every pointer event of a PointerInput modifier.
+type PointerEventHandler func(event *PointerEvent)
+
+// PointerInputScope is passed to the block of a PointerInput modifier.
+// Handlers registered on the scope stay installed until the modifier's key
+// changes or it leaves the composition; gesture detectors are built on it.
+//
+// Gio is immediate mode, so instead of Compose's suspending
+// awaitPointerEventScope, handlers are callbacks: OnPointerEvent for events,
+// OnCancel when the gesture is taken away, and OnFrame for time based logic
+// such as long presses. Handlers run in registration order, so consuming a
+// change hides it from the handlers registered after.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/ui/ui/src/commonMain/kotlin/androidx/compose/ui/input/pointer/SuspendingPointerInputFilter.kt
+type PointerInputScope interface {
+	// Size returns the size of the pointer input region, in pixels.
+	Size() unit.IntSize
+	// Density returns the density used to convert Dp thresholds to pixels.
+	Density() unit.Density
+	// ViewConfiguration returns the gesture thresholds.
+	ViewConfiguration() ViewConfiguration
+
+	// OnPointerEvent registers a handler for raw pointer events.
+	OnPointerEvent(handler PointerEventHandler)
+	// OnCancel registers a handler called when the system cancels the
+	// current gesture, for example because another handler grabbed the pointer.
+	OnCancel(handler func())
+	// OnFrame registers a handler called once per frame with the frame time,
+	// after the frame's events were dispatched.
+	OnFrame(handler func(now time.Time))
+
+	// InvalidateAt requests a frame at t, so that OnFrame handlers can act on
+	// deadlines even when no events arrive.
+	InvalidateAt(t time.Time)
+	// Grab claims the pointer: other handlers, such as scrollable parents,
+	// receive a cancel and no further events for it.
+	Grab(id PointerID)
+	// ReceiveScrollEvents opts in to mouse wheel and trackpad scroll events.
+	// It is off by default so that scrollable parents keep receiving them.
+	ReceiveScrollEvents()
+}
+
+var _ PointerInputScope = (*pointerInputScope)(nil)
+
+// pointerState is the last known state of a pointer.
+type pointerState struct {
+	position    geometry.Offset
+	pressed     bool
+	uptime      time.Duration
+	pointerType PointerType
+}
+
+type pointerInputScope struct {
+	size              unit.IntSize
+	density           unit.Density
+	viewConfiguration ViewConfiguration
+
+	eventHandlers  []PointerEventHandler
+	cancelHandlers []func()
+	frameHandlers  []func(now time.Time)
+
+	// pointers holds the tracked pointers, in the order they first appeared.
+	pointers []PointerID
+	states   map[PointerID]*pointerState
+
+	scroll       bool
+	grabs        []PointerID
+	invalidateAt time.Time
+	// disposed is set once the element of the scope left the window.
+	disposed bool
+}
+
+func newPointerInputScope(viewConfiguration ViewConfiguration) *pointerInputScope {
+	return &pointerInputScope{
+		density:           unit.NewDensity(1, 1),
+		viewConfiguration: viewConfiguration,
+		states:            map[PointerID]*pointerState{},
+	}
+}
+
+func (s *pointerInputScope) Size() unit.IntSize {
+	return s.size
+}
+
+func (s *pointerInputScope) Density() unit.Density {
+	return s.density
+}
+
+func (s *pointerInputScope) ViewConfiguration() ViewConfiguration {
+	return s.viewConfiguration
+}
+
+func (s *pointerInputScope) OnPointerEvent(handler PointerEventHandler) {
+	s.eventHandlers = append(s.eventHandlers, handler)
+}
+
+func (s *pointerInputScope) OnCancel(handler func()) {
+	s.cancelHandlers = append(s.cancelHandlers, handler)
+}
+
+func (s *pointerInputScope) OnFrame(handler func(now time.Time)) {
+	s.frameHandlers = append(s.frameHandlers, handler)
+}
+
+func (s *pointerInputScope) InvalidateAt(t time.Time) {
+	if s.invalidateAt.IsZero() || t.Before(s.invalidateAt) {
+		s.invalidateAt = t
+	}
+}
+
+func (s *pointerInputScope) Grab(id PointerID) {
+	s.grabs = append(s.grabs, id)
+}
+
+func (s *pointerInputScope) ReceiveScrollEvents() {
+	s.scroll = true
+}
+
+// update reads the pending Gio events for the scope, dispatches them and
+// runs the frame handlers.
+func (s *pointerInputScope) update(gtx layout.Context) {
+	s.density = unit.DensityFromMetric(gtx.Metric)
+	for {
+		ev, ok := gtx.Event(s.filter())
+		if !ok {
+			break
+		}
+		if e, ok := ev.(gioPointer.Event); ok {
+			s.dispatch(e, gtx.Now)
+		}
+	}
+	s.frame(gtx.Now)
+
+	for _, id := range s.grabs {
+		gtx.Execute(gioPointer.GrabCmd{Tag: s, ID: id})
+	}
+	s.grabs = s.grabs[:0]
+	if !s.invalidateAt.IsZero() {
+		gtx.Execute(op.InvalidateCmd{At: s.invalidateAt})
+		s.invalidateAt = time.Time{}
+	}
+}
+
+func (s *pointerInputScope) filter() gioPointer.Filter {
+	f := gioPointer.Filter{
+		Target: s,
+		Kinds:  gioPointer.Press | gioPointer.Release | gioPointer.Move | gioPointer.Drag | gioPointer.Enter | gioPointer.Leave | gioPointer.Cancel,
+	}
+	if s.scroll {
+		f.Kinds |= gioPointer.Scroll
+		f.ScrollX = gioPointer.ScrollRange{Min: math.MinInt32, Max: math.MaxInt32}
+		f.ScrollY = gioPointer.ScrollRange{Min: math.MinInt32, Max: math.MaxInt32}
+	}
+	return f
+}
+
+// dispatch converts a Gio event into a PointerEvent and hands it to the handlers.
+func (s *pointerInputScope) dispatch(e gioPointer.Event, now time.Time) {
+	if e.Kind == gioPointer.Cancel {
+		s.cancel()
+		return
+	}
+	eventType := eventTypeOf(e.Kind)
+	if eventType == PointerEventTypeUnknown {
+		return
+	}
+
+	position := geometry.NewOffset(e.Position.X, e.Position.Y)
+	state, known := s.states[e.PointerID]
+	if !known {
+		state = &pointerState{position: position, uptime: e.Time, pointerType: pointerTypeOf(e.Source)}
+		s.states[e.PointerID] = state
+		s.pointers = append(s.pointers, e.PointerID)
+	}
+
+	changes := make([]*PointerInputChange, 0, len(s.pointers))
+	for _, id := range s.pointers {
+		other := s.states[id]
+		if id == e.PointerID || !other.pressed {
+			continue
+		}
+		changes = append(changes, &PointerInputChange{
+			ID:               id,
+			Type:             other.pointerType,
+			Position:         other.position,
+			PreviousPosition: other.position,
+			Pressed:          true,
+			PreviousPressed:  true,
+			Uptime:           e.Time,
+			PreviousUptime:   other.uptime,
+		})
+	}
+
+	// Only presses, drags and releases change the buttons: a pointer leaving
+	// the element while pressed stays pressed, and keeps its state.
+	pressed := state.pressed
+	switch e.Kind {
+	case gioPointer.Press, gioPointer.Drag:
+		pressed = true
+	case gioPointer.Release:
+		pressed = false
+	}
+	change := &PointerInputChange{
+		ID:               e.PointerID,
+		Type:             state.pointerType,
+		Position:         position,
+		PreviousPosition: state.position,
+		Pressed:          pressed,
+		PreviousPressed:  state.pressed,
+		Uptime:           e.Time,
+		PreviousUptime:   state.uptime,
+	}
+	if eventType == PointerEventTypeScroll {
+		change.ScrollDelta = geometry.NewOffset(e.Scroll.X, e.Scroll.Y)
+	}
+	changes = append(changes, change)
+
+	state.position = position
+	state.pressed = pressed
+	state.uptime = e.Time
+	if !pressed && (eventType == PointerEventTypeRelease || eventType == PointerEventTypeExit || state.pointerType == PointerTypeTouch) {
+		s.forget(e.PointerID)
+	}
+
+	event := &PointerEvent{
+		Type:              eventType,
+		Changes:           changes,
+		Buttons:           e.Buttons,
+		KeyboardModifiers: e.Modifiers,
+		Time:              now,
+	}
+	for _, handler := range s.eventHandlers {
+		handler(event)
+	}
+}
+
+func (s *pointerInputScope) forget(id PointerID) {
+	delete(s.states, id)
+	for i, p := range s.pointers {
+		if p == id {
+			s.pointers = append(s.pointers[:i], s.pointers[i+1:]...)
+			break
+		}
+	}
+}
+
+func (s *pointerInputScope) cancel() {
+	s.pointers = s.pointers[:0]
+	clear(s.states)
+	for _, handler := range s.cancelHandlers {
+		handler()
+	}
+}
+
+// dispose cancels the scope for good, once its element left the window.
+func (s *pointerInputScope) dispose() {
+	if s.disposed {
+		return
+	}
+	s.disposed = true
+	s.cancel()
+}
+
+func (s *pointerInputScope) frame(now time.Time) {
+	for _, handler := range s.frameHandlers {
+		handler(now)
+	}
+}
+
+func eventTypeOf(kind gioPointer.Kind) PointerEventType {
+	switch kind {
+	case gioPointer.Press:
+		return PointerEventTypePress
+	case gioPointer.Release:
+		return PointerEventTypeRelease
+	case gioPointer.Move, gioPointer.Drag:
+		return PointerEventTypeMove
+	case gioPointer.Enter:
+		return PointerEventTypeEnter
+	case gioPointer.Leave:
+		return PointerEventTypeExit
+	case gioPointer.Scroll:
+		return PointerEventTypeScroll
+	default:
+		return PointerEventTypeUnknown
+	}
+}
+
+func pointerTypeOf(source gioPointer.Source) PointerType {
+	switch source {
+	case gioPointer.Mouse:
+		return PointerTypeMouse
+	case gioPointer.Touch:
+		return PointerTypeTouch
+	default:
+		return PointerTypeUnknown
+	}
+}
@@ -0,0 +1,120 @@
+package pointer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+
+	"gioui.org/f32"
+	gioPointer "gioui.org/io/pointer"
+)
+
+func TestPointerInputScope_MultiTouch(t *testing.T) {
+	s := newPointerInputScope(DefaultViewConfiguration())
+	var events []*PointerEvent
+	s.OnPointerEvent(func(e *PointerEvent) { events = append(events, e) })
+
+	now := time.Now()
+	touch := func(kind gioPointer.Kind, id PointerID, x, y float32) {
+		s.dispatch(gioPointer.Event{Kind: kind, Source: gioPointer.Touch, PointerID: id, Position: f32.Pt(x, y)}, now)
+	}
+	touch(gioPointer.Press, 1, 10, 10)
+	touch(gioPointer.Press, 2, 50, 50)
+	touch(gioPointer.Drag, 1, 20, 10)
+
+	last := events[len(events)-1]
+	if last.Type != PointerEventTypeMove || len(last.Changes) != 2 {
+		t.Fatalf("a move with two fingers down should report both, got %s", last)
+	}
+	moved := last.ChangedPointer()
+	if moved.ID != 1 || moved.PositionChange().X() != 10 {
+		t.Errorf("unexpected change for the moving finger: %s", moved)
+	}
+	if other, ok := last.Change(2); !ok || other.PositionChange().GetDistance() != 0 || !other.Pressed {
+		t.Errorf("the still finger should be reported unchanged")
+	}
+
+	touch(gioPointer.Release, 1, 20, 10)
+	if up := events[len(events)-1].ChangedPointer(); !up.ChangedToUp() {
+		t.Errorf("release should change the pointer to up")
+	}
+	touch(gioPointer.Drag, 2, 60, 50)
+	if n := len(events[len(events)-1].Changes); n != 1 {
+		t.Errorf("released pointers should no longer be reported, got %d changes", n)
+	}
+}
+
+func TestPointerInputScope_Cancel(t *testing.T) {
+	s := newPointerInputScope(DefaultViewConfiguration())
+	canceled := false
+	s.OnCancel(func() { canceled = true })
+
+	s.dispatch(gioPointer.Event{Kind: gioPointer.Press, Source: gioPointer.Touch, PointerID: 1}, time.Now())
+	s.dispatch(gioPointer.Event{Kind: gioPointer.Cancel}, time.Now())
+
+	if !canceled || len(s.pointers) != 0 {
+		t.Error("cancel should notify handlers and forget the pointers")
+	}
+}
+
+func TestKeysEqual(t *testing.T) {
+	if !keyEqual("a", "a") || keyEqual("a", "b") || keyEqual(1, "1") {
+		t.Error("comparable keys should compare by value")
+	}
+	if keyEqual([]int{1}, []int{1}) {
+		t.Error("uncomparable keys should never be equal")
+	}
+	if !keyEqual(nil, nil) {
+		t.Error("nil keys should be equal")
+	}
+	if !keysEqual([]any{"a", 1}, []any{"a", 1}) || keysEqual([]any{"a", 1}, []any{"a", 2}) || keysEqual([]any{"a"}, []any{"a", 1}) {
+		t.Error("keys should compare key by key")
+	}
+}
+
+func TestVelocityTracker(t *testing.T) {
+	var v VelocityTracker
+	for i := 0; i <= 5; i++ {
+		// 10px every 10ms is 1000px/s.
+		v.AddPosition(time.Duration(i)*10*time.Millisecond, geometry.NewOffset(float32(i*10), 0))
+	}
+	velocity := v.CalculateVelocity()
+	if velocity.X() < 999 || velocity.X() > 1001 || velocity.Y() != 0 {
+		t.Errorf("expected a velocity of (1000, 0), got %s", velocity)
+	}
+
+	// A long pause before the last sample means the pointer stopped.
+	v.AddPosition(500*time.Millisecond, geometry.NewOffset(50, 0))
+	if velocity := v.CalculateVelocity(); velocity.X() != 0 {
+		t.Errorf("expected no velocity after a pause, got %s", velocity)
+	}
+}
+
+func TestPointerInputScope_LeaveWhilePressed(t *testing.T) {
+	s := newPointerInputScope(DefaultViewConfiguration())
+	var changes []*PointerInputChange
+	s.OnPointerEvent(func(e *PointerEvent) { changes = append(changes, e.ChangedPointer()) })
+
+	now := time.Now()
+	mouse := func(kind gioPointer.Kind, x float32) {
+		s.dispatch(gioPointer.Event{Kind: kind, Source: gioPointer.Mouse, Buttons: gioPointer.ButtonPrimary, Position: f32.Pt(x, 10)}, now)
+	}
+	mouse(gioPointer.Press, 10)
+	mouse(gioPointer.Drag, 20)
+	mouse(gioPointer.Leave, 120)
+	mouse(gioPointer.Drag, 130)
+
+	leave, drag := changes[2], changes[3]
+	if leave.ChangedToUp() || !leave.Pressed {
+		t.Errorf("leaving while pressed should keep the pointer pressed: %s", leave)
+	}
+	if drag.ChangedToDown() || !drag.Pressed || drag.PositionChange().X() != 10 {
+		t.Errorf("the drag outside should continue the gesture: %s", drag)
+	}
+
+	mouse(gioPointer.Release, 130)
+	if up := changes[len(changes)-1]; !up.ChangedToUp() {
+		t.Errorf("release should change the pointer to up: %s", up)
+	}
+}
@@ -0,0 +1,91 @@
+package pointer
+
+import (
+	"time"
+
+	"github.com/zodimo/go-compose/compose/ui/geometry"
+)
+
+const (
+	// velocityHorizon is how far back samples are used to estimate velocity.
+	velocityHorizon = 100 * time.Millisecond
+	// velocityAssumePointerStopped is the gap after which the pointer is
+	// considered to have stopped before the last sample.
+	velocityAssumePointerStopped = 40 * time.Millisecond
+	velocityHistorySize          = 20
+)
+
+type velocitySample struct {
+	uptime   time.Duration
+	position geometry.Offset
+}
+
+// VelocityTracker estimates the velocity of a pointer from its recent
+// positions, using a least squares fit over the last 100ms.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/ui/ui/src/commonMain/kotlin/androidx/compose/ui/input/pointer/util/VelocityTracker.kt
+type VelocityTracker struct {
+	samples []velocitySample
+}
+
+// AddPosition records the position of the pointer at uptime.
+func (v *VelocityTracker) AddPosition(uptime time.Duration, position geometry.Offset) {
+	if len(v.samples) == velocityHistorySize {
+		copy(v.samples, v.samples[1:])
+		v.samples = v.samples[:velocityHistorySize-1]
+	}
+	v.samples = append(v.samples, velocitySample{uptime: uptime, position: position})
+}
+
+// AddPointerInputChange records the position of change.
+func (v *VelocityTracker) AddPointerInputChange(change *PointerInputChange) {
+	v.AddPosition(change.Uptime, change.Position)
+}
+
+// CalculateVelocity returns the velocity in pixels per second.
+func (v *VelocityTracker) CalculateVelocity() geometry.Offset {
+	n := len(v.samples)
+	if n < 2 {
+		return geometry.OffsetZero
+	}
+	newest := v.samples[n-1]
+	var ts, xs, ys []float64
+	previous := newest.uptime
+	for i := n - 1; i >= 0; i-- {
+		s := v.samples[i]
+		age := newest.uptime - s.uptime
+		if age > velocityHorizon || previous-s.uptime > velocityAssumePointerStopped {
+			break
+		}
+		previous = s.uptime
+		ts = append(ts, -age.Seconds())
+		xs = append(xs, float64(s.position.X()))
+		ys = append(ys, float64(s.position.Y()))
+	}
+	if len(ts) < 2 {
+		return geometry.OffsetZero
+	}
+	return geometry.NewOffset(float32(slope(ts, xs)), float32(slope(ts, ys)))
+}
+
+// Reset clears the recorded positions.
+func (v *VelocityTracker) Reset() {
+	v.samples = v.samples[:0]
+}
+
+// slope returns the slope of the least squares line through (x, y).
+func slope(x, y []float64) float64 {
+	n := float64(len(x))
+	var sx, sy, sxx, sxy float64
+	for i := range x {
+		sx += x[i]
+		sy += y[i]
+		sxx += x[i] * x[i]
+		sxy += x[i] * y[i]
+	}
+	d := n*sxx - sx*sx
+	if d == 0 {
+		return 0
+	}
+	return (n*sxy - sx*sy) / d
+}
@@ -0,0 +1,36 @@
+package pointer
+
+import (
+	"time"
+
+	"github.com/zodimo/go-compose/compose/ui/unit"
+)
+
+// ViewConfiguration holds the thresholds used by gesture detectors.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/ui/ui/src/commonMain/kotlin/androidx/compose/ui/platform/ViewConfiguration.kt
+type ViewConfiguration struct {
+	// LongPressTimeout is how long a pointer must stay down to trigger a long press.
+	LongPressTimeout time.Duration
+	// DoubleTapTimeout is the maximum time between the first release and the
+	// second press of a double tap.
+	DoubleTapTimeout time.Duration
+	// DoubleTapMinTime is the minimum time between the first release and the
+	// second press of a double tap. Faster taps are treated as a bounce.
+	DoubleTapMinTime time.Duration
+	// TouchSlop is the distance a pointer can move before it is considered a drag.
+	TouchSlop unit.Dp
+	// DoubleTapSlop is the maximum distance between the two taps of a double tap.
+	DoubleTapSlop unit.Dp
+}
+
+// DefaultViewConfiguration returns the Android default thresholds.
+func DefaultViewConfiguration() ViewConfiguration {
+	return ViewConfiguration{
+		LongPressTimeout: 400 * time.Millisecond,
+		DoubleTapTimeout: 300 * time.Millisecond,
+		DoubleTapMinTime: 40 * time.Millisecond,
+		TouchSlop:        unit.Dp(8),
+		DoubleTapSlop:    unit.Dp(100),
+	}
+}
@@ -2,6 +2,7 @@ package shadow
 
 import (
 	"github.com/zodimo/go-compose/compose/ui/graphics"
+	"github.com/zodimo/go-compose/compose/ui/graphics/shape"
 	"github.com/zodimo/go-compose/compose/ui/unit"
 	node "github.com/zodimo/go-compose/internal/Node"
 	"github.com/zodimo/go-compose/internal/layoutnode"
@@ -68,7 +69,7 @@ func NewShadowNode(element ShadowElement) *ShadowNode {
 
 					// Create Outline for the shape
 					// We need the outline path.
-					outline := n.shadowData.Shape.CreateOutline(dims.Size, gtx.Metric)
+					outline := shape.CreateOutline(n.shadowData.Shape, dims.Size, gtx.Metric, unit.LayoutDirectionFromTextDirection(gtx.Locale.Direction))
 
 					// Draw base layer
 					baseMacro := op.Record(gtx.Ops)
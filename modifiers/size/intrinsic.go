@@ -0,0 +1,180 @@
+package size
+
+import (
+	"github.com/zodimo/go-compose/compose/ui"
+	uilayout "github.com/zodimo/go-compose/compose/ui/layout"
+	node "github.com/zodimo/go-compose/internal/Node"
+	"github.com/zodimo/go-compose/internal/layoutnode"
+	"github.com/zodimo/go-compose/internal/modifier"
+
+	"gioui.org/layout"
+)
+
+// IntrinsicSize selects the intrinsic measurement an element is sized to.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/foundation/foundation-layout/src/commonMain/kotlin/androidx/compose/foundation/layout/Intrinsic.kt
+type IntrinsicSize int
+
+const (
+	// IntrinsicSizeMin sizes the element to the minimum intrinsic size of
+	// its content.
+	IntrinsicSizeMin IntrinsicSize = iota
+	// IntrinsicSizeMax sizes the element to the maximum intrinsic size of
+	// its content.
+	IntrinsicSizeMax
+)
+
+func (s IntrinsicSize) String() string {
+	switch s {
+	case IntrinsicSizeMin:
+		return "IntrinsicSizeMin"
+	case IntrinsicSizeMax:
+		return "IntrinsicSizeMax"
+	default:
+		return "IntrinsicSize(unknown)"
+	}
+}
+
+// IntrinsicWidth sets the width of the element to the minimum or maximum
+// intrinsic width of its content, within the incoming constraints unless
+// SizeRequired is given. Use it to make the children of a Column as wide as
+// the widest of them:
+//
+//	column.Column(content, column.WithModifier(size.IntrinsicWidth(size.IntrinsicSizeMax)))
+//
+// with the children filling the width of the column.
+func IntrinsicWidth(intrinsicSize IntrinsicSize, options ...SizeOption) ui.Modifier {
+	return intrinsicSizeModifier("intrinsicWidth", IntrinsicSizeData{Width: true, Size: intrinsicSize}, options)
+}
+
+// IntrinsicHeight sets the height of the element to the minimum or maximum
+// intrinsic height of its content, within the incoming constraints unless
+// SizeRequired is given. Use it to make a divider in a Row as tall as the
+// tallest sibling:
+//
+//	row.Row(content, row.WithModifier(size.IntrinsicHeight(size.IntrinsicSizeMin)))
+//
+// with the divider filling the height of the row.
+func IntrinsicHeight(intrinsicSize IntrinsicSize, options ...SizeOption) ui.Modifier {
+	return intrinsicSizeModifier("intrinsicHeight", IntrinsicSizeData{Width: false, Size: intrinsicSize}, options)
+}
+
+func intrinsicSizeModifier(name string, data IntrinsicSizeData, options []SizeOption) ui.Modifier {
+	opt := DefaultSizeOptions()
+	for _, option := range options {
+		if option == nil {
+			continue
+		}
+		option(&opt)
+	}
+	data.Required = opt.Required
+
+	return modifier.NewInspectableModifier(
+		modifier.NewModifier(
+			&IntrinsicSizeElement{
+				data: data,
+			},
+		),
+		modifier.NewInspectorInfo(
+			name,
+			map[string]any{
+				"intrinsicSize": data.Size,
+				"options":       opt,
+			},
+		),
+	)
+}
+
+type IntrinsicSizeData struct {
+	// Width is set for IntrinsicWidth, and unset for IntrinsicHeight.
+	Width    bool
+	Size     IntrinsicSize
+	Required bool
+}
+
+// intrinsic returns the measurement of the content the element is sized to.
+func (d IntrinsicSizeData) intrinsic() uilayout.Intrinsic {
+	switch {
+	case d.Width && d.Size == IntrinsicSizeMin:
+		return uilayout.IntrinsicMinWidth
+	case d.Width:
+		return uilayout.IntrinsicMaxWidth
+	case d.Size == IntrinsicSizeMin:
+		return uilayout.IntrinsicMinHeight
+	default:
+		return uilayout.IntrinsicMaxHeight
+	}
+}
+
+var _ Element = (*IntrinsicSizeElement)(nil)
+
+type IntrinsicSizeElement struct {
+	data IntrinsicSizeData
+}
+
+func (e *IntrinsicSizeElement) Create() Node {
+	return NewIntrinsicSizeNode(e.data)
+}
+
+func (e *IntrinsicSizeElement) Update(n Node) {
+	n.(*IntrinsicSizeNode).data = e.data
+}
+
+func (e *IntrinsicSizeElement) Equals(other Element) bool {
+	o, ok := other.(*IntrinsicSizeElement)
+	return ok && o.data == e.data
+}
+
+type IntrinsicSizeNode struct {
+	ChainNode
+	data IntrinsicSizeData
+}
+
+func NewIntrinsicSizeNode(data IntrinsicSizeData) *IntrinsicSizeNode {
+	n := &IntrinsicSizeNode{
+		data: data,
+	}
+	n.ChainNode = node.NewChainNode(
+		node.NewNodeID(),
+		node.NodeKindLayout,
+		node.LayoutPhase,
+		func(t TreeNode) {
+			no := t.(LayoutModifierNode)
+			no.AttachLayoutModifier(func(widget LayoutWidget) LayoutWidget {
+				return layoutnode.NewLayoutWidget(func(gtx LayoutContext) layoutnode.LayoutDimensions {
+					return layoutIntrinsicSize(gtx, n.data, widget.Layout)
+				})
+			})
+		},
+	)
+	return n
+}
+
+func layoutIntrinsicSize(gtx layout.Context, data IntrinsicSizeData, widget layout.Widget) layout.Dimensions {
+	intrinsic := data.intrinsic()
+	if q, ok := uilayout.IntrinsicQueryOf(gtx); ok {
+		// On its own axis the element measures as it is sized, whatever the
+		// query; on the other one it measures as its content.
+		if q.Intrinsic.IsWidth() == data.Width {
+			return q.Dimensions(uilayout.MeasureIntrinsic(gtx, widget, intrinsic, q.Size))
+		}
+		return widget(gtx)
+	}
+
+	c := gtx.Constraints
+	if data.Width {
+		width := uilayout.MeasureIntrinsic(gtx, widget, intrinsic, c.Max.Y)
+		if !data.Required {
+			width = Clamp(width, c.Min.X, c.Max.X)
+		}
+		c.Min.X, c.Max.X = width, width
+	} else {
+		height := uilayout.MeasureIntrinsic(gtx, widget, intrinsic, c.Max.X)
+		if !data.Required {
+			height = Clamp(height, c.Min.Y, c.Max.Y)
+		}
+		c.Min.Y, c.Max.Y = height, height
+	}
+	gtx.Constraints = c
+	return widget(gtx)
+}
@@ -0,0 +1,64 @@
+package size
+
+import (
+	"image"
+	"testing"
+
+	uilayout "github.com/zodimo/go-compose/compose/ui/layout"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+)
+
+// wrappingText is laid out like a text of words of 10 by 10 pixels, which
+// wraps between words.
+func wrappingText(words int) layout.Widget {
+	return func(gtx layout.Context) layout.Dimensions {
+		if q, ok := uilayout.IntrinsicQueryOf(gtx); ok && q.Intrinsic == uilayout.IntrinsicMinWidth {
+			return q.Dimensions(10)
+		}
+		perLine := max(min(words, gtx.Constraints.Max.X/10), 1)
+		lines := (words + perLine - 1) / perLine
+		return layout.Dimensions{Size: gtx.Constraints.Constrain(image.Pt(perLine*10, lines*10))}
+	}
+}
+
+func TestLayoutIntrinsicSize(t *testing.T) {
+	gtx := layout.Context{
+		Ops:         new(op.Ops),
+		Constraints: layout.Constraints{Max: image.Pt(100, 100)},
+	}
+	var constraints layout.Constraints
+	content := func(gtx layout.Context) layout.Dimensions {
+		if _, ok := uilayout.IntrinsicQueryOf(gtx); !ok {
+			constraints = gtx.Constraints
+		}
+		return wrappingText(4)(gtx)
+	}
+
+	layoutIntrinsicSize(gtx, IntrinsicSizeData{Width: true, Size: IntrinsicSizeMin}, content)
+	if want := (layout.Constraints{Min: image.Pt(10, 0), Max: image.Pt(10, 100)}); constraints != want {
+		t.Errorf("IntrinsicWidth(Min) constraints = %v, want %v", constraints, want)
+	}
+	layoutIntrinsicSize(gtx, IntrinsicSizeData{Width: true, Size: IntrinsicSizeMax}, content)
+	if want := (layout.Constraints{Min: image.Pt(40, 0), Max: image.Pt(40, 100)}); constraints != want {
+		t.Errorf("IntrinsicWidth(Max) constraints = %v, want %v", constraints, want)
+	}
+
+	gtx.Constraints.Max.X = 20
+	dims := layoutIntrinsicSize(gtx, IntrinsicSizeData{Width: false, Size: IntrinsicSizeMin}, content)
+	if want := image.Pt(20, 20); dims.Size != want {
+		t.Errorf("IntrinsicHeight(Min) size = %v, want %v", dims.Size, want)
+	}
+
+	// Without SizeRequired the size stays within the constraints.
+	gtx.Constraints.Max.Y = 15
+	dims = layoutIntrinsicSize(gtx, IntrinsicSizeData{Width: false, Size: IntrinsicSizeMin}, content)
+	if dims.Size.Y != 15 {
+		t.Errorf("IntrinsicHeight(Min) height = %d, want 15", dims.Size.Y)
+	}
+	dims = layoutIntrinsicSize(gtx, IntrinsicSizeData{Width: false, Size: IntrinsicSizeMin, Required: true}, content)
+	if dims.Size.Y != 20 {
+		t.Errorf("required IntrinsicHeight(Min) height = %d, want 20", dims.Size.Y)
+	}
+}
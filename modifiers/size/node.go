@@ -3,6 +3,7 @@ package size
 import (
 	"image"
 
+	"github.com/zodimo/go-compose/compose/ui/unit"
 	node "github.com/zodimo/go-compose/internal/Node"
 	"github.com/zodimo/go-compose/internal/layoutnode"
 
@@ -57,7 +58,7 @@ func NewSizeNode(sizeData SizeData) ChainNode {
 							if sizeData.Width != NotSet {
 								// Fixed width overrides child measurement
 								mySize.X = sizeData.Width
-							} else if sizeData.FillMaxWidth || sizeData.FillMax {
+							} else if (sizeData.FillMaxWidth || sizeData.FillMax) && hasBoundedWidth(gtx.Constraints) {
 								// Fill behavior uses max constraints
 								mySize.X = gtx.Constraints.Max.X
 							} else {
@@ -70,7 +71,7 @@ func NewSizeNode(sizeData SizeData) ChainNode {
 							// Handle Height overrides
 							if sizeData.Height != NotSet {
 								mySize.Y = sizeData.Height
-							} else if sizeData.FillMaxHeight || sizeData.FillMax {
+							} else if (sizeData.FillMaxHeight || sizeData.FillMax) && hasBoundedHeight(gtx.Constraints) {
 								mySize.Y = gtx.Constraints.Max.Y
 							} else {
 								mySize.Y = Clamp(mySize.Y, gtx.Constraints.Min.Y, gtx.Constraints.Max.Y)
@@ -201,16 +202,12 @@ func ApplySizeDataToConstraints(constraints layout.Constraints, sizeData SizeDat
 		}
 	}
 
-	// Fill Logic
-	if sizeData.FillMaxWidth {
+	// Fill Logic, which has no effect on unbounded axes, such as the ones of
+	// an intrinsic measurement.
+	if (sizeData.FillMaxWidth || sizeData.FillMax) && hasBoundedWidth(c) {
 		c.Min.X = c.Max.X
 	}
-	if sizeData.FillMaxHeight {
-		c.Min.Y = c.Max.Y
-	}
-
-	if sizeData.FillMax {
-		c.Min.X = c.Max.X
+	if (sizeData.FillMaxHeight || sizeData.FillMax) && hasBoundedHeight(c) {
 		c.Min.Y = c.Max.Y
 	}
 
@@ -239,3 +236,11 @@ func ApplySizeDataToConstraints(constraints layout.Constraints, sizeData SizeDat
 
 	return c
 }
+
+func hasBoundedWidth(c layout.Constraints) bool {
+	return c.Max.X < unit.GioInfinity
+}
+
+func hasBoundedHeight(c layout.Constraints) bool {
+	return c.Max.Y < unit.GioInfinity
+}
@@ -53,6 +53,21 @@ type Composer interface {
 	// Control Flow
 	Key(key any, content Composable) Composable
 	Range(count int, fn func(int) Composable) Composable
+
+	// NewSubcomposition returns a Subcomposition with the composition locals
+	// of the current position, to compose content apart from this composition.
+	NewSubcomposition() Subcomposition
+}
+
+// Subcomposition composes content apart from the composition it was created
+// in, such as during layout, where the content depends on measurements.
+//
+// https://cs.android.com/androidx/platform/frameworks/support/+/androidx-main:compose/runtime/runtime/src/commonMain/kotlin/androidx/compose/runtime/Composition.kt
+type Subcomposition interface {
+	// Compose composes content in the slot slotId and returns the root of its
+	// tree, whose children are the nodes content emitted. The state of the
+	// content is kept by slot from one composition to the next.
+	Compose(slotId any, content Composable) LayoutNode
 }
 
 type LayoutNode = layoutnode.LayoutNode
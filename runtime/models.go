@@ -3,7 +3,10 @@ package runtime
 import (
 	"image"
 
+	"github.com/zodimo/go-compose/compose/ui/layout"
 	"github.com/zodimo/go-compose/internal/layoutnode"
+	"github.com/zodimo/go-compose/modifiers/focus"
+	"github.com/zodimo/go-compose/modifiers/pointer"
 
 	"gioui.org/op"
 )
@@ -16,9 +19,25 @@ type runtime struct {
 func (r *runtime) Run(gtx LayoutContext, node LayoutNode) op.CallOp {
 
 	gtx.Constraints.Min = image.Point{X: 0, Y: 0}
+	// The focus owner collects the focus targets of the frame and applies
+	// focus changes requested while laying it out.
+	focusOwner := focus.Owner(node)
+	gtx = focusOwner.BeginFrame(gtx)
+	defer focusOwner.EndFrame(gtx)
+
+	// The layout pass is recorded into the operations of the coordinates
+	// owner, which reports the sizes and positions of the elements observing
+	// them once the frame is laid out. The frame itself is drawn by the draw
+	// pass.
+	coordinatesOwner := layout.Owner(node)
+
 	nodeCoordinator := layoutnode.NewNodeCoordinator(node)
 
-	nodeCoordinator.Layout(gtx)
+	nodeCoordinator.Layout(coordinatesOwner.BeginFrame(gtx))
 	nodeCoordinator.PointerPhase(gtx)
-	return nodeCoordinator.Draw(gtx)
+	call := nodeCoordinator.Draw(gtx)
+	coordinatesOwner.EndFrame()
+	// The pointer input of the elements that left the window is cancelled.
+	pointer.Owner(node).EndFrame()
+	return call
 }
@@ -3,5 +3,8 @@ package runtime
 import "gioui.org/op"
 
 type Runtime interface {
+	// Run lays out, handles the input of and records the drawing of node.
+	// The drawing is returned rather than added, for the caller to add it to
+	// the operations of the frame.
 	Run(LayoutContext, LayoutNode) op.CallOp
 }
@@ -0,0 +1,141 @@
+package runtime_test
+
+import (
+	"image"
+	"testing"
+
+	"github.com/zodimo/go-compose/compose"
+	"github.com/zodimo/go-compose/compose/foundation/layout/box"
+	uilayout "github.com/zodimo/go-compose/compose/ui/layout"
+	"github.com/zodimo/go-compose/compose/ui/platform"
+	"github.com/zodimo/go-compose/compose/ui/unit"
+	"github.com/zodimo/go-compose/modifiers/focus"
+	"github.com/zodimo/go-compose/modifiers/pointer"
+	"github.com/zodimo/go-compose/modifiers/size"
+	"github.com/zodimo/go-compose/pkg/api"
+	"github.com/zodimo/go-compose/runtime"
+	"github.com/zodimo/go-compose/state"
+	"github.com/zodimo/go-compose/store"
+
+	"gioui.org/io/input"
+	"gioui.org/layout"
+	"gioui.org/op"
+	gioUnit "gioui.org/unit"
+)
+
+func TestRun_FocusWithPositionObserver(t *testing.T) {
+	s := store.NewPersistentState(map[string]state.MutableValue{})
+	var router input.Router
+	requester := focus.NewFocusRequester()
+	var states []focus.FocusState
+	positioned := 0
+	frame := func() {
+		node := box.Box(compose.Sequence(), box.WithModifier(
+			focus.Requester(requester).
+				Then(focus.OnFocusChanged(func(state focus.FocusState) { states = append(states, state) })).
+				Then(focus.Focusable()).
+				Then(uilayout.OnGloballyPositioned(func(uilayout.LayoutCoordinates) { positioned++ })).
+				Then(size.Size(20, 20)),
+		))(compose.NewComposer(s)).Build()
+		gtx := layout.Context{
+			Ops:         new(op.Ops),
+			Source:      router.Source(),
+			Constraints: layout.Constraints{Max: image.Pt(100, 100)},
+			Metric:      gioUnit.Metric{PxPerDp: 1, PxPerSp: 1},
+		}
+		runtime.NewRuntime().Run(gtx, node).Add(gtx.Ops)
+		router.Frame(gtx.Ops)
+	}
+
+	frame()
+	if !requester.RequestFocus() {
+		t.Fatal("RequestFocus should succeed on an attached requester")
+	}
+	for range 5 {
+		frame()
+	}
+	if len(states) == 0 || !states[len(states)-1].IsFocused {
+		t.Fatalf("the box should be focused, got %+v", states)
+	}
+	if len(states) != 2 {
+		t.Errorf("OnFocusChanged should only be called on changes, got %+v", states)
+	}
+	if positioned != 1 {
+		t.Errorf("OnGloballyPositioned should be called once, got %d calls", positioned)
+	}
+}
+
+func TestRun_PointerInputKeys(t *testing.T) {
+	s := store.NewPersistentState(map[string]state.MutableValue{})
+	var router input.Router
+	// cancels counts the cancellations of each run of the block.
+	var cancels []int
+	block := func(scope pointer.PointerInputScope) {
+		run := len(cancels)
+		cancels = append(cancels, 0)
+		scope.OnCancel(func() { cancels[run]++ })
+	}
+	frame := func(shown bool, key int) {
+		target := box.Box(compose.Sequence(), box.WithModifier(
+			pointer.PointerInput(block, key).Then(size.Size(20, 20)),
+		))
+		node := box.Box(func(c api.Composer) api.Composer {
+			return c.When(shown, target)(c)
+		})(compose.NewComposer(s)).Build()
+		gtx := layout.Context{
+			Ops:         new(op.Ops),
+			Source:      router.Source(),
+			Constraints: layout.Constraints{Max: image.Pt(100, 100)},
+			Metric:      gioUnit.Metric{PxPerDp: 1, PxPerSp: 1},
+		}
+		runtime.NewRuntime().Run(gtx, node).Add(gtx.Ops)
+		router.Frame(gtx.Ops)
+	}
+
+	frame(true, 1)
+	frame(true, 1)
+	if len(cancels) != 1 {
+		t.Fatalf("the block should run once while its keys are unchanged, got %d runs", len(cancels))
+	}
+	// The router cancels new handlers, so only the cancellations after the
+	// first frames are counted.
+	before := cancels[0]
+	frame(true, 2)
+	if len(cancels) != 2 || cancels[0] != before+1 {
+		t.Fatalf("a key change should cancel the block and run it again, got %d runs and %v cancels", len(cancels), cancels)
+	}
+	frame(true, 2)
+	before = cancels[1]
+	frame(false, 2)
+	if cancels[1] != before+1 {
+		t.Fatalf("the block should be cancelled once its element leaves, got %v cancels", cancels)
+	}
+	frame(true, 2)
+	if len(cancels) != 3 || cancels[1] != before+1 {
+		t.Errorf("the block should run again once its element comes back, got %d runs and %v cancels", len(cancels), cancels)
+	}
+}
+
+func TestRun_LayoutModifierDirection(t *testing.T) {
+	for _, want := range []unit.LayoutDirection{unit.LayoutDirectionLtr, unit.LayoutDirectionRtl} {
+		var got unit.LayoutDirection
+		direction := uilayout.LayoutModifier(func(scope uilayout.MeasureScope, measurable uilayout.Measurable, constraints unit.Constraints) uilayout.MeasureResult {
+			got = scope.LayoutDirection()
+			p := measurable.Measure(constraints)
+			return scope.Layout(p.Width(), p.Height(), func() { p.PlaceRelative(0, 0) })
+		})
+		c := compose.NewComposer(store.NewPersistentState(map[string]state.MutableValue{}))
+		node := compose.CompositionLocalProvider1(platform.LocalLayoutDirection, want,
+			box.Box(compose.Sequence(), box.WithModifier(direction.Then(size.Size(20, 20)))),
+		)(c).Build()
+		gtx := layout.Context{
+			Ops:         new(op.Ops),
+			Constraints: layout.Constraints{Max: image.Pt(100, 100)},
+			Metric:      gioUnit.Metric{PxPerDp: 1, PxPerSp: 1},
+		}
+		runtime.NewRuntime().Run(gtx, node).Add(gtx.Ops)
+		if got != want {
+			t.Errorf("LayoutModifier measured with %v, want the provided %v", got, want)
+		}
+	}
+}